@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"go-futsal-booking-api/cmd/router"
+	"go-futsal-booking-api/internal/domain"
 	"go-futsal-booking-api/internal/handler"
 	"go-futsal-booking-api/internal/middleware"
 	"go-futsal-booking-api/internal/repository"
@@ -16,10 +17,13 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
 	_ "go-futsal-booking-api/docs"
+	// The runtime image ships without zoneinfo, which venue timezones need.
+	_ "time/tzdata"
 
 	"github.com/labstack/echo/v4"
 	echomiddleware "github.com/labstack/echo/v4/middleware"
@@ -55,46 +59,242 @@ func main() {
 
 	logger.Info("Database connected successfully")
 
-	// Init notification from mailjet
-	mailjetEmail := repository.NewMailjetRepository(
-		repository.MailjetConfig{
+	// Init notification backend
+	notifRepo, err := repository.NewNotificationRepository(repository.NotificationConfig{
+		Driver:      cfg.Notification.Driver,
+		SenderEmail: cfg.Notification.SenderEmail,
+		SenderName:  cfg.Notification.SenderName,
+		FileDir:     cfg.Notification.FileDir,
+		Mailjet: repository.MailjetConfig{
 			MailjetBaseURL:           cfg.Mailjet.MailjetBaseUrl,
 			MailjetBasicAuthUsername: cfg.Mailjet.MailjetBasicAuthUsername,
 			MailjetBasicAuthPassword: cfg.Mailjet.MailjetBasicAuthPassword,
 			MailjetSenderEmail:       cfg.Mailjet.MailjetSenderEmail,
 			MailjetSenderName:        cfg.Mailjet.MailjetSenderName,
 		},
-	)
+		SMTP: repository.SMTPConfig{
+			Host:     cfg.Notification.SMTP.Host,
+			Port:     cfg.Notification.SMTP.Port,
+			Username: cfg.Notification.SMTP.Username,
+			Password: cfg.Notification.SMTP.Password,
+			TLSMode:  cfg.Notification.SMTP.TLSMode,
+			Timeout:  cfg.Notification.SMTP.Timeout,
+		},
+	})
+	if err != nil {
+		logger.Fatal("Failed to init notification backend", "error", err)
+	}
+
+	logger.Info("Notification backend initialized", "driver", cfg.Notification.Driver)
+
+	textMessageRepo, err := repository.NewTextMessageRepository(repository.TextMessageConfig{
+		Driver: cfg.TextMessage.Driver,
+		HTTP: repository.TextMessageHTTPConfig{
+			BaseURL: cfg.TextMessage.BaseURL,
+			APIKey:  cfg.TextMessage.APIKey,
+			Sender:  cfg.TextMessage.Sender,
+			Timeout: cfg.TextMessage.Timeout,
+		},
+	})
+	if err != nil {
+		logger.Fatal("Failed to init text message backend", "error", err)
+	}
+
+	logger.Info("Text message backend initialized", "driver", cfg.TextMessage.Driver)
+
+	blobStorage, err := repository.NewBlobStorage(repository.BlobStorageConfig{
+		Driver:        cfg.Storage.Driver,
+		PublicBaseURL: cfg.Storage.PublicBaseURL,
+		LocalDir:      cfg.Storage.LocalDir,
+		S3: repository.S3Config{
+			Endpoint:        cfg.Storage.S3.Endpoint,
+			Region:          cfg.Storage.S3.Region,
+			Bucket:          cfg.Storage.S3.Bucket,
+			AccessKeyID:     cfg.Storage.S3.AccessKeyID,
+			SecretAccessKey: cfg.Storage.S3.SecretAccessKey,
+			Timeout:         cfg.Storage.S3.Timeout,
+		},
+	})
+	if err != nil {
+		logger.Fatal("Failed to init blob storage", "error", err)
+	}
+
+	logger.Info("Blob storage initialized", "driver", cfg.Storage.Driver)
 
 	// Init validate
 	validate := validator.New()
 
 	// Init repo
 	userRepo := repository.NewUserRepository(db)
+	roleRepo := repository.NewRoleRepository(db)
+	permissionRepo := repository.NewPermissionRepository(db)
 	fieldRepo := repository.NewFieldRepository(db)
+	fieldTypeRepo := repository.NewFieldTypeRepository(db)
 	venueRepo := repository.NewVenueRepository(db)
 	scheduleRepo := repository.NewScheduleRepository(db)
 	bookingRepo := repository.NewBookingRepository(db)
+	maintenanceRepo := repository.NewMaintenanceWindowRepository(db)
+	reviewRepo := repository.NewReviewRepository(db)
+	favouriteRepo := repository.NewFavouriteRepository(db)
+	savedSearchRepo := repository.NewSavedSearchRepository(db)
+	venueMemberRepo := repository.NewVenueMemberRepository(db)
+	loginAttemptRepo := repository.NewLoginAttemptRepository(db)
+	twoFactorRepo := repository.NewTwoFactorRepository(db)
+	oidcStateRepo := repository.NewOIDCStateRepository(db)
+	userIdentityRepo := repository.NewUserIdentityRepository(db)
+	outboxRepo := repository.NewOutboxRepository(db)
+	paymentRepo := repository.NewPaymentRepository(db)
+	preferenceRepo := repository.NewNotificationPreferenceRepository(db)
+	phoneVerificationRepo := repository.NewPhoneVerificationRepository(db)
+	webhookRepo := repository.NewWebhookRepository(db)
+	inboxRepo := repository.NewInboxRepository(db)
+	calendarRepo := repository.NewCalendarRepository(db)
+	amenityRepo := repository.NewAmenityRepository(db)
+	photoRepo := repository.NewPhotoRepository(db)
+	catalogRepo := repository.NewCatalogRepository(db)
+	txManager := repository.NewTransactionManager(db)
+
+	// Init single sign-on provider, left nil when no issuer is configured
+	var oidcProvider repository.OIDCProvider
+	if cfg.OIDC.IssuerURL != "" {
+		oidcProvider = repository.NewOIDCProvider(repository.OIDCConfig{
+			ProviderName: cfg.OIDC.ProviderName,
+			IssuerURL:    cfg.OIDC.IssuerURL,
+			ClientID:     cfg.OIDC.ClientID,
+			ClientSecret: cfg.OIDC.ClientSecret,
+			RedirectURL:  cfg.OIDC.RedirectURL,
+			Scopes:       cfg.OIDC.Scopes,
+		})
+	}
 
 	// Init service
-	userService := service.NewUserService(userRepo, validate, mailjetEmail, cfg.App.AppEmailVerificationKey, cfg.App.AppDeploymentUrl)
-	fieldService := service.NewFieldService(fieldRepo, venueRepo, scheduleRepo)
-	venueService := service.NewVenueService(venueRepo)
-	scheduleService := service.NewScheduleService(scheduleRepo, fieldRepo, bookingRepo)
-	bookingService := service.NewBookingService(bookingRepo, scheduleRepo, userRepo)
+	roleService := service.NewRoleService(roleRepo, permissionRepo, userRepo, txManager)
+	loginGuard := service.NewLoginGuard(loginAttemptRepo, service.LoginGuardConfig{
+		AccountFreeAttempts:  cfg.LoginThrottle.AccountFreeAttempts,
+		AccountLockThreshold: cfg.LoginThrottle.AccountLockThreshold,
+		IPFreeAttempts:       cfg.LoginThrottle.IPFreeAttempts,
+		IPLockThreshold:      cfg.LoginThrottle.IPLockThreshold,
+		BaseDelay:            cfg.LoginThrottle.BaseDelay,
+		MaxDelay:             cfg.LoginThrottle.MaxDelay,
+		LockDuration:         cfg.LoginThrottle.LockDuration,
+		Window:               cfg.LoginThrottle.Window,
+	})
+	emailTemplateService, err := service.NewEmailTemplateService(cfg.App.Name)
+	if err != nil {
+		logger.Fatal("Failed to load email templates", "error", err)
+	}
+	inboxService := service.NewInboxService(inboxRepo, emailTemplateService)
+	inboxRetentionJob := service.NewInboxRetentionJob(inboxRepo, service.InboxRetentionConfig{
+		RetainRead:   cfg.Inbox.RetainRead,
+		PollInterval: cfg.Inbox.CleanupInterval,
+		BatchSize:    cfg.Inbox.CleanupBatchSize,
+	})
+	twoFactorService := service.NewTwoFactorService(userRepo, twoFactorRepo, loginGuard, inboxService, cfg.App.AppTOTPEncryptionKey, cfg.App.Name)
+
+	outboxConfig := service.OutboxConfig{
+		MaxAttempts:  cfg.Outbox.MaxAttempts,
+		BaseDelay:    cfg.Outbox.BaseDelay,
+		MaxDelay:     cfg.Outbox.MaxDelay,
+		PollInterval: cfg.Outbox.PollInterval,
+		BatchSize:    cfg.Outbox.BatchSize,
+		Lease:        cfg.Outbox.Lease,
+	}
+	outboxService := service.NewOutboxService(outboxRepo, outboxConfig)
+
+	outboxDispatcher := service.NewOutboxDispatcher(outboxRepo, outboxConfig)
+	outboxDispatcher.Handle(domain.OutboxTopicEmail, service.NewEmailOutboxHandler(notifRepo))
+	outboxDispatcher.Handle(domain.OutboxTopicTextMessage, service.NewTextMessageOutboxHandler(textMessageRepo))
+	webhookConfig := service.WebhookConfig{
+		EncryptionKey: cfg.Webhook.EncryptionKey,
+		Timeout:       cfg.Webhook.Timeout,
+		MaxAttempts:   cfg.Outbox.MaxAttempts,
+		UserAgent:     cfg.App.Name + "-Webhooks/" + cfg.App.Version,
+		// Local receivers are usually plain http on localhost.
+		AllowInsecureTargets: cfg.App.Environment == "development",
+	}
+	outboxDispatcher.Handle(domain.OutboxTopicWebhook, service.NewWebhookOutboxHandler(webhookRepo, webhookConfig))
+	notificationRouter := service.NewNotificationRouter(outboxService, emailTemplateService, preferenceRepo, inboxService)
+	preferenceService := service.NewNotificationPreferenceService(preferenceRepo)
+	phoneVerificationService := service.NewPhoneVerificationService(userRepo, phoneVerificationRepo, txManager, outboxService, emailTemplateService, inboxService, cfg.App.Name, service.PhoneVerificationConfig{
+		Channel:        cfg.PhoneVerification.Channel,
+		CodeTTL:        cfg.PhoneVerification.CodeTTL,
+		ResendCooldown: cfg.PhoneVerification.ResendCooldown,
+		MaxAttempts:    cfg.PhoneVerification.MaxAttempts,
+	})
+
+	userService := service.NewUserService(userRepo, roleRepo, loginGuard, twoFactorService, validate, txManager, outboxService, emailTemplateService, inboxService, cfg.App.AppEmailVerificationKey, cfg.App.AppDeploymentUrl)
+	oidcService := service.NewOIDCService(oidcProvider, oidcStateRepo, userIdentityRepo, userRepo, roleRepo, userService)
+	authorizer := service.NewAuthorizer(userRepo, roleRepo, venueMemberRepo)
+	amenityService := service.NewAmenityService(amenityRepo)
+	photoService := service.NewPhotoService(photoRepo, venueRepo, fieldRepo, blobStorage, authorizer, service.PhotoConfig{
+		MaxBytes: cfg.Storage.MaxUploadBytes,
+	})
+	webhookService := service.NewWebhookService(webhookRepo, outboxService, authorizer, txManager, webhookConfig)
+	calendarService := service.NewCalendarService(calendarRepo, bookingRepo, service.CalendarConfig{
+		ProductID:    cfg.App.Name,
+		BaseURL:      cfg.App.AppDeploymentUrl,
+		FeedLookback: cfg.Calendar.FeedLookback,
+	})
+	checkInService := service.NewCheckInService(bookingRepo, authorizer, cfg.CheckIn.SigningKey)
+	bookingNotifier := service.NewBookingNotifier(notificationRouter, webhookService, calendarService)
+	availabilityHub := service.NewAvailabilityHub(cfg.AvailabilityStream.BufferSize)
+	deletionGuard := service.NewDeletionGuard(bookingRepo, txManager, bookingNotifier, availabilityHub)
+	fieldService := service.NewFieldService(fieldRepo, venueRepo, scheduleRepo, fieldTypeRepo, authorizer, deletionGuard)
+	venueService := service.NewVenueService(venueRepo, venueMemberRepo, userRepo, amenityRepo, authorizer, deletionGuard, txManager)
+	scheduleService := service.NewScheduleService(scheduleRepo, fieldRepo, bookingRepo, maintenanceRepo, authorizer, deletionGuard)
+	bookingService := service.NewBookingService(bookingRepo, scheduleRepo, maintenanceRepo, userRepo, paymentRepo, authorizer, txManager, bookingNotifier, availabilityHub)
+	maintenanceService := service.NewMaintenanceService(maintenanceRepo, fieldRepo, scheduleRepo, bookingRepo, authorizer, txManager, bookingNotifier, availabilityHub)
+	reviewService := service.NewReviewService(reviewRepo, venueRepo, bookingRepo, authorizer)
+	favouriteService := service.NewFavouriteService(favouriteRepo, venueRepo, fieldRepo)
+	savedSearchService := service.NewSavedSearchService(savedSearchRepo, fieldTypeRepo)
+	catalogService := service.NewCatalogService(catalogRepo, fieldTypeRepo, txManager)
+	reminderScheduler := service.NewBookingReminderScheduler(bookingRepo, txManager, bookingNotifier, service.ReminderConfig{
+		Offsets:      cfg.Reminder.Offsets,
+		PollInterval: cfg.Reminder.PollInterval,
+		BatchSize:    cfg.Reminder.BatchSize,
+	})
+	bookingCompletionJob := service.NewBookingCompletionJob(bookingRepo, service.BookingCompletionConfig{
+		PollInterval: cfg.BookingCompletion.PollInterval,
+		BatchSize:    cfg.BookingCompletion.BatchSize,
+	})
+	savedSearchAlertJob := service.NewSavedSearchAlertJob(savedSearchRepo, txManager, notificationRouter, service.SavedSearchAlertConfig{
+		PollInterval: cfg.SavedSearchAlert.PollInterval,
+		Lookahead:    cfg.SavedSearchAlert.Lookahead,
+		BatchSize:    cfg.SavedSearchAlert.BatchSize,
+	})
 
 	// Init handler
 	userHandler := handler.NewUserHandler(userService)
+	twoFactorHandler := handler.NewTwoFactorHandler(twoFactorService)
+	oidcHandler := handler.NewOIDCHandler(oidcService)
+	roleHandler := handler.NewRoleHandler(roleService)
 	fieldHandler := handler.NewFieldHandler(fieldService)
 	venueHandler := handler.NewVenueHandler(venueService)
+	amenityHandler := handler.NewAmenityHandler(amenityService)
+	photoHandler := handler.NewPhotoHandler(photoService)
 	scheduleHandler := handler.NewScheduleHandler(scheduleService)
-	bookingHandler := handler.NewBookingHandler(bookingService)
+	maintenanceHandler := handler.NewMaintenanceHandler(maintenanceService)
+	reviewHandler := handler.NewReviewHandler(reviewService)
+	favouriteHandler := handler.NewFavouriteHandler(favouriteService)
+	savedSearchHandler := handler.NewSavedSearchHandler(savedSearchService)
+	catalogHandler := handler.NewCatalogHandler(catalogService)
+	bookingHandler := handler.NewBookingHandler(bookingService, roleService)
+	notificationHandler := handler.NewNotificationHandler(emailTemplateService, outboxService)
+	notificationPreferenceHandler := handler.NewNotificationPreferenceHandler(preferenceService, phoneVerificationService)
+	inboxHandler := handler.NewInboxHandler(inboxService)
+	calendarHandler := handler.NewCalendarHandler(bookingService, calendarService)
+	checkInHandler := handler.NewCheckInHandler(checkInService)
+	webhookHandler := handler.NewWebhookHandler(webhookService)
+	availabilityHandler := handler.NewAvailabilityHandler(fieldService, availabilityHub, cfg.AvailabilityStream.HeartbeatInterval)
 
 	// Init echo
 	e := echo.New()
 	e.HideBanner = true
 	e.HidePort = true
 
+	// Client IPs come from the connection, or from X-Forwarded-For set by a trusted proxy
+	e.IPExtractor = middleware.IPExtractor(cfg.Server.TrustedProxies)
+
 	// HTTP error handler
 	e.HTTPErrorHandler = middleware.ErrorHandler
 
@@ -108,18 +308,73 @@ func main() {
 
 	// Auth middleware
 	authRequired := middleware.AuthMiddleware()
-	adminOnly := middleware.AdminOnly()
 
 	// Swagger Documentation
 	e.GET("/swagger/*", echoSwagger.WrapHandler)
 
+	// Uploaded photos, when they are kept on this server
+	if cfg.Storage.Driver == repository.BlobStorageDriverLocal {
+		e.Static("/uploads", cfg.Storage.LocalDir)
+	}
+
 	// Setup routes
 	api := e.Group("/api/v1")
-	router.SetupUserRoutes(api, userHandler)
-	router.SetupFieldRoutes(api, fieldHandler, authRequired, adminOnly)
-	router.SetupVenueRoutes(api, venueHandler, authRequired, adminOnly)
-	router.SetupScheduleRoutes(api, scheduleHandler, authRequired, adminOnly)
-	router.SetupBookingRoutes(api, bookingHandler, authRequired, adminOnly)
+	router.SetupUserRoutes(api, userHandler, authRequired, roleService)
+	router.SetupTwoFactorRoutes(api, twoFactorHandler, authRequired)
+	router.SetupOIDCRoutes(api, oidcHandler)
+	router.SetupRoleRoutes(api, roleHandler, authRequired, roleService)
+	router.SetupFieldRoutes(api, fieldHandler, authRequired, roleService)
+	router.SetupAvailabilityRoutes(api, availabilityHandler, authRequired)
+	router.SetupVenueRoutes(api, venueHandler, authRequired, roleService)
+	router.SetupAmenityRoutes(api, amenityHandler, authRequired, roleService)
+	router.SetupCatalogRoutes(api, catalogHandler, authRequired, roleService)
+	router.SetupPhotoRoutes(api, photoHandler, authRequired)
+	router.SetupScheduleRoutes(api, scheduleHandler, authRequired, roleService)
+	router.SetupMaintenanceRoutes(api, maintenanceHandler, authRequired)
+	router.SetupReviewRoutes(api, reviewHandler, authRequired, roleService)
+	router.SetupBookingRoutes(api, bookingHandler, authRequired)
+	router.SetupCalendarRoutes(api, calendarHandler, authRequired)
+	router.SetupCheckInRoutes(api, checkInHandler, authRequired)
+	router.SetupNotificationRoutes(api, notificationHandler, authRequired, roleService)
+	router.SetupNotificationPreferenceRoutes(api, notificationPreferenceHandler, authRequired)
+	router.SetupInboxRoutes(api, inboxHandler, authRequired)
+	router.SetupFavouriteRoutes(api, favouriteHandler, authRequired)
+	router.SetupSavedSearchRoutes(api, savedSearchHandler, authRequired)
+	router.SetupWebhookRoutes(api, webhookHandler, authRequired)
+
+	// Background workers
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	var workers sync.WaitGroup
+
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		outboxDispatcher.Run(workerCtx)
+	}()
+
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		reminderScheduler.Run(workerCtx)
+	}()
+
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		inboxRetentionJob.Run(workerCtx)
+	}()
+
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		bookingCompletionJob.Run(workerCtx)
+	}()
+
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		savedSearchAlertJob.Run(workerCtx)
+	}()
 
 	// Goroutine server
 	go func() {
@@ -140,10 +395,17 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
+	// End open availability streams, otherwise Shutdown waits for them
+	availabilityHub.Close()
+
 	// Shutdown server
 	if err := e.Shutdown(ctx); err != nil {
 		logger.Error("Server shutdown error", "error", err)
 	}
 
+	// Stop workers after the server so requests in flight can still enqueue
+	stopWorkers()
+	workers.Wait()
+
 	logger.Info("Server stopped")
 }
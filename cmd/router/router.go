@@ -1,53 +1,261 @@
 package router
 
 import (
+	"go-futsal-booking-api/internal/domain"
 	"go-futsal-booking-api/internal/handler"
+	"go-futsal-booking-api/internal/middleware"
 
 	"github.com/labstack/echo/v4"
 )
 
-func SetupUserRoutes(api *echo.Group, handler *handler.UserHandler) {
+func SetupUserRoutes(api *echo.Group, handler *handler.UserHandler, authRequired echo.MiddlewareFunc, rbac middleware.PermissionChecker) {
+	manageUsers := middleware.RequirePermission(rbac, domain.PermUserManage)
+
 	users := api.Group("/users")
 
 	users.GET("/email-verification/:code", handler.VerifyEmail)
 	users.POST("/register", handler.Register)
 	users.POST("/login", handler.Login)
+
+	users.POST("/:id/unlock", handler.UnlockUser, authRequired, manageUsers)
+	users.PUT("/me/language", handler.UpdateLanguage, authRequired)
+}
+
+func SetupNotificationRoutes(api *echo.Group, handler *handler.NotificationHandler, authRequired echo.MiddlewareFunc, rbac middleware.PermissionChecker) {
+	notifications := api.Group("/notifications", authRequired, middleware.RequirePermission(rbac, domain.PermNotificationManage))
+	notifications.GET("/templates", handler.GetEmailTemplates)
+	notifications.GET("/templates/:name/preview", handler.PreviewEmailTemplate)
+	notifications.GET("/outbox", handler.GetOutboxMessages)
+	notifications.GET("/outbox/:id", handler.GetOutboxMessageByID)
+	notifications.POST("/outbox/:id/replay", handler.ReplayOutboxMessage)
+}
+
+func SetupNotificationPreferenceRoutes(api *echo.Group, handler *handler.NotificationPreferenceHandler, authRequired echo.MiddlewareFunc) {
+	me := api.Group("/users/me", authRequired)
+	me.GET("/notification-preferences", handler.GetPreferences)
+	me.PUT("/notification-preferences", handler.UpdatePreferences)
+	me.POST("/phone", handler.RequestPhoneVerification)
+	me.POST("/phone/verify", handler.VerifyPhone)
+	me.DELETE("/phone", handler.RemovePhone)
+}
+
+// SetupCalendarRoutes leaves the feed without authRequired: calendar apps
+// cannot log in, so the token in the subscription URL identifies the user.
+func SetupCalendarRoutes(api *echo.Group, handler *handler.CalendarHandler, authRequired echo.MiddlewareFunc) {
+	api.GET("/bookings/:id/calendar.ics", handler.GetBookingCalendar, authRequired)
+	api.POST("/users/me/calendar-feed", handler.CreateCalendarFeed, authRequired)
+	api.GET("/users/me/calendar.ics", handler.GetCalendarFeed)
+}
+
+// SetupCheckInRoutes only requires a login; the service checks that the
+// caller owns the booking or is staff of the venue.
+func SetupCheckInRoutes(api *echo.Group, handler *handler.CheckInHandler, authRequired echo.MiddlewareFunc) {
+	api.GET("/bookings/:id/check-in.png", handler.GetCheckInCode, authRequired)
+	api.POST("/bookings/check-in", handler.CheckIn, authRequired)
+}
+
+// SetupInboxRoutes lives under /users/me because /notifications is the admin
+// email tooling.
+func SetupInboxRoutes(api *echo.Group, handler *handler.InboxHandler, authRequired echo.MiddlewareFunc) {
+	inbox := api.Group("/users/me/notifications", authRequired)
+	inbox.GET("", handler.GetNotifications)
+	inbox.GET("/unread-count", handler.GetUnreadCount)
+	inbox.POST("/read-all", handler.MarkAllRead)
+	inbox.POST("/:id/read", handler.MarkRead)
+}
+
+func SetupFavouriteRoutes(api *echo.Group, handler *handler.FavouriteHandler, authRequired echo.MiddlewareFunc) {
+	favourites := api.Group("/users/me/favourites", authRequired)
+	favourites.GET("", handler.GetFavourites)
+	favourites.PUT("/venues/:id", handler.AddFavouriteVenue)
+	favourites.DELETE("/venues/:id", handler.RemoveFavouriteVenue)
+	favourites.PUT("/fields/:id", handler.AddFavouriteField)
+	favourites.DELETE("/fields/:id", handler.RemoveFavouriteField)
+}
+
+func SetupSavedSearchRoutes(api *echo.Group, handler *handler.SavedSearchHandler, authRequired echo.MiddlewareFunc) {
+	searches := api.Group("/users/me/saved-searches", authRequired)
+	searches.GET("", handler.GetSavedSearches)
+	searches.POST("", handler.CreateSavedSearch)
+	searches.PUT("/:id", handler.UpdateSavedSearch)
+	searches.DELETE("/:id", handler.DeleteSavedSearch)
+}
+
+// SetupWebhookRoutes only requires a login; access to each subscription is
+// checked by the service against its venue or the webhook:manage permission.
+func SetupWebhookRoutes(api *echo.Group, handler *handler.WebhookHandler, authRequired echo.MiddlewareFunc) {
+	webhooks := api.Group("/webhooks", authRequired)
+	webhooks.POST("", handler.CreateWebhook)
+	webhooks.GET("", handler.GetWebhooks)
+	webhooks.GET("/:id", handler.GetWebhookByID)
+	webhooks.PUT("/:id", handler.UpdateWebhook)
+	webhooks.DELETE("/:id", handler.DeleteWebhook)
+	webhooks.POST("/:id/rotate-secret", handler.RotateWebhookSecret)
+	webhooks.GET("/:id/deliveries", handler.GetWebhookDeliveries)
+	webhooks.POST("/deliveries/:id/replay", handler.ReplayWebhookDelivery)
+}
+
+func SetupOIDCRoutes(api *echo.Group, handler *handler.OIDCHandler) {
+	oidc := api.Group("/users/oidc")
+	oidc.GET("/login", handler.BeginLogin)
+	oidc.GET("/callback", handler.Callback)
 }
 
-func SetupFieldRoutes(api *echo.Group, handler *handler.FieldHandler, authRequired echo.MiddlewareFunc, adminOnly echo.MiddlewareFunc) {
+func SetupTwoFactorRoutes(api *echo.Group, handler *handler.TwoFactorHandler, authRequired echo.MiddlewareFunc) {
+	login := api.Group("/users/login/2fa")
+	login.POST("", handler.VerifyLogin)
+	login.POST("/setup", handler.SetupWithChallenge)
+	login.POST("/enable", handler.EnableWithChallenge)
+
+	me := api.Group("/users/me/2fa", authRequired)
+	me.POST("/setup", handler.Setup)
+	me.POST("/enable", handler.Enable)
+	me.POST("/disable", handler.Disable)
+	me.POST("/recovery-codes", handler.RegenerateRecoveryCodes)
+}
+
+func SetupRoleRoutes(api *echo.Group, handler *handler.RoleHandler, authRequired echo.MiddlewareFunc, rbac middleware.PermissionChecker) {
+	manageRoles := middleware.RequirePermission(rbac, domain.PermRoleManage)
+
+	api.GET("/permissions", handler.GetAllPermissions, authRequired, manageRoles)
+	api.PUT("/users/:id/role", handler.AssignUserRole, authRequired, middleware.RequirePermission(rbac, domain.PermUserManage, domain.PermRoleManage))
+
+	roles := api.Group("/roles", authRequired, manageRoles)
+	roles.GET("", handler.GetAllRoles)
+	roles.GET("/:id", handler.GetRoleByID)
+	roles.POST("", handler.CreateRole)
+	roles.PUT("/:id", handler.UpdateRole)
+	roles.PUT("/:id/permissions", handler.SetRolePermissions)
+	roles.DELETE("/:id", handler.DeleteRole)
+}
+
+// SetupFieldRoutes only requires a login for changes; the service checks venue
+// membership. Restoring is a platform-wide action and needs deleted:restore.
+func SetupFieldRoutes(api *echo.Group, handler *handler.FieldHandler, authRequired echo.MiddlewareFunc, rbac middleware.PermissionChecker) {
+	restoreDeleted := middleware.RequirePermission(rbac, domain.PermDeletedRestore)
+
+	api.GET("/field-types", handler.GetFieldTypes, authRequired)
+
 	fields := api.Group("/fields")
 	fields.GET("", handler.GetFieldsByVenue, authRequired)
 	fields.GET("/:id", handler.GetFieldByID, authRequired)
 
-	fields.POST("", handler.CreateField, authRequired, adminOnly)
-	fields.PUT("/:id", handler.UpdateField, authRequired, adminOnly)
-	fields.DELETE("/:id", handler.DeleteField, authRequired, adminOnly)
+	fields.POST("", handler.CreateField, authRequired)
+	fields.PUT("/:id", handler.UpdateField, authRequired)
+	fields.GET("/:id/deletion-preview", handler.GetFieldDeletionPreview, authRequired)
+	fields.DELETE("/:id", handler.DeleteField, authRequired)
+	fields.POST("/:id/restore", handler.RestoreField, authRequired, restoreDeleted)
 }
 
-func SetupVenueRoutes(api *echo.Group, handler *handler.VenueHandler, authRequired echo.MiddlewareFunc, adminOnly echo.MiddlewareFunc) {
+func SetupAvailabilityRoutes(api *echo.Group, handler *handler.AvailabilityHandler, authRequired echo.MiddlewareFunc) {
+	api.GET("/fields/:id/availability/stream", handler.StreamAvailability, authRequired)
+}
+
+// SetupVenueRoutes requires venue:write to create a venue and deleted:restore to
+// restore one. Every other change is checked against the venue's members by
+// the service.
+func SetupVenueRoutes(api *echo.Group, handler *handler.VenueHandler, authRequired echo.MiddlewareFunc, rbac middleware.PermissionChecker) {
+	writeVenues := middleware.RequirePermission(rbac, domain.PermVenueWrite)
+	restoreDeleted := middleware.RequirePermission(rbac, domain.PermDeletedRestore)
+
 	venues := api.Group("/venues")
-	venues.GET("", handler.GetAllVenues, authRequired)
+	venues.GET("", handler.SearchVenues, authRequired)
+	venues.GET("/nearby", handler.NearbyVenues, authRequired)
 	venues.GET("/:id", handler.GetVenueByID, authRequired)
 
-	venues.POST("", handler.CreateVenue, authRequired, adminOnly)
-	venues.PUT("/:id", handler.UpdateVenue, authRequired, adminOnly)
-	venues.DELETE("/:id", handler.DeleteVenue, authRequired, adminOnly)
+	venues.POST("", handler.CreateVenue, authRequired, writeVenues)
+	venues.PUT("/:id", handler.UpdateVenue, authRequired)
+	venues.GET("/:id/deletion-preview", handler.GetVenueDeletionPreview, authRequired)
+	venues.DELETE("/:id", handler.DeleteVenue, authRequired)
+	venues.POST("/:id/restore", handler.RestoreVenue, authRequired, restoreDeleted)
+
+	venues.GET("/:id/members", handler.GetVenueMembers, authRequired)
+	venues.POST("/:id/members", handler.AddVenueMember, authRequired)
+	venues.PUT("/:id/members/:userId", handler.UpdateVenueMember, authRequired)
+	venues.DELETE("/:id/members/:userId", handler.RemoveVenueMember, authRequired)
 }
 
-func SetupScheduleRoutes(api *echo.Group, handler *handler.ScheduleHandler, authRequired echo.MiddlewareFunc, adminOnly echo.MiddlewareFunc) {
+func SetupAmenityRoutes(api *echo.Group, handler *handler.AmenityHandler, authRequired echo.MiddlewareFunc, rbac middleware.PermissionChecker) {
+	manageAmenities := middleware.RequirePermission(rbac, domain.PermAmenityManage)
+
+	amenities := api.Group("/amenities")
+	amenities.GET("", handler.GetAmenities, authRequired)
+	amenities.POST("", handler.CreateAmenity, authRequired, manageAmenities)
+	amenities.DELETE("/:code", handler.DeleteAmenity, authRequired, manageAmenities)
+}
+
+func SetupCatalogRoutes(api *echo.Group, handler *handler.CatalogHandler, authRequired echo.MiddlewareFunc, rbac middleware.PermissionChecker) {
+	catalog := api.Group("/catalog", authRequired, middleware.RequirePermission(rbac, domain.PermCatalogManage))
+	catalog.POST("/import", handler.ImportCatalog)
+	catalog.GET("/export", handler.ExportCatalog)
+}
+
+// SetupPhotoRoutes only requires a login; the service checks venue
+// membership before photos are changed.
+func SetupPhotoRoutes(api *echo.Group, handler *handler.PhotoHandler, authRequired echo.MiddlewareFunc) {
+	api.GET("/venues/:id/photos", handler.GetVenuePhotos, authRequired)
+	api.POST("/venues/:id/photos", handler.UploadVenuePhoto, authRequired)
+	api.PUT("/venues/:id/photos/order", handler.ReorderVenuePhotos, authRequired)
+
+	api.GET("/fields/:id/photos", handler.GetFieldPhotos, authRequired)
+	api.POST("/fields/:id/photos", handler.UploadFieldPhoto, authRequired)
+	api.PUT("/fields/:id/photos/order", handler.ReorderFieldPhotos, authRequired)
+
+	photos := api.Group("/photos", authRequired)
+	photos.PUT("/:id/cover", handler.SetCoverPhoto)
+	photos.DELETE("/:id", handler.DeletePhoto)
+}
+
+// SetupMaintenanceRoutes only requires a login; the service checks venue
+// membership before windows are changed or their bookings listed.
+func SetupMaintenanceRoutes(api *echo.Group, handler *handler.MaintenanceHandler, authRequired echo.MiddlewareFunc) {
+	api.GET("/fields/:id/maintenance-windows", handler.GetMaintenanceWindows, authRequired)
+	api.POST("/fields/:id/maintenance-windows", handler.CreateMaintenanceWindow, authRequired)
+
+	windows := api.Group("/maintenance-windows", authRequired)
+	windows.GET("/:id/affected-bookings", handler.GetAffectedBookings)
+	windows.DELETE("/:id", handler.DeleteMaintenanceWindow)
+}
+
+// SetupReviewRoutes leaves posting and replying to the service, which checks
+// the booking and venue ownership; moderation needs review:moderate.
+func SetupReviewRoutes(api *echo.Group, handler *handler.ReviewHandler, authRequired echo.MiddlewareFunc, rbac middleware.PermissionChecker) {
+	moderateReviews := middleware.RequirePermission(rbac, domain.PermReviewModerate)
+
+	api.GET("/venues/:id/reviews", handler.GetVenueReviews, authRequired)
+	api.POST("/venues/:id/reviews", handler.CreateReview, authRequired)
+
+	reviews := api.Group("/reviews", authRequired)
+	reviews.PUT("/:id/reply", handler.ReplyToReview)
+	reviews.PUT("/:id/moderation", handler.ModerateReview, moderateReviews)
+	reviews.DELETE("/:id", handler.DeleteReview, moderateReviews)
+}
+
+// SetupScheduleRoutes only requires a login for changes; the service checks
+// venue membership. Restoring is a platform-wide action and needs
+// deleted:restore.
+func SetupScheduleRoutes(api *echo.Group, handler *handler.ScheduleHandler, authRequired echo.MiddlewareFunc, rbac middleware.PermissionChecker) {
+	restoreDeleted := middleware.RequirePermission(rbac, domain.PermDeletedRestore)
+
 	schedules := api.Group("/schedules")
 	schedules.GET("", handler.GetScheduleByField, authRequired)
 	schedules.GET("/:id", handler.GetScheduleByID, authRequired)
 
-	schedules.POST("", handler.CreateSchedule, authRequired, adminOnly)
-	schedules.PUT("/:id", handler.UpdateSchedule, authRequired, adminOnly)
-	schedules.DELETE("/:id", handler.DeleteSchedule, authRequired, adminOnly)
+	schedules.POST("", handler.CreateSchedule, authRequired)
+	schedules.PUT("/:id", handler.UpdateSchedule, authRequired)
+	schedules.GET("/:id/deletion-preview", handler.GetScheduleDeletionPreview, authRequired)
+	schedules.DELETE("/:id", handler.DeleteSchedule, authRequired)
+	schedules.POST("/:id/restore", handler.RestoreSchedule, authRequired, restoreDeleted)
 }
 
-func SetupBookingRoutes(api *echo.Group, handler *handler.BookingHandler, authRequired echo.MiddlewareFunc, adminOnly echo.MiddlewareFunc) {
+func SetupBookingRoutes(api *echo.Group, handler *handler.BookingHandler, authRequired echo.MiddlewareFunc) {
 	bookings := api.Group("/bookings")
 	bookings.GET("/:id", handler.GetBookingDetails, authRequired)
 	bookings.GET("", handler.GetMyBookings, authRequired)
 
 	bookings.POST("", handler.CreateBooking, authRequired)
+	bookings.POST("/:id/cancel", handler.CancelBooking, authRequired)
+	bookings.POST("/:id/payments", handler.RecordPayment, authRequired)
+
+	api.GET("/venues/:id/bookings", handler.GetVenueBookings, authRequired)
 }
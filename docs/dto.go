@@ -6,6 +6,21 @@ type SuccessResponse struct {
 	Data    interface{} `json:"data,omitempty"`
 }
 
+type Pagination struct {
+	Total      int64  `json:"total" example:"120"`
+	Limit      int    `json:"limit" example:"50"`
+	Offset     int    `json:"offset" example:"0"`
+	HasMore    bool   `json:"has_more" example:"true"`
+	NextCursor string `json:"next_cursor,omitempty" example:"eyJzIjoibmFtZSIsImlkIjo1MH0"`
+}
+
+type PaginatedResponse struct {
+	Success    bool        `json:"success" example:"true"`
+	Message    string      `json:"message" example:"Operation successful"`
+	Data       interface{} `json:"data"`
+	Pagination Pagination  `json:"pagination"`
+}
+
 type ErrorResponse struct {
 	Success bool        `json:"success" example:"false"`
 	Error   string      `json:"error" example:"ERROR_CODE"`
@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"regexp"
+	"time"
+)
+
+// Amenity is an entry of the managed list of facilities venues can offer,
+// such as parking or showers. Code identifies it in requests and filters.
+type Amenity struct {
+	Code      string
+	Name      string
+	CreatedAt time.Time
+}
+
+var amenityCodePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]{1,31}$`)
+
+// IsValidAmenityCode reports whether code is 2 to 32 upper-case letters,
+// digits or underscores, starting with a letter.
+func IsValidAmenityCode(code string) bool {
+	return amenityCodePattern.MatchString(code)
+}
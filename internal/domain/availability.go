@@ -0,0 +1,44 @@
+package domain
+
+import "time"
+
+const (
+	SlotUpdateBooked   = "slot.booked"
+	SlotUpdateReleased = "slot.released"
+	// SlotUpdateClosed is sent for slots that can no longer be booked because
+	// they were deleted or fall in a maintenance window.
+	SlotUpdateClosed = "slot.closed"
+)
+
+// SlotUpdate tells stream subscribers that a schedule slot of a field was
+// taken, freed or closed on a given date.
+type SlotUpdate struct {
+	Type       string    `json:"type"`
+	FieldID    uint      `json:"field_id"`
+	ScheduleID uint      `json:"schedule_id"`
+	Date       string    `json:"date"`
+	StartTime  string    `json:"start_time"`
+	EndTime    string    `json:"end_time"`
+	Available  bool      `json:"available"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// NewSlotUpdate describes the slot held by booking. updateType is one of the
+// SlotUpdate constants.
+func NewSlotUpdate(updateType string, booking Booking, at time.Time) SlotUpdate {
+	return NewScheduleSlotUpdate(updateType, booking.Schedule, booking.BookingDate, at)
+}
+
+// NewScheduleSlotUpdate describes the slot of schedule on date.
+func NewScheduleSlotUpdate(updateType string, schedule Schedule, date time.Time, at time.Time) SlotUpdate {
+	return SlotUpdate{
+		Type:       updateType,
+		FieldID:    schedule.Field.ID,
+		ScheduleID: schedule.ID,
+		Date:       date.Format("2006-01-02"),
+		StartTime:  schedule.StartTime.Format("15:04"),
+		EndTime:    schedule.EndTime.Format("15:04"),
+		Available:  updateType == SlotUpdateReleased,
+		OccurredAt: at,
+	}
+}
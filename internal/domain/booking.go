@@ -2,6 +2,16 @@ package domain
 
 import "time"
 
+const (
+	BookingStatusPending   = "PENDING"
+	BookingStatusConfirmed = "CONFIRMED"
+	BookingStatusCancelled = "CANCELLED"
+	BookingStatusCheckedIn = "CHECKED_IN"
+	// BookingStatusCompleted is set once the slot of a confirmed or checked-in
+	// booking has ended.
+	BookingStatusCompleted = "COMPLETED"
+)
+
 type Booking struct {
 	ID          uint
 	User        User
@@ -9,7 +19,31 @@ type Booking struct {
 	BookingDate time.Time
 	Status      string
 	TotalPrice  float64
+	CheckedInAt *time.Time
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	DeletedAt   *time.Time
 }
+
+// StartsAt combines the booking date with the schedule's time of day, in local time.
+func (b Booking) StartsAt() time.Time {
+	return combineDateAndTime(b.BookingDate, b.Schedule.StartTime, time.Local)
+}
+
+func (b Booking) EndsAt() time.Time {
+	return combineDateAndTime(b.BookingDate, b.Schedule.EndTime, time.Local)
+}
+
+// StartsAtVenue is StartsAt in the venue's timezone, the time players see on
+// the clock at the venue.
+func (b Booking) StartsAtVenue() time.Time {
+	return combineDateAndTime(b.BookingDate, b.Schedule.StartTime, b.Schedule.Field.Venue.Location())
+}
+
+func (b Booking) EndsAtVenue() time.Time {
+	return combineDateAndTime(b.BookingDate, b.Schedule.EndTime, b.Schedule.Field.Venue.Location())
+}
+
+func combineDateAndTime(date, clock time.Time, loc *time.Location) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), clock.Hour(), clock.Minute(), clock.Second(), 0, loc)
+}
@@ -0,0 +1,45 @@
+package domain
+
+import "time"
+
+// Calendar event statuses, as defined for VEVENT in RFC 5545.
+const (
+	CalendarStatusTentative = "TENTATIVE"
+	CalendarStatusConfirmed = "CONFIRMED"
+	CalendarStatusCancelled = "CANCELLED"
+)
+
+// CalendarEvent is one VEVENT. Start and End carry the timezone the event is
+// shown in.
+type CalendarEvent struct {
+	UID          string
+	Summary      string
+	Description  string
+	Location     string
+	Status       string
+	Start        time.Time
+	End          time.Time
+	Created      time.Time
+	LastModified time.Time
+}
+
+// CalendarFeed is a user's private calendar subscription. Only the hash of the
+// token in the subscription URL is stored.
+type CalendarFeed struct {
+	UserID    uint
+	TokenHash string
+	CreatedAt time.Time
+}
+
+// CalendarStatusOf maps a booking status to the event status calendar apps
+// understand.
+func CalendarStatusOf(bookingStatus string) string {
+	switch bookingStatus {
+	case BookingStatusConfirmed, BookingStatusCheckedIn:
+		return CalendarStatusConfirmed
+	case BookingStatusCancelled:
+		return CalendarStatusCancelled
+	}
+
+	return CalendarStatusTentative
+}
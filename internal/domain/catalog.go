@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	CatalogFormatCSV  = "csv"
+	CatalogFormatXLSX = "xlsx"
+)
+
+// CatalogColumns are the columns of a catalog file, in order. Imports and
+// exports use the same columns so an export can be edited and imported again.
+var CatalogColumns = []string{
+	"venue_id",
+	"venue_name",
+	"venue_address",
+	"venue_city",
+	"venue_timezone",
+	"field_id",
+	"field_name",
+	"field_type",
+	"field_indoor",
+	"field_lighting",
+	"field_max_players",
+	"schedule_id",
+	"day_of_week",
+	"start_time",
+	"end_time",
+	"price",
+}
+
+// CatalogIDColumns may be left out of an imported file. Without them every
+// row is matched by name.
+var CatalogIDColumns = []string{"venue_id", "field_id", "schedule_id"}
+
+// CatalogRow is one line of a catalog file: a schedule together with its field
+// and venue. Each is matched by its ID when the row has one, which lets an
+// import rename it. Otherwise venues are matched by name, fields by venue and
+// name, and schedules by field, day and start time. Field is nil for a venue
+// without fields and Schedule is nil for a field without schedules.
+type CatalogRow struct {
+	// Row is the line of the file, counting the header as line 1.
+	Row      int
+	Venue    Venue
+	Field    *Field
+	Schedule *Schedule
+}
+
+// CatalogImport counts what an import created and changed. Rows that match
+// the stored venue, field or schedule exactly are left alone.
+type CatalogImport struct {
+	VenuesCreated    int
+	VenuesUpdated    int
+	FieldsCreated    int
+	FieldsUpdated    int
+	SchedulesCreated int
+	SchedulesUpdated int
+	DryRun           bool
+}
+
+// CatalogRowError is a problem with one cell or row of a catalog file. Column
+// is empty when the row as a whole is wrong.
+type CatalogRowError struct {
+	Row     int
+	Column  string
+	Message string
+}
+
+// CatalogImportError is returned when a catalog file has invalid rows. Every
+// row is checked, so it lists all of them, and nothing is imported.
+type CatalogImportError struct {
+	Rows []CatalogRowError
+}
+
+func (e *CatalogImportError) Error() string {
+	problems := make([]string, len(e.Rows))
+	for i, row := range e.Rows {
+		problems[i] = fmt.Sprintf("row %d: %s", row.Row, row.Message)
+	}
+
+	return fmt.Sprintf("%v: %s", ErrInvalidCatalog, strings.Join(problems, "; "))
+}
+
+func (e *CatalogImportError) Unwrap() error {
+	return ErrInvalidCatalog
+}
@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+// RestoreWindow is how long a deleted venue, field or schedule can still be
+// restored.
+const RestoreWindow = 30 * 24 * time.Hour
+
+// DeletionScope picks what is being deleted. Exactly one of the ids is set;
+// the venue or field takes everything below it along.
+type DeletionScope struct {
+	VenueID    uint
+	FieldID    uint
+	ScheduleID uint
+}
+
+// DeletionImpact is what deleting a venue, field or schedule affects. Fields
+// and Schedules count the ones deleted along with it; FutureBookings counts
+// the pending, confirmed and checked-in bookings that have not ended yet.
+type DeletionImpact struct {
+	Fields         int64
+	Schedules      int64
+	FutureBookings int64
+}
+
+// Restorable reports whether something deleted at deletedAt can still be
+// restored at now.
+func Restorable(deletedAt *time.Time, now time.Time) bool {
+	return deletedAt != nil && now.Sub(*deletedAt) <= RestoreWindow
+}
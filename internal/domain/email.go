@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	EmailTemplateAccountActivation   = "account_activation"
+	EmailTemplateAccountLocked       = "account_locked"
+	EmailTemplatePasswordReset       = "password_reset"
+	EmailTemplateBookingReceived     = "booking_received"
+	EmailTemplateBookingConfirmation = "booking_confirmation"
+	EmailTemplateBookingCancellation = "booking_cancellation"
+	EmailTemplateBookingReminder     = "booking_reminder"
+	EmailTemplateBookingMaintenance  = "booking_maintenance"
+	EmailTemplatePaymentReceipt      = "payment_receipt"
+	EmailTemplateSavedSearchMatch    = "saved_search_match"
+)
+
+var EmailTemplates = []string{
+	EmailTemplateAccountActivation,
+	EmailTemplateAccountLocked,
+	EmailTemplatePasswordReset,
+	EmailTemplateBookingReceived,
+	EmailTemplateBookingConfirmation,
+	EmailTemplateBookingCancellation,
+	EmailTemplateBookingReminder,
+	EmailTemplateBookingMaintenance,
+	EmailTemplatePaymentReceipt,
+	EmailTemplateSavedSearchMatch,
+}
+
+type EmailAddress struct {
+	Name  string
+	Email string
+}
+
+type EmailAttachment struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+// Email is a provider-agnostic message. At least one of TextBody and HTMLBody
+// must be set; when both are, clients pick the best one they can display.
+type Email struct {
+	To          []EmailAddress
+	Cc          []EmailAddress
+	Bcc         []EmailAddress
+	Subject     string
+	TextBody    string
+	HTMLBody    string
+	Attachments []EmailAttachment
+}
+
+// Recipients returns every envelope recipient, including Bcc.
+func (e Email) Recipients() []EmailAddress {
+	recipients := make([]EmailAddress, 0, len(e.To)+len(e.Cc)+len(e.Bcc))
+	recipients = append(recipients, e.To...)
+	recipients = append(recipients, e.Cc...)
+	recipients = append(recipients, e.Bcc...)
+
+	return recipients
+}
+
+// NotificationError is returned by notification backends. Retryable marks
+// failures that may succeed later, such as timeouts, throttling or 5xx responses.
+type NotificationError struct {
+	Provider  string
+	Retryable bool
+	Err       error
+}
+
+func (e *NotificationError) Error() string {
+	kind := "permanent"
+	if e.Retryable {
+		kind = "retryable"
+	}
+
+	return fmt.Sprintf("%s: %s notification failure: %v", e.Provider, kind, e.Err)
+}
+
+func (e *NotificationError) Unwrap() error {
+	return e.Err
+}
+
+// IsRetryableNotification reports whether a failed send is worth retrying. Errors
+// that are not NotificationErrors are treated as retryable since their cause is unknown.
+func IsRetryableNotification(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var notificationErr *NotificationError
+	if errors.As(err, &notificationErr) {
+		return notificationErr.Retryable
+	}
+
+	return true
+}
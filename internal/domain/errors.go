@@ -3,24 +3,114 @@ package domain
 import "errors"
 
 var (
-	ErrForbidden            = errors.New("forbidden: user does not have the required permissions")
-	ErrBookingNotFound      = errors.New("booking not found")
-	ErrSlotUnavailable      = errors.New("slot already booked for this date")
-	ErrScheduleNotFound     = errors.New("schedule not found")
-	ErrSlotAlreadyBooked    = errors.New("slot already booked for this date")
-	ErrInvalidBookingDate   = errors.New("invalid booking date")
-	ErrDayMistmatch         = errors.New("booking date does not match schedule day")
-	ErrPastDateBooking      = errors.New("cannot book past date")
-	ErrScheduleNotAvailable = errors.New("schedule is not available")
-	ErrInvalidDayOfWeek     = errors.New("day of week must be between 1-7")
-	ErrInvalidPrice         = errors.New("price must be postive")
-	ErrScheduleHasBookings  = errors.New("cannot modifty schedule with existing bookings")
-	ErrInvalidDuration      = errors.New("duration must be at least 1 hour")
-	ErrInvalidTimeRange     = errors.New("invalid time range")
-	ErrDuplicateFieldName   = errors.New("field with this name already exists in venue")
-	ErrFieldNotFound        = errors.New("field not found")
-	ErrVenueNotFound        = errors.New("venue not found")
-	ErrInvalidFieldData     = errors.New("invalid field data")
-	ErrFieldTypeNotFound    = errors.New("field type not found")
-	ErrUserNotFound         = errors.New("user not found")
+	ErrForbidden             = errors.New("forbidden: user does not have the required permissions")
+	ErrBookingNotFound       = errors.New("booking not found")
+	ErrSlotUnavailable       = errors.New("slot already booked for this date")
+	ErrScheduleNotFound      = errors.New("schedule not found")
+	ErrSlotAlreadyBooked     = errors.New("slot already booked for this date")
+	ErrInvalidBookingDate    = errors.New("invalid booking date")
+	ErrDayMistmatch          = errors.New("booking date does not match schedule day")
+	ErrPastDateBooking       = errors.New("cannot book past date")
+	ErrScheduleNotAvailable  = errors.New("schedule is not available")
+	ErrInvalidDayOfWeek      = errors.New("day of week must be between 1-7")
+	ErrInvalidPrice          = errors.New("price must be postive")
+	ErrScheduleHasBookings   = errors.New("cannot modifty schedule with existing bookings")
+	ErrInvalidDuration       = errors.New("duration must be at least 1 hour")
+	ErrInvalidTimeRange      = errors.New("invalid time range")
+	ErrDuplicateFieldName    = errors.New("field with this name already exists in venue")
+	ErrFieldNotFound         = errors.New("field not found")
+	ErrVenueNotFound         = errors.New("venue not found")
+	ErrInvalidFieldData      = errors.New("invalid field data")
+	ErrFieldTypeNotFound     = errors.New("field type not found")
+	ErrInvalidFieldDetails   = errors.New("field length and width must be between 0 and 100 metres, max players between 2 and 50, and status ACTIVE or INACTIVE")
+	ErrFieldInactive         = errors.New("field is not open for booking")
+	ErrUserNotFound          = errors.New("user not found")
+	ErrRoleNotFound          = errors.New("role not found")
+	ErrRoleAlreadyExists     = errors.New("role with this name already exists")
+	ErrRoleInUse             = errors.New("role is still assigned to users")
+	ErrPermissionNotFound    = errors.New("permission not found")
+	ErrBuiltInRole           = errors.New("built-in roles cannot be renamed or deleted")
+	ErrAdminRoleLockout      = errors.New("ADMIN role must keep the role:manage permission")
+	ErrLastRoleManager       = errors.New("at least one user must keep the role:manage permission")
+	ErrVenueMemberNotFound   = errors.New("venue member not found")
+	ErrVenueMemberExists     = errors.New("user is already a member of this venue")
+	ErrInvalidVenueRole      = errors.New("venue role must be one of OWNER, MANAGER, STAFF")
+	ErrLastVenueOwner        = errors.New("venue must keep at least one owner")
+	ErrInvalidCredentials    = errors.New("invalid email or password")
+	ErrTooManyLoginAttempts  = errors.New("too many login attempts")
+	ErrAccountLocked         = errors.New("account is temporarily locked")
+	ErrTwoFactorNotFound     = errors.New("two-factor authentication has not been set up")
+	ErrTwoFactorEnabled      = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorNotEnabled   = errors.New("two-factor authentication is not enabled")
+	ErrTwoFactorMandatory    = errors.New("two-factor authentication is mandatory for this role")
+	ErrInvalidTwoFactorCode  = errors.New("invalid two-factor code")
+	ErrInvalidChallenge      = errors.New("invalid or expired login challenge")
+	ErrOIDCNotConfigured     = errors.New("single sign-on is not configured")
+	ErrInvalidOIDCState      = errors.New("invalid or expired single sign-on state")
+	ErrOIDCEmailNotVerified  = errors.New("identity provider did not return a verified email")
+	ErrIdentityNotFound      = errors.New("linked identity not found")
+	ErrOIDCAccountUnverified = errors.New("an unverified account already uses this email; verify it before signing in with single sign-on")
+	ErrInvalidEmail          = errors.New("email must have a recipient, a subject and a body")
+	ErrEmailTemplateNotFound = errors.New("email template not found")
+	ErrBookingNotCancellable = errors.New("cannot cancel booking with status")
+	ErrBookingNotPayable     = errors.New("only pending bookings can be paid")
+	ErrOutboxMessageNotFound = errors.New("outbox message not found")
+	ErrOutboxNotReplayable   = errors.New("only dead outbox messages can be replayed")
+	ErrUnknownOutboxTopic    = errors.New("unknown outbox topic")
+	ErrUnsupportedLanguage   = errors.New("unsupported language")
+	ErrInvalidTextMessage    = errors.New("text message must have a channel, a recipient and a body")
+	ErrInvalidPhoneNumber    = errors.New("invalid phone number")
+	ErrPhoneNumberTaken      = errors.New("phone number is already verified by another account")
+	ErrPhoneNotVerified      = errors.New("phone number has not been verified")
+	ErrInvalidPhoneCode      = errors.New("invalid or expired verification code")
+	ErrPhoneCodeCooldown     = errors.New("verification code was sent recently, try again later")
+	ErrUnknownEvent          = errors.New("unknown notification event")
+	ErrUnknownChannel        = errors.New("notification channel must be one of EMAIL, WHATSAPP, SMS")
+	ErrWebhookNotFound       = errors.New("webhook subscription not found")
+	ErrDeliveryNotFound      = errors.New("webhook delivery not found")
+	ErrInvalidWebhookURL     = errors.New("webhook url must be a public https url")
+	ErrUnknownWebhookEvent   = errors.New("unknown webhook event type")
+	ErrStreamClosed          = errors.New("availability stream is shutting down")
+	ErrInboxNotFound         = errors.New("notification not found")
+	ErrInvalidTimezone       = errors.New("timezone must be an IANA name such as Asia/Jakarta")
+	ErrInvalidCalendarToken  = errors.New("invalid calendar token")
+	ErrInvalidCheckInToken   = errors.New("invalid check-in code")
+	ErrBookingNotConfirmed   = errors.New("only confirmed bookings can be checked in")
+	ErrAlreadyCheckedIn      = errors.New("booking is already checked in")
+	ErrCheckInWrongDate      = errors.New("booking is not for today")
+	ErrCheckInWrongVenue     = errors.New("booking is for another venue")
+	ErrInvalidVenueSort      = errors.New("sort must be one of name, -name, price, -price, rating, -rating, newest")
+	ErrInvalidPriceRange     = errors.New("min_price must not be greater than max_price")
+	ErrInvalidCursor         = errors.New("invalid or expired pagination cursor")
+	ErrInvalidCoordinates    = errors.New("latitude must be between -90 and 90 and longitude between -180 and 180")
+	ErrInvalidOpeningHours   = errors.New("opening hours need a day of week from 1 to 7, each day at most once, and different opening and closing times")
+	ErrAmenityNotFound       = errors.New("amenity not found")
+	ErrAmenityExists         = errors.New("amenity already exists")
+	ErrInvalidAmenityCode    = errors.New("amenity code must be 2-32 upper-case letters, digits or underscores")
+	ErrInvalidRadius         = errors.New("radius_km must be greater than 0 and at most 100")
+	ErrPhotoNotFound         = errors.New("photo not found")
+	ErrPhotoTooLarge         = errors.New("photo is too large")
+	ErrUnsupportedPhoto      = errors.New("photo must be a JPEG or PNG image of at most 24 megapixels")
+	ErrInvalidPhotoOrder     = errors.New("photo order must list every photo of the gallery exactly once")
+	ErrMaintenanceNotFound   = errors.New("maintenance window not found")
+	ErrInvalidMaintenance    = errors.New("maintenance window must end after it starts and must not be over already")
+	ErrMaintenanceOverlap    = errors.New("field already has maintenance planned in this period")
+	ErrFieldInMaintenance    = errors.New("field is under maintenance at that time")
+	ErrReviewNotFound        = errors.New("review not found")
+	ErrReviewExists          = errors.New("booking has already been reviewed")
+	ErrReviewNotAllowed      = errors.New("only a completed booking of your own at this venue can be reviewed")
+	ErrInvalidRating         = errors.New("rating must be between 1 and 5")
+	ErrEmptyReviewReply      = errors.New("reply must not be empty")
+	ErrInvalidReviewStatus   = errors.New("review status must be one of PUBLISHED, HIDDEN")
+	ErrFavouriteNotFound     = errors.New("not in favourites")
+	ErrSavedSearchNotFound   = errors.New("saved search not found")
+	ErrInvalidSavedSearch    = errors.New("day_of_week must be between 0 and 7 and start_time must be before end_time")
+	ErrTooManySavedSearches  = errors.New("saved search limit reached")
+	ErrHasFutureBookings     = errors.New("there are upcoming bookings; delete with force=true to cancel them")
+	ErrNotDeleted            = errors.New("not deleted")
+	ErrRestoreExpired        = errors.New("deleted too long ago to restore")
+	ErrParentDeleted         = errors.New("restore the venue or field it belongs to first")
+	ErrInvalidCatalog        = errors.New("catalog file has invalid rows")
+	ErrUnsupportedCatalog    = errors.New("catalog file must be a .csv or .xlsx file")
+	ErrCatalogTooLarge       = errors.New("catalog file is too large")
 )
@@ -0,0 +1,8 @@
+package domain
+
+// Favourites are the venues and fields a user keeps for quick access. Deleted
+// venues and fields drop out of them.
+type Favourites struct {
+	Venues []Venue
+	Fields []Field
+}
@@ -4,12 +4,62 @@ import (
 	"time"
 )
 
+const (
+	FieldStatusActive   = "ACTIVE"
+	FieldStatusInactive = "INACTIVE"
+)
+
+// DefaultFieldMaxPlayers is a futsal match: two teams of five.
+const DefaultFieldMaxPlayers = 10
+
 type Field struct {
-	ID        uint
-	Name      string
-	Type      string
-	Venue     Venue
+	ID   uint
+	Name string
+	// Type is a FieldType code, the playing surface.
+	Type  string
+	Venue Venue
+	// LengthMeters and WidthMeters are nil when the venue has not given them.
+	LengthMeters *float64
+	WidthMeters  *float64
+	Indoor       bool
+	HasLighting  bool
+	MaxPlayers   int
+	// Status is FieldStatusActive or FieldStatusInactive. Inactive fields
+	// cannot be booked and are left out of venue search.
+	Status    string
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt *time.Time
 }
+
+// IsActive reports whether the field can be booked. Fields without a status
+// are active.
+func (f Field) IsActive() bool {
+	return f.Status != FieldStatusInactive
+}
+
+// ValidateDetails checks the dimensions, capacity and status of the field.
+func (f Field) ValidateDetails() error {
+	for _, size := range []*float64{f.LengthMeters, f.WidthMeters} {
+		if size != nil && !(*size > 0 && *size <= 100) {
+			return ErrInvalidFieldDetails
+		}
+	}
+
+	if f.MaxPlayers < 2 || f.MaxPlayers > 50 {
+		return ErrInvalidFieldDetails
+	}
+
+	if f.Status != FieldStatusActive && f.Status != FieldStatusInactive {
+		return ErrInvalidFieldDetails
+	}
+
+	return nil
+}
+
+// FieldType is a playing surface a field can have, such as SINTETIS.
+type FieldType struct {
+	Code        string
+	Name        string
+	Description string
+}
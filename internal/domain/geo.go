@@ -0,0 +1,70 @@
+package domain
+
+import "math"
+
+// EarthRadiusKm is the mean radius of the Earth used for distances.
+const EarthRadiusKm = 6371.0088
+
+// GeoPoint is a WGS 84 position in degrees.
+type GeoPoint struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// GeoBox is a latitude and longitude range, in degrees.
+type GeoBox struct {
+	MinLatitude  float64
+	MaxLatitude  float64
+	MinLongitude float64
+	MaxLongitude float64
+}
+
+func (p GeoPoint) Valid() bool {
+	return p.Latitude >= -90 && p.Latitude <= 90 && p.Longitude >= -180 && p.Longitude <= 180
+}
+
+// DistanceKm is the great-circle distance to q by the haversine formula.
+func (p GeoPoint) DistanceKm(q GeoPoint) float64 {
+	lat1, lat2 := radians(p.Latitude), radians(q.Latitude)
+	dLat := lat2 - lat1
+	dLng := radians(q.Longitude - p.Longitude)
+
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(dLng/2), 2)
+
+	return 2 * EarthRadiusKm * math.Asin(math.Sqrt(math.Min(1, h)))
+}
+
+// BoundingBox returns a box holding every point within radiusKm of p, used to
+// narrow a search before computing exact distances. When the circle reaches a
+// pole or crosses the antimeridian the box spans all longitudes.
+func (p GeoPoint) BoundingBox(radiusKm float64) GeoBox {
+	dLat := degrees(radiusKm / EarthRadiusKm)
+	box := GeoBox{
+		MinLatitude:  p.Latitude - dLat,
+		MaxLatitude:  p.Latitude + dLat,
+		MinLongitude: -180,
+		MaxLongitude: 180,
+	}
+
+	if box.MinLatitude <= -90 || box.MaxLatitude >= 90 {
+		box.MinLatitude = math.Max(box.MinLatitude, -90)
+		box.MaxLatitude = math.Min(box.MaxLatitude, 90)
+		return box
+	}
+
+	dLng := degrees(math.Asin(math.Sin(radiusKm/EarthRadiusKm) / math.Cos(radians(p.Latitude))))
+	if p.Longitude-dLng >= -180 && p.Longitude+dLng <= 180 {
+		box.MinLongitude = p.Longitude - dLng
+		box.MaxLongitude = p.Longitude + dLng
+	}
+
+	return box
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func degrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
@@ -0,0 +1,49 @@
+package domain
+
+import "time"
+
+// Inbox-only events. Booking events and account_locked reuse the email template
+// names.
+const (
+	InboxEventAccountActivated  = "account_activated"
+	InboxEventPhoneVerified     = "phone_verified"
+	InboxEventTwoFactorEnabled  = "two_factor_enabled"
+	InboxEventTwoFactorDisabled = "two_factor_disabled"
+)
+
+// InboxTemplates have a "title" and a "body" definition under templates/inbox.
+var InboxTemplates = []string{
+	EmailTemplateAccountLocked,
+	EmailTemplateBookingReceived,
+	EmailTemplateBookingConfirmation,
+	EmailTemplateBookingCancellation,
+	EmailTemplateBookingReminder,
+	EmailTemplateBookingMaintenance,
+	EmailTemplatePaymentReceipt,
+	EmailTemplateSavedSearchMatch,
+	InboxEventAccountActivated,
+	InboxEventPhoneVerified,
+	InboxEventTwoFactorEnabled,
+	InboxEventTwoFactorDisabled,
+}
+
+// InboxNotification is an in-app notification shown under the notification bell.
+type InboxNotification struct {
+	ID        uint
+	UserID    uint
+	Event     string
+	Title     string
+	Body      string
+	ReadAt    *time.Time
+	CreatedAt time.Time
+}
+
+func (n InboxNotification) IsRead() bool {
+	return n.ReadAt != nil
+}
+
+type InboxFilter struct {
+	UnreadOnly bool
+	Limit      int
+	Offset     int
+}
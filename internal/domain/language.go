@@ -0,0 +1,36 @@
+package domain
+
+import "strings"
+
+const (
+	LanguageIndonesian = "id"
+	LanguageEnglish    = "en"
+	DefaultLanguage    = LanguageIndonesian
+)
+
+var SupportedLanguages = []string{LanguageIndonesian, LanguageEnglish}
+
+func IsSupportedLanguage(language string) bool {
+	for _, supported := range SupportedLanguages {
+		if language == supported {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NormalizeLanguage maps a language tag or an Accept-Language value such as
+// "en-US,en;q=0.9" to the first supported language, or DefaultLanguage.
+func NormalizeLanguage(value string) string {
+	for _, tag := range strings.Split(value, ",") {
+		tag = strings.TrimSpace(strings.SplitN(tag, ";", 2)[0])
+		primary := strings.ToLower(strings.SplitN(tag, "-", 2)[0])
+
+		if IsSupportedLanguage(primary) {
+			return primary
+		}
+	}
+
+	return DefaultLanguage
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	LoginScopeAccount = "ACCOUNT"
+	LoginScopeIP      = "IP"
+)
+
+// LoginAttempt tracks consecutive failed logins for one account or one client IP.
+type LoginAttempt struct {
+	Scope        string
+	Key          string
+	FailedCount  int
+	LastFailedAt *time.Time
+	LockedUntil  *time.Time
+}
+
+// LoginBackoff is how long a key must wait between attempts. It gets FreeAttempts
+// tries, then BaseDelay doubling per further failure up to MaxDelay. Failures
+// older than Window are forgotten.
+type LoginBackoff struct {
+	FreeAttempts int
+	BaseDelay    time.Duration
+	MaxDelay     time.Duration
+	Window       time.Duration
+}
+
+// Delay is how long to wait after the last failure once failedCount failures
+// have been counted.
+func (b LoginBackoff) Delay(failedCount int) time.Duration {
+	if failedCount < b.FreeAttempts {
+		return 0
+	}
+
+	delay := b.BaseDelay
+	for i := 0; i < failedCount-b.FreeAttempts && delay < b.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, b.MaxDelay)
+}
+
+// LoginThrottleError is returned when a login is refused before the password is
+// checked. It wraps ErrTooManyLoginAttempts or ErrAccountLocked.
+type LoginThrottleError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+func (e *LoginThrottleError) Error() string {
+	return fmt.Sprintf("%v, retry after %v", e.Err, e.RetryAfter.Round(time.Second))
+}
+
+func (e *LoginThrottleError) Unwrap() error {
+	return e.Err
+}
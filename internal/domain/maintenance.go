@@ -0,0 +1,68 @@
+package domain
+
+import "time"
+
+const (
+	// MaintenanceSuggestionDays is how many days before and after a booking
+	// hit by maintenance are searched for alternative slots.
+	MaintenanceSuggestionDays = 3
+	// MaintenanceSuggestionLimit caps the alternatives offered per booking.
+	MaintenanceSuggestionLimit = 3
+	MaintenanceReasonMaxLength = 255
+)
+
+// MaintenanceWindow blocks bookings of a field from StartsAt until EndsAt.
+type MaintenanceWindow struct {
+	ID        uint
+	FieldID   uint
+	StartsAt  time.Time
+	EndsAt    time.Time
+	Reason    string
+	CreatedBy uint
+	CreatedAt time.Time
+}
+
+// Overlaps reports whether the window shares any time with [start, end).
+func (w MaintenanceWindow) Overlaps(start, end time.Time) bool {
+	return w.StartsAt.Before(end) && w.EndsAt.After(start)
+}
+
+// AlternativeSlot is a free slot offered instead of a booking that falls in a
+// maintenance window.
+type AlternativeSlot struct {
+	Schedule Schedule
+	Date     time.Time
+}
+
+func (s AlternativeSlot) StartsAt() time.Time {
+	return combineDateAndTime(s.Date, s.Schedule.StartTime, s.Schedule.Field.Venue.Location())
+}
+
+func (s AlternativeSlot) EndsAt() time.Time {
+	return combineDateAndTime(s.Date, s.Schedule.EndTime, s.Schedule.Field.Venue.Location())
+}
+
+// MaintenanceImpact is a booking that falls in a maintenance window, with the
+// slots suggested to its customer.
+type MaintenanceImpact struct {
+	Booking      Booking
+	Alternatives []AlternativeSlot
+}
+
+// ScheduleSlotAt returns when schedule takes place on date, in the venue's
+// timezone.
+func ScheduleSlotAt(schedule Schedule, date time.Time) (time.Time, time.Time) {
+	slot := AlternativeSlot{Schedule: schedule, Date: date}
+	return slot.StartsAt(), slot.EndsAt()
+}
+
+// ScheduleDay numbers date's weekday the way schedules do, 1 (Monday) to 7
+// (Sunday).
+func ScheduleDay(date time.Time) int {
+	day := int(date.Weekday())
+	if day == 0 {
+		day = 7
+	}
+
+	return day
+}
@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+// OIDCState remembers one in-flight authorization request until its callback.
+type OIDCState struct {
+	State        string
+	CodeVerifier string
+	Nonce        string
+	ExpiresAt    time.Time
+}
+
+// OIDCClaims are the verified ID token claims the login flow relies on.
+type OIDCClaims struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Nonce         string
+}
+
+// UserIdentity links an account at an external identity provider to a User.
+type UserIdentity struct {
+	ID        uint
+	UserID    uint
+	Provider  string
+	Subject   string
+	Email     string
+	CreatedAt time.Time
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	OutboxStatusPending = "PENDING"
+	OutboxStatusSent    = "SENT"
+	OutboxStatusDead    = "DEAD"
+)
+
+const (
+	OutboxTopicEmail       = "email.send"
+	OutboxTopicTextMessage = "text_message.send"
+)
+
+// OutboxMessage is written in the same transaction as the change that caused it
+// and delivered afterwards by the dispatcher. Messages that keep failing, or fail
+// permanently, end up DEAD until an admin replays them.
+type OutboxMessage struct {
+	ID            uint
+	Topic         string
+	Payload       json.RawMessage
+	Status        string
+	Attempts      int
+	MaxAttempts   int
+	NextAttemptAt time.Time
+	LockedUntil   *time.Time
+	LastError     string
+	SentAt        *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type OutboxFilter struct {
+	Status string
+	Topic  string
+	Limit  int
+	Offset int
+}
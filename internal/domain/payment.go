@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	PaymentMethodTransferBank = "TRANSFER_BANK"
+	PaymentMethodEWallet      = "E_WALLET"
+	PaymentMethodCash         = "CASH"
+)
+
+const (
+	PaymentStatusPending = "PENDING"
+	PaymentStatusSuccess = "SUCCESS"
+	PaymentStatusFailed  = "FAILED"
+)
+
+type Payment struct {
+	ID            uint
+	BookingID     uint
+	PaymentMethod string
+	Amount        float64
+	Status        string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// ReceiptNumber is the human-facing identifier printed on payment receipts.
+func (p Payment) ReceiptNumber() string {
+	return fmt.Sprintf("INV-%s-%06d", p.CreatedAt.Format("20060102"), p.ID)
+}
@@ -0,0 +1,21 @@
+package domain
+
+const (
+	PermVenueWrite         = "venue:write"
+	PermVenueManageAny     = "venue:manage:any"
+	PermBookingReadAny     = "booking:read:any"
+	PermRoleManage         = "role:manage"
+	PermUserManage         = "user:manage"
+	PermNotificationManage = "notification:manage"
+	PermWebhookManage      = "webhook:manage"
+	PermAmenityManage      = "amenity:manage"
+	PermReviewModerate     = "review:moderate"
+	PermDeletedRestore     = "deleted:restore"
+	PermCatalogManage      = "catalog:manage"
+)
+
+type Permission struct {
+	ID          uint
+	Name        string
+	Description string
+}
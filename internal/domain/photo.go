@@ -0,0 +1,46 @@
+package domain
+
+import "time"
+
+// Photo is an image of a venue, or of one of its fields when FieldID is set.
+// StorageKey and ThumbnailKey locate the files in blob storage; URL and
+// ThumbnailURL are filled in when photos are returned.
+type Photo struct {
+	ID           uint
+	VenueID      uint
+	FieldID      *uint
+	StorageKey   string
+	ThumbnailKey string
+	URL          string
+	ThumbnailURL string
+	ContentType  string
+	Width        int
+	Height       int
+	SizeBytes    int64
+	// Position orders the photos of a gallery, starting at 1.
+	Position   int
+	IsCover    bool
+	UploadedBy uint
+	CreatedAt  time.Time
+}
+
+// PhotoGallery is the ordered set of photos of a venue, or of one of its
+// fields when FieldID is set. A gallery has one cover photo unless it is
+// empty.
+type PhotoGallery struct {
+	VenueID uint
+	FieldID *uint
+}
+
+const (
+	// PhotoMaxPixels keeps decoding a small file from allocating gigabytes.
+	PhotoMaxPixels = 24_000_000
+	// PhotoThumbnailSize is the longest side of a thumbnail, in pixels.
+	PhotoThumbnailSize = 400
+)
+
+// PhotoExtensions maps the accepted photo content types to file extensions.
+var PhotoExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+}
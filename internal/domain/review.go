@@ -0,0 +1,41 @@
+package domain
+
+import "time"
+
+const (
+	ReviewStatusPublished = "PUBLISHED"
+	// ReviewStatusHidden is set by moderators. Hidden reviews are not listed
+	// and do not count towards the venue's rating.
+	ReviewStatusHidden = "HIDDEN"
+
+	ReviewMinRating        = 1
+	ReviewMaxRating        = 5
+	ReviewCommentMaxLength = 2000
+	ReviewReplyMaxLength   = 1000
+)
+
+// Review is a customer's rating of a venue, left for one of their completed
+// bookings there. The venue's owner may reply to it once.
+type Review struct {
+	ID        uint
+	VenueID   uint
+	BookingID uint
+	User      User
+	Rating    int
+	Comment   string
+	Reply     string
+	RepliedBy *uint
+	RepliedAt *time.Time
+	Status    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (r Review) IsPublished() bool {
+	return r.Status == ReviewStatusPublished
+}
+
+type ReviewFilter struct {
+	Limit  int
+	Offset int
+}
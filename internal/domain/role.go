@@ -6,6 +6,17 @@ const (
 )
 
 type Role struct {
-	ID       uint
-	RoleName string
+	ID          uint
+	RoleName    string
+	Permissions []Permission
+}
+
+func (r Role) HasPermission(name string) bool {
+	for _, p := range r.Permissions {
+		if p.Name == name {
+			return true
+		}
+	}
+
+	return false
 }
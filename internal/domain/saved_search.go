@@ -0,0 +1,54 @@
+package domain
+
+import "time"
+
+const (
+	SavedSearchMaxPerUser    = 20
+	SavedSearchNameMaxLength = 100
+	// SavedSearchAlertSlots caps the slots listed in one alert. The rest are
+	// left for the next alert.
+	SavedSearchAlertSlots = 5
+)
+
+// SavedSearch is a set of slot criteria a user keeps to look up again, and
+// optionally to be told about. Empty criteria match anything.
+type SavedSearch struct {
+	ID     uint
+	UserID uint
+	// User is only loaded for alerts.
+	User      User
+	Name      string
+	City      string
+	FieldType string
+	// DayOfWeek runs from 1 (Monday) to 7 (Sunday) as for schedules, or is 0
+	// for any day.
+	DayOfWeek int
+	// StartTime and EndTime are times of day a slot must lie within. Either
+	// may be nil.
+	StartTime *time.Time
+	EndTime   *time.Time
+	// Notify asks for an alert whenever a matching slot in the coming days is
+	// free and has not been alerted yet.
+	Notify    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Validate checks the day and that the time range, when both ends are set,
+// is not empty.
+func (s SavedSearch) Validate() error {
+	if s.DayOfWeek < 0 || s.DayOfWeek > 7 {
+		return ErrInvalidSavedSearch
+	}
+	if s.StartTime != nil && s.EndTime != nil && s.StartTime.Format("15:04") >= s.EndTime.Format("15:04") {
+		return ErrInvalidSavedSearch
+	}
+
+	return nil
+}
+
+// SavedSearchSlot is a free slot matching a saved search.
+type SavedSearchSlot struct {
+	Schedule Schedule
+	Date     time.Time
+}
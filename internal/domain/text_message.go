@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	NotificationChannelEmail    = "EMAIL"
+	NotificationChannelWhatsApp = "WHATSAPP"
+	NotificationChannelSMS      = "SMS"
+)
+
+var NotificationChannels = []string{
+	NotificationChannelEmail,
+	NotificationChannelWhatsApp,
+	NotificationChannelSMS,
+}
+
+// NotificationEvents are the notifications users may route to another channel.
+// Account and security emails always go by email.
+var NotificationEvents = []string{
+	EmailTemplateBookingReceived,
+	EmailTemplateBookingConfirmation,
+	EmailTemplateBookingCancellation,
+	EmailTemplateBookingReminder,
+	EmailTemplateBookingMaintenance,
+	EmailTemplatePaymentReceipt,
+	EmailTemplateSavedSearchMatch,
+}
+
+const TextMessageTemplatePhoneVerification = "phone_verification"
+
+// TextMessageTemplates have a short "message" definition for SMS and WhatsApp.
+var TextMessageTemplates = append([]string{TextMessageTemplatePhoneVerification}, NotificationEvents...)
+
+// TextMessage is a short plain-text message sent over SMS or WhatsApp. To is an
+// E.164 phone number.
+type TextMessage struct {
+	Channel string
+	To      string
+	Body    string
+}
+
+// NotificationPreference routes one event to a channel for a user. Events
+// without a preference go by email.
+type NotificationPreference struct {
+	UserID    uint
+	Event     string
+	Channel   string
+	UpdatedAt time.Time
+}
+
+// PhoneVerification is a pending one-time code sent to a phone number the user
+// wants to add. Only a hash of the code is stored.
+type PhoneVerification struct {
+	UserID      uint
+	PhoneNumber string
+	CodeHash    string
+	Attempts    int
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+}
+
+func IsNotificationChannel(channel string) bool {
+	for _, c := range NotificationChannels {
+		if channel == c {
+			return true
+		}
+	}
+
+	return false
+}
+
+func IsNotificationEvent(event string) bool {
+	for _, e := range NotificationEvents {
+		if event == e {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NormalizePhoneNumber turns a phone number into E.164. Local Indonesian
+// numbers such as 0812-3456-7890 are assumed to be +62.
+func NormalizePhoneNumber(value string) (string, error) {
+	digits := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '(', ')', '.':
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(value))
+
+	switch {
+	case strings.HasPrefix(digits, "+"):
+		digits = digits[1:]
+	case strings.HasPrefix(digits, "0"):
+		digits = "62" + digits[1:]
+	}
+
+	if len(digits) < 9 || len(digits) > 15 || digits[0] == '0' {
+		return "", ErrInvalidPhoneNumber
+	}
+
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return "", ErrInvalidPhoneNumber
+		}
+	}
+
+	return "+" + digits, nil
+}
@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+const (
+	ChallengeTwoFactorLogin = "2fa_login"
+	ChallengeTwoFactorSetup = "2fa_setup"
+)
+
+// TwoFactor holds a user's TOTP enrolment. Secret is kept encrypted at rest and is
+// only decrypted inside the service.
+type TwoFactor struct {
+	UserID       uint
+	Secret       string
+	Enabled      bool
+	LastUsedStep int64
+	EnabledAt    *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type TwoFactorSetup struct {
+	Secret     string
+	OTPAuthURI string
+}
+
+// RoleRequiresTwoFactor reports whether users of a role may only log in with TOTP.
+func RoleRequiresTwoFactor(roleName string) bool {
+	return roleName == RoleAdmin
+}
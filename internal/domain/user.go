@@ -12,8 +12,16 @@ type User struct {
 	Password   string
 	Age        int
 	Address    string
-	Role       Role
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
-	DeletedAt  *time.Time
+	Language   string
+	// PhoneNumber is E.164 and only set once verified by a one-time code.
+	PhoneNumber     string
+	PhoneVerifiedAt *time.Time
+	Role            Role
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       *time.Time
+}
+
+func (u User) HasVerifiedPhone() bool {
+	return u.PhoneNumber != "" && u.PhoneVerifiedAt != nil
 }
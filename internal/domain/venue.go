@@ -4,12 +4,91 @@ import (
 	"time"
 )
 
+// DefaultVenueTimezone is used for venues created without a timezone.
+const DefaultVenueTimezone = "Asia/Jakarta"
+
 type Venue struct {
-	ID        uint
-	Name      string
-	Address   string
-	City      string
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt *time.Time
+	ID      uint
+	Name    string
+	Address string
+	City    string
+	// Timezone is the IANA name of the zone the venue's schedules are in.
+	Timezone string
+	// Coordinates is nil until the venue is placed on the map.
+	Coordinates *GeoPoint
+	// PhoneNumber and WhatsAppNumber are E.164 numbers, or empty.
+	PhoneNumber    string
+	WhatsAppNumber string
+	// OpeningHours has at most one entry per day; days without one are closed.
+	OpeningHours []OpeningHours
+	Amenities    []Amenity
+	// Rating is the average of the venue's published reviews, 0 while it has
+	// none. Both are kept up to date as reviews come and go.
+	Rating      float64
+	ReviewCount int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   *time.Time
+}
+
+// OpeningHours are a venue's hours on one day of the week. DayOfWeek runs from
+// 1 (Monday) to 7 (Sunday) as for schedules. A ClosesAt before OpensAt means
+// the venue closes after midnight.
+type OpeningHours struct {
+	DayOfWeek int
+	OpensAt   time.Time
+	ClosesAt  time.Time
+}
+
+// ValidateOpeningHours checks that every day is valid, listed once and has
+// different opening and closing times.
+func ValidateOpeningHours(hours []OpeningHours) error {
+	seen := make(map[int]bool, len(hours))
+	for _, h := range hours {
+		if h.DayOfWeek < 1 || h.DayOfWeek > 7 || seen[h.DayOfWeek] {
+			return ErrInvalidOpeningHours
+		}
+		if h.OpensAt.Format("15:04") == h.ClosesAt.Format("15:04") {
+			return ErrInvalidOpeningHours
+		}
+		seen[h.DayOfWeek] = true
+	}
+
+	return nil
+}
+
+// MaxNearbyRadiusKm bounds nearby searches so they stay cheap.
+const MaxNearbyRadiusKm = 100
+
+// NearbyVenue is a venue with its distance from a search point.
+type NearbyVenue struct {
+	Venue      Venue
+	DistanceKm float64
+}
+
+// Location returns the venue's timezone, or the server's local time when it
+// is missing or unknown.
+func (v Venue) Location() *time.Location {
+	if v.Timezone == "" {
+		return time.Local
+	}
+
+	loc, err := time.LoadLocation(v.Timezone)
+	if err != nil {
+		return time.Local
+	}
+
+	return loc
+}
+
+// FullAddress joins the address and city, skipping whichever is empty.
+func (v Venue) FullAddress() string {
+	switch {
+	case v.Address == "":
+		return v.City
+	case v.City == "":
+		return v.Address
+	}
+
+	return v.Address + ", " + v.City
 }
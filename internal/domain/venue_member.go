@@ -0,0 +1,41 @@
+package domain
+
+import "time"
+
+const (
+	VenueRoleOwner   = "OWNER"
+	VenueRoleManager = "MANAGER"
+	VenueRoleStaff   = "STAFF"
+)
+
+var venueRoleRanks = map[string]int{
+	VenueRoleStaff:   1,
+	VenueRoleManager: 2,
+	VenueRoleOwner:   3,
+}
+
+type VenueMember struct {
+	ID        uint
+	Venue     Venue
+	User      User
+	Role      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IsValidVenueRole reports whether role is one of the venue membership roles.
+func IsValidVenueRole(role string) bool {
+	_, ok := venueRoleRanks[role]
+	return ok
+}
+
+// VenueRoleAtLeast reports whether role grants at least the privileges of minRole.
+// Owners can do everything managers can, and managers everything staff can.
+func VenueRoleAtLeast(role, minRole string) bool {
+	rank, ok := venueRoleRanks[role]
+	if !ok {
+		return false
+	}
+
+	return rank >= venueRoleRanks[minRole]
+}
@@ -0,0 +1,94 @@
+package domain
+
+import "time"
+
+const (
+	VenueSortName       = "name"
+	VenueSortNameDesc   = "-name"
+	VenueSortPrice      = "price"
+	VenueSortPriceDesc  = "-price"
+	VenueSortRating     = "rating"
+	VenueSortRatingDesc = "-rating"
+	VenueSortNewest     = "newest"
+)
+
+var VenueSorts = []string{VenueSortName, VenueSortNameDesc, VenueSortPrice, VenueSortPriceDesc, VenueSortRating, VenueSortRatingDesc, VenueSortNewest}
+
+// VenueFilter narrows a venue search. FieldType, the price range and Date and
+// StartTime all describe one slot: a venue matches when a single schedule
+// satisfies every one of them that is set.
+type VenueFilter struct {
+	City string
+	// Query matches the name, address or city, case-insensitively.
+	Query     string
+	FieldType string
+	// Date asks for a slot on that day that is not booked. StartTime, a time
+	// of day, further asks for the slot to be running at that time.
+	Date      *time.Time
+	StartTime *time.Time
+	MinPrice  *float64
+	MaxPrice  *float64
+	// Amenities are codes the venue must all have.
+	Amenities []string
+	Sort      string
+	Limit     int
+	Offset    int
+	// After continues a cursor search from the last venue of the previous
+	// page. Offset is ignored when it is set.
+	After *VenueCursor
+}
+
+// VenueListing is a search result. MinPrice is the cheapest slot matching the
+// filter, or nil if the venue has no schedules.
+type VenueListing struct {
+	Venue    Venue
+	MinPrice *float64
+}
+
+// VenueCursor is the position of a venue in a sort order. Only the key of that
+// order is set.
+type VenueCursor struct {
+	Sort      string    `json:"s"`
+	ID        uint      `json:"id"`
+	Name      string    `json:"n,omitempty"`
+	CreatedAt time.Time `json:"c,omitempty"`
+	MinPrice  *float64  `json:"p,omitempty"`
+	Rating    float64   `json:"r,omitempty"`
+}
+
+// VenuePage is one page of a venue search. NextCursor is empty on the last
+// page.
+type VenuePage struct {
+	Venues     []VenueListing
+	Total      int64
+	HasMore    bool
+	NextCursor string
+}
+
+func IsVenueSort(sort string) bool {
+	for _, s := range VenueSorts {
+		if sort == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CursorAfter returns the cursor that continues a search after listing.
+func (l VenueListing) CursorAfter(sort string) VenueCursor {
+	cursor := VenueCursor{Sort: sort, ID: l.Venue.ID}
+
+	switch sort {
+	case VenueSortName, VenueSortNameDesc:
+		cursor.Name = l.Venue.Name
+	case VenueSortPrice, VenueSortPriceDesc:
+		cursor.MinPrice = l.MinPrice
+	case VenueSortRating, VenueSortRatingDesc:
+		cursor.Rating = l.Venue.Rating
+	case VenueSortNewest:
+		cursor.CreatedAt = l.Venue.CreatedAt
+	}
+
+	return cursor
+}
@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	WebhookEventBookingCreated   = "booking.created"
+	WebhookEventBookingConfirmed = "booking.confirmed"
+	WebhookEventBookingCancelled = "booking.cancelled"
+	WebhookEventPaymentSucceeded = "payment.succeeded"
+)
+
+var WebhookEventTypes = []string{
+	WebhookEventBookingCreated,
+	WebhookEventBookingConfirmed,
+	WebhookEventBookingCancelled,
+	WebhookEventPaymentSucceeded,
+}
+
+const (
+	WebhookDeliveryPending   = "PENDING"
+	WebhookDeliverySucceeded = "SUCCEEDED"
+	WebhookDeliveryFailed    = "FAILED"
+)
+
+const OutboxTopicWebhook = "webhook.deliver"
+
+// WebhookSubscription sends the chosen event types to URL. Subscriptions with a
+// VenueID only receive events of that venue; the others receive every event.
+type WebhookSubscription struct {
+	ID          uint
+	VenueID     *uint
+	URL         string
+	Description string
+	EventTypes  []string
+	// Secret is encrypted at rest and only returned when it is generated.
+	Secret    string
+	Active    bool
+	CreatedBy uint
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (s WebhookSubscription) Subscribes(eventType string) bool {
+	for _, t := range s.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WebhookEvent is the JSON body posted to subscribers.
+type WebhookEvent struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      any       `json:"data"`
+}
+
+// WebhookDelivery is one attempt history of sending an event to a subscription.
+// A replay creates a new delivery pointing at the original through ReplayOf.
+type WebhookDelivery struct {
+	ID             uint
+	SubscriptionID uint
+	EventID        string
+	EventType      string
+	Payload        json.RawMessage
+	Status         string
+	Attempts       int
+	ResponseStatus int
+	LastError      string
+	DurationMs     int64
+	DeliveredAt    *time.Time
+	ReplayOf       *uint
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// WebhookAttempt is the outcome of a single POST to a subscriber.
+type WebhookAttempt struct {
+	Status         string
+	ResponseStatus int
+	Error          string
+	DurationMs     int64
+	At             time.Time
+}
+
+type WebhookDeliveryFilter struct {
+	Status string
+	Limit  int
+	Offset int
+}
+
+func IsWebhookEventType(eventType string) bool {
+	for _, t := range WebhookEventTypes {
+		if eventType == t {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,6 @@
+package request
+
+type CreateAmenityRequest struct {
+	Code string `json:"code" validate:"required,max=32" example:"PRAYER_ROOM"`
+	Name string `json:"name" validate:"required,max=100" example:"Prayer room"`
+}
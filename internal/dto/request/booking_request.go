@@ -15,3 +15,16 @@ type UpdateBookingRequest struct {
 	// Status      string  `json:"status"`
 	// TotalPrice  float64 `json:"total_price" validate:"required"`
 }
+
+type CancelBookingRequest struct {
+	Reason string `json:"reason" validate:"max=500"`
+}
+
+type RecordPaymentRequest struct {
+	PaymentMethod string `json:"payment_method" validate:"required,oneof=TRANSFER_BANK E_WALLET CASH"`
+}
+
+type CheckInRequest struct {
+	Token   string `json:"token" validate:"required,max=200"`
+	VenueID uint   `json:"venue_id" validate:"required"`
+}
@@ -3,10 +3,25 @@ package request
 type CreateFieldRequest struct {
 	VenueID   uint   `json:"venue_id" validate:"required"`
 	Name      string `json:"name" validate:"required"`
-	FieldType string `json:"field_type" validate:"required"`
+	FieldType string `json:"field_type" validate:"required" example:"SINTETIS"`
+	FieldDetailsRequest
 }
 
 type UpdateFieldRequest struct {
 	Name      string `json:"name" validate:"required"`
-	FieldType string `json:"field_type" validate:"required"`
+	FieldType string `json:"field_type" validate:"required" example:"SINTETIS"`
+	FieldDetailsRequest
+}
+
+// FieldDetailsRequest holds the optional attributes of a field. On create an
+// omitted value gets its default; on update it keeps its current value.
+type FieldDetailsRequest struct {
+	LengthMeters *float64 `json:"length_m" validate:"omitempty,gt=0,lte=100" example:"40"`
+	WidthMeters  *float64 `json:"width_m" validate:"omitempty,gt=0,lte=100" example:"20"`
+	Indoor       *bool    `json:"indoor" example:"true"`
+	HasLighting  *bool    `json:"has_lighting" example:"true"`
+	// MaxPlayers defaults to 10.
+	MaxPlayers *int `json:"max_players" validate:"omitempty,min=2,max=50" example:"10"`
+	// Status defaults to ACTIVE.
+	Status *string `json:"status" validate:"omitempty,oneof=ACTIVE INACTIVE" example:"ACTIVE"`
 }
@@ -0,0 +1,9 @@
+package request
+
+import "time"
+
+type CreateMaintenanceWindowRequest struct {
+	StartsAt time.Time `json:"starts_at" validate:"required" example:"2025-03-14T06:00:00+07:00"`
+	EndsAt   time.Time `json:"ends_at" validate:"required" example:"2025-03-16T22:00:00+07:00"`
+	Reason   string    `json:"reason" validate:"required,max=255" example:"Resurfacing"`
+}
@@ -0,0 +1,18 @@
+package request
+
+type PhoneNumberRequest struct {
+	PhoneNumber string `json:"phone_number" validate:"required,max=20" example:"081234567890"`
+}
+
+type PhoneVerificationRequest struct {
+	Code string `json:"code" validate:"required,len=6,numeric" example:"123456"`
+}
+
+type NotificationPreferenceRequest struct {
+	Event   string `json:"event" validate:"required" example:"booking_reminder"`
+	Channel string `json:"channel" validate:"required,oneof=EMAIL WHATSAPP SMS" example:"WHATSAPP"`
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	Preferences []NotificationPreferenceRequest `json:"preferences" validate:"required,min=1,dive"`
+}
@@ -0,0 +1,6 @@
+package request
+
+// ReorderPhotosRequest lists every photo of the gallery in the new order.
+type ReorderPhotosRequest struct {
+	PhotoIDs []uint `json:"photo_ids" validate:"required,min=1,dive,gt=0" example:"3,1,2"`
+}
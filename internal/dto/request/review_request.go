@@ -0,0 +1,15 @@
+package request
+
+type CreateReviewRequest struct {
+	BookingID uint   `json:"booking_id" validate:"required" example:"42"`
+	Rating    int    `json:"rating" validate:"required,min=1,max=5" example:"5"`
+	Comment   string `json:"comment" validate:"max=2000" example:"Great pitch, friendly staff."`
+}
+
+type ReplyReviewRequest struct {
+	Reply string `json:"reply" validate:"required,max=1000" example:"Thank you, see you next week!"`
+}
+
+type ModerateReviewRequest struct {
+	Status string `json:"status" validate:"required,oneof=PUBLISHED HIDDEN" example:"HIDDEN"`
+}
@@ -0,0 +1,18 @@
+package request
+
+type CreateRoleRequest struct {
+	Name        string   `json:"name" validate:"required,max=100"`
+	Permissions []string `json:"permissions"`
+}
+
+type UpdateRoleRequest struct {
+	Name string `json:"name" validate:"required,max=100"`
+}
+
+type AssignUserRoleRequest struct {
+	RoleID uint `json:"role_id" validate:"required"`
+}
+
+type SetRolePermissionsRequest struct {
+	Permissions []string `json:"permissions" validate:"required"`
+}
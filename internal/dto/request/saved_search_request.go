@@ -0,0 +1,16 @@
+package request
+
+// SavedSearchRequest creates or replaces a saved search. Empty criteria
+// match anything.
+type SavedSearchRequest struct {
+	Name      string `json:"name" validate:"required,max=100" example:"Friday evenings"`
+	City      string `json:"city" validate:"max=100" example:"Jakarta"`
+	FieldType string `json:"field_type" validate:"max=20" example:"VINYL"`
+	// DayOfWeek is 1 (Monday) to 7 (Sunday), or 0 for any day.
+	DayOfWeek int `json:"day_of_week" validate:"min=0,max=7" example:"5"`
+	// StartTime and EndTime bound the slot start times, in the venue's time.
+	StartTime string `json:"start_time" validate:"omitempty,datetime=15:04" example:"18:00"`
+	EndTime   string `json:"end_time" validate:"omitempty,datetime=15:04" example:"22:00"`
+	// Notify asks for an alert when a matching slot is free.
+	Notify bool `json:"notify" example:"true"`
+}
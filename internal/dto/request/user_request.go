@@ -6,6 +6,11 @@ type UserRegisterRequest struct {
 	Password string `json:"password" validate:"required,min=6"`
 	Age      int    `json:"age" validate:"required,min=15"`
 	Address  string `json:"address" validate:"required"`
+	Language string `json:"language" validate:"omitempty,oneof=id en" example:"id"`
+}
+
+type UserLanguageRequest struct {
+	Language string `json:"language" validate:"required,oneof=id en" example:"en"`
 }
 
 type UserLoginRequest struct {
@@ -4,10 +4,50 @@ type CreateVenueRequest struct {
 	Name    string `json:"name" validate:"required"`
 	Address string `json:"address" validate:"required"`
 	City    string `json:"city" validate:"required"`
+	// Timezone defaults to Asia/Jakarta.
+	Timezone string `json:"timezone" validate:"max=64" example:"Asia/Makassar"`
+	// Latitude and Longitude are optional but must be given together.
+	Latitude  *float64 `json:"latitude" validate:"required_with=Longitude,omitempty,min=-90,max=90" example:"-6.2183"`
+	Longitude *float64 `json:"longitude" validate:"required_with=Latitude,omitempty,min=-180,max=180" example:"106.8022"`
+	// PhoneNumber and WhatsAppNumber accept local Indonesian or E.164 numbers.
+	PhoneNumber    *string `json:"phone_number" validate:"omitempty,max=20" example:"0215551234"`
+	WhatsAppNumber *string `json:"whatsapp_number" validate:"omitempty,max=20" example:"+6281234567890"`
+	// OpeningHours lists the days the venue is open.
+	OpeningHours []OpeningHoursRequest `json:"opening_hours" validate:"omitempty,max=7,dive"`
+	// Amenities are codes from GET /amenities.
+	Amenities []string `json:"amenities" validate:"omitempty,max=50" example:"PARKING,SHOWER"`
 }
 
 type UpdateVenueRequest struct {
 	Name    string `json:"name" validate:"required"`
 	Address string `json:"address" validate:"required"`
 	City    string `json:"city" validate:"required"`
+	// Timezone is left unchanged when empty.
+	Timezone string `json:"timezone" validate:"max=64" example:"Asia/Makassar"`
+	// Latitude and Longitude are left unchanged when both are omitted.
+	Latitude  *float64 `json:"latitude" validate:"required_with=Longitude,omitempty,min=-90,max=90" example:"-6.2183"`
+	Longitude *float64 `json:"longitude" validate:"required_with=Latitude,omitempty,min=-180,max=180" example:"106.8022"`
+	// Omitted contact numbers, opening hours and amenities are left
+	// unchanged; an empty string or list clears them.
+	PhoneNumber    *string               `json:"phone_number" validate:"omitempty,max=20" example:"0215551234"`
+	WhatsAppNumber *string               `json:"whatsapp_number" validate:"omitempty,max=20" example:"+6281234567890"`
+	OpeningHours   []OpeningHoursRequest `json:"opening_hours" validate:"omitempty,max=7,dive"`
+	Amenities      []string              `json:"amenities" validate:"omitempty,max=50" example:"PARKING,SHOWER"`
+}
+
+// OpeningHoursRequest is one day of a venue's week, 1 (Monday) to 7 (Sunday).
+// A closes_at before opens_at means closing after midnight.
+type OpeningHoursRequest struct {
+	DayOfWeek int    `json:"day_of_week" validate:"required,min=1,max=7" example:"1"`
+	OpensAt   string `json:"opens_at" validate:"required,datetime=15:04" example:"08:00"`
+	ClosesAt  string `json:"closes_at" validate:"required,datetime=15:04" example:"23:00"`
+}
+
+type AddVenueMemberRequest struct {
+	UserID uint   `json:"user_id" validate:"required"`
+	Role   string `json:"role" validate:"required,oneof=OWNER MANAGER STAFF"`
+}
+
+type UpdateVenueMemberRequest struct {
+	Role string `json:"role" validate:"required,oneof=OWNER MANAGER STAFF"`
 }
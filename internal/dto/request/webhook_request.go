@@ -0,0 +1,17 @@
+package request
+
+type CreateWebhookRequest struct {
+	// VenueID limits the subscription to one venue's events. Leave it out for a
+	// platform-wide subscription, which needs the webhook:manage permission.
+	VenueID     *uint    `json:"venue_id,omitempty" example:"1"`
+	URL         string   `json:"url" validate:"required,url,max=2048" example:"https://partner.example.com/webhooks/futsal"`
+	Description string   `json:"description" validate:"max=255" example:"Accounting sync"`
+	EventTypes  []string `json:"event_types" validate:"required,min=1,dive,required" example:"booking.created,payment.succeeded"`
+}
+
+type UpdateWebhookRequest struct {
+	URL         string   `json:"url" validate:"required,url,max=2048" example:"https://partner.example.com/webhooks/futsal"`
+	Description string   `json:"description" validate:"max=255" example:"Accounting sync"`
+	EventTypes  []string `json:"event_types" validate:"required,min=1,dive,required" example:"booking.created,payment.succeeded"`
+	Active      *bool    `json:"active" validate:"required" example:"true"`
+}
@@ -0,0 +1,15 @@
+package response
+
+import "go-futsal-booking-api/internal/domain"
+
+type AmenityResponse struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+func ToAmenityResponse(amenity *domain.Amenity) AmenityResponse {
+	return AmenityResponse{
+		Code: amenity.Code,
+		Name: amenity.Name,
+	}
+}
@@ -6,13 +6,14 @@ import (
 )
 
 type BookingResponse struct {
-	ID          uint      `json:"id"`
-	UserID      uint      `json:"user_id"`
-	ScheduleID  uint      `json:"schedule_id"`
-	BookingDate time.Time `json:"booking_date"`
-	Status      string    `json:"status"`
-	TotalPrice  float64   `json:"total_price"`
-	CreatedAt   time.Time `json:"created_at"`
+	ID          uint       `json:"id"`
+	UserID      uint       `json:"user_id"`
+	ScheduleID  uint       `json:"schedule_id"`
+	BookingDate time.Time  `json:"booking_date"`
+	Status      string     `json:"status"`
+	TotalPrice  float64    `json:"total_price"`
+	CheckedInAt *time.Time `json:"checked_in_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
 }
 
 func ToBookingResponse(booking *domain.Booking) BookingResponse {
@@ -23,6 +24,29 @@ func ToBookingResponse(booking *domain.Booking) BookingResponse {
 		BookingDate: booking.BookingDate,
 		Status:      booking.Status,
 		TotalPrice:  booking.TotalPrice,
+		CheckedInAt: booking.CheckedInAt,
 		CreatedAt:   booking.CreatedAt,
 	}
 }
+
+type PaymentResponse struct {
+	ID            uint      `json:"id"`
+	BookingID     uint      `json:"booking_id"`
+	ReceiptNumber string    `json:"receipt_number"`
+	PaymentMethod string    `json:"payment_method"`
+	Amount        float64   `json:"amount"`
+	Status        string    `json:"status"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+func ToPaymentResponse(payment *domain.Payment) PaymentResponse {
+	return PaymentResponse{
+		ID:            payment.ID,
+		BookingID:     payment.BookingID,
+		ReceiptNumber: payment.ReceiptNumber(),
+		PaymentMethod: payment.PaymentMethod,
+		Amount:        payment.Amount,
+		Status:        payment.Status,
+		CreatedAt:     payment.CreatedAt,
+	}
+}
@@ -0,0 +1,6 @@
+package response
+
+type CalendarFeedResponse struct {
+	// URL is only shown once; creating a new feed replaces it.
+	URL string `json:"url" example:"https://api.example.com/api/v1/users/me/calendar.ics?token=cal_3f9a..."`
+}
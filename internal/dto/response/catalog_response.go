@@ -0,0 +1,48 @@
+package response
+
+import "go-futsal-booking-api/internal/domain"
+
+// CatalogImportResponse counts what a catalog import created and changed.
+// Nothing is kept when DryRun is set.
+type CatalogImportResponse struct {
+	VenuesCreated    int  `json:"venues_created" example:"2"`
+	VenuesUpdated    int  `json:"venues_updated" example:"1"`
+	FieldsCreated    int  `json:"fields_created" example:"5"`
+	FieldsUpdated    int  `json:"fields_updated" example:"0"`
+	SchedulesCreated int  `json:"schedules_created" example:"84"`
+	SchedulesUpdated int  `json:"schedules_updated" example:"12"`
+	DryRun           bool `json:"dry_run" example:"false"`
+}
+
+// CatalogRowErrorResponse is a problem with one row of a catalog file. Column
+// is left out when the row as a whole is wrong.
+type CatalogRowErrorResponse struct {
+	Row     int    `json:"row" example:"7"`
+	Column  string `json:"column,omitempty" example:"field_type"`
+	Message string `json:"message" example:"field type not found"`
+}
+
+func ToCatalogImportResponse(result *domain.CatalogImport) CatalogImportResponse {
+	return CatalogImportResponse{
+		VenuesCreated:    result.VenuesCreated,
+		VenuesUpdated:    result.VenuesUpdated,
+		FieldsCreated:    result.FieldsCreated,
+		FieldsUpdated:    result.FieldsUpdated,
+		SchedulesCreated: result.SchedulesCreated,
+		SchedulesUpdated: result.SchedulesUpdated,
+		DryRun:           result.DryRun,
+	}
+}
+
+func ToCatalogRowErrorResponses(rows []domain.CatalogRowError) []CatalogRowErrorResponse {
+	res := make([]CatalogRowErrorResponse, len(rows))
+	for i, row := range rows {
+		res[i] = CatalogRowErrorResponse{
+			Row:     row.Row,
+			Column:  row.Column,
+			Message: row.Message,
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,19 @@
+package response
+
+import "go-futsal-booking-api/internal/domain"
+
+// DeletionImpactResponse is what deleting a venue, field or schedule affects.
+// FutureBookings are cancelled when the deletion is forced.
+type DeletionImpactResponse struct {
+	Fields         int64 `json:"fields" example:"3"`
+	Schedules      int64 `json:"schedules" example:"42"`
+	FutureBookings int64 `json:"future_bookings" example:"5"`
+}
+
+func ToDeletionImpactResponse(impact *domain.DeletionImpact) DeletionImpactResponse {
+	return DeletionImpactResponse{
+		Fields:         impact.Fields,
+		Schedules:      impact.Schedules,
+		FutureBookings: impact.FutureBookings,
+	}
+}
@@ -0,0 +1,23 @@
+package response
+
+import "go-futsal-booking-api/internal/domain"
+
+type FavouritesResponse struct {
+	Venues []VenueResponse `json:"venues"`
+	Fields []FieldResponse `json:"fields"`
+}
+
+func ToFavouritesResponse(favourites *domain.Favourites) FavouritesResponse {
+	res := FavouritesResponse{
+		Venues: make([]VenueResponse, len(favourites.Venues)),
+		Fields: make([]FieldResponse, len(favourites.Fields)),
+	}
+	for i := range favourites.Venues {
+		res.Venues[i] = ToVenueResponse(&favourites.Venues[i])
+	}
+	for i := range favourites.Fields {
+		res.Fields[i] = ToFieldResponse(&favourites.Fields[i])
+	}
+
+	return res
+}
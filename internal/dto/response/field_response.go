@@ -6,19 +6,45 @@ import (
 )
 
 type FieldResponse struct {
-	ID        uint      `json:"id"`
-	Name      string    `json:"name"`
-	Type      string    `json:"type"`
-	Venue     string    `json:"venue"`
-	CreatedAt time.Time `json:"created_at"`
+	ID           uint      `json:"id"`
+	Name         string    `json:"name"`
+	Type         string    `json:"type"`
+	Venue        string    `json:"venue"`
+	LengthMeters *float64  `json:"length_m,omitempty"`
+	WidthMeters  *float64  `json:"width_m,omitempty"`
+	Indoor       bool      `json:"indoor"`
+	HasLighting  bool      `json:"has_lighting"`
+	MaxPlayers   int       `json:"max_players"`
+	Status       string    `json:"status"`
+	CreatedAt    time.Time `json:"created_at"`
 }
 
 func ToFieldResponse(field *domain.Field) FieldResponse {
 	return FieldResponse{
-		ID:        field.ID,
-		Name:      field.Name,
-		Type:      field.Type,
-		Venue:     field.Venue.Name,
-		CreatedAt: field.CreatedAt,
+		ID:           field.ID,
+		Name:         field.Name,
+		Type:         field.Type,
+		Venue:        field.Venue.Name,
+		LengthMeters: field.LengthMeters,
+		WidthMeters:  field.WidthMeters,
+		Indoor:       field.Indoor,
+		HasLighting:  field.HasLighting,
+		MaxPlayers:   field.MaxPlayers,
+		Status:       field.Status,
+		CreatedAt:    field.CreatedAt,
+	}
+}
+
+type FieldTypeResponse struct {
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+func ToFieldTypeResponse(fieldType *domain.FieldType) FieldTypeResponse {
+	return FieldTypeResponse{
+		Code:        fieldType.Code,
+		Name:        fieldType.Name,
+		Description: fieldType.Description,
 	}
 }
@@ -0,0 +1,44 @@
+package response
+
+import (
+	"go-futsal-booking-api/internal/domain"
+	"time"
+)
+
+type InboxNotificationResponse struct {
+	ID        uint       `json:"id"`
+	Event     string     `json:"event"`
+	Title     string     `json:"title"`
+	Body      string     `json:"body"`
+	Read      bool       `json:"read"`
+	ReadAt    *time.Time `json:"read_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type InboxListResponse struct {
+	Notifications []InboxNotificationResponse `json:"notifications"`
+	UnreadCount   int64                       `json:"unread_count"`
+	Total         int64                       `json:"total"`
+	Limit         int                         `json:"limit"`
+	Offset        int                         `json:"offset"`
+}
+
+type UnreadCountResponse struct {
+	UnreadCount int64 `json:"unread_count"`
+}
+
+type MarkAllReadResponse struct {
+	Marked int64 `json:"marked"`
+}
+
+func ToInboxNotificationResponse(notification *domain.InboxNotification) InboxNotificationResponse {
+	return InboxNotificationResponse{
+		ID:        notification.ID,
+		Event:     notification.Event,
+		Title:     notification.Title,
+		Body:      notification.Body,
+		Read:      notification.IsRead(),
+		ReadAt:    notification.ReadAt,
+		CreatedAt: notification.CreatedAt,
+	}
+}
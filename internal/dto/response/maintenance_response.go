@@ -0,0 +1,82 @@
+package response
+
+import (
+	"go-futsal-booking-api/internal/domain"
+	"time"
+)
+
+type MaintenanceWindowResponse struct {
+	ID        uint      `json:"id"`
+	FieldID   uint      `json:"field_id"`
+	StartsAt  time.Time `json:"starts_at"`
+	EndsAt    time.Time `json:"ends_at"`
+	Reason    string    `json:"reason"`
+	CreatedBy uint      `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func ToMaintenanceWindowResponse(window *domain.MaintenanceWindow) MaintenanceWindowResponse {
+	return MaintenanceWindowResponse{
+		ID:        window.ID,
+		FieldID:   window.FieldID,
+		StartsAt:  window.StartsAt,
+		EndsAt:    window.EndsAt,
+		Reason:    window.Reason,
+		CreatedBy: window.CreatedBy,
+		CreatedAt: window.CreatedAt,
+	}
+}
+
+// AlternativeSlotResponse is a free slot; book it with schedule_id and date.
+type AlternativeSlotResponse struct {
+	ScheduleID uint      `json:"schedule_id"`
+	FieldID    uint      `json:"field_id"`
+	FieldName  string    `json:"field_name"`
+	Date       string    `json:"date"`
+	StartTime  time.Time `json:"start_time"`
+	EndTime    time.Time `json:"end_time"`
+	Price      float64   `json:"price"`
+}
+
+type AffectedBookingResponse struct {
+	BookingID    uint                      `json:"booking_id"`
+	UserID       uint                      `json:"user_id"`
+	Status       string                    `json:"status"`
+	StartTime    time.Time                 `json:"start_time"`
+	EndTime      time.Time                 `json:"end_time"`
+	Alternatives []AlternativeSlotResponse `json:"alternatives"`
+}
+
+func ToAffectedBookingResponses(impacts []domain.MaintenanceImpact) []AffectedBookingResponse {
+	res := make([]AffectedBookingResponse, len(impacts))
+	for i, impact := range impacts {
+		alternatives := make([]AlternativeSlotResponse, len(impact.Alternatives))
+		for j, slot := range impact.Alternatives {
+			alternatives[j] = AlternativeSlotResponse{
+				ScheduleID: slot.Schedule.ID,
+				FieldID:    slot.Schedule.Field.ID,
+				FieldName:  slot.Schedule.Field.Name,
+				Date:       slot.Date.Format("2006-01-02"),
+				StartTime:  slot.StartsAt(),
+				EndTime:    slot.EndsAt(),
+				Price:      slot.Schedule.Price,
+			}
+		}
+
+		res[i] = AffectedBookingResponse{
+			BookingID:    impact.Booking.ID,
+			UserID:       impact.Booking.User.ID,
+			Status:       impact.Booking.Status,
+			StartTime:    impact.Booking.StartsAtVenue(),
+			EndTime:      impact.Booking.EndsAtVenue(),
+			Alternatives: alternatives,
+		}
+	}
+
+	return res
+}
+
+type CreateMaintenanceWindowResponse struct {
+	MaintenanceWindowResponse
+	AffectedBookings []AffectedBookingResponse `json:"affected_bookings"`
+}
@@ -0,0 +1,32 @@
+package response
+
+import (
+	"go-futsal-booking-api/internal/domain"
+	"time"
+)
+
+type NotificationPreferenceResponse struct {
+	Event   string `json:"event"`
+	Channel string `json:"channel"`
+}
+
+type PhoneVerificationResponse struct {
+	PhoneNumber string    `json:"phone_number"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+func ToNotificationPreferenceResponses(preferences []domain.NotificationPreference) []NotificationPreferenceResponse {
+	items := make([]NotificationPreferenceResponse, len(preferences))
+	for i, p := range preferences {
+		items[i] = NotificationPreferenceResponse{Event: p.Event, Channel: p.Channel}
+	}
+
+	return items
+}
+
+func ToPhoneVerificationResponse(verification *domain.PhoneVerification) PhoneVerificationResponse {
+	return PhoneVerificationResponse{
+		PhoneNumber: verification.PhoneNumber,
+		ExpiresAt:   verification.ExpiresAt,
+	}
+}
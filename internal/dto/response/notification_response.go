@@ -0,0 +1,72 @@
+package response
+
+import (
+	"encoding/json"
+	"go-futsal-booking-api/internal/domain"
+	"time"
+)
+
+type EmailTemplateResponse struct {
+	Name      string   `json:"name"`
+	Languages []string `json:"languages"`
+}
+
+type EmailPreviewResponse struct {
+	Name     string `json:"name"`
+	Language string `json:"language"`
+	Subject  string `json:"subject"`
+	TextBody string `json:"text_body"`
+	HTMLBody string `json:"html_body"`
+}
+
+func ToEmailPreviewResponse(name, language string, email domain.Email) EmailPreviewResponse {
+	return EmailPreviewResponse{
+		Name:     name,
+		Language: language,
+		Subject:  email.Subject,
+		TextBody: email.TextBody,
+		HTMLBody: email.HTMLBody,
+	}
+}
+
+type OutboxMessageResponse struct {
+	ID            uint            `json:"id"`
+	Topic         string          `json:"topic"`
+	Status        string          `json:"status"`
+	Attempts      int             `json:"attempts"`
+	MaxAttempts   int             `json:"max_attempts"`
+	NextAttemptAt time.Time       `json:"next_attempt_at"`
+	LastError     string          `json:"last_error,omitempty"`
+	SentAt        *time.Time      `json:"sent_at,omitempty"`
+	CreatedAt     time.Time       `json:"created_at"`
+	Payload       json.RawMessage `json:"payload,omitempty" swaggertype:"object"`
+}
+
+type OutboxMessageListResponse struct {
+	Messages []OutboxMessageResponse `json:"messages"`
+	Total    int64                   `json:"total"`
+	Limit    int                     `json:"limit"`
+	Offset   int                     `json:"offset"`
+}
+
+// ToOutboxMessageResponse leaves the payload out of list responses, where it
+// would mostly be rendered email bodies.
+func ToOutboxMessageResponse(message *domain.OutboxMessage, withPayload bool) OutboxMessageResponse {
+	res := OutboxMessageResponse{
+		ID:            message.ID,
+		Topic:         message.Topic,
+		Status:        message.Status,
+		Attempts:      message.Attempts,
+		MaxAttempts:   message.MaxAttempts,
+		NextAttemptAt: message.NextAttemptAt,
+		LastError:     message.LastError,
+		SentAt:        message.SentAt,
+		CreatedAt:     message.CreatedAt,
+	}
+
+	if withPayload {
+		res.Payload = message.Payload
+	}
+
+	return res
+}
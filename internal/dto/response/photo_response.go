@@ -0,0 +1,47 @@
+package response
+
+import (
+	"go-futsal-booking-api/internal/domain"
+	"time"
+)
+
+type PhotoResponse struct {
+	ID           uint      `json:"id"`
+	VenueID      uint      `json:"venue_id"`
+	FieldID      *uint     `json:"field_id,omitempty"`
+	URL          string    `json:"url"`
+	ThumbnailURL string    `json:"thumbnail_url"`
+	ContentType  string    `json:"content_type"`
+	Width        int       `json:"width"`
+	Height       int       `json:"height"`
+	SizeBytes    int64     `json:"size_bytes"`
+	Position     int       `json:"position"`
+	IsCover      bool      `json:"is_cover"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func ToPhotoResponse(photo *domain.Photo) PhotoResponse {
+	return PhotoResponse{
+		ID:           photo.ID,
+		VenueID:      photo.VenueID,
+		FieldID:      photo.FieldID,
+		URL:          photo.URL,
+		ThumbnailURL: photo.ThumbnailURL,
+		ContentType:  photo.ContentType,
+		Width:        photo.Width,
+		Height:       photo.Height,
+		SizeBytes:    photo.SizeBytes,
+		Position:     photo.Position,
+		IsCover:      photo.IsCover,
+		CreatedAt:    photo.CreatedAt,
+	}
+}
+
+func ToPhotoResponses(photos []domain.Photo) []PhotoResponse {
+	res := make([]PhotoResponse, len(photos))
+	for i := range photos {
+		res[i] = ToPhotoResponse(&photos[i])
+	}
+
+	return res
+}
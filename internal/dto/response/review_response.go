@@ -0,0 +1,54 @@
+package response
+
+import (
+	"go-futsal-booking-api/internal/domain"
+	"time"
+)
+
+type ReviewResponse struct {
+	ID        uint                 `json:"id"`
+	VenueID   uint                 `json:"venue_id"`
+	BookingID uint                 `json:"booking_id"`
+	UserID    uint                 `json:"user_id"`
+	UserName  string               `json:"user_name" example:"Budi Santoso"`
+	Rating    int                  `json:"rating" example:"5"`
+	Comment   string               `json:"comment" example:"Great pitch, friendly staff."`
+	Reply     *ReviewReplyResponse `json:"reply,omitempty"`
+	Status    string               `json:"status" example:"PUBLISHED"`
+	CreatedAt time.Time            `json:"created_at"`
+}
+
+// ReviewReplyResponse is the venue owner's answer to a review.
+type ReviewReplyResponse struct {
+	Body      string    `json:"body" example:"Thank you, see you next week!"`
+	RepliedAt time.Time `json:"replied_at"`
+}
+
+type ReviewListResponse struct {
+	Reviews []ReviewResponse `json:"reviews"`
+	Total   int64            `json:"total"`
+	Limit   int              `json:"limit"`
+	Offset  int              `json:"offset"`
+}
+
+func ToReviewResponse(review *domain.Review) ReviewResponse {
+	res := ReviewResponse{
+		ID:        review.ID,
+		VenueID:   review.VenueID,
+		BookingID: review.BookingID,
+		UserID:    review.User.ID,
+		UserName:  review.User.FullName,
+		Rating:    review.Rating,
+		Comment:   review.Comment,
+		Status:    review.Status,
+		CreatedAt: review.CreatedAt,
+	}
+	if review.Reply != "" && review.RepliedAt != nil {
+		res.Reply = &ReviewReplyResponse{
+			Body:      review.Reply,
+			RepliedAt: *review.RepliedAt,
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,41 @@
+package response
+
+import "go-futsal-booking-api/internal/domain"
+
+type PermissionResponse struct {
+	ID          uint   `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type RoleResponse struct {
+	ID          uint     `json:"id"`
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+type UserRoleResponse struct {
+	UserID uint         `json:"user_id"`
+	Role   RoleResponse `json:"role"`
+}
+
+func ToPermissionResponse(permission *domain.Permission) PermissionResponse {
+	return PermissionResponse{
+		ID:          permission.ID,
+		Name:        permission.Name,
+		Description: permission.Description,
+	}
+}
+
+func ToRoleResponse(role *domain.Role) RoleResponse {
+	permissions := make([]string, len(role.Permissions))
+	for i, p := range role.Permissions {
+		permissions[i] = p.Name
+	}
+
+	return RoleResponse{
+		ID:          role.ID,
+		Name:        role.RoleName,
+		Permissions: permissions,
+	}
+}
@@ -0,0 +1,39 @@
+package response
+
+import (
+	"go-futsal-booking-api/internal/domain"
+	"time"
+)
+
+type SavedSearchResponse struct {
+	ID        uint   `json:"id"`
+	Name      string `json:"name" example:"Friday evenings"`
+	City      string `json:"city" example:"Jakarta"`
+	FieldType string `json:"field_type" example:"VINYL"`
+	DayOfWeek int    `json:"day_of_week" example:"5"`
+	// StartTime and EndTime are empty when not set.
+	StartTime string    `json:"start_time" example:"18:00"`
+	EndTime   string    `json:"end_time" example:"22:00"`
+	Notify    bool      `json:"notify"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func ToSavedSearchResponse(search *domain.SavedSearch) SavedSearchResponse {
+	res := SavedSearchResponse{
+		ID:        search.ID,
+		Name:      search.Name,
+		City:      search.City,
+		FieldType: search.FieldType,
+		DayOfWeek: search.DayOfWeek,
+		Notify:    search.Notify,
+		CreatedAt: search.CreatedAt,
+	}
+	if search.StartTime != nil {
+		res.StartTime = search.StartTime.Format("15:04")
+	}
+	if search.EndTime != nil {
+		res.EndTime = search.EndTime.Format("15:04")
+	}
+
+	return res
+}
@@ -0,0 +1,32 @@
+package response
+
+import "go-futsal-booking-api/internal/domain"
+
+type TwoFactorChallengeResponse struct {
+	ChallengeToken string `json:"challenge_token"`
+	// Purpose is "2fa_login" when a code is expected, or "2fa_setup" when the
+	// account must enrol before it can log in.
+	Purpose string `json:"purpose"`
+}
+
+type TwoFactorSetupResponse struct {
+	Secret     string `json:"secret"`
+	OTPAuthURI string `json:"otpauth_uri"`
+}
+
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type TwoFactorEnrolledLoginResponse struct {
+	Token         string       `json:"token"`
+	User          UserResponse `json:"user"`
+	RecoveryCodes []string     `json:"recovery_codes"`
+}
+
+func ToTwoFactorSetupResponse(setup *domain.TwoFactorSetup) TwoFactorSetupResponse {
+	return TwoFactorSetupResponse{
+		Secret:     setup.Secret,
+		OTPAuthURI: setup.OTPAuthURI,
+	}
+}
@@ -6,12 +6,15 @@ import (
 )
 
 type UserResponse struct {
-	ID        uint      `json:"id"`
-	FullName  string    `json:"full_name"`
-	Email     string    `json:"email"`
-	Age       int       `json:"age"`
-	Address   string    `json:"address"`
-	CreatedAt time.Time `json:"created_at"`
+	ID       uint   `json:"id"`
+	FullName string `json:"full_name"`
+	Email    string `json:"email"`
+	Age      int    `json:"age"`
+	Address  string `json:"address"`
+	Language string `json:"language"`
+	// PhoneNumber is only present once verified.
+	PhoneNumber string    `json:"phone_number,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
 }
 
 type LoginResponse struct {
@@ -21,11 +24,13 @@ type LoginResponse struct {
 
 func ToUserResponse(user *domain.User) UserResponse {
 	return UserResponse{
-		ID:        user.ID,
-		FullName:  user.FullName,
-		Email:     user.Email,
-		Age:       user.Age,
-		Address:   user.Address,
-		CreatedAt: user.CreatedAt,
+		ID:          user.ID,
+		FullName:    user.FullName,
+		Email:       user.Email,
+		Age:         user.Age,
+		Address:     user.Address,
+		Language:    user.Language,
+		PhoneNumber: user.PhoneNumber,
+		CreatedAt:   user.CreatedAt,
 	}
 }
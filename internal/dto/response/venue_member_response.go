@@ -0,0 +1,26 @@
+package response
+
+import (
+	"go-futsal-booking-api/internal/domain"
+	"time"
+)
+
+type VenueMemberResponse struct {
+	VenueID   uint      `json:"venue_id"`
+	UserID    uint      `json:"user_id"`
+	FullName  string    `json:"full_name"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func ToVenueMemberResponse(member *domain.VenueMember) VenueMemberResponse {
+	return VenueMemberResponse{
+		VenueID:   member.Venue.ID,
+		UserID:    member.User.ID,
+		FullName:  member.User.FullName,
+		Email:     member.User.Email,
+		Role:      member.Role,
+		CreatedAt: member.CreatedAt,
+	}
+}
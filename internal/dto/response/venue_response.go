@@ -2,23 +2,90 @@ package response
 
 import (
 	"go-futsal-booking-api/internal/domain"
+	"math"
 	"time"
 )
 
 type VenueResponse struct {
-	ID        uint      `json:"id"`
-	Name      string    `json:"name"`
-	Address   string    `json:"address"`
-	City      string    `json:"city"`
-	CreatedAt time.Time `json:"created_at"`
+	ID             uint                   `json:"id"`
+	Name           string                 `json:"name"`
+	Address        string                 `json:"address"`
+	City           string                 `json:"city"`
+	Timezone       string                 `json:"timezone"`
+	Latitude       *float64               `json:"latitude,omitempty"`
+	Longitude      *float64               `json:"longitude,omitempty"`
+	PhoneNumber    string                 `json:"phone_number,omitempty"`
+	WhatsAppNumber string                 `json:"whatsapp_number,omitempty"`
+	OpeningHours   []OpeningHoursResponse `json:"opening_hours"`
+	Amenities      []AmenityResponse      `json:"amenities"`
+	Rating         float64                `json:"rating" example:"4.5"`
+	ReviewCount    int                    `json:"review_count" example:"12"`
+	CreatedAt      time.Time              `json:"created_at"`
+}
+
+type OpeningHoursResponse struct {
+	DayOfWeek int    `json:"day_of_week" example:"1"`
+	OpensAt   string `json:"opens_at" example:"08:00"`
+	ClosesAt  string `json:"closes_at" example:"23:00"`
 }
 
 func ToVenueResponse(venue *domain.Venue) VenueResponse {
-	return VenueResponse{
-		ID:        venue.ID,
-		Name:      venue.Name,
-		Address:   venue.Address,
-		City:      venue.City,
-		CreatedAt: venue.CreatedAt,
+	res := VenueResponse{
+		ID:             venue.ID,
+		Name:           venue.Name,
+		Address:        venue.Address,
+		City:           venue.City,
+		Timezone:       venue.Timezone,
+		PhoneNumber:    venue.PhoneNumber,
+		WhatsAppNumber: venue.WhatsAppNumber,
+		OpeningHours:   make([]OpeningHoursResponse, len(venue.OpeningHours)),
+		Amenities:      make([]AmenityResponse, len(venue.Amenities)),
+		Rating:         venue.Rating,
+		ReviewCount:    venue.ReviewCount,
+		CreatedAt:      venue.CreatedAt,
+	}
+	for i, h := range venue.OpeningHours {
+		res.OpeningHours[i] = OpeningHoursResponse{
+			DayOfWeek: h.DayOfWeek,
+			OpensAt:   h.OpensAt.Format("15:04"),
+			ClosesAt:  h.ClosesAt.Format("15:04"),
+		}
+	}
+	for i := range venue.Amenities {
+		res.Amenities[i] = ToAmenityResponse(&venue.Amenities[i])
+	}
+	if venue.Coordinates != nil {
+		res.Latitude = &venue.Coordinates.Latitude
+		res.Longitude = &venue.Coordinates.Longitude
+	}
+
+	return res
+}
+
+// VenueListingResponse is a venue in search results. MinPrice is the cheapest
+// slot matching the search, or null if the venue has no schedules.
+type VenueListingResponse struct {
+	VenueResponse
+	MinPrice *float64 `json:"min_price"`
+}
+
+func ToVenueListingResponse(listing *domain.VenueListing) VenueListingResponse {
+	return VenueListingResponse{
+		VenueResponse: ToVenueResponse(&listing.Venue),
+		MinPrice:      listing.MinPrice,
+	}
+}
+
+// NearbyVenueResponse is a venue in nearby results, with its distance from the
+// search point rounded to 10 m.
+type NearbyVenueResponse struct {
+	VenueResponse
+	DistanceKm float64 `json:"distance_km"`
+}
+
+func ToNearbyVenueResponse(nearby *domain.NearbyVenue) NearbyVenueResponse {
+	return NearbyVenueResponse{
+		VenueResponse: ToVenueResponse(&nearby.Venue),
+		DistanceKm:    math.Round(nearby.DistanceKm*100) / 100,
 	}
 }
@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/dto/request"
+	dto "go-futsal-booking-api/internal/dto/response"
+	"go-futsal-booking-api/internal/service"
+	"go-futsal-booking-api/pkg/logger"
+	jsonres "go-futsal-booking-api/pkg/response"
+	"go-futsal-booking-api/pkg/validator"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type AmenityHandler struct {
+	amenityService service.AmenityService
+	timeout        time.Duration
+}
+
+func NewAmenityHandler(amenityService service.AmenityService) *AmenityHandler {
+	return &AmenityHandler{
+		amenityService: amenityService,
+		timeout:        30 * time.Second,
+	}
+}
+
+// GetAmenities godoc
+// @Summary List amenities
+// @Description List the amenities venues can offer, for venue forms and the amenities filter of venue search
+// @Tags Amenities
+// @Produce json
+// @Success 200 {object} docs.SuccessResponse{data=[]dto.AmenityResponse} "Amenities"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /amenities [get]
+func (h *AmenityHandler) GetAmenities(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	amenities, err := h.amenityService.GetAmenities(ctx)
+	if err != nil {
+		return h.handleError(c, err, "Failed to get amenities")
+	}
+
+	res := make([]dto.AmenityResponse, len(amenities))
+	for i := range amenities {
+		res[i] = dto.ToAmenityResponse(&amenities[i])
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Amenities", res,
+	))
+}
+
+// CreateAmenity godoc
+// @Summary Add an amenity
+// @Description Add an amenity to the list venues can pick from
+// @Tags Amenities
+// @Accept json
+// @Produce json
+// @Param amenity body request.CreateAmenityRequest true "Amenity"
+// @Success 201 {object} docs.SuccessResponse{data=dto.AmenityResponse} "Amenity created"
+// @Failure 400 {object} docs.ErrorResponse "Bad Request or Validation Error"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Missing amenity:manage permission)"
+// @Failure 409 {object} docs.ErrorResponse "Amenity already exists"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /amenities [post]
+func (h *AmenityHandler) CreateAmenity(c echo.Context) error {
+	var req request.CreateAmenityRequest
+
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Failed to fetch request", err,
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	amenity, err := h.amenityService.CreateAmenity(ctx, req.Code, req.Name)
+	if err != nil {
+		return h.handleError(c, err, "Failed to create amenity")
+	}
+
+	return c.JSON(http.StatusCreated, jsonres.Success(
+		"Amenity created", dto.ToAmenityResponse(amenity),
+	))
+}
+
+// DeleteAmenity godoc
+// @Summary Remove an amenity
+// @Description Remove an amenity from the list and from every venue that has it
+// @Tags Amenities
+// @Produce json
+// @Param code path string true "Amenity code"
+// @Success 200 {object} docs.SuccessResponse "Amenity deleted"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Missing amenity:manage permission)"
+// @Failure 404 {object} docs.ErrorResponse "Amenity Not Found"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /amenities/{code} [delete]
+func (h *AmenityHandler) DeleteAmenity(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	if err := h.amenityService.DeleteAmenity(ctx, c.Param("code")); err != nil {
+		return h.handleError(c, err, "Failed to delete amenity")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Amenity deleted", nil,
+	))
+}
+
+func (h *AmenityHandler) handleError(c echo.Context, err error, message string) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return c.JSON(http.StatusRequestTimeout, jsonres.Error(
+			"TIMEOUT", "Request timeout", nil,
+		))
+	case errors.Is(err, domain.ErrInvalidAmenityCode):
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", err.Error(), nil,
+		))
+	case errors.Is(err, domain.ErrAmenityExists):
+		return c.JSON(http.StatusConflict, jsonres.Error(
+			"CONFLICT", err.Error(), nil,
+		))
+	case errors.Is(err, domain.ErrAmenityNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", "Amenity not found", nil,
+		))
+	}
+
+	logger.Error(message, err)
+	return c.JSON(http.StatusInternalServerError, jsonres.Error(
+		"INTERNAL_ERROR", message, nil,
+	))
+}
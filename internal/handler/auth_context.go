@@ -0,0 +1,20 @@
+package handler
+
+import (
+	jsonres "go-futsal-booking-api/pkg/response"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// userIDFromContext returns the authenticated user ID set by AuthMiddleware, or 0.
+func userIDFromContext(c echo.Context) uint {
+	userID, _ := c.Get("user_id").(uint)
+	return userID
+}
+
+func forbiddenVenueAccess(c echo.Context) error {
+	return c.JSON(http.StatusForbidden, jsonres.Error(
+		"FORBIDDEN", "You are not allowed to manage this venue", nil,
+	))
+}
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/service"
+	"go-futsal-booking-api/pkg/logger"
+	jsonres "go-futsal-booking-api/pkg/response"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type AvailabilityHandler struct {
+	fieldService service.FieldService
+	hub          *service.AvailabilityHub
+	heartbeat    time.Duration
+	timeout      time.Duration
+}
+
+func NewAvailabilityHandler(fieldService service.FieldService, hub *service.AvailabilityHub, heartbeat time.Duration) *AvailabilityHandler {
+	return &AvailabilityHandler{
+		fieldService: fieldService,
+		hub:          hub,
+		heartbeat:    heartbeat,
+		timeout:      30 * time.Second,
+	}
+}
+
+// StreamAvailability godoc
+// @Summary Stream slot availability of a field
+// @Description Server-Sent Events stream with a domain.SlotUpdate as data of each event: "slot.booked" when a booking on the field is created, "slot.released" when one is cancelled, and "slot.closed" when a slot can no longer be booked because its venue, field or schedule was deleted or it falls in a new maintenance window. A forced deletion sends "slot.released" then "slot.closed" for each cancelled booking. Pending bookings do not expire, so there is no expiry event. A comment line is sent as heartbeat. The stream ends when the client falls behind or the server shuts down; reconnect and reload availability when it does.
+// @Tags Fields
+// @Produce text/event-stream
+// @Param id path uint true "Field ID"
+// @Success 200 {object} domain.SlotUpdate "Event stream of slot updates"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Field ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 404 {object} docs.ErrorResponse "Field Not Found"
+// @Failure 503 {object} docs.ErrorResponse "Server is shutting down"
+// @Security ApiKeyAuth
+// @Router /fields/{id}/availability/stream [get]
+func (h *AvailabilityHandler) StreamAvailability(c echo.Context) error {
+	fieldID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || fieldID == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid field id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	// The connection is checked once, when it is opened.
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	_, err = h.fieldService.GetFieldByID(ctx, uint(fieldID))
+	cancel()
+	if err != nil {
+		return h.handleError(c, err, uint(fieldID))
+	}
+
+	sub, err := h.hub.Subscribe(uint(fieldID))
+	if err != nil {
+		return h.handleError(c, err, uint(fieldID))
+	}
+	defer sub.Close()
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set("Cache-Control", "no-cache")
+	res.Header().Set("Connection", "keep-alive")
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+
+	if _, err := fmt.Fprintf(res, "retry: 3000\n: watching field %d\n\n", fieldID); err != nil {
+		return nil
+	}
+	res.Flush()
+
+	logger.Info("availability stream opened", "field_id", fieldID, "user_id", userIDFromContext(c))
+
+	ticker := time.NewTicker(h.heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.Request().Context().Done():
+			return nil
+		case update, ok := <-sub.Updates:
+			if !ok {
+				return nil
+			}
+
+			data, err := json.Marshal(update)
+			if err != nil {
+				logger.Error("failed to encode slot update", err)
+				continue
+			}
+
+			if _, err := fmt.Fprintf(res, "event: %s\ndata: %s\n\n", update.Type, data); err != nil {
+				return nil
+			}
+		case <-ticker.C:
+			if _, err := fmt.Fprint(res, ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+		}
+
+		res.Flush()
+	}
+}
+
+func (h *AvailabilityHandler) handleError(c echo.Context, err error, fieldID uint) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return c.JSON(http.StatusRequestTimeout, jsonres.Error(
+			"TIMEOUT", "Request timeout", nil,
+		))
+	case errors.Is(err, domain.ErrFieldNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", "Field not found", map[string]any{"field_id": fieldID},
+		))
+	case errors.Is(err, domain.ErrStreamClosed):
+		return c.JSON(http.StatusServiceUnavailable, jsonres.Error(
+			"SERVICE_UNAVAILABLE", err.Error(), nil,
+		))
+	}
+
+	logger.Error("Failed to open availability stream", err)
+	return c.JSON(http.StatusInternalServerError, jsonres.Error(
+		"INTERNAL_ERROR", "Failed to open availability stream", nil,
+	))
+}
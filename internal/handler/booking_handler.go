@@ -23,19 +23,34 @@ import (
 
 type BookingHandler struct {
 	bookingService service.BookingService
+	roleService    service.RoleService
 	timeout        time.Duration
 }
 
-func NewBookingHandler(bookingService service.BookingService) *BookingHandler {
+func NewBookingHandler(bookingService service.BookingService, roleService service.RoleService) *BookingHandler {
 	return &BookingHandler{
 		bookingService: bookingService,
+		roleService:    roleService,
 		timeout:        30 * time.Second,
 	}
 }
 
+// canReadAnyBooking reports whether the caller may read bookings owned by other users.
+func (h *BookingHandler) canReadAnyBooking(ctx context.Context, c echo.Context) bool {
+	roleID, _ := c.Get("role_id").(uint)
+
+	allowed, err := h.roleService.HasPermission(ctx, roleID, domain.PermBookingReadAny)
+	if err != nil {
+		logger.Error("Failed to check booking read permission", err)
+		return false
+	}
+
+	return allowed
+}
+
 // CreateBooking godoc
 // @Summary Create a new booking
-// @Description Create a new booking for a specific schedule (Customer or Admin)
+// @Description Create a new booking for a specific schedule (Customer or Admin). The booking stays pending until its payment is recorded; the customer is told it was received and gets the confirmation once it is paid.
 // @Tags Bookings
 // @Accept json
 // @Produce json
@@ -44,6 +59,7 @@ func NewBookingHandler(bookingService service.BookingService) *BookingHandler {
 // @Failure 400 {object} docs.ErrorResponse "Bad Request or Validation Error"
 // @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
 // @Failure 404 {object} docs.ErrorResponse "Schedule Not Found"
+// @Failure 422 {object} docs.ErrorResponse "Field inactive or under maintenance at that time"
 // @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
 // @Security ApiKeyAuth
 // @Router /bookings [post]
@@ -102,6 +118,18 @@ func (h *BookingHandler) CreateBooking(c echo.Context) error {
 			))
 		}
 
+		if errors.Is(err, domain.ErrFieldInactive) {
+			return c.JSON(http.StatusUnprocessableEntity, jsonres.Error(
+				"FIELD_INACTIVE", err.Error(), map[string]any{"schedule_id": req.ScheduleID},
+			))
+		}
+
+		if errors.Is(err, domain.ErrFieldInMaintenance) {
+			return c.JSON(http.StatusUnprocessableEntity, jsonres.Error(
+				"FIELD_UNDER_MAINTENANCE", err.Error(), map[string]any{"schedule_id": req.ScheduleID, "booking_date": req.BookingDate},
+			))
+		}
+
 		logger.Error("Failed to create booking", err)
 		return c.JSON(http.StatusInternalServerError, jsonres.Error(
 			"INTERNAL_ERROR", "Failed to create booking", nil,
@@ -115,38 +143,48 @@ func (h *BookingHandler) CreateBooking(c echo.Context) error {
 
 // GetMyBookings godoc
 // @Summary Get bookings by user ID
-// @Description Get a list of all bookings for a specific user (e.g., "My Bookings")
+// @Description Get a list of all bookings for the authenticated user (e.g., "My Bookings"). Reading another user's bookings requires the booking:read:any permission.
 // @Tags Bookings
 // @Produce json
-// @Param user_id query uint true "User ID"
+// @Param user_id query uint false "User ID, defaults to the authenticated user"
 // @Success 200 {object} docs.SuccessResponse{data=[]dto.BookingResponse} "Bookings retrieved successfully"
-// @Failure 400 {object} docs.ErrorResponse "Missing or Invalid User ID"
+// @Failure 400 {object} docs.ErrorResponse "Invalid User ID"
 // @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Missing booking:read:any permission)"
 // @Failure 404 {object} docs.ErrorResponse "User Not Found"
 // @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
 // @Security ApiKeyAuth
 // @Router /bookings [get]
 func (h *BookingHandler) GetMyBookings(c echo.Context) error {
-	// userIdStr := c.Param("user_id")
-	userIdStr := c.QueryParam("user_id")
-	if userIdStr == "" {
-		logger.Error("missing user_id query parameter")
-		return c.JSON(http.StatusBadRequest, jsonres.Error(
-			"BAD_REQUEST", "missing user_id query parameter", map[string]interface{}{"id": userIdStr},
+	tokenUserID, ok := c.Get("user_id").(uint)
+	if !ok || tokenUserID == 0 {
+		logger.Error("Invalid or missing user token")
+		return c.JSON(http.StatusUnauthorized, jsonres.Error(
+			"UNAUTHORIZED", "Invalid or missing user token", nil,
 		))
 	}
 
-	userId, err := strconv.ParseUint(userIdStr, 10, 64)
-	if err != nil {
-		logger.Error("Invalid user id", err)
-		return c.JSON(http.StatusBadRequest, jsonres.Error(
-			"BAD_REQUEST", "Invalid user id", map[string]interface{}{"id": userIdStr},
-		))
+	userId := uint64(tokenUserID)
+	if userIdStr := c.QueryParam("user_id"); userIdStr != "" {
+		parsed, err := strconv.ParseUint(userIdStr, 10, 64)
+		if err != nil {
+			logger.Error("Invalid user id", err)
+			return c.JSON(http.StatusBadRequest, jsonres.Error(
+				"BAD_REQUEST", "Invalid user id", map[string]interface{}{"id": userIdStr},
+			))
+		}
+		userId = parsed
 	}
 
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
 	defer cancel()
 
+	if uint(userId) != tokenUserID && !h.canReadAnyBooking(ctx, c) {
+		return c.JSON(http.StatusForbidden, jsonres.Error(
+			"FORBIDDEN", "Not allowed to read bookings of other users", nil,
+		))
+	}
+
 	bookings, err := h.bookingService.GetMyBookings(ctx, uint(userId))
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
@@ -195,6 +233,7 @@ func (h *BookingHandler) GetMyBookings(c echo.Context) error {
 // @Success 200 {object} docs.SuccessResponse{data=dto.BookingResponse} "Booking retrieved successfully"
 // @Failure 400 {object} docs.ErrorResponse "Invalid Booking ID"
 // @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not the booking owner, venue staff or booking:read:any holder)"
 // @Failure 404 {object} docs.ErrorResponse "Booking Not Found"
 // @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
 // @Security ApiKeyAuth
@@ -213,7 +252,7 @@ func (h *BookingHandler) GetBookingDetails(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
 	defer cancel()
 
-	booking, err := h.bookingService.GetBookingByID(ctx, uint(bookingId))
+	booking, err := h.bookingService.GetBookingByID(ctx, uint(bookingId), userIDFromContext(c))
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
 			logger.Warn("request timeout", map[string]any{"timeout": h.timeout})
@@ -233,6 +272,12 @@ func (h *BookingHandler) GetBookingDetails(c echo.Context) error {
 			))
 		}
 
+		if errors.Is(err, domain.ErrForbidden) {
+			return c.JSON(http.StatusForbidden, jsonres.Error(
+				"FORBIDDEN", "Not allowed to read this booking", nil,
+			))
+		}
+
 		logger.Error("Failed to find booking by id", err)
 		return c.JSON(http.StatusInternalServerError, jsonres.Error(
 			"INTERNAL_SERVER_ERROR", "Failed to find booking by id", nil,
@@ -243,3 +288,189 @@ func (h *BookingHandler) GetBookingDetails(c echo.Context) error {
 		"Bookings retrieved successfully", dto.ToBookingResponse(booking),
 	))
 }
+
+// GetVenueBookings godoc
+// @Summary Get bookings of a venue
+// @Description Get every booking made at a venue. Requires venue staff membership or the venue:manage:any permission.
+// @Tags Bookings
+// @Produce json
+// @Param id path uint true "Venue ID"
+// @Success 200 {object} docs.SuccessResponse{data=[]dto.BookingResponse} "Bookings retrieved successfully"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Venue ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not venue staff)"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /venues/{id}/bookings [get]
+func (h *BookingHandler) GetVenueBookings(c echo.Context) error {
+	venueId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		logger.Error("Invalid venue id", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid venue id", map[string]interface{}{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	bookings, err := h.bookingService.GetVenueBookings(ctx, uint(venueId), userIDFromContext(c))
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return c.JSON(http.StatusRequestTimeout, jsonres.Error(
+				"TIMEOUT",
+				"Request timeout",
+				nil,
+			))
+		}
+
+		if errors.Is(err, domain.ErrForbidden) {
+			return forbiddenVenueAccess(c)
+		}
+
+		logger.Error("Failed to retrieve venue bookings", err)
+		return c.JSON(http.StatusInternalServerError, jsonres.Error(
+			"INTERNAL_ERROR", "Failed to retrieve venue bookings", nil,
+		))
+	}
+
+	bookingResponses := make([]dto.BookingResponse, len(bookings))
+	for i, booking := range bookings {
+		bookingResponses[i] = dto.ToBookingResponse(booking)
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Bookings retrieved successfully", bookingResponses,
+	))
+}
+
+// CancelBooking godoc
+// @Summary Cancel a booking
+// @Description Cancel a booking. Allowed for the customer who made it and for venue managers. The customer is emailed, with the reason if one is given.
+// @Tags Bookings
+// @Accept json
+// @Produce json
+// @Param id path uint true "Booking ID"
+// @Param request body request.CancelBookingRequest false "Cancellation reason"
+// @Success 200 {object} docs.SuccessResponse "Booking cancelled"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Booking ID or Validation Error"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not the booking owner or a venue manager)"
+// @Failure 404 {object} docs.ErrorResponse "Booking Not Found"
+// @Failure 409 {object} docs.ErrorResponse "Booking can no longer be cancelled"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /bookings/{id}/cancel [post]
+func (h *BookingHandler) CancelBooking(c echo.Context) error {
+	bookingId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		logger.Error("Invalid booking id", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid booking id", map[string]interface{}{"booking_id": c.Param("id")},
+		))
+	}
+
+	var req request.CancelBookingRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Failed to fetch request", err,
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	if err := h.bookingService.CancelBooking(ctx, uint(bookingId), userIDFromContext(c), req.Reason); err != nil {
+		return h.handleBookingUpdateError(c, err, uint(bookingId), "Failed to cancel booking")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Booking cancelled", nil,
+	))
+}
+
+// RecordPayment godoc
+// @Summary Record a booking payment
+// @Description Record a payment taken at the venue. Confirms the booking, then sends the customer the confirmation with its calendar file and a receipt. A booking paid by another desk at the same time is refused. Requires venue staff membership or the venue:manage:any permission.
+// @Tags Bookings
+// @Accept json
+// @Produce json
+// @Param id path uint true "Booking ID"
+// @Param request body request.RecordPaymentRequest true "Payment method"
+// @Success 201 {object} docs.SuccessResponse{data=dto.PaymentResponse} "Payment recorded"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Booking ID or Validation Error"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not venue staff)"
+// @Failure 404 {object} docs.ErrorResponse "Booking Not Found"
+// @Failure 409 {object} docs.ErrorResponse "Booking is not pending"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /bookings/{id}/payments [post]
+func (h *BookingHandler) RecordPayment(c echo.Context) error {
+	bookingId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		logger.Error("Invalid booking id", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid booking id", map[string]interface{}{"booking_id": c.Param("id")},
+		))
+	}
+
+	var req request.RecordPaymentRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Failed to fetch request", err,
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	payment, err := h.bookingService.RecordPayment(ctx, uint(bookingId), userIDFromContext(c), req.PaymentMethod)
+	if err != nil {
+		return h.handleBookingUpdateError(c, err, uint(bookingId), "Failed to record payment")
+	}
+
+	return c.JSON(http.StatusCreated, jsonres.Success(
+		"Payment recorded", dto.ToPaymentResponse(payment),
+	))
+}
+
+func (h *BookingHandler) handleBookingUpdateError(c echo.Context, err error, bookingID uint, message string) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return c.JSON(http.StatusRequestTimeout, jsonres.Error(
+			"TIMEOUT", "Request timeout", nil,
+		))
+	case errors.Is(err, domain.ErrBookingNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", "Booking not found", map[string]any{"booking_id": bookingID},
+		))
+	case errors.Is(err, domain.ErrForbidden):
+		return c.JSON(http.StatusForbidden, jsonres.Error(
+			"FORBIDDEN", "Not allowed to update this booking", nil,
+		))
+	case errors.Is(err, domain.ErrBookingNotCancellable), errors.Is(err, domain.ErrBookingNotPayable):
+		return c.JSON(http.StatusConflict, jsonres.Error(
+			"CONFLICT", err.Error(), nil,
+		))
+	}
+
+	logger.Error(message, err)
+	return c.JSON(http.StatusInternalServerError, jsonres.Error(
+		"INTERNAL_ERROR", message, nil,
+	))
+}
@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	dto "go-futsal-booking-api/internal/dto/response"
+	"go-futsal-booking-api/internal/service"
+	"go-futsal-booking-api/pkg/logger"
+	jsonres "go-futsal-booking-api/pkg/response"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const calendarContentType = "text/calendar; charset=utf-8"
+
+type CalendarHandler struct {
+	bookingService  service.BookingService
+	calendarService service.CalendarService
+	timeout         time.Duration
+}
+
+func NewCalendarHandler(bookingService service.BookingService, calendarService service.CalendarService) *CalendarHandler {
+	return &CalendarHandler{
+		bookingService:  bookingService,
+		calendarService: calendarService,
+		timeout:         30 * time.Second,
+	}
+}
+
+// GetBookingCalendar godoc
+// @Summary Download a booking as a calendar file
+// @Description Get the booking as an iCalendar (.ics) event in the venue's timezone, for importing into Google Calendar, Outlook or Apple Calendar
+// @Tags Calendar
+// @Produce text/calendar
+// @Param id path uint true "Booking ID"
+// @Success 200 {string} string "iCalendar file"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Booking ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not the booking owner, venue staff or booking:read:any holder)"
+// @Failure 404 {object} docs.ErrorResponse "Booking Not Found"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /bookings/{id}/calendar.ics [get]
+func (h *CalendarHandler) GetBookingCalendar(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || id == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid booking id", map[string]any{"booking_id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	booking, err := h.bookingService.GetBookingByID(ctx, uint(id), userIDFromContext(c))
+	if err != nil {
+		return h.handleError(c, err, "Failed to get booking calendar")
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="booking-%d.ics"`, booking.ID))
+
+	return c.Blob(http.StatusOK, calendarContentType, h.calendarService.BookingCalendar(*booking))
+}
+
+// CreateCalendarFeed godoc
+// @Summary Create my calendar subscription URL
+// @Description Create a private URL that calendar apps can subscribe to, listing all of the caller's bookings. The URL works without a login, so treat it like a password. Creating a new one disables the previous URL.
+// @Tags Calendar
+// @Produce json
+// @Success 201 {object} docs.SuccessResponse{data=dto.CalendarFeedResponse} "Calendar feed created"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /users/me/calendar-feed [post]
+func (h *CalendarHandler) CreateCalendarFeed(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	feedURL, err := h.calendarService.CreateFeed(ctx, userIDFromContext(c))
+	if err != nil {
+		return h.handleError(c, err, "Failed to create calendar feed")
+	}
+
+	return c.JSON(http.StatusCreated, jsonres.Success(
+		"Calendar feed created", dto.CalendarFeedResponse{URL: feedURL},
+	))
+}
+
+// GetCalendarFeed godoc
+// @Summary Subscribe to my bookings
+// @Description iCalendar feed of the token owner's bookings, for calendar apps. Cancelled bookings stay in the feed as cancelled events so apps remove them.
+// @Tags Calendar
+// @Produce text/calendar
+// @Param token query string true "Token from the subscription URL"
+// @Success 200 {string} string "iCalendar feed"
+// @Failure 401 {object} docs.ErrorResponse "Invalid calendar token"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Router /users/me/calendar.ics [get]
+func (h *CalendarHandler) GetCalendarFeed(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	calendar, err := h.calendarService.GetFeed(ctx, c.QueryParam("token"))
+	if err != nil {
+		return h.handleError(c, err, "Failed to get calendar feed")
+	}
+
+	c.Response().Header().Set(echo.HeaderCacheControl, "private, max-age=300")
+
+	return c.Blob(http.StatusOK, calendarContentType, calendar)
+}
+
+func (h *CalendarHandler) handleError(c echo.Context, err error, message string) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return c.JSON(http.StatusRequestTimeout, jsonres.Error(
+			"TIMEOUT", "Request timeout", nil,
+		))
+	case errors.Is(err, domain.ErrInvalidCalendarToken):
+		return c.JSON(http.StatusUnauthorized, jsonres.Error(
+			"UNAUTHORIZED", err.Error(), nil,
+		))
+	case errors.Is(err, domain.ErrBookingNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", "Booking not found", nil,
+		))
+	case errors.Is(err, domain.ErrForbidden):
+		return c.JSON(http.StatusForbidden, jsonres.Error(
+			"FORBIDDEN", "Not allowed to read this booking", nil,
+		))
+	}
+
+	logger.Error(message, err)
+	return c.JSON(http.StatusInternalServerError, jsonres.Error(
+		"INTERNAL_ERROR", message, nil,
+	))
+}
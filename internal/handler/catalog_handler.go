@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	dto "go-futsal-booking-api/internal/dto/response"
+	"go-futsal-booking-api/internal/service"
+	"go-futsal-booking-api/pkg/logger"
+	jsonres "go-futsal-booking-api/pkg/response"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+var catalogContentTypes = map[string]string{
+	domain.CatalogFormatCSV:  "text/csv; charset=utf-8",
+	domain.CatalogFormatXLSX: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+type CatalogHandler struct {
+	catalogService service.CatalogService
+	timeout        time.Duration
+}
+
+func NewCatalogHandler(catalogService service.CatalogService) *CatalogHandler {
+	return &CatalogHandler{
+		catalogService: catalogService,
+		timeout:        30 * time.Second,
+	}
+}
+
+// ImportCatalog godoc
+// @Summary Import venues, fields and schedules
+// @Description Create and update venues, fields and schedules from a CSV or XLSX file with the columns venue_id, venue_name, venue_address, venue_city, venue_timezone, field_id, field_name, field_type, field_indoor, field_lighting, field_max_players, schedule_id, day_of_week, start_time, end_time and price. Each row is a schedule; leave the schedule columns empty for a field without schedules and the field columns empty for a venue without fields. Venues, fields and schedules are matched by venue_id, field_id and schedule_id, which lets the import rename them. The ID columns may be left empty or out of the file: venues are then matched by name, and a name shared by several venues is refused; fields by venue and name; and schedules by field, day and start time. Nothing is deleted. A leading apostrophe before =, +, -, @, tab or carriage return is dropped, as the export adds it. Every row is checked and the file is imported in full or not at all. New venues are owned by the importer. Requires the catalog:manage permission.
+// @Tags Catalog
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "CSV or XLSX file, at most 5 MiB"
+// @Param dry_run query bool false "Check the file without keeping any change"
+// @Success 200 {object} docs.SuccessResponse{data=dto.CatalogImportResponse} "Catalog imported"
+// @Failure 400 {object} docs.ErrorResponse "Missing file, unsupported format or invalid dry_run"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Missing catalog:manage permission)"
+// @Failure 413 {object} docs.ErrorResponse "File too large"
+// @Failure 422 {object} docs.ErrorResponse{details=[]dto.CatalogRowErrorResponse} "Invalid rows; nothing was imported"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /catalog/import [post]
+func (h *CatalogHandler) ImportCatalog(c echo.Context) error {
+	dryRun := false
+	if value := c.QueryParam("dry_run"); value != "" {
+		var err error
+		if dryRun, err = strconv.ParseBool(value); err != nil {
+			return c.JSON(http.StatusBadRequest, jsonres.Error(
+				"BAD_REQUEST", "dry_run must be true or false", map[string]any{"dry_run": value},
+			))
+		}
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Missing catalog file", nil,
+		))
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		logger.Error("Failed to open uploaded catalog", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Failed to read catalog file", nil,
+		))
+	}
+	defer src.Close()
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	result, err := h.catalogService.Import(ctx, file.Filename, src, userIDFromContext(c), dryRun)
+	if err != nil {
+		return h.handleError(c, err, "Failed to import catalog")
+	}
+
+	message := "Catalog imported"
+	if result.DryRun {
+		message = "Catalog checked, nothing was imported"
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		message, dto.ToCatalogImportResponse(result),
+	))
+}
+
+// ExportCatalog godoc
+// @Summary Export venues, fields and schedules
+// @Description Download every venue, field and schedule as a CSV or XLSX file in the format accepted by the import, with their IDs, so it can be edited and imported again. CSV cells starting with =, +, -, @, tab or carriage return get a leading apostrophe so spreadsheet programs do not run them as formulas. Requires the catalog:manage permission.
+// @Tags Catalog
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param format query string false "csv (default) or xlsx"
+// @Success 200 {string} string "Catalog file"
+// @Failure 400 {object} docs.ErrorResponse "Unsupported format"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Missing catalog:manage permission)"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /catalog/export [get]
+func (h *CatalogHandler) ExportCatalog(c echo.Context) error {
+	format := c.QueryParam("format")
+	if format == "" {
+		format = domain.CatalogFormatCSV
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	file, err := h.catalogService.Export(ctx, format)
+	if err != nil {
+		return h.handleError(c, err, "Failed to export catalog")
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="catalog.%s"`, format))
+
+	return c.Blob(http.StatusOK, catalogContentTypes[format], file)
+}
+
+func (h *CatalogHandler) handleError(c echo.Context, err error, message string) error {
+	var importErr *domain.CatalogImportError
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return c.JSON(http.StatusRequestTimeout, jsonres.Error(
+			"TIMEOUT", "Request timeout", nil,
+		))
+	case errors.As(err, &importErr):
+		return c.JSON(http.StatusUnprocessableEntity, jsonres.Error(
+			"INVALID_CATALOG", domain.ErrInvalidCatalog.Error(), dto.ToCatalogRowErrorResponses(importErr.Rows),
+		))
+	case errors.Is(err, domain.ErrUnsupportedCatalog):
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", domain.ErrUnsupportedCatalog.Error(), nil,
+		))
+	case errors.Is(err, domain.ErrCatalogTooLarge):
+		return c.JSON(http.StatusRequestEntityTooLarge, jsonres.Error(
+			"CATALOG_TOO_LARGE", err.Error(), nil,
+		))
+	}
+
+	logger.Error(message, err)
+	return c.JSON(http.StatusInternalServerError, jsonres.Error(
+		"INTERNAL_ERROR", message, nil,
+	))
+}
@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/dto/request"
+	dto "go-futsal-booking-api/internal/dto/response"
+	"go-futsal-booking-api/internal/service"
+	"go-futsal-booking-api/pkg/logger"
+	jsonres "go-futsal-booking-api/pkg/response"
+	"go-futsal-booking-api/pkg/validator"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CheckInHandler struct {
+	checkInService service.CheckInService
+	timeout        time.Duration
+}
+
+func NewCheckInHandler(checkInService service.CheckInService) *CheckInHandler {
+	return &CheckInHandler{
+		checkInService: checkInService,
+		timeout:        30 * time.Second,
+	}
+}
+
+// GetCheckInCode godoc
+// @Summary Get my booking's check-in QR code
+// @Description Get a PNG QR code to show at the venue's front desk. Only the booking owner can get it, and only while the booking is confirmed. Anyone holding the code can check the booking in, so do not share it.
+// @Tags Bookings
+// @Produce png
+// @Param id path uint true "Booking ID"
+// @Success 200 {file} file "QR code image"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Booking ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not the booking owner)"
+// @Failure 404 {object} docs.ErrorResponse "Booking Not Found"
+// @Failure 409 {object} docs.ErrorResponse "Booking is already checked in"
+// @Failure 422 {object} docs.ErrorResponse "Booking is not confirmed"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /bookings/{id}/check-in.png [get]
+func (h *CheckInHandler) GetCheckInCode(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || id == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid booking id", map[string]any{"booking_id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	png, err := h.checkInService.QRCode(ctx, uint(id), userIDFromContext(c))
+	if err != nil {
+		return h.handleError(c, err, "Failed to get check-in code")
+	}
+
+	c.Response().Header().Set(echo.HeaderCacheControl, "private, no-store")
+
+	return c.Blob(http.StatusOK, "image/png", png)
+}
+
+// CheckIn godoc
+// @Summary Check a customer in
+// @Description Verify the token from a customer's check-in QR code and mark the booking as checked in. The caller must be staff of the venue, and the booking must be confirmed, at that venue and for today in the venue's timezone. Each code works once.
+// @Tags Bookings
+// @Accept json
+// @Produce json
+// @Param check_in body request.CheckInRequest true "Scanned token and the staff member's venue"
+// @Success 200 {object} docs.SuccessResponse{data=dto.BookingResponse} "Booking checked in"
+// @Failure 400 {object} docs.ErrorResponse "Bad Request, Validation Error or Invalid check-in code"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not staff of the venue)"
+// @Failure 409 {object} docs.ErrorResponse "Booking is already checked in"
+// @Failure 422 {object} docs.ErrorResponse "Booking is not confirmed, not for today or for another venue"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /bookings/check-in [post]
+func (h *CheckInHandler) CheckIn(c echo.Context) error {
+	var req request.CheckInRequest
+
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Failed to fetch request", err,
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	booking, err := h.checkInService.CheckIn(ctx, req.Token, req.VenueID, userIDFromContext(c))
+	if err != nil {
+		return h.handleError(c, err, "Failed to check in booking")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Booking checked in", dto.ToBookingResponse(booking),
+	))
+}
+
+func (h *CheckInHandler) handleError(c echo.Context, err error, message string) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return c.JSON(http.StatusRequestTimeout, jsonres.Error(
+			"TIMEOUT", "Request timeout", nil,
+		))
+	case errors.Is(err, domain.ErrInvalidCheckInToken):
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"INVALID_CHECK_IN_CODE", err.Error(), nil,
+		))
+	case errors.Is(err, domain.ErrBookingNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", "Booking not found", nil,
+		))
+	case errors.Is(err, domain.ErrForbidden):
+		return c.JSON(http.StatusForbidden, jsonres.Error(
+			"FORBIDDEN", "Not allowed to check in this booking", nil,
+		))
+	case errors.Is(err, domain.ErrAlreadyCheckedIn):
+		return c.JSON(http.StatusConflict, jsonres.Error(
+			"ALREADY_CHECKED_IN", err.Error(), nil,
+		))
+	case errors.Is(err, domain.ErrBookingNotConfirmed),
+		errors.Is(err, domain.ErrCheckInWrongDate),
+		errors.Is(err, domain.ErrCheckInWrongVenue):
+		return c.JSON(http.StatusUnprocessableEntity, jsonres.Error(
+			"CHECK_IN_REJECTED", err.Error(), nil,
+		))
+	}
+
+	logger.Error(message, err)
+	return c.JSON(http.StatusInternalServerError, jsonres.Error(
+		"INTERNAL_ERROR", message, nil,
+	))
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	dto "go-futsal-booking-api/internal/dto/response"
+	jsonres "go-futsal-booking-api/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// forceFromQuery reads the force query parameter of the delete endpoints.
+func forceFromQuery(c echo.Context) (bool, error) {
+	force := c.QueryParam("force")
+	if force == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(force)
+}
+
+func invalidForce(c echo.Context) error {
+	return c.JSON(http.StatusBadRequest, jsonres.Error(
+		"BAD_REQUEST", "force must be true or false", map[string]any{"force": c.QueryParam("force")},
+	))
+}
+
+// futureBookingsConflict answers a deletion blocked by upcoming bookings,
+// with what the deletion would affect.
+func futureBookingsConflict(c echo.Context, impact *domain.DeletionImpact) error {
+	var details any
+	if impact != nil {
+		details = dto.ToDeletionImpactResponse(impact)
+	}
+
+	return c.JSON(http.StatusConflict, jsonres.Error(
+		"HAS_FUTURE_BOOKINGS", domain.ErrHasFutureBookings.Error(), details,
+	))
+}
+
+// restoreError answers the errors every restore endpoint shares. It returns
+// false for any other error.
+func restoreError(c echo.Context, err error) (bool, error) {
+	switch {
+	case errors.Is(err, domain.ErrNotDeleted),
+		errors.Is(err, domain.ErrParentDeleted):
+		return true, c.JSON(http.StatusConflict, jsonres.Error(
+			"CONFLICT", err.Error(), nil,
+		))
+	case errors.Is(err, domain.ErrRestoreExpired):
+		return true, c.JSON(http.StatusGone, jsonres.Error(
+			"RESTORE_EXPIRED", err.Error(), nil,
+		))
+	}
+
+	return false, nil
+}
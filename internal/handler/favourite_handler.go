@@ -0,0 +1,210 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	dto "go-futsal-booking-api/internal/dto/response"
+	"go-futsal-booking-api/internal/service"
+	"go-futsal-booking-api/pkg/logger"
+	jsonres "go-futsal-booking-api/pkg/response"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type FavouriteHandler struct {
+	favouriteService service.FavouriteService
+	timeout          time.Duration
+}
+
+func NewFavouriteHandler(favouriteService service.FavouriteService) *FavouriteHandler {
+	return &FavouriteHandler{
+		favouriteService: favouriteService,
+		timeout:          30 * time.Second,
+	}
+}
+
+// GetFavourites godoc
+// @Summary List my favourites
+// @Description List the venues and fields the current user has favourited, most recently added first.
+// @Tags Favourites
+// @Produce json
+// @Success 200 {object} docs.SuccessResponse{data=dto.FavouritesResponse} "Favourites"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /users/me/favourites [get]
+func (h *FavouriteHandler) GetFavourites(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	favourites, err := h.favouriteService.GetFavourites(ctx, userIDFromContext(c))
+	if err != nil {
+		return h.handleError(c, err, "Failed to get favourites")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Favourites", dto.ToFavouritesResponse(favourites),
+	))
+}
+
+// AddFavouriteVenue godoc
+// @Summary Favourite a venue
+// @Description Add a venue to the current user's favourites. Adding it again has no effect.
+// @Tags Favourites
+// @Produce json
+// @Param id path uint true "Venue ID"
+// @Success 200 {object} docs.SuccessResponse "Venue favourited"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Venue ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 404 {object} docs.ErrorResponse "Venue Not Found"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /users/me/favourites/venues/{id} [put]
+func (h *FavouriteHandler) AddFavouriteVenue(c echo.Context) error {
+	venueID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || venueID == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid venue id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	if err := h.favouriteService.AddVenue(ctx, userIDFromContext(c), uint(venueID)); err != nil {
+		return h.handleError(c, err, "Failed to favourite venue")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Venue favourited", nil,
+	))
+}
+
+// RemoveFavouriteVenue godoc
+// @Summary Unfavourite a venue
+// @Description Remove a venue from the current user's favourites.
+// @Tags Favourites
+// @Produce json
+// @Param id path uint true "Venue ID"
+// @Success 200 {object} docs.SuccessResponse "Venue removed from favourites"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Venue ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 404 {object} docs.ErrorResponse "Not in favourites"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /users/me/favourites/venues/{id} [delete]
+func (h *FavouriteHandler) RemoveFavouriteVenue(c echo.Context) error {
+	venueID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || venueID == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid venue id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	if err := h.favouriteService.RemoveVenue(ctx, userIDFromContext(c), uint(venueID)); err != nil {
+		return h.handleError(c, err, "Failed to unfavourite venue")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Venue removed from favourites", nil,
+	))
+}
+
+// AddFavouriteField godoc
+// @Summary Favourite a field
+// @Description Add a field to the current user's favourites. Adding it again has no effect.
+// @Tags Favourites
+// @Produce json
+// @Param id path uint true "Field ID"
+// @Success 200 {object} docs.SuccessResponse "Field favourited"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Field ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 404 {object} docs.ErrorResponse "Field Not Found"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /users/me/favourites/fields/{id} [put]
+func (h *FavouriteHandler) AddFavouriteField(c echo.Context) error {
+	fieldID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || fieldID == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid field id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	if err := h.favouriteService.AddField(ctx, userIDFromContext(c), uint(fieldID)); err != nil {
+		return h.handleError(c, err, "Failed to favourite field")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Field favourited", nil,
+	))
+}
+
+// RemoveFavouriteField godoc
+// @Summary Unfavourite a field
+// @Description Remove a field from the current user's favourites.
+// @Tags Favourites
+// @Produce json
+// @Param id path uint true "Field ID"
+// @Success 200 {object} docs.SuccessResponse "Field removed from favourites"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Field ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 404 {object} docs.ErrorResponse "Not in favourites"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /users/me/favourites/fields/{id} [delete]
+func (h *FavouriteHandler) RemoveFavouriteField(c echo.Context) error {
+	fieldID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || fieldID == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid field id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	if err := h.favouriteService.RemoveField(ctx, userIDFromContext(c), uint(fieldID)); err != nil {
+		return h.handleError(c, err, "Failed to unfavourite field")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Field removed from favourites", nil,
+	))
+}
+
+func (h *FavouriteHandler) handleError(c echo.Context, err error, message string) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return c.JSON(http.StatusRequestTimeout, jsonres.Error(
+			"TIMEOUT", "Request timeout", nil,
+		))
+	case errors.Is(err, domain.ErrVenueNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", "Venue not found", nil,
+		))
+	case errors.Is(err, domain.ErrFieldNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", "Field not found", nil,
+		))
+	case errors.Is(err, domain.ErrFavouriteNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", err.Error(), nil,
+		))
+	}
+
+	logger.Error(message, err)
+	return c.JSON(http.StatusInternalServerError, jsonres.Error(
+		"INTERNAL_ERROR", message, nil,
+	))
+}
@@ -166,15 +166,15 @@ func (h *FieldHandler) GetFieldsByVenue(c echo.Context) error {
 
 // CreateField godoc
 // @Summary Create a new field (Admin only)
-// @Description Create a new field for a specific venue
+// @Description Create a new field for a specific venue. Requires MANAGER membership of the venue.
 // @Tags Fields
 // @Accept json
 // @Produce json
 // @Param field body request.CreateFieldRequest true "Field creation request"
 // @Success 201 {object} docs.SuccessResponse{data=dto.FieldResponse} "Field successfully created"
-// @Failure 400 {object} docs.ErrorResponse "Bad Request or Validation Error"
+// @Failure 400 {object} docs.ErrorResponse "Bad Request, Validation Error, unknown field type or invalid details"
 // @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
-// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not Admin)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not a venue manager)"
 // @Failure 404 {object} docs.ErrorResponse "Venue Not Found"
 // @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
 // @Security ApiKeyAuth
@@ -202,10 +202,12 @@ func (h *FieldHandler) CreateField(c echo.Context) error {
 	newField, err := h.fieldService.CreateField(
 		ctx,
 		&request.CreateFieldRequest{
-			VenueID:   req.VenueID,
-			Name:      req.Name,
-			FieldType: req.FieldType,
+			VenueID:             req.VenueID,
+			Name:                req.Name,
+			FieldType:           req.FieldType,
+			FieldDetailsRequest: req.FieldDetailsRequest,
 		},
+		userIDFromContext(c),
 	)
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
@@ -226,6 +228,16 @@ func (h *FieldHandler) CreateField(c echo.Context) error {
 			))
 		}
 
+		if errors.Is(err, domain.ErrFieldTypeNotFound) || errors.Is(err, domain.ErrInvalidFieldDetails) {
+			return c.JSON(http.StatusBadRequest, jsonres.Error(
+				"BAD_REQUEST", err.Error(), map[string]any{"field_type": req.FieldType},
+			))
+		}
+
+		if errors.Is(err, domain.ErrForbidden) {
+			return forbiddenVenueAccess(c)
+		}
+
 		logger.Error("Failed to create field", err)
 		return c.JSON(http.StatusInternalServerError, jsonres.Error(
 			"INTERNAL_ERROR", "Failed to create field", nil,
@@ -239,16 +251,16 @@ func (h *FieldHandler) CreateField(c echo.Context) error {
 
 // UpdateField godoc
 // @Summary Update a field (Admin only)
-// @Description Update details of an existing field
+// @Description Update details of an existing field. Requires MANAGER membership of the venue.
 // @Tags Fields
 // @Accept json
 // @Produce json
 // @Param id path uint true "Field ID"
 // @Param field body request.UpdateFieldRequest true "Field update request"
 // @Success 200 {object} docs.SuccessResponse{data=dto.FieldResponse} "Field successfully updated"
-// @Failure 400 {object} docs.ErrorResponse "Bad Request, Invalid ID, or Validation Error"
+// @Failure 400 {object} docs.ErrorResponse "Bad Request, Invalid ID, Validation Error, unknown field type or invalid details"
 // @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
-// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not Admin)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not a venue manager)"
 // @Failure 404 {object} docs.ErrorResponse "Field Not Found"
 // @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
 // @Security ApiKeyAuth
@@ -285,8 +297,8 @@ func (h *FieldHandler) UpdateField(c echo.Context) error {
 	updatedField, err := h.fieldService.UpdateField(
 		ctx,
 		uint(fieldIdInt),
-		req.Name,
-		req.FieldType,
+		&req,
+		userIDFromContext(c),
 	)
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
@@ -307,6 +319,16 @@ func (h *FieldHandler) UpdateField(c echo.Context) error {
 			))
 		}
 
+		if errors.Is(err, domain.ErrFieldTypeNotFound) || errors.Is(err, domain.ErrInvalidFieldDetails) {
+			return c.JSON(http.StatusBadRequest, jsonres.Error(
+				"BAD_REQUEST", err.Error(), map[string]any{"field_type": req.FieldType},
+			))
+		}
+
+		if errors.Is(err, domain.ErrForbidden) {
+			return forbiddenVenueAccess(c)
+		}
+
 		logger.Error("Failed to update field", err)
 		return c.JSON(http.StatusInternalServerError, jsonres.Error(
 			"INTERNAL_ERROR", "Failed to update field", nil,
@@ -318,17 +340,54 @@ func (h *FieldHandler) UpdateField(c echo.Context) error {
 	))
 }
 
+// GetFieldDeletionPreview godoc
+// @Summary Preview deleting a field
+// @Description Count the schedules and upcoming bookings that deleting the field would affect. Requires MANAGER membership of the venue.
+// @Tags Fields
+// @Produce json
+// @Param id path uint true "Field ID"
+// @Success 200 {object} docs.SuccessResponse{data=dto.DeletionImpactResponse} "Deletion preview"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Field ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not a venue manager)"
+// @Failure 404 {object} docs.ErrorResponse "Field Not Found"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /fields/{id}/deletion-preview [get]
+func (h *FieldHandler) GetFieldDeletionPreview(c echo.Context) error {
+	fieldId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || fieldId == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid field id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	impact, err := h.fieldService.GetFieldDeletionPreview(ctx, uint(fieldId), userIDFromContext(c))
+	if err != nil {
+		return h.deletionError(c, err, "Failed to preview field deletion")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Deletion preview", dto.ToDeletionImpactResponse(impact),
+	))
+}
+
 // DeleteField godoc
 // @Summary Delete a field (Admin only)
-// @Description Delete an existing field by its ID
+// @Description Delete a field together with its schedules. When upcoming bookings exist the deletion is refused with 409 unless force=true, which cancels them and tells the customers. Deleted fields can be restored for 30 days. Requires MANAGER membership of the venue.
 // @Tags Fields
 // @Produce json
 // @Param id path uint true "Field ID"
+// @Param force query bool false "Cancel upcoming bookings instead of refusing"
 // @Success 200 {object} docs.SuccessResponse "Field deleted successfully"
 // @Failure 400 {object} docs.ErrorResponse "Invalid Field ID"
 // @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
-// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not Admin)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not a venue manager)"
 // @Failure 404 {object} docs.ErrorResponse "Field Not Found"
+// @Failure 409 {object} docs.ErrorResponse "Field has upcoming bookings"
 // @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
 // @Security ApiKeyAuth
 // @Router /fields/{id} [delete]
@@ -343,38 +402,127 @@ func (h *FieldHandler) DeleteField(c echo.Context) error {
 		))
 	}
 
+	force, err := forceFromQuery(c)
+	if err != nil {
+		return invalidForce(c)
+	}
+
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
 	defer cancel()
 
-	err = h.fieldService.DeleteField(
+	impact, err := h.fieldService.DeleteField(
 		ctx,
 		uint(fieldIdInt),
+		userIDFromContext(c),
+		force,
 	)
 	if err != nil {
-		if errors.Is(err, context.DeadlineExceeded) {
-			return c.JSON(http.StatusRequestTimeout, jsonres.Error(
-				"TIMEOUT",
-				"Request timeout",
-				nil,
-			))
+		if errors.Is(err, domain.ErrHasFutureBookings) {
+			return futureBookingsConflict(c, impact)
 		}
+		return h.deletionError(c, err, "Failed to delete field")
+	}
 
-		if errors.Is(err, domain.ErrFieldNotFound) {
-			return c.JSON(http.StatusNotFound, jsonres.Error(
-				"NOT_FOUND",
-				"Field not found",
-				map[string]interface{}{"field_id": fieldIdInt},
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Field deleted successfully",
+		map[string]any{"field_id": fieldId, "impact": dto.ToDeletionImpactResponse(impact)},
+	))
+}
+
+// RestoreField godoc
+// @Summary Restore a deleted field
+// @Description Restore a field deleted in the last 30 days, with the schedules deleted along with it. Its venue must not be deleted. Bookings cancelled by the deletion stay cancelled. Requires the deleted:restore permission.
+// @Tags Fields
+// @Produce json
+// @Param id path uint true "Field ID"
+// @Success 200 {object} docs.SuccessResponse{data=dto.FieldResponse} "Field restored"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Field ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Missing Permission)"
+// @Failure 404 {object} docs.ErrorResponse "Field Not Found"
+// @Failure 409 {object} docs.ErrorResponse "Field is not deleted or its venue is"
+// @Failure 410 {object} docs.ErrorResponse "Deleted too long ago"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /fields/{id}/restore [post]
+func (h *FieldHandler) RestoreField(c echo.Context) error {
+	fieldId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || fieldId == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid field id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	field, err := h.fieldService.RestoreField(ctx, uint(fieldId))
+	if err != nil {
+		if handled, res := restoreError(c, err); handled {
+			return res
+		}
+		return h.deletionError(c, err, "Failed to restore field")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Field restored", dto.ToFieldResponse(field),
+	))
+}
+
+func (h *FieldHandler) deletionError(c echo.Context, err error, message string) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return c.JSON(http.StatusRequestTimeout, jsonres.Error(
+			"TIMEOUT", "Request timeout", nil,
+		))
+	case errors.Is(err, domain.ErrFieldNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", "Field not found", nil,
+		))
+	case errors.Is(err, domain.ErrForbidden):
+		return forbiddenVenueAccess(c)
+	}
+
+	logger.Error(message, err)
+	return c.JSON(http.StatusInternalServerError, jsonres.Error(
+		"INTERNAL_SERVER_ERROR", message, nil,
+	))
+}
+
+// GetFieldTypes godoc
+// @Summary List field types
+// @Description List the playing surfaces a field can have. Use the code as field_type when creating or updating a field, or to filter venue search.
+// @Tags Fields
+// @Produce json
+// @Success 200 {object} docs.SuccessResponse{data=[]dto.FieldTypeResponse} "Field types"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /field-types [get]
+func (h *FieldHandler) GetFieldTypes(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	types, err := h.fieldService.GetFieldTypes(ctx)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return c.JSON(http.StatusRequestTimeout, jsonres.Error(
+				"TIMEOUT", "Request timeout", nil,
 			))
 		}
 
-		logger.Error("Failed to delete field", err)
+		logger.Error("Failed to get field types", err)
 		return c.JSON(http.StatusInternalServerError, jsonres.Error(
-			"INTERNAL_SERVER_ERROR", "Failed to delete field", err,
+			"INTERNAL_ERROR", "Failed to get field types", nil,
 		))
 	}
 
+	res := make([]dto.FieldTypeResponse, len(types))
+	for i := range types {
+		res[i] = dto.ToFieldTypeResponse(&types[i])
+	}
+
 	return c.JSON(http.StatusOK, jsonres.Success(
-		"Field deleted successfully",
-		map[string]any{"field_id": fieldId},
+		"Field types", res,
 	))
 }
@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	dto "go-futsal-booking-api/internal/dto/response"
+	"go-futsal-booking-api/internal/service"
+	"go-futsal-booking-api/pkg/logger"
+	jsonres "go-futsal-booking-api/pkg/response"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type InboxHandler struct {
+	inboxService service.InboxService
+	timeout      time.Duration
+}
+
+func NewInboxHandler(inboxService service.InboxService) *InboxHandler {
+	return &InboxHandler{
+		inboxService: inboxService,
+		timeout:      30 * time.Second,
+	}
+}
+
+// GetNotifications godoc
+// @Summary List my in-app notifications
+// @Description List the caller's inbox, newest first, with the number of unread notifications
+// @Tags Inbox
+// @Produce json
+// @Param unread query bool false "Only unread notifications"
+// @Param limit query int false "Page size (default 50, max 200)"
+// @Param offset query int false "Number of notifications to skip"
+// @Success 200 {object} docs.SuccessResponse{data=dto.InboxListResponse} "Notifications"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /users/me/notifications [get]
+func (h *InboxHandler) GetNotifications(c echo.Context) error {
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil || limit <= 0 {
+		limit = 50
+	}
+	limit = min(limit, 200)
+
+	offset, err := strconv.Atoi(c.QueryParam("offset"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+
+	unreadOnly, _ := strconv.ParseBool(c.QueryParam("unread"))
+
+	filter := domain.InboxFilter{
+		UnreadOnly: unreadOnly,
+		Limit:      limit,
+		Offset:     offset,
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	userID := userIDFromContext(c)
+
+	notifications, total, err := h.inboxService.GetNotifications(ctx, userID, filter)
+	if err != nil {
+		return h.handleError(c, err, "Failed to get notifications")
+	}
+
+	unread, err := h.inboxService.CountUnread(ctx, userID)
+	if err != nil {
+		return h.handleError(c, err, "Failed to get notifications")
+	}
+
+	res := dto.InboxListResponse{
+		Notifications: make([]dto.InboxNotificationResponse, len(notifications)),
+		UnreadCount:   unread,
+		Total:         total,
+		Limit:         limit,
+		Offset:        offset,
+	}
+	for i := range notifications {
+		res.Notifications[i] = dto.ToInboxNotificationResponse(&notifications[i])
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Notifications", res,
+	))
+}
+
+// GetUnreadCount godoc
+// @Summary Count my unread notifications
+// @Description Number shown on the notification bell
+// @Tags Inbox
+// @Produce json
+// @Success 200 {object} docs.SuccessResponse{data=dto.UnreadCountResponse} "Unread notifications"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /users/me/notifications/unread-count [get]
+func (h *InboxHandler) GetUnreadCount(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	unread, err := h.inboxService.CountUnread(ctx, userIDFromContext(c))
+	if err != nil {
+		return h.handleError(c, err, "Failed to count unread notifications")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Unread notifications", dto.UnreadCountResponse{UnreadCount: unread},
+	))
+}
+
+// MarkRead godoc
+// @Summary Mark a notification as read
+// @Description Marking a notification that is already read keeps its original read time
+// @Tags Inbox
+// @Produce json
+// @Param id path uint true "Notification ID"
+// @Success 200 {object} docs.SuccessResponse{data=dto.InboxNotificationResponse} "Notification marked as read"
+// @Failure 400 {object} docs.ErrorResponse "Invalid ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 404 {object} docs.ErrorResponse "Notification Not Found"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /users/me/notifications/{id}/read [post]
+func (h *InboxHandler) MarkRead(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || id == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid notification id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	notification, err := h.inboxService.MarkRead(ctx, userIDFromContext(c), uint(id))
+	if err != nil {
+		return h.handleError(c, err, "Failed to mark notification as read")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Notification marked as read", dto.ToInboxNotificationResponse(&notification),
+	))
+}
+
+// MarkAllRead godoc
+// @Summary Mark all my notifications as read
+// @Tags Inbox
+// @Produce json
+// @Success 200 {object} docs.SuccessResponse{data=dto.MarkAllReadResponse} "Notifications marked as read"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /users/me/notifications/read-all [post]
+func (h *InboxHandler) MarkAllRead(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	marked, err := h.inboxService.MarkAllRead(ctx, userIDFromContext(c))
+	if err != nil {
+		return h.handleError(c, err, "Failed to mark notifications as read")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Notifications marked as read", dto.MarkAllReadResponse{Marked: marked},
+	))
+}
+
+func (h *InboxHandler) handleError(c echo.Context, err error, message string) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return c.JSON(http.StatusRequestTimeout, jsonres.Error(
+			"TIMEOUT", "Request timeout", nil,
+		))
+	case errors.Is(err, domain.ErrInboxNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", err.Error(), nil,
+		))
+	}
+
+	logger.Error(message, err)
+	return c.JSON(http.StatusInternalServerError, jsonres.Error(
+		"INTERNAL_ERROR", message, nil,
+	))
+}
@@ -0,0 +1,226 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/dto/request"
+	dto "go-futsal-booking-api/internal/dto/response"
+	"go-futsal-booking-api/internal/service"
+	"go-futsal-booking-api/pkg/logger"
+	jsonres "go-futsal-booking-api/pkg/response"
+	"go-futsal-booking-api/pkg/validator"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type MaintenanceHandler struct {
+	maintenanceService service.MaintenanceService
+	timeout            time.Duration
+}
+
+func NewMaintenanceHandler(maintenanceService service.MaintenanceService) *MaintenanceHandler {
+	return &MaintenanceHandler{
+		maintenanceService: maintenanceService,
+		timeout:            30 * time.Second,
+	}
+}
+
+// GetMaintenanceWindows godoc
+// @Summary List maintenance windows of a field
+// @Description List the current and upcoming maintenance windows of a field. The field cannot be booked during them.
+// @Tags Maintenance
+// @Produce json
+// @Param id path uint true "Field ID"
+// @Success 200 {object} docs.SuccessResponse{data=[]dto.MaintenanceWindowResponse} "Maintenance windows"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Field ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 404 {object} docs.ErrorResponse "Field Not Found"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /fields/{id}/maintenance-windows [get]
+func (h *MaintenanceHandler) GetMaintenanceWindows(c echo.Context) error {
+	fieldID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || fieldID == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid field id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	windows, err := h.maintenanceService.GetMaintenanceWindows(ctx, uint(fieldID))
+	if err != nil {
+		return h.handleError(c, err, "Failed to get maintenance windows")
+	}
+
+	res := make([]dto.MaintenanceWindowResponse, len(windows))
+	for i := range windows {
+		res[i] = dto.ToMaintenanceWindowResponse(&windows[i])
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Maintenance windows", res,
+	))
+}
+
+// CreateMaintenanceWindow godoc
+// @Summary Close a field for maintenance
+// @Description Close a field for booking from starts_at until ends_at. Bookings already made in that time are kept; their customers are notified with free slots they could move to, which are also returned here. Requires at least MANAGER membership.
+// @Tags Maintenance
+// @Accept json
+// @Produce json
+// @Param id path uint true "Field ID"
+// @Param window body request.CreateMaintenanceWindowRequest true "Maintenance window"
+// @Success 201 {object} docs.SuccessResponse{data=dto.CreateMaintenanceWindowResponse} "Maintenance window created"
+// @Failure 400 {object} docs.ErrorResponse "Bad Request or Validation Error"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not a venue manager)"
+// @Failure 404 {object} docs.ErrorResponse "Field Not Found"
+// @Failure 409 {object} docs.ErrorResponse "Overlaps another maintenance window"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /fields/{id}/maintenance-windows [post]
+func (h *MaintenanceHandler) CreateMaintenanceWindow(c echo.Context) error {
+	fieldID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || fieldID == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid field id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	var req request.CreateMaintenanceWindowRequest
+
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Failed to fetch request", err,
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	window, impacts, err := h.maintenanceService.CreateMaintenanceWindow(ctx, uint(fieldID), &req, userIDFromContext(c))
+	if err != nil {
+		return h.handleError(c, err, "Failed to create maintenance window")
+	}
+
+	return c.JSON(http.StatusCreated, jsonres.Success(
+		"Maintenance window created", dto.CreateMaintenanceWindowResponse{
+			MaintenanceWindowResponse: dto.ToMaintenanceWindowResponse(window),
+			AffectedBookings:          dto.ToAffectedBookingResponses(impacts),
+		},
+	))
+}
+
+// GetAffectedBookings godoc
+// @Summary List bookings inside a maintenance window
+// @Description List the pending and confirmed bookings that fall in a maintenance window, each with free slots its customer could move to. Requires at least STAFF membership.
+// @Tags Maintenance
+// @Produce json
+// @Param id path uint true "Maintenance window ID"
+// @Success 200 {object} docs.SuccessResponse{data=[]dto.AffectedBookingResponse} "Affected bookings"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Maintenance Window ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not venue staff)"
+// @Failure 404 {object} docs.ErrorResponse "Maintenance Window Not Found"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /maintenance-windows/{id}/affected-bookings [get]
+func (h *MaintenanceHandler) GetAffectedBookings(c echo.Context) error {
+	windowID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || windowID == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid maintenance window id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	impacts, err := h.maintenanceService.GetAffectedBookings(ctx, uint(windowID), userIDFromContext(c))
+	if err != nil {
+		return h.handleError(c, err, "Failed to get affected bookings")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Affected bookings", dto.ToAffectedBookingResponses(impacts),
+	))
+}
+
+// DeleteMaintenanceWindow godoc
+// @Summary Delete a maintenance window
+// @Description Delete a maintenance window, opening the field for booking again. Requires at least MANAGER membership.
+// @Tags Maintenance
+// @Produce json
+// @Param id path uint true "Maintenance window ID"
+// @Success 200 {object} docs.SuccessResponse "Maintenance window deleted"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Maintenance Window ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not a venue manager)"
+// @Failure 404 {object} docs.ErrorResponse "Maintenance Window Not Found"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /maintenance-windows/{id} [delete]
+func (h *MaintenanceHandler) DeleteMaintenanceWindow(c echo.Context) error {
+	windowID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || windowID == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid maintenance window id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	if err := h.maintenanceService.DeleteMaintenanceWindow(ctx, uint(windowID), userIDFromContext(c)); err != nil {
+		return h.handleError(c, err, "Failed to delete maintenance window")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Maintenance window deleted", nil,
+	))
+}
+
+func (h *MaintenanceHandler) handleError(c echo.Context, err error, message string) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return c.JSON(http.StatusRequestTimeout, jsonres.Error(
+			"TIMEOUT", "Request timeout", nil,
+		))
+	case errors.Is(err, domain.ErrInvalidMaintenance):
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", err.Error(), nil,
+		))
+	case errors.Is(err, domain.ErrForbidden):
+		return forbiddenVenueAccess(c)
+	case errors.Is(err, domain.ErrFieldNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", "Field not found", nil,
+		))
+	case errors.Is(err, domain.ErrMaintenanceNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", "Maintenance window not found", nil,
+		))
+	case errors.Is(err, domain.ErrMaintenanceOverlap):
+		return c.JSON(http.StatusConflict, jsonres.Error(
+			"CONFLICT", err.Error(), nil,
+		))
+	}
+
+	logger.Error(message, err)
+	return c.JSON(http.StatusInternalServerError, jsonres.Error(
+		"INTERNAL_ERROR", message, nil,
+	))
+}
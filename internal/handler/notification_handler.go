@@ -0,0 +1,252 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	dto "go-futsal-booking-api/internal/dto/response"
+	"go-futsal-booking-api/internal/service"
+	"go-futsal-booking-api/pkg/logger"
+	jsonres "go-futsal-booking-api/pkg/response"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type NotificationHandler struct {
+	emailTemplates service.EmailTemplateService
+	outboxService  service.OutboxService
+	timeout        time.Duration
+}
+
+func NewNotificationHandler(emailTemplates service.EmailTemplateService, outboxService service.OutboxService) *NotificationHandler {
+	return &NotificationHandler{
+		emailTemplates: emailTemplates,
+		outboxService:  outboxService,
+		timeout:        30 * time.Second,
+	}
+}
+
+func (h *NotificationHandler) outboxError(c echo.Context, err error, fallback string) error {
+	switch {
+	case errors.Is(err, domain.ErrOutboxMessageNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", err.Error(), nil,
+		))
+	case errors.Is(err, domain.ErrOutboxNotReplayable):
+		return c.JSON(http.StatusConflict, jsonres.Error(
+			"CONFLICT", err.Error(), nil,
+		))
+	}
+
+	logger.Error(fallback, err)
+	return c.JSON(http.StatusInternalServerError, jsonres.Error(
+		"INTERNAL_ERROR", fallback, nil,
+	))
+}
+
+// GetEmailTemplates godoc
+// @Summary List email templates
+// @Description List the notification email templates and the languages each is available in
+// @Tags Notifications
+// @Produce json
+// @Success 200 {object} docs.SuccessResponse{data=[]dto.EmailTemplateResponse} "Email templates"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Missing notification:manage permission)"
+// @Security ApiKeyAuth
+// @Router /notifications/templates [get]
+func (h *NotificationHandler) GetEmailTemplates(c echo.Context) error {
+	names := h.emailTemplates.Templates()
+
+	templates := make([]dto.EmailTemplateResponse, len(names))
+	for i, name := range names {
+		templates[i] = dto.EmailTemplateResponse{Name: name, Languages: domain.SupportedLanguages}
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Email templates", templates,
+	))
+}
+
+// PreviewEmailTemplate godoc
+// @Summary Preview an email template
+// @Description Render an email template with sample data. Use format=html to get the HTML body
+// @Description as a page for viewing in the browser instead of a JSON envelope.
+// @Tags Notifications
+// @Produce json
+// @Produce html
+// @Param name path string true "Template name" Enums(account_activation, account_locked, password_reset, booking_received, booking_confirmation, booking_cancellation, booking_reminder, payment_receipt)
+// @Param lang query string false "Language (id or en), defaults to id"
+// @Param format query string false "Response format (json or html), defaults to json"
+// @Success 200 {object} docs.SuccessResponse{data=dto.EmailPreviewResponse} "Rendered template"
+// @Failure 400 {object} docs.ErrorResponse "Unsupported language"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Missing notification:manage permission)"
+// @Failure 404 {object} docs.ErrorResponse "Template Not Found"
+// @Security ApiKeyAuth
+// @Router /notifications/templates/{name}/preview [get]
+func (h *NotificationHandler) PreviewEmailTemplate(c echo.Context) error {
+	name := c.Param("name")
+	language := c.QueryParam("lang")
+
+	email, err := h.emailTemplates.Preview(name, language)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrEmailTemplateNotFound):
+			return c.JSON(http.StatusNotFound, jsonres.Error(
+				"NOT_FOUND", err.Error(), map[string]any{"name": name},
+			))
+		case errors.Is(err, domain.ErrUnsupportedLanguage):
+			return c.JSON(http.StatusBadRequest, jsonres.Error(
+				"BAD_REQUEST", err.Error(), map[string]any{"supported": domain.SupportedLanguages},
+			))
+		}
+
+		logger.Error("Failed to render email preview", err)
+		return c.JSON(http.StatusInternalServerError, jsonres.Error(
+			"INTERNAL_ERROR", "Failed to render email preview", nil,
+		))
+	}
+
+	if c.QueryParam("format") == "html" {
+		return c.HTML(http.StatusOK, email.HTMLBody)
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Email preview", dto.ToEmailPreviewResponse(name, domain.NormalizeLanguage(language), email),
+	))
+}
+
+// GetOutboxMessages godoc
+// @Summary List outbox messages
+// @Description List queued, delivered and dead-lettered outbox messages, newest first
+// @Tags Notifications
+// @Produce json
+// @Param status query string false "Filter by status" Enums(PENDING, SENT, DEAD)
+// @Param topic query string false "Filter by topic, e.g. email.send"
+// @Param limit query int false "Page size (1-200), defaults to 50"
+// @Param offset query int false "Number of messages to skip"
+// @Success 200 {object} docs.SuccessResponse{data=dto.OutboxMessageListResponse} "Outbox messages"
+// @Failure 400 {object} docs.ErrorResponse "Invalid filter"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Missing notification:manage permission)"
+// @Security ApiKeyAuth
+// @Router /notifications/outbox [get]
+func (h *NotificationHandler) GetOutboxMessages(c echo.Context) error {
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil || limit <= 0 {
+		limit = 50
+	}
+	limit = min(limit, 200)
+
+	offset, err := strconv.Atoi(c.QueryParam("offset"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+
+	filter := domain.OutboxFilter{
+		Status: strings.ToUpper(c.QueryParam("status")),
+		Topic:  c.QueryParam("topic"),
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	messages, total, err := h.outboxService.GetMessages(ctx, filter)
+	if err != nil {
+		if strings.Contains(err.Error(), "invalid outbox status") {
+			return c.JSON(http.StatusBadRequest, jsonres.Error(
+				"BAD_REQUEST", err.Error(), map[string]any{"status": c.QueryParam("status")},
+			))
+		}
+		return h.outboxError(c, err, "Failed to get outbox messages")
+	}
+
+	res := dto.OutboxMessageListResponse{
+		Messages: make([]dto.OutboxMessageResponse, len(messages)),
+		Total:    total,
+		Limit:    limit,
+		Offset:   offset,
+	}
+	for i := range messages {
+		res.Messages[i] = dto.ToOutboxMessageResponse(&messages[i], false)
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Outbox messages", res,
+	))
+}
+
+// GetOutboxMessageByID godoc
+// @Summary Get an outbox message
+// @Description Get an outbox message including its payload and last delivery error
+// @Tags Notifications
+// @Produce json
+// @Param id path uint true "Outbox message ID"
+// @Success 200 {object} docs.SuccessResponse{data=dto.OutboxMessageResponse} "Outbox message"
+// @Failure 400 {object} docs.ErrorResponse "Invalid ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Missing notification:manage permission)"
+// @Failure 404 {object} docs.ErrorResponse "Outbox Message Not Found"
+// @Security ApiKeyAuth
+// @Router /notifications/outbox/{id} [get]
+func (h *NotificationHandler) GetOutboxMessageByID(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || id == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid outbox message id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	message, err := h.outboxService.GetMessageByID(ctx, uint(id))
+	if err != nil {
+		return h.outboxError(c, err, "Failed to get outbox message")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Outbox message", dto.ToOutboxMessageResponse(message, true),
+	))
+}
+
+// ReplayOutboxMessage godoc
+// @Summary Replay a dead outbox message
+// @Description Move a DEAD message back to PENDING with a fresh attempt budget so the dispatcher delivers it again
+// @Tags Notifications
+// @Produce json
+// @Param id path uint true "Outbox message ID"
+// @Success 200 {object} docs.SuccessResponse{data=dto.OutboxMessageResponse} "Outbox message replayed"
+// @Failure 400 {object} docs.ErrorResponse "Invalid ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Missing notification:manage permission)"
+// @Failure 404 {object} docs.ErrorResponse "Outbox Message Not Found"
+// @Failure 409 {object} docs.ErrorResponse "Message is not dead"
+// @Security ApiKeyAuth
+// @Router /notifications/outbox/{id}/replay [post]
+func (h *NotificationHandler) ReplayOutboxMessage(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || id == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid outbox message id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	message, err := h.outboxService.Replay(ctx, uint(id))
+	if err != nil {
+		return h.outboxError(c, err, "Failed to replay outbox message")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Outbox message replayed", dto.ToOutboxMessageResponse(message, false),
+	))
+}
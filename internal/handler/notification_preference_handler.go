@@ -0,0 +1,242 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/dto/request"
+	dto "go-futsal-booking-api/internal/dto/response"
+	"go-futsal-booking-api/internal/service"
+	"go-futsal-booking-api/pkg/logger"
+	jsonres "go-futsal-booking-api/pkg/response"
+	"go-futsal-booking-api/pkg/validator"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type NotificationPreferenceHandler struct {
+	preferenceService service.NotificationPreferenceService
+	phoneService      service.PhoneVerificationService
+	timeout           time.Duration
+}
+
+func NewNotificationPreferenceHandler(preferenceService service.NotificationPreferenceService, phoneService service.PhoneVerificationService) *NotificationPreferenceHandler {
+	return &NotificationPreferenceHandler{
+		preferenceService: preferenceService,
+		phoneService:      phoneService,
+		timeout:           30 * time.Second,
+	}
+}
+
+// GetPreferences godoc
+// @Summary Get notification channel preferences
+// @Description List the channel (EMAIL, WHATSAPP or SMS) used for each booking notification. WHATSAPP and SMS only take effect once a phone number is verified; until then email is used.
+// @Tags Notifications
+// @Produce json
+// @Success 200 {object} docs.SuccessResponse{data=[]dto.NotificationPreferenceResponse} "Preferences retrieved"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /users/me/notification-preferences [get]
+func (h *NotificationPreferenceHandler) GetPreferences(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	preferences, err := h.preferenceService.GetPreferences(ctx, userIDFromContext(c))
+	if err != nil {
+		return h.handleError(c, err, "Failed to get notification preferences")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Preferences retrieved", dto.ToNotificationPreferenceResponses(preferences),
+	))
+}
+
+// UpdatePreferences godoc
+// @Summary Update notification channel preferences
+// @Description Choose the channel for one or more notification events. Events left out keep their current channel.
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Param request body request.UpdateNotificationPreferencesRequest true "Preferences"
+// @Success 200 {object} docs.SuccessResponse{data=[]dto.NotificationPreferenceResponse} "Preferences updated"
+// @Failure 400 {object} docs.ErrorResponse "Validation Error or unknown event"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /users/me/notification-preferences [put]
+func (h *NotificationPreferenceHandler) UpdatePreferences(c echo.Context) error {
+	var req request.UpdateNotificationPreferencesRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid request body", err.Error(),
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	preferences := make([]domain.NotificationPreference, len(req.Preferences))
+	for i, p := range req.Preferences {
+		preferences[i] = domain.NotificationPreference{Event: p.Event, Channel: p.Channel}
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	updated, err := h.preferenceService.UpdatePreferences(ctx, userIDFromContext(c), preferences)
+	if err != nil {
+		return h.handleError(c, err, "Failed to update notification preferences")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Preferences updated", dto.ToNotificationPreferenceResponses(updated),
+	))
+}
+
+// RequestPhoneVerification godoc
+// @Summary Add a phone number
+// @Description Send a one-time code to the phone number. The number is saved once the code is confirmed. Local Indonesian numbers (08...) are accepted.
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Param request body request.PhoneNumberRequest true "Phone number"
+// @Success 202 {object} docs.SuccessResponse{data=dto.PhoneVerificationResponse} "Verification code sent"
+// @Failure 400 {object} docs.ErrorResponse "Validation Error or invalid phone number"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 409 {object} docs.ErrorResponse "Phone number used by another account"
+// @Failure 429 {object} docs.ErrorResponse "Code requested too recently"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /users/me/phone [post]
+func (h *NotificationPreferenceHandler) RequestPhoneVerification(c echo.Context) error {
+	var req request.PhoneNumberRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid request body", err.Error(),
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	verification, err := h.phoneService.RequestCode(ctx, userIDFromContext(c), req.PhoneNumber)
+	if err != nil {
+		return h.handleError(c, err, "Failed to send verification code")
+	}
+
+	return c.JSON(http.StatusAccepted, jsonres.Success(
+		"Verification code sent", dto.ToPhoneVerificationResponse(verification),
+	))
+}
+
+// VerifyPhone godoc
+// @Summary Confirm a phone number
+// @Description Confirm the phone number with the code sent to it
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Param request body request.PhoneVerificationRequest true "Verification code"
+// @Success 200 {object} docs.SuccessResponse{data=dto.UserResponse} "Phone number verified"
+// @Failure 400 {object} docs.ErrorResponse "Validation Error or invalid code"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 409 {object} docs.ErrorResponse "Phone number used by another account"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /users/me/phone/verify [post]
+func (h *NotificationPreferenceHandler) VerifyPhone(c echo.Context) error {
+	var req request.PhoneVerificationRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid request body", err.Error(),
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	user, err := h.phoneService.VerifyCode(ctx, userIDFromContext(c), req.Code)
+	if err != nil {
+		return h.handleError(c, err, "Failed to verify phone number")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Phone number verified", dto.ToUserResponse(&user),
+	))
+}
+
+// RemovePhone godoc
+// @Summary Remove the phone number
+// @Description Remove the verified phone number. Notifications go by email afterwards.
+// @Tags Notifications
+// @Produce json
+// @Success 200 {object} docs.SuccessResponse "Phone number removed"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /users/me/phone [delete]
+func (h *NotificationPreferenceHandler) RemovePhone(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	if err := h.phoneService.RemovePhone(ctx, userIDFromContext(c)); err != nil {
+		return h.handleError(c, err, "Failed to remove phone number")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Phone number removed", nil,
+	))
+}
+
+func (h *NotificationPreferenceHandler) handleError(c echo.Context, err error, message string) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return c.JSON(http.StatusRequestTimeout, jsonres.Error(
+			"TIMEOUT", "Request timeout", nil,
+		))
+	case errors.Is(err, domain.ErrInvalidPhoneNumber),
+		errors.Is(err, domain.ErrInvalidPhoneCode),
+		errors.Is(err, domain.ErrUnknownEvent),
+		errors.Is(err, domain.ErrUnknownChannel):
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", err.Error(), nil,
+		))
+	case errors.Is(err, domain.ErrPhoneNumberTaken):
+		return c.JSON(http.StatusConflict, jsonres.Error(
+			"CONFLICT", err.Error(), nil,
+		))
+	case errors.Is(err, domain.ErrPhoneCodeCooldown):
+		return c.JSON(http.StatusTooManyRequests, jsonres.Error(
+			"TOO_MANY_REQUESTS", err.Error(), nil,
+		))
+	case errors.Is(err, domain.ErrUserNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", err.Error(), nil,
+		))
+	}
+
+	logger.Error(message, err)
+	return c.JSON(http.StatusInternalServerError, jsonres.Error(
+		"INTERNAL_ERROR", message, nil,
+	))
+}
@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	dto "go-futsal-booking-api/internal/dto/response"
+	"go-futsal-booking-api/internal/service"
+	"go-futsal-booking-api/pkg/logger"
+	jsonres "go-futsal-booking-api/pkg/response"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type OIDCHandler struct {
+	oidcService service.OIDCService
+	timeout     time.Duration
+}
+
+func NewOIDCHandler(oidcService service.OIDCService) *OIDCHandler {
+	return &OIDCHandler{
+		oidcService: oidcService,
+		timeout:     30 * time.Second,
+	}
+}
+
+// BeginLogin godoc
+// @Summary Start single sign-on
+// @Description Redirect to the configured OpenID Connect provider (authorization code flow with PKCE)
+// @Tags Users
+// @Success 302 "Redirect to the identity provider"
+// @Failure 404 {object} docs.ErrorResponse "Single sign-on is not configured"
+// @Router /users/oidc/login [get]
+func (h *OIDCHandler) BeginLogin(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	authURL, err := h.oidcService.BeginLogin(ctx)
+	if err != nil {
+		if errors.Is(err, domain.ErrOIDCNotConfigured) {
+			return c.JSON(http.StatusNotFound, jsonres.Error(
+				"NOT_CONFIGURED", err.Error(), nil,
+			))
+		}
+
+		logger.Error("Failed to start single sign-on", err)
+		return c.JSON(http.StatusBadGateway, jsonres.Error(
+			"SSO_UNAVAILABLE", "Failed to reach identity provider", nil,
+		))
+	}
+
+	return c.Redirect(http.StatusFound, authURL)
+}
+
+// Callback godoc
+// @Summary Finish single sign-on
+// @Description Redeem the authorization code returned by the identity provider. Links or creates the account and logs in. An existing account with the same email is only linked once its email has been verified.
+// @Tags Users
+// @Produce json
+// @Param code query string true "Authorization code"
+// @Param state query string true "State from /users/oidc/login"
+// @Success 200 {object} docs.SuccessResponse{data=dto.LoginResponse} "Login successful, or a two-factor challenge"
+// @Failure 400 {object} docs.ErrorResponse "Provider returned an error"
+// @Failure 401 {object} docs.ErrorResponse "Invalid state or unverified email"
+// @Failure 404 {object} docs.ErrorResponse "Single sign-on is not configured"
+// @Failure 409 {object} docs.ErrorResponse "An unverified account already uses the email"
+// @Router /users/oidc/callback [get]
+func (h *OIDCHandler) Callback(c echo.Context) error {
+	if providerErr := c.QueryParam("error"); providerErr != "" {
+		logger.Warn("Identity provider returned an error", "error", providerErr, "description", c.QueryParam("error_description"))
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"SSO_FAILED", "Identity provider returned an error", map[string]any{"error": providerErr},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	result, err := h.oidcService.CompleteLogin(ctx, c.QueryParam("state"), c.QueryParam("code"))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrOIDCNotConfigured):
+			return c.JSON(http.StatusNotFound, jsonres.Error(
+				"NOT_CONFIGURED", err.Error(), nil,
+			))
+		case errors.Is(err, domain.ErrInvalidOIDCState), errors.Is(err, domain.ErrOIDCEmailNotVerified):
+			return c.JSON(http.StatusUnauthorized, jsonres.Error(
+				"LOGIN_FAILED", err.Error(), nil,
+			))
+		case errors.Is(err, domain.ErrOIDCAccountUnverified):
+			return c.JSON(http.StatusConflict, jsonres.Error(
+				"ACCOUNT_NOT_VERIFIED", err.Error(), nil,
+			))
+		}
+
+		logger.Error("Failed to finish single sign-on", err)
+		return c.JSON(http.StatusUnauthorized, jsonres.Error(
+			"LOGIN_FAILED", err.Error(), nil,
+		))
+	}
+
+	if result.ChallengeToken != "" {
+		return c.JSON(http.StatusOK, jsonres.Success(
+			"Two-factor authentication required",
+			dto.TwoFactorChallengeResponse{
+				ChallengeToken: result.ChallengeToken,
+				Purpose:        result.ChallengePurpose,
+			},
+		))
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Login successful",
+		dto.LoginResponse{
+			Token: result.Token,
+			User:  dto.ToUserResponse(&result.User),
+		},
+	))
+}
@@ -0,0 +1,366 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/dto/request"
+	dto "go-futsal-booking-api/internal/dto/response"
+	"go-futsal-booking-api/internal/service"
+	"go-futsal-booking-api/pkg/logger"
+	jsonres "go-futsal-booking-api/pkg/response"
+	"go-futsal-booking-api/pkg/validator"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type PhotoHandler struct {
+	photoService service.PhotoService
+	timeout      time.Duration
+}
+
+func NewPhotoHandler(photoService service.PhotoService) *PhotoHandler {
+	return &PhotoHandler{
+		photoService: photoService,
+		timeout:      30 * time.Second,
+	}
+}
+
+// GetVenuePhotos godoc
+// @Summary List venue photos
+// @Description List the photos of a venue in display order. Field photos are listed per field.
+// @Tags Photos
+// @Produce json
+// @Param id path uint true "Venue ID"
+// @Success 200 {object} docs.SuccessResponse{data=[]dto.PhotoResponse} "Photos"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Venue ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 404 {object} docs.ErrorResponse "Venue Not Found"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /venues/{id}/photos [get]
+func (h *PhotoHandler) GetVenuePhotos(c echo.Context) error {
+	return h.getPhotos(c, h.photoService.VenueGallery)
+}
+
+// GetFieldPhotos godoc
+// @Summary List field photos
+// @Description List the photos of a field in display order.
+// @Tags Photos
+// @Produce json
+// @Param id path uint true "Field ID"
+// @Success 200 {object} docs.SuccessResponse{data=[]dto.PhotoResponse} "Photos"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Field ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 404 {object} docs.ErrorResponse "Field Not Found"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /fields/{id}/photos [get]
+func (h *PhotoHandler) GetFieldPhotos(c echo.Context) error {
+	return h.getPhotos(c, h.photoService.FieldGallery)
+}
+
+// UploadVenuePhoto godoc
+// @Summary Upload a venue photo
+// @Description Upload a JPEG or PNG photo of a venue, added at the end of the gallery. A thumbnail is generated, and the first photo becomes the cover. Requires at least MANAGER membership.
+// @Tags Photos
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path uint true "Venue ID"
+// @Param photo formData file true "JPEG or PNG image"
+// @Success 201 {object} docs.SuccessResponse{data=dto.PhotoResponse} "Photo uploaded"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Venue ID or missing photo"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not a venue manager)"
+// @Failure 404 {object} docs.ErrorResponse "Venue Not Found"
+// @Failure 413 {object} docs.ErrorResponse "Photo is too large"
+// @Failure 415 {object} docs.ErrorResponse "Photo is not a JPEG or PNG image"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /venues/{id}/photos [post]
+func (h *PhotoHandler) UploadVenuePhoto(c echo.Context) error {
+	return h.uploadPhoto(c, h.photoService.VenueGallery)
+}
+
+// UploadFieldPhoto godoc
+// @Summary Upload a field photo
+// @Description Upload a JPEG or PNG photo of a field, added at the end of its gallery. A thumbnail is generated, and the first photo becomes the cover. Requires at least MANAGER membership of the field's venue.
+// @Tags Photos
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path uint true "Field ID"
+// @Param photo formData file true "JPEG or PNG image"
+// @Success 201 {object} docs.SuccessResponse{data=dto.PhotoResponse} "Photo uploaded"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Field ID or missing photo"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not a venue manager)"
+// @Failure 404 {object} docs.ErrorResponse "Field Not Found"
+// @Failure 413 {object} docs.ErrorResponse "Photo is too large"
+// @Failure 415 {object} docs.ErrorResponse "Photo is not a JPEG or PNG image"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /fields/{id}/photos [post]
+func (h *PhotoHandler) UploadFieldPhoto(c echo.Context) error {
+	return h.uploadPhoto(c, h.photoService.FieldGallery)
+}
+
+// ReorderVenuePhotos godoc
+// @Summary Reorder venue photos
+// @Description Set the display order of a venue's photos. The list must hold every photo of the gallery once. Requires at least MANAGER membership.
+// @Tags Photos
+// @Accept json
+// @Produce json
+// @Param id path uint true "Venue ID"
+// @Param order body request.ReorderPhotosRequest true "Photo IDs in display order"
+// @Success 200 {object} docs.SuccessResponse{data=[]dto.PhotoResponse} "Photos reordered"
+// @Failure 400 {object} docs.ErrorResponse "Bad Request, Validation Error or incomplete order"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not a venue manager)"
+// @Failure 404 {object} docs.ErrorResponse "Venue Not Found"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /venues/{id}/photos/order [put]
+func (h *PhotoHandler) ReorderVenuePhotos(c echo.Context) error {
+	return h.reorderPhotos(c, h.photoService.VenueGallery)
+}
+
+// ReorderFieldPhotos godoc
+// @Summary Reorder field photos
+// @Description Set the display order of a field's photos. The list must hold every photo of the gallery once. Requires at least MANAGER membership of the field's venue.
+// @Tags Photos
+// @Accept json
+// @Produce json
+// @Param id path uint true "Field ID"
+// @Param order body request.ReorderPhotosRequest true "Photo IDs in display order"
+// @Success 200 {object} docs.SuccessResponse{data=[]dto.PhotoResponse} "Photos reordered"
+// @Failure 400 {object} docs.ErrorResponse "Bad Request, Validation Error or incomplete order"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not a venue manager)"
+// @Failure 404 {object} docs.ErrorResponse "Field Not Found"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /fields/{id}/photos/order [put]
+func (h *PhotoHandler) ReorderFieldPhotos(c echo.Context) error {
+	return h.reorderPhotos(c, h.photoService.FieldGallery)
+}
+
+// SetCoverPhoto godoc
+// @Summary Set the cover photo
+// @Description Make a photo the cover of its venue or field gallery. Requires at least MANAGER membership.
+// @Tags Photos
+// @Produce json
+// @Param id path uint true "Photo ID"
+// @Success 200 {object} docs.SuccessResponse{data=dto.PhotoResponse} "Cover photo set"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Photo ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not a venue manager)"
+// @Failure 404 {object} docs.ErrorResponse "Photo Not Found"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /photos/{id}/cover [put]
+func (h *PhotoHandler) SetCoverPhoto(c echo.Context) error {
+	photoID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || photoID == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid photo id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	photo, err := h.photoService.SetCoverPhoto(ctx, uint(photoID), userIDFromContext(c))
+	if err != nil {
+		return h.handleError(c, err, "Failed to set cover photo")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Cover photo set", dto.ToPhotoResponse(photo),
+	))
+}
+
+// DeletePhoto godoc
+// @Summary Delete a photo
+// @Description Delete a photo and its files. If it was the cover, the next photo of the gallery becomes the cover. Requires at least MANAGER membership.
+// @Tags Photos
+// @Produce json
+// @Param id path uint true "Photo ID"
+// @Success 200 {object} docs.SuccessResponse "Photo deleted"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Photo ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not a venue manager)"
+// @Failure 404 {object} docs.ErrorResponse "Photo Not Found"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /photos/{id} [delete]
+func (h *PhotoHandler) DeletePhoto(c echo.Context) error {
+	photoID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || photoID == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid photo id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	if err := h.photoService.DeletePhoto(ctx, uint(photoID), userIDFromContext(c)); err != nil {
+		return h.handleError(c, err, "Failed to delete photo")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Photo deleted", nil,
+	))
+}
+
+// galleryResolver is PhotoService.VenueGallery or PhotoService.FieldGallery,
+// so venue and field routes share their handlers.
+type galleryResolver func(ctx context.Context, id uint) (domain.PhotoGallery, error)
+
+func (h *PhotoHandler) gallery(ctx context.Context, c echo.Context, resolve galleryResolver) (domain.PhotoGallery, error) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || id == 0 {
+		return domain.PhotoGallery{}, errInvalidGalleryID
+	}
+
+	return resolve(ctx, uint(id))
+}
+
+var errInvalidGalleryID = errors.New("invalid id")
+
+func (h *PhotoHandler) getPhotos(c echo.Context, resolve galleryResolver) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	gallery, err := h.gallery(ctx, c, resolve)
+	if err != nil {
+		return h.handleError(c, err, "Failed to get photos")
+	}
+
+	photos, err := h.photoService.GetPhotos(ctx, gallery)
+	if err != nil {
+		return h.handleError(c, err, "Failed to get photos")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Photos", dto.ToPhotoResponses(photos),
+	))
+}
+
+func (h *PhotoHandler) uploadPhoto(c echo.Context, resolve galleryResolver) error {
+	file, err := c.FormFile("photo")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Missing photo file", nil,
+		))
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		logger.Error("Failed to open uploaded photo", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Failed to read photo file", nil,
+		))
+	}
+	defer src.Close()
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	gallery, err := h.gallery(ctx, c, resolve)
+	if err != nil {
+		return h.handleError(c, err, "Failed to upload photo")
+	}
+
+	photo, err := h.photoService.UploadPhoto(ctx, gallery, src, userIDFromContext(c))
+	if err != nil {
+		return h.handleError(c, err, "Failed to upload photo")
+	}
+
+	return c.JSON(http.StatusCreated, jsonres.Success(
+		"Photo uploaded", dto.ToPhotoResponse(photo),
+	))
+}
+
+func (h *PhotoHandler) reorderPhotos(c echo.Context, resolve galleryResolver) error {
+	var req request.ReorderPhotosRequest
+
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Failed to fetch request", err,
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	gallery, err := h.gallery(ctx, c, resolve)
+	if err != nil {
+		return h.handleError(c, err, "Failed to reorder photos")
+	}
+
+	photos, err := h.photoService.ReorderPhotos(ctx, gallery, req.PhotoIDs, userIDFromContext(c))
+	if err != nil {
+		return h.handleError(c, err, "Failed to reorder photos")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Photos reordered", dto.ToPhotoResponses(photos),
+	))
+}
+
+func (h *PhotoHandler) handleError(c echo.Context, err error, message string) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return c.JSON(http.StatusRequestTimeout, jsonres.Error(
+			"TIMEOUT", "Request timeout", nil,
+		))
+	case errors.Is(err, errInvalidGalleryID):
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid id", map[string]any{"id": c.Param("id")},
+		))
+	case errors.Is(err, domain.ErrInvalidPhotoOrder):
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", err.Error(), nil,
+		))
+	case errors.Is(err, domain.ErrForbidden):
+		return c.JSON(http.StatusForbidden, jsonres.Error(
+			"FORBIDDEN", "Not allowed to manage photos of this venue", nil,
+		))
+	case errors.Is(err, domain.ErrVenueNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", "Venue not found", nil,
+		))
+	case errors.Is(err, domain.ErrFieldNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", "Field not found", nil,
+		))
+	case errors.Is(err, domain.ErrPhotoNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", "Photo not found", nil,
+		))
+	case errors.Is(err, domain.ErrPhotoTooLarge):
+		return c.JSON(http.StatusRequestEntityTooLarge, jsonres.Error(
+			"PHOTO_TOO_LARGE", err.Error(), nil,
+		))
+	case errors.Is(err, domain.ErrUnsupportedPhoto):
+		return c.JSON(http.StatusUnsupportedMediaType, jsonres.Error(
+			"UNSUPPORTED_PHOTO", err.Error(), nil,
+		))
+	}
+
+	logger.Error(message, err)
+	return c.JSON(http.StatusInternalServerError, jsonres.Error(
+		"INTERNAL_ERROR", message, nil,
+	))
+}
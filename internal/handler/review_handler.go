@@ -0,0 +1,320 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/dto/request"
+	dto "go-futsal-booking-api/internal/dto/response"
+	"go-futsal-booking-api/internal/service"
+	"go-futsal-booking-api/pkg/logger"
+	jsonres "go-futsal-booking-api/pkg/response"
+	"go-futsal-booking-api/pkg/validator"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ReviewHandler struct {
+	reviewService service.ReviewService
+	timeout       time.Duration
+}
+
+func NewReviewHandler(reviewService service.ReviewService) *ReviewHandler {
+	return &ReviewHandler{
+		reviewService: reviewService,
+		timeout:       30 * time.Second,
+	}
+}
+
+// GetVenueReviews godoc
+// @Summary List reviews of a venue
+// @Description List the published reviews of a venue, newest first. The venue's average rating and review count are part of the venue itself.
+// @Tags Reviews
+// @Produce json
+// @Param id path uint true "Venue ID"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param offset query int false "Number of reviews to skip"
+// @Success 200 {object} docs.SuccessResponse{data=dto.ReviewListResponse} "Reviews"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Venue ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 404 {object} docs.ErrorResponse "Venue Not Found"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /venues/{id}/reviews [get]
+func (h *ReviewHandler) GetVenueReviews(c echo.Context) error {
+	venueID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || venueID == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid venue id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil || limit <= 0 {
+		limit = 20
+	}
+	limit = min(limit, 100)
+
+	offset, err := strconv.Atoi(c.QueryParam("offset"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	reviews, total, err := h.reviewService.GetVenueReviews(ctx, uint(venueID), domain.ReviewFilter{Limit: limit, Offset: offset})
+	if err != nil {
+		return h.handleError(c, err, "Failed to get reviews")
+	}
+
+	res := dto.ReviewListResponse{
+		Reviews: make([]dto.ReviewResponse, len(reviews)),
+		Total:   total,
+		Limit:   limit,
+		Offset:  offset,
+	}
+	for i := range reviews {
+		res.Reviews[i] = dto.ToReviewResponse(&reviews[i])
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Reviews", res,
+	))
+}
+
+// CreateReview godoc
+// @Summary Review a venue
+// @Description Rate a venue from 1 to 5 with an optional comment. The booking must be the caller's own, at this venue and COMPLETED, which it becomes once its slot has ended. Each booking can be reviewed once.
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Param id path uint true "Venue ID"
+// @Param review body request.CreateReviewRequest true "Review"
+// @Success 201 {object} docs.SuccessResponse{data=dto.ReviewResponse} "Review created"
+// @Failure 400 {object} docs.ErrorResponse "Bad Request or Validation Error"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 404 {object} docs.ErrorResponse "Venue or Booking Not Found"
+// @Failure 409 {object} docs.ErrorResponse "Booking already reviewed"
+// @Failure 422 {object} docs.ErrorResponse "Booking cannot be reviewed"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /venues/{id}/reviews [post]
+func (h *ReviewHandler) CreateReview(c echo.Context) error {
+	venueID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || venueID == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid venue id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	var req request.CreateReviewRequest
+
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Failed to fetch request", err,
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	review, err := h.reviewService.CreateReview(ctx, uint(venueID), &req, userIDFromContext(c))
+	if err != nil {
+		return h.handleError(c, err, "Failed to create review")
+	}
+
+	return c.JSON(http.StatusCreated, jsonres.Success(
+		"Review created", dto.ToReviewResponse(review),
+	))
+}
+
+// ReplyToReview godoc
+// @Summary Reply to a review
+// @Description Reply to a review of the venue, replacing any earlier reply. Requires OWNER membership of the venue.
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Param id path uint true "Review ID"
+// @Param reply body request.ReplyReviewRequest true "Reply"
+// @Success 200 {object} docs.SuccessResponse{data=dto.ReviewResponse} "Reply saved"
+// @Failure 400 {object} docs.ErrorResponse "Bad Request or Validation Error"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not the venue owner)"
+// @Failure 404 {object} docs.ErrorResponse "Review Not Found"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /reviews/{id}/reply [put]
+func (h *ReviewHandler) ReplyToReview(c echo.Context) error {
+	reviewID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || reviewID == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid review id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	var req request.ReplyReviewRequest
+
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Failed to fetch request", err,
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	review, err := h.reviewService.ReplyToReview(ctx, uint(reviewID), req.Reply, userIDFromContext(c))
+	if err != nil {
+		return h.handleError(c, err, "Failed to reply to review")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Reply saved", dto.ToReviewResponse(review),
+	))
+}
+
+// ModerateReview godoc
+// @Summary Hide or publish a review
+// @Description Hide a review from the venue's listing and rating, or publish it again. Requires the review:moderate permission.
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Param id path uint true "Review ID"
+// @Param moderation body request.ModerateReviewRequest true "New status"
+// @Success 200 {object} docs.SuccessResponse{data=dto.ReviewResponse} "Review moderated"
+// @Failure 400 {object} docs.ErrorResponse "Bad Request or Validation Error"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Missing Permission)"
+// @Failure 404 {object} docs.ErrorResponse "Review Not Found"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /reviews/{id}/moderation [put]
+func (h *ReviewHandler) ModerateReview(c echo.Context) error {
+	reviewID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || reviewID == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid review id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	var req request.ModerateReviewRequest
+
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Failed to fetch request", err,
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	review, err := h.reviewService.ModerateReview(ctx, uint(reviewID), req.Status)
+	if err != nil {
+		return h.handleError(c, err, "Failed to moderate review")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Review moderated", dto.ToReviewResponse(review),
+	))
+}
+
+// DeleteReview godoc
+// @Summary Delete a review
+// @Description Delete a review for good, removing it from the venue's rating. The booking can then be reviewed again. Requires the review:moderate permission.
+// @Tags Reviews
+// @Produce json
+// @Param id path uint true "Review ID"
+// @Success 200 {object} docs.SuccessResponse "Review deleted"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Review ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Missing Permission)"
+// @Failure 404 {object} docs.ErrorResponse "Review Not Found"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /reviews/{id} [delete]
+func (h *ReviewHandler) DeleteReview(c echo.Context) error {
+	reviewID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || reviewID == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid review id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	if err := h.reviewService.DeleteReview(ctx, uint(reviewID)); err != nil {
+		return h.handleError(c, err, "Failed to delete review")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Review deleted", nil,
+	))
+}
+
+func (h *ReviewHandler) handleError(c echo.Context, err error, message string) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return c.JSON(http.StatusRequestTimeout, jsonres.Error(
+			"TIMEOUT", "Request timeout", nil,
+		))
+	case errors.Is(err, domain.ErrInvalidRating),
+		errors.Is(err, domain.ErrEmptyReviewReply),
+		errors.Is(err, domain.ErrInvalidReviewStatus):
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", err.Error(), nil,
+		))
+	case errors.Is(err, domain.ErrForbidden):
+		return forbiddenVenueAccess(c)
+	case errors.Is(err, domain.ErrVenueNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", "Venue not found", nil,
+		))
+	case errors.Is(err, domain.ErrBookingNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", "Booking not found", nil,
+		))
+	case errors.Is(err, domain.ErrReviewNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", "Review not found", nil,
+		))
+	case errors.Is(err, domain.ErrReviewExists):
+		return c.JSON(http.StatusConflict, jsonres.Error(
+			"CONFLICT", err.Error(), nil,
+		))
+	case errors.Is(err, domain.ErrReviewNotAllowed):
+		return c.JSON(http.StatusUnprocessableEntity, jsonres.Error(
+			"REVIEW_NOT_ALLOWED", err.Error(), nil,
+		))
+	}
+
+	logger.Error(message, err)
+	return c.JSON(http.StatusInternalServerError, jsonres.Error(
+		"INTERNAL_ERROR", message, nil,
+	))
+}
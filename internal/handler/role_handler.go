@@ -0,0 +1,394 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/dto/request"
+	dto "go-futsal-booking-api/internal/dto/response"
+	"go-futsal-booking-api/internal/service"
+	"go-futsal-booking-api/pkg/logger"
+	jsonres "go-futsal-booking-api/pkg/response"
+	"go-futsal-booking-api/pkg/validator"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	_ "go-futsal-booking-api/docs"
+)
+
+type RoleHandler struct {
+	roleService service.RoleService
+	timeout     time.Duration
+}
+
+func NewRoleHandler(roleService service.RoleService) *RoleHandler {
+	return &RoleHandler{
+		roleService: roleService,
+		timeout:     30 * time.Second,
+	}
+}
+
+func (h *RoleHandler) roleError(c echo.Context, err error, fallback string) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return c.JSON(http.StatusRequestTimeout, jsonres.Error(
+			"TIMEOUT", "Request timeout", nil,
+		))
+	case errors.Is(err, domain.ErrRoleNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", "Role not found", nil,
+		))
+	case errors.Is(err, domain.ErrUserNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", "User not found", nil,
+		))
+	case errors.Is(err, domain.ErrRoleAlreadyExists), errors.Is(err, domain.ErrRoleInUse), errors.Is(err, domain.ErrLastRoleManager):
+		return c.JSON(http.StatusConflict, jsonres.Error(
+			"CONFLICT", err.Error(), nil,
+		))
+	case errors.Is(err, domain.ErrPermissionNotFound),
+		errors.Is(err, domain.ErrBuiltInRole),
+		errors.Is(err, domain.ErrAdminRoleLockout):
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", err.Error(), nil,
+		))
+	}
+
+	logger.Error(fallback, err)
+	return c.JSON(http.StatusInternalServerError, jsonres.Error(
+		"INTERNAL_ERROR", fallback, nil,
+	))
+}
+
+// GetAllRoles godoc
+// @Summary List roles (Admin only)
+// @Description Get every role together with its granted permissions
+// @Tags Roles
+// @Produce json
+// @Success 200 {object} docs.SuccessResponse{data=[]dto.RoleResponse} "Roles retrieved successfully"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Missing role:manage permission)"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /roles [get]
+func (h *RoleHandler) GetAllRoles(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	roles, err := h.roleService.GetAllRoles(ctx)
+	if err != nil {
+		return h.roleError(c, err, "Failed to retrieve roles")
+	}
+
+	roleResponses := make([]dto.RoleResponse, len(roles))
+	for i := range roles {
+		roleResponses[i] = dto.ToRoleResponse(&roles[i])
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Roles retrieved successfully", roleResponses,
+	))
+}
+
+// GetRoleByID godoc
+// @Summary Get role by ID (Admin only)
+// @Description Get a role and its granted permissions
+// @Tags Roles
+// @Produce json
+// @Param id path uint true "Role ID"
+// @Success 200 {object} docs.SuccessResponse{data=dto.RoleResponse} "Role retrieved successfully"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Role ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Missing role:manage permission)"
+// @Failure 404 {object} docs.ErrorResponse "Role Not Found"
+// @Security ApiKeyAuth
+// @Router /roles/{id} [get]
+func (h *RoleHandler) GetRoleByID(c echo.Context) error {
+	roleId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		logger.Error("Invalid role id", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid role id", map[string]interface{}{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	role, err := h.roleService.GetRoleByID(ctx, uint(roleId))
+	if err != nil {
+		return h.roleError(c, err, "Failed to retrieve role")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Role retrieved successfully", dto.ToRoleResponse(role),
+	))
+}
+
+// CreateRole godoc
+// @Summary Create a role (Admin only)
+// @Description Create a new role, optionally granting it permissions straight away
+// @Tags Roles
+// @Accept json
+// @Produce json
+// @Param role body request.CreateRoleRequest true "Role creation request"
+// @Success 201 {object} docs.SuccessResponse{data=dto.RoleResponse} "Role successfully created"
+// @Failure 400 {object} docs.ErrorResponse "Bad Request, Validation Error or Unknown Permission"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Missing role:manage permission)"
+// @Failure 409 {object} docs.ErrorResponse "Role Already Exists"
+// @Security ApiKeyAuth
+// @Router /roles [post]
+func (h *RoleHandler) CreateRole(c echo.Context) error {
+	var req request.CreateRoleRequest
+
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Failed to fetch request", err,
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		logger.Error("Failed to validate create role request", errs)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	role, err := h.roleService.CreateRole(ctx, req.Name, req.Permissions)
+	if err != nil {
+		return h.roleError(c, err, "Failed to create role")
+	}
+
+	return c.JSON(http.StatusCreated, jsonres.Success(
+		"Role successfully created", dto.ToRoleResponse(role),
+	))
+}
+
+// UpdateRole godoc
+// @Summary Rename a role (Admin only)
+// @Description Rename a custom role. Built-in roles cannot be renamed. Users holding the role keep its permissions, as tokens refer to the role by id.
+// @Tags Roles
+// @Accept json
+// @Produce json
+// @Param id path uint true "Role ID"
+// @Param role body request.UpdateRoleRequest true "Role update request"
+// @Success 200 {object} docs.SuccessResponse{data=dto.RoleResponse} "Role successfully updated"
+// @Failure 400 {object} docs.ErrorResponse "Bad Request or Validation Error"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Missing role:manage permission)"
+// @Failure 404 {object} docs.ErrorResponse "Role Not Found"
+// @Failure 409 {object} docs.ErrorResponse "Role Already Exists"
+// @Security ApiKeyAuth
+// @Router /roles/{id} [put]
+func (h *RoleHandler) UpdateRole(c echo.Context) error {
+	roleId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		logger.Error("Invalid role id", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid role id", map[string]interface{}{"id": c.Param("id")},
+		))
+	}
+
+	var req request.UpdateRoleRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Failed to fetch request", err,
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		logger.Error("Failed to validate update role request", errs)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	role, err := h.roleService.UpdateRole(ctx, uint(roleId), req.Name)
+	if err != nil {
+		return h.roleError(c, err, "Failed to update role")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Role successfully updated", dto.ToRoleResponse(role),
+	))
+}
+
+// SetRolePermissions godoc
+// @Summary Replace role permissions (Admin only)
+// @Description Replace the full set of permissions granted to a role
+// @Tags Roles
+// @Accept json
+// @Produce json
+// @Param id path uint true "Role ID"
+// @Param permissions body request.SetRolePermissionsRequest true "Permission names"
+// @Success 200 {object} docs.SuccessResponse{data=dto.RoleResponse} "Role permissions updated"
+// @Failure 400 {object} docs.ErrorResponse "Bad Request or Unknown Permission"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Missing role:manage permission)"
+// @Failure 404 {object} docs.ErrorResponse "Role Not Found"
+// @Security ApiKeyAuth
+// @Router /roles/{id}/permissions [put]
+func (h *RoleHandler) SetRolePermissions(c echo.Context) error {
+	roleId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		logger.Error("Invalid role id", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid role id", map[string]interface{}{"id": c.Param("id")},
+		))
+	}
+
+	var req request.SetRolePermissionsRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Failed to fetch request", err,
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		logger.Error("Failed to validate role permissions request", errs)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	role, err := h.roleService.SetRolePermissions(ctx, uint(roleId), req.Permissions)
+	if err != nil {
+		return h.roleError(c, err, "Failed to update role permissions")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Role permissions updated", dto.ToRoleResponse(role),
+	))
+}
+
+// DeleteRole godoc
+// @Summary Delete a role (Admin only)
+// @Description Delete a custom role that is no longer assigned to any user
+// @Tags Roles
+// @Produce json
+// @Param id path uint true "Role ID"
+// @Success 200 {object} docs.SuccessResponse "Role deleted successfully"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Role ID or Built-in Role"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Missing role:manage permission)"
+// @Failure 404 {object} docs.ErrorResponse "Role Not Found"
+// @Failure 409 {object} docs.ErrorResponse "Role Still In Use"
+// @Security ApiKeyAuth
+// @Router /roles/{id} [delete]
+func (h *RoleHandler) DeleteRole(c echo.Context) error {
+	roleId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		logger.Error("Invalid role id", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid role id", map[string]interface{}{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	if err := h.roleService.DeleteRole(ctx, uint(roleId)); err != nil {
+		return h.roleError(c, err, "Failed to delete role")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Role deleted successfully", map[string]any{"role_id": roleId},
+	))
+}
+
+// AssignUserRole godoc
+// @Summary Give a user a role (Admin only)
+// @Description Replace the role of a user. Deleted roles cannot be given, and the last users holding role:manage cannot be moved to a role without it. Tokens carry the role, so the change applies from the user's next login.
+// @Tags Roles
+// @Accept json
+// @Produce json
+// @Param id path uint true "User ID"
+// @Param role body request.AssignUserRoleRequest true "Role to give"
+// @Success 200 {object} docs.SuccessResponse{data=dto.UserRoleResponse} "User role updated"
+// @Failure 400 {object} docs.ErrorResponse "Bad Request or Validation Error"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Missing user:manage or role:manage permission)"
+// @Failure 404 {object} docs.ErrorResponse "User or Role Not Found"
+// @Failure 409 {object} docs.ErrorResponse "Last role:manage holder"
+// @Security ApiKeyAuth
+// @Router /users/{id}/role [put]
+func (h *RoleHandler) AssignUserRole(c echo.Context) error {
+	userID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || userID == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid user id", map[string]interface{}{"id": c.Param("id")},
+		))
+	}
+
+	var req request.AssignUserRoleRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Failed to fetch request", err,
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		logger.Error("Failed to validate assign user role request", errs)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	role, err := h.roleService.AssignUserRole(ctx, uint(userID), req.RoleID)
+	if err != nil {
+		return h.roleError(c, err, "Failed to update user role")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"User role updated", dto.UserRoleResponse{UserID: uint(userID), Role: dto.ToRoleResponse(role)},
+	))
+}
+
+// GetAllPermissions godoc
+// @Summary List permissions (Admin only)
+// @Description Get every permission that can be granted to a role
+// @Tags Roles
+// @Produce json
+// @Success 200 {object} docs.SuccessResponse{data=[]dto.PermissionResponse} "Permissions retrieved successfully"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Missing role:manage permission)"
+// @Security ApiKeyAuth
+// @Router /permissions [get]
+func (h *RoleHandler) GetAllPermissions(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	permissions, err := h.roleService.GetAllPermissions(ctx)
+	if err != nil {
+		return h.roleError(c, err, "Failed to retrieve permissions")
+	}
+
+	permissionResponses := make([]dto.PermissionResponse, len(permissions))
+	for i := range permissions {
+		permissionResponses[i] = dto.ToPermissionResponse(&permissions[i])
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Permissions retrieved successfully", permissionResponses,
+	))
+}
@@ -0,0 +1,213 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/dto/request"
+	dto "go-futsal-booking-api/internal/dto/response"
+	"go-futsal-booking-api/internal/service"
+	"go-futsal-booking-api/pkg/logger"
+	jsonres "go-futsal-booking-api/pkg/response"
+	"go-futsal-booking-api/pkg/validator"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type SavedSearchHandler struct {
+	savedSearchService service.SavedSearchService
+	timeout            time.Duration
+}
+
+func NewSavedSearchHandler(savedSearchService service.SavedSearchService) *SavedSearchHandler {
+	return &SavedSearchHandler{
+		savedSearchService: savedSearchService,
+		timeout:            30 * time.Second,
+	}
+}
+
+// GetSavedSearches godoc
+// @Summary List my saved searches
+// @Description List the current user's saved searches, oldest first.
+// @Tags Saved Searches
+// @Produce json
+// @Success 200 {object} docs.SuccessResponse{data=[]dto.SavedSearchResponse} "Saved searches"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /users/me/saved-searches [get]
+func (h *SavedSearchHandler) GetSavedSearches(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	searches, err := h.savedSearchService.GetSavedSearches(ctx, userIDFromContext(c))
+	if err != nil {
+		return h.handleError(c, err, "Failed to get saved searches")
+	}
+
+	res := make([]dto.SavedSearchResponse, len(searches))
+	for i := range searches {
+		res[i] = dto.ToSavedSearchResponse(&searches[i])
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Saved searches", res,
+	))
+}
+
+// CreateSavedSearch godoc
+// @Summary Save a search
+// @Description Save slot search criteria: city, field type, day of week and a range of start times, all optional. With notify set, the user is told by their preferred channel when matching slots in the coming days are free. Each user can keep up to 20 saved searches.
+// @Tags Saved Searches
+// @Accept json
+// @Produce json
+// @Param search body request.SavedSearchRequest true "Search criteria"
+// @Success 201 {object} docs.SuccessResponse{data=dto.SavedSearchResponse} "Search saved"
+// @Failure 400 {object} docs.ErrorResponse "Bad Request or Validation Error"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 409 {object} docs.ErrorResponse "Saved search limit reached"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /users/me/saved-searches [post]
+func (h *SavedSearchHandler) CreateSavedSearch(c echo.Context) error {
+	var req request.SavedSearchRequest
+
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Failed to fetch request", err,
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	search, err := h.savedSearchService.CreateSavedSearch(ctx, &req, userIDFromContext(c))
+	if err != nil {
+		return h.handleError(c, err, "Failed to save search")
+	}
+
+	return c.JSON(http.StatusCreated, jsonres.Success(
+		"Search saved", dto.ToSavedSearchResponse(search),
+	))
+}
+
+// UpdateSavedSearch godoc
+// @Summary Update a saved search
+// @Description Replace the criteria of one of the current user's saved searches.
+// @Tags Saved Searches
+// @Accept json
+// @Produce json
+// @Param id path uint true "Saved search ID"
+// @Param search body request.SavedSearchRequest true "Search criteria"
+// @Success 200 {object} docs.SuccessResponse{data=dto.SavedSearchResponse} "Saved search updated"
+// @Failure 400 {object} docs.ErrorResponse "Bad Request or Validation Error"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 404 {object} docs.ErrorResponse "Saved Search Not Found"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /users/me/saved-searches/{id} [put]
+func (h *SavedSearchHandler) UpdateSavedSearch(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || id == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid saved search id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	var req request.SavedSearchRequest
+
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Failed to fetch request", err,
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	search, err := h.savedSearchService.UpdateSavedSearch(ctx, uint(id), &req, userIDFromContext(c))
+	if err != nil {
+		return h.handleError(c, err, "Failed to update saved search")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Saved search updated", dto.ToSavedSearchResponse(search),
+	))
+}
+
+// DeleteSavedSearch godoc
+// @Summary Delete a saved search
+// @Description Delete one of the current user's saved searches, stopping its alerts.
+// @Tags Saved Searches
+// @Produce json
+// @Param id path uint true "Saved search ID"
+// @Success 200 {object} docs.SuccessResponse "Saved search deleted"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Saved Search ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 404 {object} docs.ErrorResponse "Saved Search Not Found"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /users/me/saved-searches/{id} [delete]
+func (h *SavedSearchHandler) DeleteSavedSearch(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || id == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid saved search id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	if err := h.savedSearchService.DeleteSavedSearch(ctx, uint(id), userIDFromContext(c)); err != nil {
+		return h.handleError(c, err, "Failed to delete saved search")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Saved search deleted", nil,
+	))
+}
+
+func (h *SavedSearchHandler) handleError(c echo.Context, err error, message string) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return c.JSON(http.StatusRequestTimeout, jsonres.Error(
+			"TIMEOUT", "Request timeout", nil,
+		))
+	case errors.Is(err, domain.ErrInvalidSavedSearch),
+		errors.Is(err, domain.ErrFieldTypeNotFound):
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", err.Error(), nil,
+		))
+	case errors.Is(err, domain.ErrSavedSearchNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", "Saved search not found", nil,
+		))
+	case errors.Is(err, domain.ErrTooManySavedSearches):
+		return c.JSON(http.StatusConflict, jsonres.Error(
+			"CONFLICT", err.Error(), nil,
+		))
+	}
+
+	logger.Error(message, err)
+	return c.JSON(http.StatusInternalServerError, jsonres.Error(
+		"INTERNAL_ERROR", message, nil,
+	))
+}
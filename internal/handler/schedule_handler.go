@@ -95,12 +95,13 @@ func (h *ScheduleHandler) GetScheduleByID(c echo.Context) error {
 
 // GetScheduleByField godoc
 // @Summary Get schedules by field ID
-// @Description Get a list of all schedules associated with a specific field
+// @Description Get a list of all schedules associated with a specific field. With date, only the schedules of that day that are not inside a maintenance window are listed.
 // @Tags Schedules
 // @Produce json
 // @Param fieldId query uint true "Field ID"
+// @Param date query string false "Only schedules open for booking on this date (YYYY-MM-DD)"
 // @Success 200 {object} docs.SuccessResponse{data=[]dto.ScheduleResponse} "Schedule retrieved successfully"
-// @Failure 400 {object} docs.ErrorResponse "Missing or Invalid Field ID"
+// @Failure 400 {object} docs.ErrorResponse "Missing or Invalid Field ID or date"
 // @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
 // @Failure 404 {object} docs.ErrorResponse "Field Not Found"
 // @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
@@ -126,10 +127,21 @@ func (h *ScheduleHandler) GetScheduleByField(c echo.Context) error {
 		))
 	}
 
+	var date *time.Time
+	if v := c.QueryParam("date"); v != "" {
+		parsed, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, jsonres.Error(
+				"BAD_REQUEST", "Invalid date", map[string]interface{}{"date": "must be a date in YYYY-MM-DD format"},
+			))
+		}
+		date = &parsed
+	}
+
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
 	defer cancel()
 
-	schedules, err := h.scheduleService.GetScheduleByField(ctx, uint(fieldId))
+	schedules, err := h.scheduleService.GetScheduleByField(ctx, uint(fieldId), date)
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
 			logger.Warn("request timeout", map[string]any{"timeout": h.timeout})
@@ -169,7 +181,7 @@ func (h *ScheduleHandler) GetScheduleByField(c echo.Context) error {
 
 // CreateSchedule godoc
 // @Summary Create a new schedule (Admin only)
-// @Description Create a new weekly schedule for a specific field
+// @Description Create a new weekly schedule for a specific field. Requires MANAGER membership of the venue.
 // @Tags Schedules
 // @Accept json
 // @Produce json
@@ -177,7 +189,7 @@ func (h *ScheduleHandler) GetScheduleByField(c echo.Context) error {
 // @Success 201 {object} docs.SuccessResponse{data=dto.ScheduleResponse} "Schedule successfully created"
 // @Failure 400 {object} docs.ErrorResponse "Bad Request or Validation Error"
 // @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
-// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not Admin)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not a venue manager)"
 // @Failure 404 {object} docs.ErrorResponse "Field Not Found"
 // @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
 // @Security ApiKeyAuth
@@ -211,6 +223,7 @@ func (h *ScheduleHandler) CreateSchedule(c echo.Context) error {
 			EndTime:   req.EndTime,
 			Price:     req.Price,
 		},
+		userIDFromContext(c),
 	)
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
@@ -230,6 +243,10 @@ func (h *ScheduleHandler) CreateSchedule(c echo.Context) error {
 			))
 		}
 
+		if errors.Is(err, domain.ErrForbidden) {
+			return forbiddenVenueAccess(c)
+		}
+
 		logger.Error("Failed to create schedule", err)
 		return c.JSON(http.StatusInternalServerError, jsonres.Error(
 			"INTERNAL_ERROR", "Failed to create schedule", nil,
@@ -243,7 +260,7 @@ func (h *ScheduleHandler) CreateSchedule(c echo.Context) error {
 
 // UpdateSchedule godoc
 // @Summary Update a schedule (Admin only)
-// @Description Update details of an existing schedule
+// @Description Update details of an existing schedule. Requires MANAGER membership of the venue.
 // @Tags Schedules
 // @Accept json
 // @Produce json
@@ -252,7 +269,7 @@ func (h *ScheduleHandler) CreateSchedule(c echo.Context) error {
 // @Success 200 {object} docs.SuccessResponse{data=dto.ScheduleResponse} "Schedule successfully updated"
 // @Failure 400 {object} docs.ErrorResponse "Bad Request, Invalid ID, or Validation Error"
 // @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
-// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not Admin)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not a venue manager)"
 // @Failure 404 {object} docs.ErrorResponse "Schedule Not Found"
 // @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
 // @Security ApiKeyAuth
@@ -293,6 +310,7 @@ func (h *ScheduleHandler) UpdateSchedule(c echo.Context) error {
 		req.StartTime,
 		req.EndTime,
 		req.Price,
+		userIDFromContext(c),
 	)
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
@@ -312,6 +330,10 @@ func (h *ScheduleHandler) UpdateSchedule(c echo.Context) error {
 			))
 		}
 
+		if errors.Is(err, domain.ErrForbidden) {
+			return forbiddenVenueAccess(c)
+		}
+
 		logger.Error("Failed to update schedule", err)
 		return c.JSON(http.StatusInternalServerError, jsonres.Error(
 			"INTERNAL_ERROR", "Failed to update schedule", nil,
@@ -323,17 +345,54 @@ func (h *ScheduleHandler) UpdateSchedule(c echo.Context) error {
 	))
 }
 
+// GetScheduleDeletionPreview godoc
+// @Summary Preview deleting a schedule
+// @Description Count the upcoming bookings that deleting the schedule would affect. Requires MANAGER membership of the venue.
+// @Tags Schedules
+// @Produce json
+// @Param id path uint true "Schedule ID"
+// @Success 200 {object} docs.SuccessResponse{data=dto.DeletionImpactResponse} "Deletion preview"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Schedule ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not a venue manager)"
+// @Failure 404 {object} docs.ErrorResponse "Schedule Not Found"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /schedules/{id}/deletion-preview [get]
+func (h *ScheduleHandler) GetScheduleDeletionPreview(c echo.Context) error {
+	scheduleId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || scheduleId == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid schedule id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	impact, err := h.scheduleService.GetScheduleDeletionPreview(ctx, uint(scheduleId), userIDFromContext(c))
+	if err != nil {
+		return h.deletionError(c, err, "Failed to preview schedule deletion")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Deletion preview", dto.ToDeletionImpactResponse(impact),
+	))
+}
+
 // DeleteSchedule godoc
 // @Summary Delete a schedule (Admin only)
-// @Description Delete an existing schedule by its ID
+// @Description Delete a schedule. When upcoming bookings exist the deletion is refused with 409 unless force=true, which cancels them and tells the customers. Deleted schedules can be restored for 30 days. Requires MANAGER membership of the venue.
 // @Tags Schedules
 // @Produce json
 // @Param id path uint true "Schedule ID"
+// @Param force query bool false "Cancel upcoming bookings instead of refusing"
 // @Success 200 {object} docs.SuccessResponse "Schedule deleted successfully"
 // @Failure 400 {object} docs.ErrorResponse "Invalid Schedule ID"
 // @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
-// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not Admin)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not a venue manager)"
 // @Failure 404 {object} docs.ErrorResponse "Schedule Not Found"
+// @Failure 409 {object} docs.ErrorResponse "Schedule has upcoming bookings"
 // @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
 // @Security ApiKeyAuth
 // @Router /schedules/{id} [delete]
@@ -348,36 +407,84 @@ func (h *ScheduleHandler) DeleteSchedule(c echo.Context) error {
 		))
 	}
 
+	force, err := forceFromQuery(c)
+	if err != nil {
+		return invalidForce(c)
+	}
+
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
 	defer cancel()
 
-	err = h.scheduleService.DeleteSchedule(ctx, uint(scheduleId))
+	impact, err := h.scheduleService.DeleteSchedule(ctx, uint(scheduleId), userIDFromContext(c), force)
 	if err != nil {
-		if errors.Is(err, context.DeadlineExceeded) {
-			return c.JSON(http.StatusRequestTimeout, jsonres.Error(
-				"TIMEOUT",
-				"Request timeout",
-				nil,
-			))
+		if errors.Is(err, domain.ErrHasFutureBookings) {
+			return futureBookingsConflict(c, impact)
 		}
+		return h.deletionError(c, err, "Failed to delete schedule")
+	}
 
-		if errors.Is(err, domain.ErrScheduleNotFound) {
-			logger.Error("schedule not found", err)
-			return c.JSON(http.StatusNotFound, jsonres.Error(
-				"NOT_FOUND",
-				"schedule not found",
-				map[string]interface{}{"schedule_id": scheduleId},
-			))
-		}
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Schedule deleted successfully",
+		map[string]any{"schedule_id": scheduleId, "impact": dto.ToDeletionImpactResponse(impact)},
+	))
+}
 
-		logger.Error("Failed to delete schedule", err)
-		return c.JSON(http.StatusInternalServerError, jsonres.Error(
-			"INTERNAL_SERVER_ERROR", "Failed to delete schedule", err,
+// RestoreSchedule godoc
+// @Summary Restore a deleted schedule
+// @Description Restore a schedule deleted in the last 30 days. Its field and venue must not be deleted. Bookings cancelled by the deletion stay cancelled. Requires the deleted:restore permission.
+// @Tags Schedules
+// @Produce json
+// @Param id path uint true "Schedule ID"
+// @Success 200 {object} docs.SuccessResponse{data=dto.ScheduleResponse} "Schedule restored"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Schedule ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Missing Permission)"
+// @Failure 404 {object} docs.ErrorResponse "Schedule Not Found"
+// @Failure 409 {object} docs.ErrorResponse "Schedule is not deleted or its field is"
+// @Failure 410 {object} docs.ErrorResponse "Deleted too long ago"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /schedules/{id}/restore [post]
+func (h *ScheduleHandler) RestoreSchedule(c echo.Context) error {
+	scheduleId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || scheduleId == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid schedule id", map[string]any{"id": c.Param("id")},
 		))
 	}
 
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	schedule, err := h.scheduleService.RestoreSchedule(ctx, uint(scheduleId))
+	if err != nil {
+		if handled, res := restoreError(c, err); handled {
+			return res
+		}
+		return h.deletionError(c, err, "Failed to restore schedule")
+	}
+
 	return c.JSON(http.StatusOK, jsonres.Success(
-		"Schedule deleted successfully",
-		map[string]any{"schedule_id": scheduleId},
+		"Schedule restored", dto.ToScheduleResponse(schedule),
+	))
+}
+
+func (h *ScheduleHandler) deletionError(c echo.Context, err error, message string) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return c.JSON(http.StatusRequestTimeout, jsonres.Error(
+			"TIMEOUT", "Request timeout", nil,
+		))
+	case errors.Is(err, domain.ErrScheduleNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", "schedule not found", nil,
+		))
+	case errors.Is(err, domain.ErrForbidden):
+		return forbiddenVenueAccess(c)
+	}
+
+	logger.Error(message, err)
+	return c.JSON(http.StatusInternalServerError, jsonres.Error(
+		"INTERNAL_SERVER_ERROR", message, nil,
 	))
 }
@@ -0,0 +1,350 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/dto/request"
+	dto "go-futsal-booking-api/internal/dto/response"
+	"go-futsal-booking-api/internal/service"
+	"go-futsal-booking-api/pkg/logger"
+	jsonres "go-futsal-booking-api/pkg/response"
+	"go-futsal-booking-api/pkg/validator"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type TwoFactorHandler struct {
+	twoFactorService service.TwoFactorService
+	timeout          time.Duration
+}
+
+func NewTwoFactorHandler(twoFactorService service.TwoFactorService) *TwoFactorHandler {
+	return &TwoFactorHandler{
+		twoFactorService: twoFactorService,
+		timeout:          30 * time.Second,
+	}
+}
+
+func (h *TwoFactorHandler) twoFactorError(c echo.Context, err error, fallback string) error {
+	var throttleErr *domain.LoginThrottleError
+	switch {
+	case errors.As(err, &throttleErr):
+		retryAfter := int(math.Ceil(throttleErr.RetryAfter.Seconds()))
+		c.Response().Header().Set("Retry-After", strconv.Itoa(retryAfter))
+		return c.JSON(http.StatusTooManyRequests, jsonres.Error(
+			"TOO_MANY_ATTEMPTS", throttleErr.Err.Error(), map[string]any{"retry_after_seconds": retryAfter},
+		))
+	case errors.Is(err, domain.ErrInvalidTwoFactorCode), errors.Is(err, domain.ErrInvalidChallenge):
+		return c.JSON(http.StatusUnauthorized, jsonres.Error(
+			"INVALID_CODE", err.Error(), nil,
+		))
+	case errors.Is(err, domain.ErrTwoFactorEnabled):
+		return c.JSON(http.StatusConflict, jsonres.Error(
+			"CONFLICT", err.Error(), nil,
+		))
+	case errors.Is(err, domain.ErrTwoFactorNotFound), errors.Is(err, domain.ErrTwoFactorNotEnabled):
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", err.Error(), nil,
+		))
+	case errors.Is(err, domain.ErrTwoFactorMandatory):
+		return c.JSON(http.StatusForbidden, jsonres.Error(
+			"FORBIDDEN", err.Error(), nil,
+		))
+	case errors.Is(err, domain.ErrUserNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", err.Error(), nil,
+		))
+	}
+
+	logger.Error(fallback, err)
+	return c.JSON(http.StatusInternalServerError, jsonres.Error(
+		"INTERNAL_ERROR", fallback, nil,
+	))
+}
+
+// VerifyLogin godoc
+// @Summary Complete a two-factor login
+// @Description Exchange a 2fa_login challenge token and a TOTP or recovery code for an access token
+// @Tags Two-Factor
+// @Accept json
+// @Produce json
+// @Param request body request.TwoFactorLoginRequest true "Challenge token and code"
+// @Success 200 {object} docs.SuccessResponse{data=dto.LoginResponse} "Login successful"
+// @Failure 400 {object} docs.ErrorResponse "Validation Error"
+// @Failure 401 {object} docs.ErrorResponse "Invalid code or challenge"
+// @Failure 429 {object} docs.ErrorResponse "Too many attempts"
+// @Router /users/login/2fa [post]
+func (h *TwoFactorHandler) VerifyLogin(c echo.Context) error {
+	var req request.TwoFactorLoginRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid request body", err.Error(),
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		logger.Error("Failed to validate two-factor login", errs)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	result, err := h.twoFactorService.CompleteLogin(ctx, req.ChallengeToken, req.Code)
+	if err != nil {
+		return h.twoFactorError(c, err, "Failed to verify two-factor code")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Login successful",
+		dto.LoginResponse{
+			Token: result.Token,
+			User:  dto.ToUserResponse(&result.User),
+		},
+	))
+}
+
+// SetupWithChallenge godoc
+// @Summary Start mandatory two-factor enrolment during login
+// @Description Generate a TOTP secret for an account that received a 2fa_setup challenge token
+// @Tags Two-Factor
+// @Accept json
+// @Produce json
+// @Param request body request.TwoFactorChallengeRequest true "Setup challenge token"
+// @Success 200 {object} docs.SuccessResponse{data=dto.TwoFactorSetupResponse} "Scan the otpauth URI with an authenticator app"
+// @Failure 400 {object} docs.ErrorResponse "Validation Error"
+// @Failure 401 {object} docs.ErrorResponse "Invalid challenge"
+// @Failure 409 {object} docs.ErrorResponse "Two-factor already enabled"
+// @Router /users/login/2fa/setup [post]
+func (h *TwoFactorHandler) SetupWithChallenge(c echo.Context) error {
+	var req request.TwoFactorChallengeRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid request body", err.Error(),
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		logger.Error("Failed to validate two-factor setup", errs)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	setup, err := h.twoFactorService.BeginSetupWithChallenge(ctx, req.ChallengeToken)
+	if err != nil {
+		return h.twoFactorError(c, err, "Failed to start two-factor setup")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Scan the otpauth URI with an authenticator app", dto.ToTwoFactorSetupResponse(setup),
+	))
+}
+
+// EnableWithChallenge godoc
+// @Summary Finish mandatory two-factor enrolment during login
+// @Description Confirm the first TOTP code, enable two-factor authentication and log in
+// @Tags Two-Factor
+// @Accept json
+// @Produce json
+// @Param request body request.TwoFactorLoginRequest true "Setup challenge token and TOTP code"
+// @Success 200 {object} docs.SuccessResponse{data=dto.TwoFactorEnrolledLoginResponse} "Two-factor enabled, store the recovery codes safely"
+// @Failure 400 {object} docs.ErrorResponse "Validation Error or setup not started"
+// @Failure 401 {object} docs.ErrorResponse "Invalid code or challenge"
+// @Failure 429 {object} docs.ErrorResponse "Too many attempts"
+// @Router /users/login/2fa/enable [post]
+func (h *TwoFactorHandler) EnableWithChallenge(c echo.Context) error {
+	var req request.TwoFactorLoginRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid request body", err.Error(),
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		logger.Error("Failed to validate two-factor enable", errs)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	result, recoveryCodes, err := h.twoFactorService.EnableWithChallenge(ctx, req.ChallengeToken, req.Code)
+	if err != nil {
+		return h.twoFactorError(c, err, "Failed to enable two-factor authentication")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Two-factor enabled, store the recovery codes safely",
+		dto.TwoFactorEnrolledLoginResponse{
+			Token:         result.Token,
+			User:          dto.ToUserResponse(&result.User),
+			RecoveryCodes: recoveryCodes,
+		},
+	))
+}
+
+// Setup godoc
+// @Summary Start two-factor enrolment
+// @Description Generate a TOTP secret for the current user. It is not active until confirmed with /users/me/2fa/enable.
+// @Tags Two-Factor
+// @Produce json
+// @Success 200 {object} docs.SuccessResponse{data=dto.TwoFactorSetupResponse} "Scan the otpauth URI with an authenticator app"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 409 {object} docs.ErrorResponse "Two-factor already enabled"
+// @Security ApiKeyAuth
+// @Router /users/me/2fa/setup [post]
+func (h *TwoFactorHandler) Setup(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	setup, err := h.twoFactorService.BeginSetup(ctx, userIDFromContext(c))
+	if err != nil {
+		return h.twoFactorError(c, err, "Failed to start two-factor setup")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Scan the otpauth URI with an authenticator app", dto.ToTwoFactorSetupResponse(setup),
+	))
+}
+
+// Enable godoc
+// @Summary Enable two-factor authentication
+// @Description Confirm the first TOTP code after setup and receive one-time recovery codes
+// @Tags Two-Factor
+// @Accept json
+// @Produce json
+// @Param request body request.TwoFactorCodeRequest true "TOTP code"
+// @Success 200 {object} docs.SuccessResponse{data=dto.RecoveryCodesResponse} "Two-factor enabled, store the recovery codes safely"
+// @Failure 400 {object} docs.ErrorResponse "Validation Error or setup not started"
+// @Failure 401 {object} docs.ErrorResponse "Invalid code"
+// @Failure 409 {object} docs.ErrorResponse "Two-factor already enabled"
+// @Security ApiKeyAuth
+// @Router /users/me/2fa/enable [post]
+func (h *TwoFactorHandler) Enable(c echo.Context) error {
+	var req request.TwoFactorCodeRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid request body", err.Error(),
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		logger.Error("Failed to validate two-factor code", errs)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	recoveryCodes, err := h.twoFactorService.Enable(ctx, userIDFromContext(c), req.Code)
+	if err != nil {
+		return h.twoFactorError(c, err, "Failed to enable two-factor authentication")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Two-factor enabled, store the recovery codes safely",
+		dto.RecoveryCodesResponse{RecoveryCodes: recoveryCodes},
+	))
+}
+
+// Disable godoc
+// @Summary Disable two-factor authentication
+// @Description Turn off two-factor authentication with a TOTP or recovery code. Not allowed for ADMIN accounts.
+// @Tags Two-Factor
+// @Accept json
+// @Produce json
+// @Param request body request.TwoFactorCodeRequest true "TOTP or recovery code"
+// @Success 200 {object} docs.SuccessResponse "Two-factor disabled"
+// @Failure 400 {object} docs.ErrorResponse "Validation Error or two-factor not enabled"
+// @Failure 401 {object} docs.ErrorResponse "Invalid code"
+// @Failure 403 {object} docs.ErrorResponse "Two-factor is mandatory for this role"
+// @Security ApiKeyAuth
+// @Router /users/me/2fa/disable [post]
+func (h *TwoFactorHandler) Disable(c echo.Context) error {
+	var req request.TwoFactorCodeRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid request body", err.Error(),
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		logger.Error("Failed to validate two-factor code", errs)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	if err := h.twoFactorService.Disable(ctx, userIDFromContext(c), req.Code); err != nil {
+		return h.twoFactorError(c, err, "Failed to disable two-factor authentication")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Two-factor disabled", nil,
+	))
+}
+
+// RegenerateRecoveryCodes godoc
+// @Summary Regenerate recovery codes
+// @Description Replace every recovery code after confirming a TOTP code. Old codes stop working.
+// @Tags Two-Factor
+// @Accept json
+// @Produce json
+// @Param request body request.TwoFactorCodeRequest true "TOTP code"
+// @Success 200 {object} docs.SuccessResponse{data=dto.RecoveryCodesResponse} "Recovery codes regenerated"
+// @Failure 400 {object} docs.ErrorResponse "Validation Error or two-factor not enabled"
+// @Failure 401 {object} docs.ErrorResponse "Invalid code"
+// @Security ApiKeyAuth
+// @Router /users/me/2fa/recovery-codes [post]
+func (h *TwoFactorHandler) RegenerateRecoveryCodes(c echo.Context) error {
+	var req request.TwoFactorCodeRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid request body", err.Error(),
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		logger.Error("Failed to validate two-factor code", errs)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	recoveryCodes, err := h.twoFactorService.RegenerateRecoveryCodes(ctx, userIDFromContext(c), req.Code)
+	if err != nil {
+		return h.twoFactorError(c, err, "Failed to regenerate recovery codes")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Recovery codes regenerated",
+		dto.RecoveryCodesResponse{RecoveryCodes: recoveryCodes},
+	))
+}
@@ -2,13 +2,17 @@ package handler
 
 import (
 	"context"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
 	"go-futsal-booking-api/internal/dto/request"
 	dto "go-futsal-booking-api/internal/dto/response"
 	"go-futsal-booking-api/internal/service"
 	"go-futsal-booking-api/pkg/logger"
 	jsonres "go-futsal-booking-api/pkg/response"
 	"go-futsal-booking-api/pkg/validator"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -33,11 +37,13 @@ func NewUserHandler(userService service.UserService) *UserHandler {
 
 // Register godoc
 // @Summary Register a new user
-// @Description Create a new customer account
+// @Description Create a new customer account. Emails are sent in the requested language,
+// @Description falling back to the Accept-Language header and then Indonesian.
 // @Tags Users
 // @Accept json
 // @Produce json
 // @Param user body request.UserRegisterRequest true "User registration details"
+// @Param Accept-Language header string false "Preferred language when none is given in the body (id or en)"
 // @Success 201 {object} docs.SuccessResponse{data=dto.UserResponse} "User registered successfully"
 // @Failure 400 {object} docs.ErrorResponse "Invalid request body or validation error"
 // @Failure 400 {object} docs.ErrorResponse "REGISTER_FAILED (e.g., email already exists)"
@@ -59,6 +65,11 @@ func (h *UserHandler) Register(c echo.Context) error {
 		))
 	}
 
+	language := reqUser.Language
+	if language == "" {
+		language = domain.NormalizeLanguage(c.Request().Header.Get("Accept-Language"))
+	}
+
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
 	defer cancel()
 
@@ -69,6 +80,7 @@ func (h *UserHandler) Register(c echo.Context) error {
 		reqUser.Password,
 		reqUser.Age,
 		reqUser.Address,
+		language,
 	)
 	if err != nil {
 		logger.Error("Failed to register user", err)
@@ -84,7 +96,8 @@ func (h *UserHandler) Register(c echo.Context) error {
 
 // Login godoc
 // @Summary Log in a user
-// @Description Log in with email and password to receive a JWT token
+// @Description Log in with email and password to receive a JWT token. Accounts with two-factor
+// @Description authentication, and ADMIN accounts, receive a challenge token instead (see /users/login/2fa).
 // @Tags Users
 // @Accept json
 // @Produce json
@@ -92,6 +105,7 @@ func (h *UserHandler) Register(c echo.Context) error {
 // @Success 200 {object} docs.SuccessResponse{data=dto.LoginResponse} "Login successful"
 // @Failure 400 {object} docs.ErrorResponse "Invalid request body or validation error"
 // @Failure 401 {object} docs.ErrorResponse "LOGIN_FAILED (Invalid credentials or email not verified)"
+// @Failure 429 {object} docs.ErrorResponse "TOO_MANY_ATTEMPTS or ACCOUNT_LOCKED (see Retry-After header)"
 // @Router /users/login [post]
 func (h *UserHandler) Login(c echo.Context) error {
 	var reqUser request.UserLoginRequest
@@ -113,19 +127,44 @@ func (h *UserHandler) Login(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
 	defer cancel()
 
-	token, user, err := h.userService.Login(ctx, reqUser.Email, reqUser.Password)
+	result, err := h.userService.Login(ctx, reqUser.Email, reqUser.Password, c.RealIP())
 	if err != nil {
+		var throttleErr *domain.LoginThrottleError
+		if errors.As(err, &throttleErr) {
+			retryAfter := int(math.Ceil(throttleErr.RetryAfter.Seconds()))
+			c.Response().Header().Set("Retry-After", strconv.Itoa(retryAfter))
+
+			code := "TOO_MANY_ATTEMPTS"
+			if errors.Is(err, domain.ErrAccountLocked) {
+				code = "ACCOUNT_LOCKED"
+			}
+
+			return c.JSON(http.StatusTooManyRequests, jsonres.Error(
+				code, throttleErr.Err.Error(), map[string]any{"retry_after_seconds": retryAfter},
+			))
+		}
+
 		logger.Error("Failed to login with user", err)
 		return c.JSON(http.StatusUnauthorized, jsonres.Error(
 			"LOGIN_FAILED", err.Error(), nil,
 		))
 	}
 
+	if result.ChallengeToken != "" {
+		return c.JSON(http.StatusOK, jsonres.Success(
+			"Two-factor authentication required",
+			dto.TwoFactorChallengeResponse{
+				ChallengeToken: result.ChallengeToken,
+				Purpose:        result.ChallengePurpose,
+			},
+		))
+	}
+
 	return c.JSON(http.StatusOK, jsonres.Success(
 		"Login successful",
 		dto.LoginResponse{
-			Token: token,
-			User:  dto.ToUserResponse(&user),
+			Token: result.Token,
+			User:  dto.ToUserResponse(&result.User),
 		},
 	))
 }
@@ -162,3 +201,102 @@ func (h *UserHandler) VerifyEmail(c echo.Context) error {
 		"Success to Verifying Email", nil,
 	))
 }
+
+// UnlockUser godoc
+// @Summary Unlock a user's login
+// @Description Clear failed login attempts and any temporary lockout on a user account
+// @Tags Users
+// @Produce json
+// @Param id path uint true "User ID"
+// @Success 200 {object} docs.SuccessResponse "User unlocked"
+// @Failure 400 {object} docs.ErrorResponse "Invalid User ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Missing user:manage permission)"
+// @Failure 404 {object} docs.ErrorResponse "User Not Found"
+// @Security ApiKeyAuth
+// @Router /users/{id}/unlock [post]
+func (h *UserHandler) UnlockUser(c echo.Context) error {
+	userId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || userId == 0 {
+		logger.Error("Invalid user id", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid user id", map[string]interface{}{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	if err := h.userService.UnlockUser(ctx, uint(userId)); err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return c.JSON(http.StatusNotFound, jsonres.Error(
+				"NOT_FOUND", err.Error(), nil,
+			))
+		}
+
+		logger.Error("Failed to unlock user", err)
+		return c.JSON(http.StatusInternalServerError, jsonres.Error(
+			"INTERNAL_ERROR", "Failed to unlock user", nil,
+		))
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"User unlocked", map[string]any{"user_id": userId},
+	))
+}
+
+// UpdateLanguage godoc
+// @Summary Set preferred language
+// @Description Choose the language (id or en) used for the current user's notifications
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param request body request.UserLanguageRequest true "Preferred language"
+// @Success 200 {object} docs.SuccessResponse{data=dto.UserResponse} "Language updated"
+// @Failure 400 {object} docs.ErrorResponse "Validation Error or unsupported language"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 404 {object} docs.ErrorResponse "User Not Found"
+// @Security ApiKeyAuth
+// @Router /users/me/language [put]
+func (h *UserHandler) UpdateLanguage(c echo.Context) error {
+	var req request.UserLanguageRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid request body", err.Error(),
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		logger.Error("Failed to validate language", errs)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	user, err := h.userService.UpdateLanguage(ctx, userIDFromContext(c), req.Language)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnsupportedLanguage):
+			return c.JSON(http.StatusBadRequest, jsonres.Error(
+				"BAD_REQUEST", err.Error(), nil,
+			))
+		case errors.Is(err, domain.ErrUserNotFound):
+			return c.JSON(http.StatusNotFound, jsonres.Error(
+				"NOT_FOUND", err.Error(), nil,
+			))
+		}
+
+		logger.Error("Failed to update language", err)
+		return c.JSON(http.StatusInternalServerError, jsonres.Error(
+			"INTERNAL_ERROR", "Failed to update language", nil,
+		))
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Language updated", dto.ToUserResponse(&user),
+	))
+}
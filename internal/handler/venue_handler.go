@@ -10,8 +10,10 @@ import (
 	"go-futsal-booking-api/pkg/logger"
 	jsonres "go-futsal-booking-api/pkg/response"
 	"go-futsal-booking-api/pkg/validator"
+	"math"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/labstack/echo/v4"
@@ -93,50 +95,264 @@ func (h *VenueHandler) GetVenueByID(c echo.Context) error {
 	))
 }
 
-// GetAllVenues godoc
-// @Summary Get all venues
-// @Description Get a list of all available venues
+// SearchVenues godoc
+// @Summary Search venues
+// @Description List venues, filtered and sorted. field_type, min_price, max_price, date and time all describe one slot: a venue matches when one of its schedules satisfies all of them, and min_price in the results is the cheapest such slot. Page with limit and offset, or pass pagination.next_cursor back as cursor for pages that stay stable while venues are added.
 // @Tags Venues
 // @Produce json
-// @Success 200 {object} docs.SuccessResponse{data=[]dto.VenueResponse} "Venue retrieved successfully"
+// @Param city query string false "City, case-insensitive"
+// @Param q query string false "Text in the name, address or city"
+// @Param field_type query string false "Field type code from GET /field-types, e.g. SINTETIS"
+// @Param date query string false "Only venues with a free slot on this date (YYYY-MM-DD)"
+// @Param time query string false "With date, only slots running at this time (HH:MM)"
+// @Param min_price query number false "Minimum slot price"
+// @Param max_price query number false "Maximum slot price"
+// @Param amenities query string false "Comma-separated amenity codes the venue must all have, e.g. PARKING,SHOWER"
+// @Param sort query string false "name (default), -name, price, -price, rating, -rating or newest"
+// @Param limit query int false "Page size (default 50, max 200)"
+// @Param offset query int false "Number of venues to skip"
+// @Param cursor query string false "next_cursor of the previous page; overrides offset"
+// @Success 200 {object} docs.PaginatedResponse{data=[]dto.VenueListingResponse} "Venue retrieved successfully"
+// @Failure 400 {object} docs.ErrorResponse "Invalid filter, sort or cursor"
 // @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
 // @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
 // @Security ApiKeyAuth
 // @Router /venues [get]
-func (h *VenueHandler) GetAllVenues(c echo.Context) error {
+func (h *VenueHandler) SearchVenues(c echo.Context) error {
+	filter, errs := venueFilterFromQuery(c)
+	if len(errs) > 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Invalid venue search", errs,
+		))
+	}
+
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
 	defer cancel()
 
-	venues, err := h.venueService.GetAllVenues(ctx)
+	page, err := h.venueService.SearchVenues(ctx, filter, c.QueryParam("cursor"))
 	if err != nil {
-		if errors.Is(err, context.DeadlineExceeded) {
+		switch {
+		case errors.Is(err, context.DeadlineExceeded):
 			logger.Warn("request timeout", map[string]any{"timeout": h.timeout})
 			return c.JSON(http.StatusRequestTimeout, jsonres.Error(
 				"TIMEOUT",
 				"Request timeout",
 				nil,
 			))
+		case errors.Is(err, domain.ErrInvalidVenueSort),
+			errors.Is(err, domain.ErrInvalidPriceRange),
+			errors.Is(err, domain.ErrInvalidCursor):
+			return c.JSON(http.StatusBadRequest, jsonres.Error(
+				"BAD_REQUEST", err.Error(), nil,
+			))
+		}
+
+		logger.Error("Failed to search venues", err)
+		return c.JSON(http.StatusInternalServerError, jsonres.Error(
+			"INTERNAL_SERVER_ERROR", "Failed to search venues", nil,
+		))
+	}
+
+	venueResponse := make([]dto.VenueListingResponse, len(page.Venues))
+	for i := range page.Venues {
+		venueResponse[i] = dto.ToVenueListingResponse(&page.Venues[i])
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Paginated(
+		"Venue retrieved successfully", venueResponse, jsonres.Pagination{
+			Total:      page.Total,
+			Limit:      filter.Limit,
+			Offset:     filter.Offset,
+			HasMore:    page.HasMore,
+			NextCursor: page.NextCursor,
+		},
+	))
+}
+
+// NearbyVenues godoc
+// @Summary Find venues near a point
+// @Description List venues within radius_km of a point, nearest first, with their distance in km. Venues without coordinates are not included.
+// @Tags Venues
+// @Produce json
+// @Param lat query number true "Latitude of the point"
+// @Param lng query number true "Longitude of the point"
+// @Param radius_km query number false "Search radius in km (default 10, max 100)"
+// @Param limit query int false "Maximum number of venues (default 50, max 200)"
+// @Success 200 {object} docs.SuccessResponse{data=[]dto.NearbyVenueResponse} "Nearby venues"
+// @Failure 400 {object} docs.ErrorResponse "Invalid coordinates or radius"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /venues/nearby [get]
+func (h *VenueHandler) NearbyVenues(c echo.Context) error {
+	lat, latErr := strconv.ParseFloat(c.QueryParam("lat"), 64)
+	lng, lngErr := strconv.ParseFloat(c.QueryParam("lng"), 64)
+	if latErr != nil || lngErr != nil {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "lat and lng are required numbers", map[string]any{"lat": c.QueryParam("lat"), "lng": c.QueryParam("lng")},
+		))
+	}
+
+	radiusKm := 10.0
+	if v := c.QueryParam("radius_km"); v != "" {
+		parsed, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, jsonres.Error(
+				"BAD_REQUEST", domain.ErrInvalidRadius.Error(), map[string]any{"radius_km": v},
+			))
+		}
+		radiusKm = parsed
+	}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil || limit <= 0 {
+		limit = 50
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	venues, err := h.venueService.NearbyVenues(ctx, domain.GeoPoint{Latitude: lat, Longitude: lng}, radiusKm, min(limit, 200))
+	if err != nil {
+		switch {
+		case errors.Is(err, context.DeadlineExceeded):
+			return c.JSON(http.StatusRequestTimeout, jsonres.Error(
+				"TIMEOUT", "Request timeout", nil,
+			))
+		case errors.Is(err, domain.ErrInvalidCoordinates), errors.Is(err, domain.ErrInvalidRadius):
+			return c.JSON(http.StatusBadRequest, jsonres.Error(
+				"BAD_REQUEST", err.Error(), nil,
+			))
 		}
 
-		logger.Error("Failed to find all venue", err)
+		logger.Error("Failed to find nearby venues", err)
 		return c.JSON(http.StatusInternalServerError, jsonres.Error(
-			"INTERNAL_SERVER_ERROR", "Failed to find all venue", err,
+			"INTERNAL_SERVER_ERROR", "Failed to find nearby venues", nil,
 		))
 	}
 
-	venueResponse := make([]dto.VenueResponse, len(venues))
+	venueResponse := make([]dto.NearbyVenueResponse, len(venues))
 	for i := range venues {
-		venueResponse[i] = dto.ToVenueResponse(&venues[i])
+		venueResponse[i] = dto.ToNearbyVenueResponse(&venues[i])
 	}
 
 	return c.JSON(http.StatusOK, jsonres.Success(
-		"Venue retrieved successfully", venueResponse,
+		"Nearby venues", venueResponse,
 	))
 }
 
+func venueInputOf(req request.CreateVenueRequest) service.VenueInput {
+	input := service.VenueInput{
+		Name:           req.Name,
+		Address:        req.Address,
+		City:           req.City,
+		Timezone:       req.Timezone,
+		Coordinates:    coordinatesOf(req.Latitude, req.Longitude),
+		PhoneNumber:    req.PhoneNumber,
+		WhatsAppNumber: req.WhatsAppNumber,
+		Amenities:      req.Amenities,
+	}
+
+	if req.OpeningHours != nil {
+		// The times were checked by the request validation.
+		input.OpeningHours = make([]domain.OpeningHours, len(req.OpeningHours))
+		for i, h := range req.OpeningHours {
+			opensAt, _ := time.Parse("15:04", h.OpensAt)
+			closesAt, _ := time.Parse("15:04", h.ClosesAt)
+			input.OpeningHours[i] = domain.OpeningHours{DayOfWeek: h.DayOfWeek, OpensAt: opensAt, ClosesAt: closesAt}
+		}
+	}
+
+	return input
+}
+
+// coordinatesOf returns nil unless both latitude and longitude are given.
+func coordinatesOf(latitude, longitude *float64) *domain.GeoPoint {
+	if latitude == nil || longitude == nil {
+		return nil
+	}
+
+	return &domain.GeoPoint{Latitude: *latitude, Longitude: *longitude}
+}
+
+// amenityCodesOf splits a comma-separated amenities parameter.
+func amenityCodesOf(param string) []string {
+	var codes []string
+	for _, code := range strings.Split(param, ",") {
+		if code = strings.ToUpper(strings.TrimSpace(code)); code != "" {
+			codes = append(codes, code)
+		}
+	}
+
+	return codes
+}
+
+// venueFilterFromQuery reads the search query parameters. Malformed values are
+// returned by parameter name.
+func venueFilterFromQuery(c echo.Context) (domain.VenueFilter, map[string]string) {
+	errs := make(map[string]string)
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil || limit <= 0 {
+		limit = 50
+	}
+
+	offset, err := strconv.Atoi(c.QueryParam("offset"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+
+	filter := domain.VenueFilter{
+		City:      strings.TrimSpace(c.QueryParam("city")),
+		Query:     strings.TrimSpace(c.QueryParam("q")),
+		FieldType: strings.TrimSpace(c.QueryParam("field_type")),
+		Amenities: amenityCodesOf(c.QueryParam("amenities")),
+		Sort:      c.QueryParam("sort"),
+		Limit:     min(limit, 200),
+		Offset:    offset,
+	}
+
+	if v := c.QueryParam("date"); v != "" {
+		date, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			errs["date"] = "must be a date in YYYY-MM-DD format"
+		} else {
+			filter.Date = &date
+		}
+	}
+
+	if v := c.QueryParam("time"); v != "" {
+		at, err := time.Parse("15:04", v)
+		switch {
+		case err != nil:
+			errs["time"] = "must be a time in HH:MM format"
+		case c.QueryParam("date") == "":
+			errs["time"] = "requires date"
+		default:
+			filter.StartTime = &at
+		}
+	}
+
+	for name, price := range map[string]**float64{"min_price": &filter.MinPrice, "max_price": &filter.MaxPrice} {
+		v := c.QueryParam(name)
+		if v == "" {
+			continue
+		}
+
+		parsed, err := strconv.ParseFloat(v, 64)
+		if err != nil || math.IsNaN(parsed) || math.IsInf(parsed, 0) || parsed < 0 {
+			errs[name] = "must be a non-negative number"
+			continue
+		}
+		*price = &parsed
+	}
+
+	return filter, errs
+}
+
 // CreateVenue godoc
 // @Summary Create a new venue (Admin only)
-// @Description Create a new futsal venue
+// @Description Create a new futsal venue, owned by the caller. Requires the venue:write permission.
 // @Tags Venues
 // @Accept json
 // @Produce json
@@ -144,7 +360,7 @@ func (h *VenueHandler) GetAllVenues(c echo.Context) error {
 // @Success 201 {object} docs.SuccessResponse{data=dto.VenueResponse} "Venue successfully created"
 // @Failure 400 {object} docs.ErrorResponse "Bad Request or Validation Error"
 // @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
-// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not Admin)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Missing venue:write permission)"
 // @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
 // @Security ApiKeyAuth
 // @Router /venues [post]
@@ -168,12 +384,7 @@ func (h *VenueHandler) CreateVenue(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
 	defer cancel()
 
-	newVenue, err := h.venueService.CreateVenue(
-		ctx,
-		req.Name,
-		req.Address,
-		req.City,
-	)
+	newVenue, err := h.venueService.CreateVenue(ctx, venueInputOf(req), userIDFromContext(c))
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
 			return c.JSON(http.StatusRequestTimeout, jsonres.Error(
@@ -183,6 +394,24 @@ func (h *VenueHandler) CreateVenue(c echo.Context) error {
 			))
 		}
 
+		if errors.Is(err, domain.ErrInvalidTimezone) {
+			return c.JSON(http.StatusBadRequest, jsonres.Error(
+				"BAD_REQUEST", err.Error(), map[string]interface{}{"timezone": req.Timezone},
+			))
+		}
+
+		if errors.Is(err, domain.ErrInvalidCoordinates) {
+			return c.JSON(http.StatusBadRequest, jsonres.Error(
+				"BAD_REQUEST", err.Error(), map[string]interface{}{"latitude": req.Latitude, "longitude": req.Longitude},
+			))
+		}
+
+		if errors.Is(err, domain.ErrInvalidPhoneNumber) || errors.Is(err, domain.ErrInvalidOpeningHours) || errors.Is(err, domain.ErrAmenityNotFound) {
+			return c.JSON(http.StatusBadRequest, jsonres.Error(
+				"BAD_REQUEST", err.Error(), nil,
+			))
+		}
+
 		logger.Error("Failed to create venue", err)
 		return c.JSON(http.StatusInternalServerError, jsonres.Error(
 			"INTERNAL_ERROR", "Failed to create venue", nil,
@@ -196,7 +425,7 @@ func (h *VenueHandler) CreateVenue(c echo.Context) error {
 
 // UpdateVenue godoc
 // @Summary Update a venue (Admin only)
-// @Description Update details of an existing venue
+// @Description Update details of an existing venue. Requires MANAGER membership of the venue.
 // @Tags Venues
 // @Accept json
 // @Produce json
@@ -205,7 +434,7 @@ func (h *VenueHandler) CreateVenue(c echo.Context) error {
 // @Success 200 {object} docs.SuccessResponse{data=dto.VenueResponse} "Venue successfully updated"
 // @Failure 400 {object} docs.ErrorResponse "Bad Request, Invalid ID, or Validation Error"
 // @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
-// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not Admin)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not a venue manager)"
 // @Failure 404 {object} docs.ErrorResponse "Venue Not Found"
 // @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
 // @Security ApiKeyAuth
@@ -239,13 +468,8 @@ func (h *VenueHandler) UpdateVenue(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
 	defer cancel()
 
-	updateVenue, err := h.venueService.UpdateVenue(
-		ctx,
-		uint(venueId),
-		req.Name,
-		req.Address,
-		req.City,
-	)
+	// The update request has the same fields as the create request.
+	updateVenue, err := h.venueService.UpdateVenue(ctx, uint(venueId), venueInputOf(request.CreateVenueRequest(req)), userIDFromContext(c))
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
 			return c.JSON(http.StatusRequestTimeout, jsonres.Error(
@@ -255,6 +479,24 @@ func (h *VenueHandler) UpdateVenue(c echo.Context) error {
 			))
 		}
 
+		if errors.Is(err, domain.ErrInvalidTimezone) {
+			return c.JSON(http.StatusBadRequest, jsonres.Error(
+				"BAD_REQUEST", err.Error(), map[string]interface{}{"timezone": req.Timezone},
+			))
+		}
+
+		if errors.Is(err, domain.ErrInvalidCoordinates) {
+			return c.JSON(http.StatusBadRequest, jsonres.Error(
+				"BAD_REQUEST", err.Error(), map[string]interface{}{"latitude": req.Latitude, "longitude": req.Longitude},
+			))
+		}
+
+		if errors.Is(err, domain.ErrInvalidPhoneNumber) || errors.Is(err, domain.ErrInvalidOpeningHours) || errors.Is(err, domain.ErrAmenityNotFound) {
+			return c.JSON(http.StatusBadRequest, jsonres.Error(
+				"BAD_REQUEST", err.Error(), nil,
+			))
+		}
+
 		if errors.Is(err, domain.ErrVenueNotFound) {
 			logger.Error("venue not found", err)
 			return c.JSON(http.StatusNotFound, jsonres.Error(
@@ -264,6 +506,10 @@ func (h *VenueHandler) UpdateVenue(c echo.Context) error {
 			))
 		}
 
+		if errors.Is(err, domain.ErrForbidden) {
+			return forbiddenVenueAccess(c)
+		}
+
 		logger.Error("Failed to update venue", err)
 		return c.JSON(http.StatusInternalServerError, jsonres.Error(
 			"INTERNAL_ERROR", "Failed to update venue", nil,
@@ -275,17 +521,54 @@ func (h *VenueHandler) UpdateVenue(c echo.Context) error {
 	))
 }
 
+// GetVenueDeletionPreview godoc
+// @Summary Preview deleting a venue
+// @Description Count the fields, schedules and upcoming bookings that deleting the venue would affect. Requires OWNER membership of the venue.
+// @Tags Venues
+// @Produce json
+// @Param id path uint true "Venue ID"
+// @Success 200 {object} docs.SuccessResponse{data=dto.DeletionImpactResponse} "Deletion preview"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Venue ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not the venue owner)"
+// @Failure 404 {object} docs.ErrorResponse "Venue Not Found"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /venues/{id}/deletion-preview [get]
+func (h *VenueHandler) GetVenueDeletionPreview(c echo.Context) error {
+	venueId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || venueId == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid venue id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	impact, err := h.venueService.GetVenueDeletionPreview(ctx, uint(venueId), userIDFromContext(c))
+	if err != nil {
+		return h.deletionError(c, err, "Failed to preview venue deletion")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Deletion preview", dto.ToDeletionImpactResponse(impact),
+	))
+}
+
 // DeleteVenue godoc
 // @Summary Delete a venue (Admin only)
-// @Description Delete an existing venue by its ID
+// @Description Delete a venue together with its fields and schedules. When upcoming bookings exist the deletion is refused with 409 unless force=true, which cancels them and tells the customers. Deleted venues can be restored for 30 days. Requires OWNER membership of the venue.
 // @Tags Venues
 // @Produce json
 // @Param id path uint true "Venue ID"
+// @Param force query bool false "Cancel upcoming bookings instead of refusing"
 // @Success 200 {object} docs.SuccessResponse "Venue deleted successfully"
 // @Failure 400 {object} docs.ErrorResponse "Invalid Venue ID"
 // @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
-// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not Admin)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not the venue owner)"
 // @Failure 404 {object} docs.ErrorResponse "Venue Not Found"
+// @Failure 409 {object} docs.ErrorResponse "Venue has upcoming bookings"
 // @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
 // @Security ApiKeyAuth
 // @Router /venues/{id} [delete]
@@ -300,36 +583,313 @@ func (h *VenueHandler) DeleteVenue(c echo.Context) error {
 		))
 	}
 
+	force, err := forceFromQuery(c)
+	if err != nil {
+		return invalidForce(c)
+	}
+
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
 	defer cancel()
 
-	err = h.venueService.DeleteVenue(ctx, uint(venueId))
+	impact, err := h.venueService.DeleteVenue(ctx, uint(venueId), userIDFromContext(c), force)
 	if err != nil {
-		if errors.Is(err, context.DeadlineExceeded) {
-			return c.JSON(http.StatusRequestTimeout, jsonres.Error(
-				"TIMEOUT",
-				"Request timeout",
-				nil,
-			))
+		if errors.Is(err, domain.ErrHasFutureBookings) {
+			return futureBookingsConflict(c, impact)
 		}
+		return h.deletionError(c, err, "Failed to delete venue")
+	}
 
-		if errors.Is(err, domain.ErrVenueNotFound) {
-			logger.Error("venue not found", err)
-			return c.JSON(http.StatusNotFound, jsonres.Error(
-				"NOT_FOUND",
-				"Venue not found",
-				map[string]interface{}{"venue_id": venueId},
-			))
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Venue deleted successfully",
+		map[string]any{"venue_id": venueId, "impact": dto.ToDeletionImpactResponse(impact)},
+	))
+}
+
+// RestoreVenue godoc
+// @Summary Restore a deleted venue
+// @Description Restore a venue deleted in the last 30 days, with the fields and schedules deleted along with it. Bookings cancelled by the deletion stay cancelled. Requires the deleted:restore permission.
+// @Tags Venues
+// @Produce json
+// @Param id path uint true "Venue ID"
+// @Success 200 {object} docs.SuccessResponse{data=dto.VenueResponse} "Venue restored"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Venue ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Missing Permission)"
+// @Failure 404 {object} docs.ErrorResponse "Venue Not Found"
+// @Failure 409 {object} docs.ErrorResponse "Venue is not deleted"
+// @Failure 410 {object} docs.ErrorResponse "Deleted too long ago"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /venues/{id}/restore [post]
+func (h *VenueHandler) RestoreVenue(c echo.Context) error {
+	venueId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || venueId == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid venue id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	venue, err := h.venueService.RestoreVenue(ctx, uint(venueId))
+	if err != nil {
+		if handled, res := restoreError(c, err); handled {
+			return res
 		}
+		return h.deletionError(c, err, "Failed to restore venue")
+	}
 
-		logger.Error("Failed to delete venue", err)
-		return c.JSON(http.StatusInternalServerError, jsonres.Error(
-			"INTERNAL_SERVER_ERROR", "Failed to delete venue", err,
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Venue restored", dto.ToVenueResponse(venue),
+	))
+}
+
+func (h *VenueHandler) deletionError(c echo.Context, err error, message string) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return c.JSON(http.StatusRequestTimeout, jsonres.Error(
+			"TIMEOUT", "Request timeout", nil,
+		))
+	case errors.Is(err, domain.ErrVenueNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", "Venue not found", nil,
+		))
+	case errors.Is(err, domain.ErrForbidden):
+		return forbiddenVenueAccess(c)
+	}
+
+	logger.Error(message, err)
+	return c.JSON(http.StatusInternalServerError, jsonres.Error(
+		"INTERNAL_SERVER_ERROR", message, nil,
+	))
+}
+
+func (h *VenueHandler) venueMemberError(c echo.Context, err error, fallback string) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return c.JSON(http.StatusRequestTimeout, jsonres.Error(
+			"TIMEOUT", "Request timeout", nil,
+		))
+	case errors.Is(err, domain.ErrForbidden):
+		return forbiddenVenueAccess(c)
+	case errors.Is(err, domain.ErrVenueNotFound),
+		errors.Is(err, domain.ErrVenueMemberNotFound),
+		errors.Is(err, domain.ErrUserNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", err.Error(), nil,
+		))
+	case errors.Is(err, domain.ErrVenueMemberExists), errors.Is(err, domain.ErrLastVenueOwner):
+		return c.JSON(http.StatusConflict, jsonres.Error(
+			"CONFLICT", err.Error(), nil,
+		))
+	case errors.Is(err, domain.ErrInvalidVenueRole):
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", err.Error(), nil,
 		))
 	}
 
+	logger.Error(fallback, err)
+	return c.JSON(http.StatusInternalServerError, jsonres.Error(
+		"INTERNAL_ERROR", fallback, nil,
+	))
+}
+
+// GetVenueMembers godoc
+// @Summary List venue members
+// @Description List owners, managers and staff of a venue. Requires at least MANAGER membership.
+// @Tags Venues
+// @Produce json
+// @Param id path uint true "Venue ID"
+// @Success 200 {object} docs.SuccessResponse{data=[]dto.VenueMemberResponse} "Venue members retrieved successfully"
+// @Failure 400 {object} docs.ErrorResponse "Invalid Venue ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not a venue manager)"
+// @Failure 404 {object} docs.ErrorResponse "Venue Not Found"
+// @Security ApiKeyAuth
+// @Router /venues/{id}/members [get]
+func (h *VenueHandler) GetVenueMembers(c echo.Context) error {
+	venueId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		logger.Error("Invalid venue id", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid venue id", map[string]interface{}{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	members, err := h.venueService.GetVenueMembers(ctx, uint(venueId), userIDFromContext(c))
+	if err != nil {
+		return h.venueMemberError(c, err, "Failed to retrieve venue members")
+	}
+
+	memberResponses := make([]dto.VenueMemberResponse, len(members))
+	for i := range members {
+		memberResponses[i] = dto.ToVenueMemberResponse(&members[i])
+	}
+
 	return c.JSON(http.StatusOK, jsonres.Success(
-		"Venue deleted successfully",
-		map[string]any{"venue_id": venueId},
+		"Venue members retrieved successfully", memberResponses,
+	))
+}
+
+// AddVenueMember godoc
+// @Summary Add a venue member
+// @Description Give a user the OWNER, MANAGER or STAFF role at a venue. Requires OWNER membership.
+// @Tags Venues
+// @Accept json
+// @Produce json
+// @Param id path uint true "Venue ID"
+// @Param member body request.AddVenueMemberRequest true "Venue member request"
+// @Success 201 {object} docs.SuccessResponse{data=dto.VenueMemberResponse} "Venue member added"
+// @Failure 400 {object} docs.ErrorResponse "Bad Request or Validation Error"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not a venue owner)"
+// @Failure 404 {object} docs.ErrorResponse "Venue or User Not Found"
+// @Failure 409 {object} docs.ErrorResponse "Already a Member"
+// @Security ApiKeyAuth
+// @Router /venues/{id}/members [post]
+func (h *VenueHandler) AddVenueMember(c echo.Context) error {
+	venueId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		logger.Error("Invalid venue id", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid venue id", map[string]interface{}{"id": c.Param("id")},
+		))
+	}
+
+	var req request.AddVenueMemberRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Failed to fetch request", err,
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		logger.Error("Failed to validate venue member request", errs)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	member, err := h.venueService.AddVenueMember(ctx, uint(venueId), req.UserID, req.Role, userIDFromContext(c))
+	if err != nil {
+		return h.venueMemberError(c, err, "Failed to add venue member")
+	}
+
+	return c.JSON(http.StatusCreated, jsonres.Success(
+		"Venue member added", dto.ToVenueMemberResponse(member),
+	))
+}
+
+// UpdateVenueMember godoc
+// @Summary Change a venue member's role
+// @Description Change the membership role of a user at a venue. Requires OWNER membership.
+// @Tags Venues
+// @Accept json
+// @Produce json
+// @Param id path uint true "Venue ID"
+// @Param userId path uint true "User ID"
+// @Param member body request.UpdateVenueMemberRequest true "Venue member role"
+// @Success 200 {object} docs.SuccessResponse{data=dto.VenueMemberResponse} "Venue member updated"
+// @Failure 400 {object} docs.ErrorResponse "Bad Request or Validation Error"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not a venue owner)"
+// @Failure 404 {object} docs.ErrorResponse "Venue Member Not Found"
+// @Failure 409 {object} docs.ErrorResponse "Last Owner"
+// @Security ApiKeyAuth
+// @Router /venues/{id}/members/{userId} [put]
+func (h *VenueHandler) UpdateVenueMember(c echo.Context) error {
+	venueId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		logger.Error("Invalid venue id", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid venue id", map[string]interface{}{"id": c.Param("id")},
+		))
+	}
+
+	memberUserId, err := strconv.ParseUint(c.Param("userId"), 10, 64)
+	if err != nil {
+		logger.Error("Invalid user id", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid user id", map[string]interface{}{"user_id": c.Param("userId")},
+		))
+	}
+
+	var req request.UpdateVenueMemberRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Failed to fetch request", err,
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		logger.Error("Failed to validate venue member request", errs)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	member, err := h.venueService.UpdateVenueMember(ctx, uint(venueId), uint(memberUserId), req.Role, userIDFromContext(c))
+	if err != nil {
+		return h.venueMemberError(c, err, "Failed to update venue member")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Venue member updated", dto.ToVenueMemberResponse(member),
+	))
+}
+
+// RemoveVenueMember godoc
+// @Summary Remove a venue member
+// @Description Revoke a user's membership at a venue. Requires OWNER membership.
+// @Tags Venues
+// @Produce json
+// @Param id path uint true "Venue ID"
+// @Param userId path uint true "User ID"
+// @Success 200 {object} docs.SuccessResponse "Venue member removed"
+// @Failure 400 {object} docs.ErrorResponse "Invalid ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden (Not a venue owner)"
+// @Failure 404 {object} docs.ErrorResponse "Venue Member Not Found"
+// @Failure 409 {object} docs.ErrorResponse "Last Owner"
+// @Security ApiKeyAuth
+// @Router /venues/{id}/members/{userId} [delete]
+func (h *VenueHandler) RemoveVenueMember(c echo.Context) error {
+	venueId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		logger.Error("Invalid venue id", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid venue id", map[string]interface{}{"id": c.Param("id")},
+		))
+	}
+
+	memberUserId, err := strconv.ParseUint(c.Param("userId"), 10, 64)
+	if err != nil {
+		logger.Error("Invalid user id", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid user id", map[string]interface{}{"user_id": c.Param("userId")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	if err := h.venueService.RemoveVenueMember(ctx, uint(venueId), uint(memberUserId), userIDFromContext(c)); err != nil {
+		return h.venueMemberError(c, err, "Failed to remove venue member")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Venue member removed", map[string]any{"venue_id": venueId, "user_id": memberUserId},
 	))
 }
@@ -0,0 +1,414 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/dto/request"
+	dto "go-futsal-booking-api/internal/dto/response"
+	"go-futsal-booking-api/internal/service"
+	"go-futsal-booking-api/pkg/logger"
+	jsonres "go-futsal-booking-api/pkg/response"
+	"go-futsal-booking-api/pkg/validator"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type WebhookHandler struct {
+	webhookService service.WebhookService
+	timeout        time.Duration
+}
+
+func NewWebhookHandler(webhookService service.WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+		timeout:        30 * time.Second,
+	}
+}
+
+// CreateWebhook godoc
+// @Summary Create a webhook subscription
+// @Description Subscribe a public https URL to booking events; plain http and internal addresses are only accepted in development. Redirects are not followed. Each delivery is a JSON POST signed with HMAC-SHA256 in the X-Webhook-Signature header as "t=<unix seconds>,v1=<hex HMAC of "<t>.<body>">". The signing secret is only returned in this response. With venue_id only that venue's events are sent and venue managers may manage the subscription; without it every event is sent and the webhook:manage permission is required.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param request body request.CreateWebhookRequest true "Subscription"
+// @Success 201 {object} docs.SuccessResponse{data=dto.WebhookSubscriptionResponse} "Webhook created"
+// @Failure 400 {object} docs.ErrorResponse "Validation Error, invalid URL or unknown event type"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /webhooks [post]
+func (h *WebhookHandler) CreateWebhook(c echo.Context) error {
+	var req request.CreateWebhookRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid request body", err.Error(),
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	subscription, err := h.webhookService.CreateSubscription(ctx, userIDFromContext(c), domain.WebhookSubscription{
+		VenueID:     req.VenueID,
+		URL:         req.URL,
+		Description: req.Description,
+		EventTypes:  req.EventTypes,
+		Active:      true,
+	})
+	if err != nil {
+		return h.handleError(c, err, "Failed to create webhook")
+	}
+
+	return c.JSON(http.StatusCreated, jsonres.Success(
+		"Webhook created", dto.ToWebhookSubscriptionResponse(subscription),
+	))
+}
+
+// GetWebhooks godoc
+// @Summary List webhook subscriptions
+// @Description List the subscriptions of a venue, or the platform-wide ones when venue_id is left out
+// @Tags Webhooks
+// @Produce json
+// @Param venue_id query uint false "Venue ID"
+// @Success 200 {object} docs.SuccessResponse{data=[]dto.WebhookSubscriptionResponse} "Webhooks retrieved"
+// @Failure 400 {object} docs.ErrorResponse "Invalid venue ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /webhooks [get]
+func (h *WebhookHandler) GetWebhooks(c echo.Context) error {
+	var venueID *uint
+	if param := c.QueryParam("venue_id"); param != "" {
+		id, err := strconv.ParseUint(param, 10, 64)
+		if err != nil || id == 0 {
+			return c.JSON(http.StatusBadRequest, jsonres.Error(
+				"BAD_REQUEST", "Invalid venue id", map[string]any{"venue_id": param},
+			))
+		}
+		v := uint(id)
+		venueID = &v
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	subscriptions, err := h.webhookService.GetSubscriptions(ctx, userIDFromContext(c), venueID)
+	if err != nil {
+		return h.handleError(c, err, "Failed to get webhooks")
+	}
+
+	res := make([]dto.WebhookSubscriptionResponse, len(subscriptions))
+	for i := range subscriptions {
+		res[i] = dto.ToWebhookSubscriptionResponse(&subscriptions[i])
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Webhooks retrieved", res,
+	))
+}
+
+// GetWebhookByID godoc
+// @Summary Get a webhook subscription
+// @Tags Webhooks
+// @Produce json
+// @Param id path uint true "Webhook ID"
+// @Success 200 {object} docs.SuccessResponse{data=dto.WebhookSubscriptionResponse} "Webhook retrieved"
+// @Failure 400 {object} docs.ErrorResponse "Invalid ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden"
+// @Failure 404 {object} docs.ErrorResponse "Webhook Not Found"
+// @Security ApiKeyAuth
+// @Router /webhooks/{id} [get]
+func (h *WebhookHandler) GetWebhookByID(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || id == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid webhook id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	subscription, err := h.webhookService.GetSubscription(ctx, userIDFromContext(c), uint(id))
+	if err != nil {
+		return h.handleError(c, err, "Failed to get webhook")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Webhook retrieved", dto.ToWebhookSubscriptionResponse(subscription),
+	))
+}
+
+// UpdateWebhook godoc
+// @Summary Update a webhook subscription
+// @Description Change the URL, event types or description, or pause deliveries with active=false. Deliveries queued while paused are marked FAILED and can be replayed later.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param id path uint true "Webhook ID"
+// @Param request body request.UpdateWebhookRequest true "Subscription"
+// @Success 200 {object} docs.SuccessResponse{data=dto.WebhookSubscriptionResponse} "Webhook updated"
+// @Failure 400 {object} docs.ErrorResponse "Validation Error, invalid URL or unknown event type"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden"
+// @Failure 404 {object} docs.ErrorResponse "Webhook Not Found"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /webhooks/{id} [put]
+func (h *WebhookHandler) UpdateWebhook(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || id == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid webhook id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	var req request.UpdateWebhookRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("Failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid request body", err.Error(),
+		))
+	}
+
+	if errs := validator.Validate(&req); len(errs) > 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"VALIDATION_ERROR", "Validation failed", errs,
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	subscription, err := h.webhookService.UpdateSubscription(ctx, userIDFromContext(c), uint(id), domain.WebhookSubscription{
+		URL:         req.URL,
+		Description: req.Description,
+		EventTypes:  req.EventTypes,
+		Active:      *req.Active,
+	})
+	if err != nil {
+		return h.handleError(c, err, "Failed to update webhook")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Webhook updated", dto.ToWebhookSubscriptionResponse(subscription),
+	))
+}
+
+// DeleteWebhook godoc
+// @Summary Delete a webhook subscription
+// @Description Delete the subscription and its delivery log
+// @Tags Webhooks
+// @Produce json
+// @Param id path uint true "Webhook ID"
+// @Success 200 {object} docs.SuccessResponse "Webhook deleted"
+// @Failure 400 {object} docs.ErrorResponse "Invalid ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden"
+// @Failure 404 {object} docs.ErrorResponse "Webhook Not Found"
+// @Security ApiKeyAuth
+// @Router /webhooks/{id} [delete]
+func (h *WebhookHandler) DeleteWebhook(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || id == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid webhook id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	if err := h.webhookService.DeleteSubscription(ctx, userIDFromContext(c), uint(id)); err != nil {
+		return h.handleError(c, err, "Failed to delete webhook")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Webhook deleted", nil,
+	))
+}
+
+// RotateWebhookSecret godoc
+// @Summary Rotate a webhook signing secret
+// @Description Replace the signing secret. The new secret is only returned in this response and signs every delivery from now on, including retries of queued ones.
+// @Tags Webhooks
+// @Produce json
+// @Param id path uint true "Webhook ID"
+// @Success 200 {object} docs.SuccessResponse{data=dto.WebhookSubscriptionResponse} "Webhook secret rotated"
+// @Failure 400 {object} docs.ErrorResponse "Invalid ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden"
+// @Failure 404 {object} docs.ErrorResponse "Webhook Not Found"
+// @Security ApiKeyAuth
+// @Router /webhooks/{id}/rotate-secret [post]
+func (h *WebhookHandler) RotateWebhookSecret(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || id == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid webhook id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	subscription, err := h.webhookService.RotateSecret(ctx, userIDFromContext(c), uint(id))
+	if err != nil {
+		return h.handleError(c, err, "Failed to rotate webhook secret")
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Webhook secret rotated", dto.ToWebhookSubscriptionResponse(subscription),
+	))
+}
+
+// GetWebhookDeliveries godoc
+// @Summary List webhook deliveries
+// @Description List the delivery log of a subscription, newest first, with the last response of each delivery
+// @Tags Webhooks
+// @Produce json
+// @Param id path uint true "Webhook ID"
+// @Param status query string false "Filter by status" Enums(PENDING, SUCCEEDED, FAILED)
+// @Param limit query int false "Page size (1-200), defaults to 50"
+// @Param offset query int false "Number of deliveries to skip"
+// @Success 200 {object} docs.SuccessResponse{data=dto.WebhookDeliveryListResponse} "Webhook deliveries"
+// @Failure 400 {object} docs.ErrorResponse "Invalid ID or filter"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden"
+// @Failure 404 {object} docs.ErrorResponse "Webhook Not Found"
+// @Security ApiKeyAuth
+// @Router /webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) GetWebhookDeliveries(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || id == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid webhook id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil || limit <= 0 {
+		limit = 50
+	}
+	limit = min(limit, 200)
+
+	offset, err := strconv.Atoi(c.QueryParam("offset"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+
+	filter := domain.WebhookDeliveryFilter{
+		Status: strings.ToUpper(c.QueryParam("status")),
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	deliveries, total, err := h.webhookService.GetDeliveries(ctx, userIDFromContext(c), uint(id), filter)
+	if err != nil {
+		if strings.Contains(err.Error(), "invalid delivery status") {
+			return c.JSON(http.StatusBadRequest, jsonres.Error(
+				"BAD_REQUEST", err.Error(), map[string]any{"status": c.QueryParam("status")},
+			))
+		}
+		return h.handleError(c, err, "Failed to get webhook deliveries")
+	}
+
+	res := dto.WebhookDeliveryListResponse{
+		Deliveries: make([]dto.WebhookDeliveryResponse, len(deliveries)),
+		Total:      total,
+		Limit:      limit,
+		Offset:     offset,
+	}
+	for i := range deliveries {
+		res.Deliveries[i] = dto.ToWebhookDeliveryResponse(&deliveries[i])
+	}
+
+	return c.JSON(http.StatusOK, jsonres.Success(
+		"Webhook deliveries", res,
+	))
+}
+
+// ReplayWebhookDelivery godoc
+// @Summary Replay a webhook delivery
+// @Description Send the same event again as a new delivery. The event keeps its id so receivers can deduplicate.
+// @Tags Webhooks
+// @Produce json
+// @Param id path uint true "Delivery ID"
+// @Success 202 {object} docs.SuccessResponse{data=dto.WebhookDeliveryResponse} "Webhook delivery queued"
+// @Failure 400 {object} docs.ErrorResponse "Invalid ID"
+// @Failure 401 {object} docs.ErrorResponse "Unauthorized (Missing Token)"
+// @Failure 403 {object} docs.ErrorResponse "Forbidden"
+// @Failure 404 {object} docs.ErrorResponse "Delivery Not Found"
+// @Failure 500 {object} docs.ErrorResponse "Internal Server Error"
+// @Security ApiKeyAuth
+// @Router /webhooks/deliveries/{id}/replay [post]
+func (h *WebhookHandler) ReplayWebhookDelivery(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || id == 0 {
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", "Invalid delivery id", map[string]any{"id": c.Param("id")},
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	delivery, err := h.webhookService.ReplayDelivery(ctx, userIDFromContext(c), uint(id))
+	if err != nil {
+		return h.handleError(c, err, "Failed to replay webhook delivery")
+	}
+
+	return c.JSON(http.StatusAccepted, jsonres.Success(
+		"Webhook delivery queued", dto.ToWebhookDeliveryResponse(delivery),
+	))
+}
+
+func (h *WebhookHandler) handleError(c echo.Context, err error, message string) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return c.JSON(http.StatusRequestTimeout, jsonres.Error(
+			"TIMEOUT", "Request timeout", nil,
+		))
+	case errors.Is(err, domain.ErrInvalidWebhookURL):
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", err.Error(), nil,
+		))
+	case errors.Is(err, domain.ErrUnknownWebhookEvent):
+		return c.JSON(http.StatusBadRequest, jsonres.Error(
+			"BAD_REQUEST", err.Error(), map[string]any{"event_types": domain.WebhookEventTypes},
+		))
+	case errors.Is(err, domain.ErrForbidden):
+		return c.JSON(http.StatusForbidden, jsonres.Error(
+			"FORBIDDEN", "Not allowed to manage these webhooks", nil,
+		))
+	case errors.Is(err, domain.ErrWebhookNotFound),
+		errors.Is(err, domain.ErrDeliveryNotFound):
+		return c.JSON(http.StatusNotFound, jsonres.Error(
+			"NOT_FOUND", err.Error(), nil,
+		))
+	}
+
+	logger.Error(message, err)
+	return c.JSON(http.StatusInternalServerError, jsonres.Error(
+		"INTERNAL_ERROR", message, nil,
+	))
+}
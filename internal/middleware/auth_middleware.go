@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"go-futsal-booking-api/pkg/logger"
 	jsonres "go-futsal-booking-api/pkg/response"
 	"go-futsal-booking-api/pkg/utils"
@@ -30,7 +31,7 @@ func AuthMiddleware() echo.MiddlewareFunc {
 			}
 
 			claims, err := utils.ParseJWT(tokenParts[1])
-			if err != nil {
+			if err != nil || claims.Purpose != "" {
 				return c.JSON(http.StatusUnauthorized, jsonres.Error(
 					"UNAUTHORIZED", "Invalid token", nil,
 				))
@@ -59,23 +60,46 @@ func AuthMiddleware() echo.MiddlewareFunc {
 
 			c.Set("user_id", uint(userIDUint))
 			c.Set("role", claims.Role)
+			c.Set("role_id", claims.RoleID)
 
 			return next(c)
 		}
 	}
 }
 
-func AdminOnly() echo.MiddlewareFunc {
+// PermissionChecker reports whether a role has been granted a permission.
+type PermissionChecker interface {
+	HasPermission(ctx context.Context, roleID uint, permission string) (bool, error)
+}
+
+// RequirePermission allows the request through only when the caller's role holds
+// every listed permission. It must run after AuthMiddleware.
+func RequirePermission(checker PermissionChecker, permissions ...string) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			role := c.Get("role")
-			roleStr, ok := role.(string)
-			if !ok || strings.ToUpper(roleStr) != "ADMIN" {
+			roleID, ok := c.Get("role_id").(uint)
+			if !ok || roleID == 0 {
 				return c.JSON(http.StatusForbidden, jsonres.Error(
-					"FORBIDDEN", "Admin access required", nil,
+					"FORBIDDEN", "Missing role in token", nil,
 				))
 			}
 
+			for _, permission := range permissions {
+				allowed, err := checker.HasPermission(c.Request().Context(), roleID, permission)
+				if err != nil {
+					logger.Error("Failed to check permission", "permission", permission, "error", err)
+					return c.JSON(http.StatusInternalServerError, jsonres.Error(
+						"INTERNAL_ERROR", "Failed to check permission", nil,
+					))
+				}
+
+				if !allowed {
+					return c.JSON(http.StatusForbidden, jsonres.Error(
+						"FORBIDDEN", "Missing required permission", map[string]any{"permission": permission},
+					))
+				}
+			}
+
 			return next(c)
 		}
 	}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"net"
+
+	"github.com/labstack/echo/v4"
+)
+
+// IPExtractor decides which address c.RealIP returns, and so which IP a login is
+// throttled under. Without trusted proxies it is the address of the connection,
+// so no header can change it. Behind proxies it is the last X-Forwarded-For hop
+// that none of them added; hops a client made up itself come before it.
+func IPExtractor(trustedProxies []*net.IPNet) echo.IPExtractor {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect()
+	}
+
+	// Echo trusts loopback, link-local and private addresses by default, which
+	// would let any client on those networks spoof its address.
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, proxy := range trustedProxies {
+		options = append(options, echo.TrustIPRange(proxy))
+	}
+
+	return echo.ExtractIPFromXFFHeader(options...)
+}
@@ -0,0 +1,89 @@
+package middleware_test
+
+import (
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/middleware"
+	"go-futsal-booking-api/internal/repository/mock"
+	"go-futsal-booking-api/internal/service"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// loginBucket sends a login through the throttle with the given headers and
+// returns the IP it was counted under.
+func loginBucket(t *testing.T, trustedProxies []*net.IPNet, remoteAddr string, headers map[string]string) string {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLoginAttemptRepo := mock.NewMockLoginAttemptRepository(ctrl)
+	loginGuard := service.NewLoginGuard(mockLoginAttemptRepo, service.LoginGuardConfig{
+		AccountFreeAttempts: 3,
+		IPFreeAttempts:      10,
+		BaseDelay:           time.Second,
+		MaxDelay:            time.Minute,
+		Window:              time.Hour,
+	})
+
+	var bucket string
+	mockLoginAttemptRepo.EXPECT().
+		Reserve(gomock.Any(), domain.LoginScopeAccount, "john.doe@example.com", gomock.Any(), gomock.Any()).
+		Return(domain.LoginAttempt{FailedCount: 1}, true, nil)
+	mockLoginAttemptRepo.EXPECT().
+		Reserve(gomock.Any(), domain.LoginScopeIP, gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_, _ any, key string, _ time.Time, _ domain.LoginBackoff) (domain.LoginAttempt, bool, error) {
+			bucket = key
+			return domain.LoginAttempt{FailedCount: 1}, true, nil
+		})
+
+	e := echo.New()
+	e.IPExtractor = middleware.IPExtractor(trustedProxies)
+	e.POST("/login", func(c echo.Context) error {
+		return loginGuard.Check(c.Request().Context(), "john.doe@example.com", c.RealIP())
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/login", nil)
+	req.RemoteAddr = remoteAddr
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	return bucket
+}
+
+func TestIPExtractor_LoginBucket(t *testing.T) {
+	spoofed := map[string]string{
+		echo.HeaderXForwardedFor: "192.0.2.1",
+		echo.HeaderXRealIP:       "192.0.2.2",
+	}
+
+	t.Run("Success - Headers are ignored without trusted proxies", func(t *testing.T) {
+		assert.Equal(t, "198.51.100.9", loginBucket(t, nil, "198.51.100.9:52100", nil))
+		assert.Equal(t, "198.51.100.9", loginBucket(t, nil, "198.51.100.9:52100", spoofed))
+	})
+
+	t.Run("Success - Private networks are not trusted by default", func(t *testing.T) {
+		_, proxy, _ := net.ParseCIDR("203.0.113.0/24")
+
+		assert.Equal(t, "10.0.0.8", loginBucket(t, []*net.IPNet{proxy}, "10.0.0.8:52100", spoofed))
+	})
+
+	t.Run("Success - Trusted proxy forwards the client address", func(t *testing.T) {
+		_, proxy, _ := net.ParseCIDR("10.0.0.0/8")
+
+		// The client made up the first hop; the proxy appended the address it saw.
+		headers := map[string]string{echo.HeaderXForwardedFor: "192.0.2.1, 198.51.100.9"}
+
+		assert.Equal(t, "198.51.100.9", loginBucket(t, []*net.IPNet{proxy}, "10.0.0.8:52100", headers))
+	})
+}
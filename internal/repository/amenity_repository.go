@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	gormContract "go-futsal-booking-api/internal/repository/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type AmenityRepository interface {
+	// Create returns domain.ErrAmenityExists if the code is taken.
+	Create(ctx context.Context, amenity *domain.Amenity) error
+	FindAll(ctx context.Context) ([]domain.Amenity, error)
+	// FindByCodes returns the amenities with the given codes; unknown codes
+	// are skipped.
+	FindByCodes(ctx context.Context, codes []string) ([]domain.Amenity, error)
+	// Delete also removes the amenity from every venue.
+	Delete(ctx context.Context, code string) error
+}
+
+type gormAmenityRepository struct {
+	DB *gorm.DB
+}
+
+func NewAmenityRepository(db *gorm.DB) AmenityRepository {
+	return &gormAmenityRepository{
+		DB: db,
+	}
+}
+
+func (r *gormAmenityRepository) Create(ctx context.Context, amenity *domain.Amenity) error {
+	var gormAmenity gormContract.AmenityGorm
+	gormAmenity.FromDomain(*amenity)
+
+	result := dbFromContext(ctx, r.DB).Clauses(clause.OnConflict{DoNothing: true}).Create(&gormAmenity)
+	if result.Error != nil {
+		return fmt.Errorf("failed to create amenity: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrAmenityExists
+	}
+
+	*amenity = gormAmenity.ToDomain()
+
+	return nil
+}
+
+func (r *gormAmenityRepository) FindAll(ctx context.Context) ([]domain.Amenity, error) {
+	var gormAmenities []gormContract.AmenityGorm
+	if err := dbFromContext(ctx, r.DB).Order("name").Find(&gormAmenities).Error; err != nil {
+		return nil, fmt.Errorf("failed to find amenities: %w", err)
+	}
+
+	amenities := make([]domain.Amenity, len(gormAmenities))
+	for i := range gormAmenities {
+		amenities[i] = gormAmenities[i].ToDomain()
+	}
+
+	return amenities, nil
+}
+
+func (r *gormAmenityRepository) FindByCodes(ctx context.Context, codes []string) ([]domain.Amenity, error) {
+	if len(codes) == 0 {
+		return nil, nil
+	}
+
+	var gormAmenities []gormContract.AmenityGorm
+	if err := dbFromContext(ctx, r.DB).Where("code IN ?", codes).Order("code").Find(&gormAmenities).Error; err != nil {
+		return nil, fmt.Errorf("failed to find amenities: %w", err)
+	}
+
+	amenities := make([]domain.Amenity, len(gormAmenities))
+	for i := range gormAmenities {
+		amenities[i] = gormAmenities[i].ToDomain()
+	}
+
+	return amenities, nil
+}
+
+func (r *gormAmenityRepository) Delete(ctx context.Context, code string) error {
+	result := dbFromContext(ctx, r.DB).Where("code = ?", code).Delete(&gormContract.AmenityGorm{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete amenity: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrAmenityNotFound
+	}
+
+	return nil
+}
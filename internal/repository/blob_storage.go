@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// BlobStorage keeps uploaded files such as photos. Keys are slash-separated
+// paths like "venues/1/photos/ab12.jpg".
+type BlobStorage interface {
+	Put(ctx context.Context, key string, body []byte, contentType string) error
+	// Delete does not fail when the key does not exist.
+	Delete(ctx context.Context, key string) error
+	// URL is where clients can download the file.
+	URL(key string) string
+}
+
+const (
+	BlobStorageDriverLocal = "local"
+	BlobStorageDriverS3    = "s3"
+)
+
+type BlobStorageConfig struct {
+	Driver string
+	// PublicBaseURL is prefixed to keys to build download URLs.
+	PublicBaseURL string
+	// LocalDir is where the local driver writes files.
+	LocalDir string
+	S3       S3Config
+}
+
+// NewBlobStorage returns the backend selected by cfg.Driver.
+func NewBlobStorage(cfg BlobStorageConfig) (BlobStorage, error) {
+	switch strings.ToLower(cfg.Driver) {
+	case BlobStorageDriverLocal, "":
+		if cfg.LocalDir == "" {
+			return nil, fmt.Errorf("blob storage driver %q needs a directory", cfg.Driver)
+		}
+		return NewLocalBlobStorage(cfg.LocalDir, cfg.PublicBaseURL)
+	case BlobStorageDriverS3:
+		if cfg.S3.Endpoint == "" || cfg.S3.Bucket == "" {
+			return nil, fmt.Errorf("blob storage driver %q needs an endpoint and a bucket", cfg.Driver)
+		}
+		if cfg.S3.PublicBaseURL == "" {
+			cfg.S3.PublicBaseURL = cfg.PublicBaseURL
+		}
+		if cfg.S3.Timeout == 0 {
+			cfg.S3.Timeout = 30 * time.Second
+		}
+		return NewS3BlobStorage(cfg.S3), nil
+	}
+
+	return nil, fmt.Errorf("unknown blob storage driver %q", cfg.Driver)
+}
+
+// validBlobKey rejects keys that could escape the storage root.
+func validBlobKey(key string) bool {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, `\`) {
+		return false
+	}
+
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return false
+		}
+	}
+
+	return true
+}
+
+func blobURL(baseURL, key string) string {
+	return strings.TrimRight(baseURL, "/") + "/" + key
+}
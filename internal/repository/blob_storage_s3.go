@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type S3Config struct {
+	// Endpoint is the service URL, such as https://s3.ap-southeast-1.amazonaws.com
+	// or a MinIO or R2 endpoint. Objects are addressed path-style as
+	// Endpoint/Bucket/key, which every S3-compatible service supports.
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// PublicBaseURL, such as a CDN in front of the bucket, is used for download
+	// URLs. Without it they point at the bucket itself.
+	PublicBaseURL string
+	Timeout       time.Duration
+}
+
+// S3BlobStorage keeps files in an S3-compatible bucket, signing requests with
+// AWS Signature Version 4.
+type S3BlobStorage struct {
+	config S3Config
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewS3BlobStorage(cfg S3Config) *S3BlobStorage {
+	if cfg.Region == "" {
+		cfg.Region = "us-east-1"
+	}
+
+	return &S3BlobStorage{
+		config: cfg,
+		client: &http.Client{Timeout: cfg.Timeout},
+		now:    time.Now,
+	}
+}
+
+func (s *S3BlobStorage) Put(ctx context.Context, key string, body []byte, contentType string) error {
+	if !validBlobKey(key) {
+		return fmt.Errorf("invalid blob key %q", key)
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", contentType)
+
+	res, err := s.do(ctx, http.MethodPut, key, body, header)
+	if err != nil {
+		return fmt.Errorf("failed to upload blob: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return s3StatusError("upload", res)
+	}
+
+	return nil
+}
+
+func (s *S3BlobStorage) Delete(ctx context.Context, key string) error {
+	if !validBlobKey(key) {
+		return fmt.Errorf("invalid blob key %q", key)
+	}
+
+	res, err := s.do(ctx, http.MethodDelete, key, nil, http.Header{})
+	if err != nil {
+		return fmt.Errorf("failed to delete blob: %w", err)
+	}
+	defer res.Body.Close()
+
+	// S3 answers 204 whether or not the object existed; some compatible
+	// services answer 404.
+	if res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNotFound {
+		return s3StatusError("delete", res)
+	}
+
+	return nil
+}
+
+func (s *S3BlobStorage) URL(key string) string {
+	if s.config.PublicBaseURL != "" {
+		return blobURL(s.config.PublicBaseURL, key)
+	}
+
+	return s.objectURL(key)
+}
+
+func (s *S3BlobStorage) objectURL(key string) string {
+	return strings.TrimRight(s.config.Endpoint, "/") + s.objectPath(key)
+}
+
+func (s *S3BlobStorage) objectPath(key string) string {
+	return "/" + s3Escape(s.config.Bucket) + "/" + s3EscapePath(key)
+}
+
+func (s *S3BlobStorage) do(ctx context.Context, method, key string, body []byte, header http.Header) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.objectURL(key), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	s.sign(req, s.objectPath(key), body)
+
+	return s.client.Do(req)
+}
+
+// sign adds a Signature Version 4 Authorization header covering the host, the
+// payload hash and the request time.
+func (s *S3BlobStorage) sign(req *http.Request, path string, body []byte) {
+	now := s.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	payloadHash := sha256.Sum256(body)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(payloadHash[:]))
+
+	const signedHeaders = "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		"",
+		"host:" + req.URL.Host,
+		"x-amz-content-sha256:" + hex.EncodeToString(payloadHash[:]),
+		"x-amz-date:" + amzDate,
+		"",
+		signedHeaders,
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+
+	scope := date + "/" + s.config.Region + "/s3/aws4_request"
+	canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(canonicalHash[:])
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.config.SecretAccessKey), date)
+	signingKey = hmacSHA256(signingKey, s.config.Region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.config.AccessKeyID, scope, signedHeaders, signature,
+	))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+
+	return mac.Sum(nil)
+}
+
+// s3Escape percent-encodes everything except the RFC 3986 unreserved
+// characters, as Signature Version 4 requires.
+func s3Escape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+
+	return b.String()
+}
+
+func s3EscapePath(key string) string {
+	parts := strings.Split(key, "/")
+	for i, part := range parts {
+		parts[i] = s3Escape(part)
+	}
+
+	return strings.Join(parts, "/")
+}
+
+func s3StatusError(action string, res *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+
+	return fmt.Errorf("failed to %s blob: s3 returned %d: %s", action, res.StatusCode, strings.TrimSpace(string(body)))
+}
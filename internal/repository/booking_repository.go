@@ -3,17 +3,46 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
 	"go-futsal-booking-api/internal/domain"
 	gormContract "go-futsal-booking-api/internal/repository/model"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type BookingRepository interface {
 	Create(ctx context.Context, booking *domain.Booking) error
 	FindByID(ctx context.Context, id uint) (domain.Booking, error)
 	FindByUserID(ctx context.Context, userID uint) ([]*domain.Booking, error)
+	FindByVenueID(ctx context.Context, venueID uint) ([]*domain.Booking, error)
 	CancelBooking(ctx context.Context, bookingID uint) error
+	UpdateStatus(ctx context.Context, bookingID uint, status string) error
+	// Confirm moves a PENDING booking to CONFIRMED. It returns false when the
+	// booking was not PENDING, so two payments recorded at once cannot both
+	// confirm it.
+	Confirm(ctx context.Context, bookingID uint, at time.Time) (bool, error)
+	// CheckIn moves a CONFIRMED booking to CHECKED_IN. It returns false when
+	// the booking was not CONFIRMED, so two desks scanning the same code at
+	// once cannot both check it in.
+	CheckIn(ctx context.Context, bookingID uint, at time.Time) (bool, error)
+	// FindDueForReminder returns active bookings starting within offset of now,
+	// in the venue's timezone, that have not had the reminder for that offset yet. Bookings made after
+	// the reminder window opened are skipped.
+	FindDueForReminder(ctx context.Context, now time.Time, offset time.Duration, limit int) ([]*domain.Booking, error)
+	// MarkReminderSent claims the reminder for a booking and offset. It returns
+	// false when it was already claimed, by this or another instance.
+	MarkReminderSent(ctx context.Context, bookingID uint, offset time.Duration, at time.Time) (bool, error)
+	// FindActiveByVenueBetween returns the pending, confirmed and checked-in
+	// bookings of a venue dated from fromDate to toDate, both inclusive.
+	FindActiveByVenueBetween(ctx context.Context, venueID uint, fromDate, toDate time.Time) ([]*domain.Booking, error)
+	// FindUpcoming returns the pending and confirmed bookings in scope that
+	// have not ended at now, earliest first.
+	FindUpcoming(ctx context.Context, scope domain.DeletionScope, now time.Time) ([]*domain.Booking, error)
+	// CompleteFinished moves up to limit confirmed and checked-in bookings whose
+	// slot ended by now, in the venue's timezone, to COMPLETED.
+	CompleteFinished(ctx context.Context, now time.Time, limit int) (int64, error)
 }
 
 type gormBookingRepository struct {
@@ -25,14 +54,14 @@ func NewBookingRepository(db *gorm.DB) BookingRepository {
 }
 
 func (r *gormBookingRepository) preload(ctx context.Context) *gorm.DB {
-	return r.DB.WithContext(ctx).Preload("User.Role").Preload("Schedule.Field.Venue")
+	return dbFromContext(ctx, r.DB).Preload("User.Role").Preload("Schedule.Field.Venue")
 }
 
 func (r *gormBookingRepository) Create(ctx context.Context, booking *domain.Booking) error {
 	var gormBooking gormContract.BookingGorm
 	gormBooking.FromDomain(*booking)
 
-	err := r.DB.WithContext(ctx).Create(&gormBooking).Error
+	err := dbFromContext(ctx, r.DB).Create(&gormBooking).Error
 	if err != nil {
 		return err
 	}
@@ -82,6 +111,190 @@ func (r *gormBookingRepository) FindByUserID(ctx context.Context, userID uint) (
 	return bookings, nil
 }
 
+func (r *gormBookingRepository) FindByVenueID(ctx context.Context, venueID uint) ([]*domain.Booking, error) {
+	var gormBookings []gormContract.BookingGorm
+
+	err := r.preload(ctx).
+		Joins("JOIN schedules ON schedules.id = bookings.schedule_id").
+		Joins("JOIN fields ON fields.id = schedules.field_id").
+		Where("fields.venue_id = ?", venueID).
+		Order("bookings.booking_date DESC").
+		Find(&gormBookings).Error
+	if err != nil {
+		return nil, err
+	}
+
+	bookings := make([]*domain.Booking, len(gormBookings))
+	for i, gb := range gormBookings {
+		b := gb.ToDomain()
+		bookings[i] = &b
+	}
+	return bookings, nil
+}
+
+func (r *gormBookingRepository) FindActiveByVenueBetween(ctx context.Context, venueID uint, fromDate, toDate time.Time) ([]*domain.Booking, error) {
+	var gormBookings []gormContract.BookingGorm
+
+	err := r.preload(ctx).
+		Joins("JOIN schedules ON schedules.id = bookings.schedule_id").
+		Joins("JOIN fields ON fields.id = schedules.field_id").
+		Where("fields.venue_id = ?", venueID).
+		Where("bookings.booking_date BETWEEN ?::date AND ?::date", fromDate.Format("2006-01-02"), toDate.Format("2006-01-02")).
+		Where("bookings.status IN ?", []string{domain.BookingStatusPending, domain.BookingStatusConfirmed, domain.BookingStatusCheckedIn}).
+		Order("bookings.booking_date, schedules.start_time").
+		Find(&gormBookings).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find venue bookings: %w", err)
+	}
+
+	bookings := make([]*domain.Booking, len(gormBookings))
+	for i, gb := range gormBookings {
+		b := gb.ToDomain()
+		bookings[i] = &b
+	}
+	return bookings, nil
+}
+
+func (r *gormBookingRepository) FindUpcoming(ctx context.Context, scope domain.DeletionScope, now time.Time) ([]*domain.Booking, error) {
+	var gormBookings []gormContract.BookingGorm
+
+	query := r.preload(ctx).
+		Joins("JOIN schedules ON schedules.id = bookings.schedule_id").
+		Joins("JOIN fields ON fields.id = schedules.field_id").
+		Joins("JOIN venues ON venues.id = fields.venue_id").
+		Where("bookings.status IN ?", []string{domain.BookingStatusPending, domain.BookingStatusConfirmed}).
+		Where("(bookings.booking_date + schedules.end_time::time) AT TIME ZONE venues.timezone > ?", now)
+
+	switch {
+	case scope.ScheduleID != 0:
+		query = query.Where("schedules.id = ?", scope.ScheduleID)
+	case scope.FieldID != 0:
+		query = query.Where("fields.id = ?", scope.FieldID)
+	default:
+		query = query.Where("venues.id = ?", scope.VenueID)
+	}
+
+	err := query.Order("bookings.booking_date, schedules.start_time, bookings.id").Find(&gormBookings).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find upcoming bookings: %w", err)
+	}
+
+	bookings := make([]*domain.Booking, len(gormBookings))
+	for i, gb := range gormBookings {
+		b := gb.ToDomain()
+		bookings[i] = &b
+	}
+	return bookings, nil
+}
+
 func (r *gormBookingRepository) CancelBooking(ctx context.Context, bookingID uint) error {
-	return r.DB.WithContext(ctx).Model(&gormContract.BookingGorm{}).Where("id = ?", bookingID).Update("status", "cancelled").Error
+	return r.UpdateStatus(ctx, bookingID, domain.BookingStatusCancelled)
+}
+
+func (r *gormBookingRepository) UpdateStatus(ctx context.Context, bookingID uint, status string) error {
+	err := dbFromContext(ctx, r.DB).Model(&gormContract.BookingGorm{}).Where("id = ?", bookingID).Update("status", status).Error
+	if err != nil {
+		return fmt.Errorf("failed to update booking status: %w", err)
+	}
+
+	return nil
+}
+
+func (r *gormBookingRepository) Confirm(ctx context.Context, bookingID uint, at time.Time) (bool, error) {
+	result := dbFromContext(ctx, r.DB).Model(&gormContract.BookingGorm{}).
+		Where("id = ? AND status = ?", bookingID, domain.BookingStatusPending).
+		Updates(map[string]any{
+			"status":     domain.BookingStatusConfirmed,
+			"updated_at": at,
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to confirm booking: %w", result.Error)
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+func (r *gormBookingRepository) CheckIn(ctx context.Context, bookingID uint, at time.Time) (bool, error) {
+	result := dbFromContext(ctx, r.DB).Model(&gormContract.BookingGorm{}).
+		Where("id = ? AND status = ?", bookingID, domain.BookingStatusConfirmed).
+		Updates(map[string]any{
+			"status":        domain.BookingStatusCheckedIn,
+			"checked_in_at": at,
+			"updated_at":    at,
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to check in booking: %w", result.Error)
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+func (r *gormBookingRepository) FindDueForReminder(ctx context.Context, now time.Time, offset time.Duration, limit int) ([]*domain.Booking, error) {
+	var gormBookings []gormContract.BookingGorm
+
+	// Schedules are in the venue's local time and bookings.created_at in UTC.
+	const startsAt = "((bookings.booking_date + schedules.start_time::time) AT TIME ZONE venues.timezone)"
+	window := fmt.Sprintf("%d minutes", int(offset.Minutes()))
+
+	err := r.preload(ctx).
+		Joins("JOIN schedules ON schedules.id = bookings.schedule_id").
+		Joins("JOIN fields ON fields.id = schedules.field_id").
+		Joins("JOIN venues ON venues.id = fields.venue_id").
+		Where("bookings.status IN ?", []string{domain.BookingStatusPending, domain.BookingStatusConfirmed}).
+		Where(startsAt+" > ?", now).
+		Where(startsAt+" <= ?", now.Add(offset)).
+		Where("bookings.created_at < ("+startsAt+" - ?::interval) AT TIME ZONE 'UTC'", window).
+		Where("NOT EXISTS (SELECT 1 FROM booking_reminders br WHERE br.booking_id = bookings.id AND br.offset_minutes = ?)", int(offset.Minutes())).
+		Order(startsAt).
+		Limit(limit).
+		Find(&gormBookings).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find bookings due for reminder: %w", err)
+	}
+
+	bookings := make([]*domain.Booking, len(gormBookings))
+	for i, gb := range gormBookings {
+		b := gb.ToDomain()
+		bookings[i] = &b
+	}
+	return bookings, nil
+}
+
+func (r *gormBookingRepository) MarkReminderSent(ctx context.Context, bookingID uint, offset time.Duration, at time.Time) (bool, error) {
+	reminder := gormContract.BookingReminderGorm{
+		BookingID:     bookingID,
+		OffsetMinutes: int(offset.Minutes()),
+		SentAt:        at,
+	}
+
+	result := dbFromContext(ctx, r.DB).Clauses(clause.OnConflict{DoNothing: true}).Create(&reminder)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to mark reminder sent: %w", result.Error)
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+func (r *gormBookingRepository) CompleteFinished(ctx context.Context, now time.Time, limit int) (int64, error) {
+	batch := dbFromContext(ctx, r.DB).Model(&gormContract.BookingGorm{}).
+		Select("bookings.id").
+		Joins("JOIN schedules ON schedules.id = bookings.schedule_id").
+		Joins("JOIN fields ON fields.id = schedules.field_id").
+		Joins("JOIN venues ON venues.id = fields.venue_id").
+		Where("bookings.status IN ?", []string{domain.BookingStatusConfirmed, domain.BookingStatusCheckedIn}).
+		Where("(bookings.booking_date + schedules.end_time::time) AT TIME ZONE venues.timezone <= ?", now).
+		Order("bookings.id").
+		Limit(limit)
+
+	result := dbFromContext(ctx, r.DB).Model(&gormContract.BookingGorm{}).
+		Where("id IN (?)", batch).
+		Updates(map[string]any{
+			"status":     domain.BookingStatusCompleted,
+			"updated_at": now,
+		})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to complete finished bookings: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
 }
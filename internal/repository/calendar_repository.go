@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	gormContract "go-futsal-booking-api/internal/repository/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CalendarRepository interface {
+	// SaveFeed replaces the user's feed token, so older subscription URLs stop
+	// working.
+	SaveFeed(ctx context.Context, feed *domain.CalendarFeed) error
+	FindFeedByTokenHash(ctx context.Context, tokenHash string) (domain.CalendarFeed, error)
+}
+
+type gormCalendarRepository struct {
+	DB *gorm.DB
+}
+
+func NewCalendarRepository(db *gorm.DB) CalendarRepository {
+	return &gormCalendarRepository{
+		DB: db,
+	}
+}
+
+func (r *gormCalendarRepository) SaveFeed(ctx context.Context, feed *domain.CalendarFeed) error {
+	var gormFeed gormContract.CalendarFeedGorm
+	gormFeed.FromDomain(*feed)
+
+	err := dbFromContext(ctx, r.DB).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"token_hash", "created_at"}),
+	}).Create(&gormFeed).Error
+	if err != nil {
+		return fmt.Errorf("failed to save calendar feed: %w", err)
+	}
+
+	*feed = gormFeed.ToDomain()
+
+	return nil
+}
+
+func (r *gormCalendarRepository) FindFeedByTokenHash(ctx context.Context, tokenHash string) (domain.CalendarFeed, error) {
+	var gormFeed gormContract.CalendarFeedGorm
+
+	err := dbFromContext(ctx, r.DB).Where("token_hash = ?", tokenHash).First(&gormFeed).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.CalendarFeed{}, domain.ErrInvalidCalendarToken
+		}
+		return domain.CalendarFeed{}, fmt.Errorf("failed to find calendar feed: %w", err)
+	}
+
+	return gormFeed.ToDomain(), nil
+}
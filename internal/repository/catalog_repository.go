@@ -0,0 +1,397 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	gormContract "go-futsal-booking-api/internal/repository/model"
+
+	"gorm.io/gorm"
+)
+
+// CatalogRepository imports and exports venues, fields and schedules in bulk.
+type CatalogRepository interface {
+	// Import creates the venues, fields and schedules of the rows that do not
+	// exist yet and updates the ones that do, all in one transaction. New
+	// venues are owned by ownerID. Rows with an unknown ID, a venue name
+	// shared by several venues, a name or start time taken by another field
+	// or schedule, or a deleted venue, field or schedule fail the import with
+	// a *domain.CatalogImportError.
+	Import(ctx context.Context, rows []domain.CatalogRow, ownerID uint) (domain.CatalogImport, error)
+	// Export returns every venue, field and schedule as rows ordered by venue
+	// name, field name, day and start time.
+	Export(ctx context.Context) ([]domain.CatalogRow, error)
+}
+
+type gormCatalogRepository struct {
+	DB *gorm.DB
+}
+
+func NewCatalogRepository(db *gorm.DB) CatalogRepository {
+	return &gormCatalogRepository{
+		DB: db,
+	}
+}
+
+// catalogConflict is a row that cannot be applied to the stored catalog as it
+// is written. Import reports it as a row error.
+type catalogConflict struct {
+	column  string
+	message string
+}
+
+func (c *catalogConflict) Error() string {
+	return c.message
+}
+
+// catalogDeleted reports a row whose venue, field or schedule is deleted. The
+// unique keys include deleted rows, so it has to be restored instead.
+func catalogDeleted(column, kind string) error {
+	return &catalogConflict{column: column, message: kind + " is deleted; restore it instead of importing it again"}
+}
+
+type catalogFieldKey struct {
+	venueID uint
+	name    string
+}
+
+func (r *gormCatalogRepository) Import(ctx context.Context, rows []domain.CatalogRow, ownerID uint) (domain.CatalogImport, error) {
+	var result domain.CatalogImport
+	var rowErrs []domain.CatalogRowError
+
+	// The rows of one venue or field agree on its name and ID, so the name
+	// identifies it within the file.
+	err := dbFromContext(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		// A zero id marks a venue or field that failed on an earlier row, so
+		// its later rows are skipped instead of reported again.
+		venueIDs := make(map[string]uint)
+		fieldIDs := make(map[catalogFieldKey]uint)
+
+		conflict := func(row domain.CatalogRow, err error) error {
+			var c *catalogConflict
+			if errors.As(err, &c) {
+				rowErrs = append(rowErrs, domain.CatalogRowError{Row: row.Row, Column: c.column, Message: c.message})
+				return nil
+			}
+			return err
+		}
+
+		for _, row := range rows {
+			venueID, seen := venueIDs[row.Venue.Name]
+			if !seen {
+				id, err := importCatalogVenue(tx, row.Venue, ownerID, &result)
+				if err := conflict(row, err); err != nil {
+					return err
+				}
+				venueID = id
+				venueIDs[row.Venue.Name] = id
+			}
+			if venueID == 0 || row.Field == nil {
+				continue
+			}
+
+			key := catalogFieldKey{venueID: venueID, name: row.Field.Name}
+			fieldID, seen := fieldIDs[key]
+			if !seen {
+				id, err := importCatalogField(tx, venueID, *row.Field, &result)
+				if err := conflict(row, err); err != nil {
+					return err
+				}
+				fieldID = id
+				fieldIDs[key] = id
+			}
+			if fieldID == 0 || row.Schedule == nil {
+				continue
+			}
+
+			err := importCatalogSchedule(tx, fieldID, *row.Schedule, &result)
+			if err := conflict(row, err); err != nil {
+				return err
+			}
+		}
+
+		if len(rowErrs) > 0 {
+			return &domain.CatalogImportError{Rows: rowErrs}
+		}
+
+		return nil
+	})
+	if err != nil {
+		var importErr *domain.CatalogImportError
+		if errors.As(err, &importErr) {
+			return domain.CatalogImport{}, err
+		}
+		return domain.CatalogImport{}, fmt.Errorf("failed to import catalog: %w", err)
+	}
+
+	return result, nil
+}
+
+// importCatalogVenue finds the venue by ID, or by name when the row has none.
+// Venue names are not unique, so a name shared by several venues is refused.
+func importCatalogVenue(tx *gorm.DB, venue domain.Venue, ownerID uint, result *domain.CatalogImport) (uint, error) {
+	var existing gormContract.VenueGorm
+	column := "venue_name"
+
+	if venue.ID != 0 {
+		column = "venue_id"
+		err := tx.Unscoped().Take(&existing, venue.ID).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, &catalogConflict{column: column, message: "venue does not exist"}
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to find venue: %w", err)
+		}
+	} else {
+		var matches []gormContract.VenueGorm
+		if err := tx.Unscoped().Where("name = ?", venue.Name).Order("id").Limit(2).Find(&matches).Error; err != nil {
+			return 0, fmt.Errorf("failed to find venue: %w", err)
+		}
+
+		switch len(matches) {
+		case 0:
+			var gormVenue gormContract.VenueGorm
+			gormVenue.FromDomain(venue)
+			if err := tx.Create(&gormVenue).Error; err != nil {
+				return 0, fmt.Errorf("failed to create venue: %w", err)
+			}
+
+			// As with venues created one by one, the importer owns the new venue.
+			owner := gormContract.VenueMemberGorm{VenueID: gormVenue.ID, UserID: ownerID, Role: domain.VenueRoleOwner}
+			if err := tx.Omit("Venue", "User").Create(&owner).Error; err != nil {
+				return 0, fmt.Errorf("failed to register venue owner: %w", err)
+			}
+
+			result.VenuesCreated++
+			return gormVenue.ID, nil
+		case 1:
+			existing = matches[0]
+		default:
+			return 0, &catalogConflict{column: column, message: "more than one venue has this name; set venue_id to pick one"}
+		}
+	}
+
+	if existing.DeletedAt.Valid {
+		return 0, catalogDeleted(column, "venue")
+	}
+
+	if existing.Name == venue.Name && existing.Address == venue.Address && existing.City == venue.City && existing.Timezone == venue.Timezone {
+		return existing.ID, nil
+	}
+
+	err := tx.Model(&existing).Updates(map[string]any{
+		"name":     venue.Name,
+		"address":  venue.Address,
+		"city":     venue.City,
+		"timezone": venue.Timezone,
+	}).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to update venue: %w", err)
+	}
+
+	result.VenuesUpdated++
+	return existing.ID, nil
+}
+
+// importCatalogField finds the field by ID within the venue, or by name when
+// the row has none.
+func importCatalogField(tx *gorm.DB, venueID uint, field domain.Field, result *domain.CatalogImport) (uint, error) {
+	var existing gormContract.FieldGorm
+	column := "field_name"
+
+	if field.ID != 0 {
+		column = "field_id"
+		err := tx.Unscoped().Where("id = ? AND venue_id = ?", field.ID, venueID).Take(&existing).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, &catalogConflict{column: column, message: "field does not exist in this venue"}
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to find field: %w", err)
+		}
+	} else {
+		err := tx.Unscoped().Where("venue_id = ? AND name = ?", venueID, field.Name).Take(&existing).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			var gormField gormContract.FieldGorm
+			gormField.FromDomain(field)
+			gormField.VenueID = venueID
+			if err := tx.Omit("Venue").Create(&gormField).Error; err != nil {
+				return 0, fmt.Errorf("failed to create field: %w", err)
+			}
+
+			result.FieldsCreated++
+			return gormField.ID, nil
+		case err != nil:
+			return 0, fmt.Errorf("failed to find field: %w", err)
+		}
+	}
+
+	if existing.DeletedAt.Valid {
+		return 0, catalogDeleted(column, "field")
+	}
+
+	if existing.Name == field.Name && existing.Type == field.Type && existing.Indoor == field.Indoor &&
+		existing.Lighting == field.HasLighting && existing.MaxPlayers == field.MaxPlayers {
+		return existing.ID, nil
+	}
+
+	if existing.Name != field.Name {
+		var taken int64
+		err := tx.Unscoped().Model(&gormContract.FieldGorm{}).
+			Where("venue_id = ? AND name = ? AND id <> ?", venueID, field.Name, existing.ID).
+			Count(&taken).Error
+		if err != nil {
+			return 0, fmt.Errorf("failed to find field: %w", err)
+		}
+		if taken > 0 {
+			return 0, &catalogConflict{column: "field_name", message: "another field of this venue has this name"}
+		}
+	}
+
+	// Status and dimensions are not part of the catalog and keep their values.
+	err := tx.Model(&existing).Updates(map[string]any{
+		"name":         field.Name,
+		"type":         field.Type,
+		"is_indoor":    field.Indoor,
+		"has_lighting": field.HasLighting,
+		"max_players":  field.MaxPlayers,
+	}).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to update field: %w", err)
+	}
+
+	result.FieldsUpdated++
+	return existing.ID, nil
+}
+
+// importCatalogSchedule finds the schedule by ID within the field, or by day
+// and start time when the row has none.
+func importCatalogSchedule(tx *gorm.DB, fieldID uint, schedule domain.Schedule, result *domain.CatalogImport) error {
+	var existing gormContract.ScheduleGorm
+	column := "start_time"
+	startTime := schedule.StartTime.Format("15:04:05")
+
+	if schedule.ID != 0 {
+		column = "schedule_id"
+		err := tx.Unscoped().Where("id = ? AND field_id = ?", schedule.ID, fieldID).Take(&existing).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &catalogConflict{column: column, message: "schedule does not exist on this field"}
+		}
+		if err != nil {
+			return fmt.Errorf("failed to find schedule: %w", err)
+		}
+	} else {
+		err := tx.Unscoped().
+			Where("field_id = ? AND day_of_week = ? AND start_time = ?", fieldID, schedule.DayOfWeek, startTime).
+			Take(&existing).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			var gormSchedule gormContract.ScheduleGorm
+			gormSchedule.FromDomain(schedule)
+			gormSchedule.FieldID = fieldID
+			if err := tx.Omit("Field").Create(&gormSchedule).Error; err != nil {
+				return fmt.Errorf("failed to create schedule: %w", err)
+			}
+
+			result.SchedulesCreated++
+			return nil
+		case err != nil:
+			return fmt.Errorf("failed to find schedule: %w", err)
+		}
+	}
+
+	if existing.DeletedAt.Valid {
+		return catalogDeleted(column, "schedule")
+	}
+
+	moved := existing.DayOfWeek != schedule.DayOfWeek || existing.StartTime.Format("15:04:05") != startTime
+	if !moved && existing.EndTime.Format("15:04:05") == schedule.EndTime.Format("15:04:05") && existing.Price == schedule.Price {
+		return nil
+	}
+
+	if moved {
+		var taken int64
+		err := tx.Unscoped().Model(&gormContract.ScheduleGorm{}).
+			Where("field_id = ? AND day_of_week = ? AND start_time = ? AND id <> ?", fieldID, schedule.DayOfWeek, startTime, existing.ID).
+			Count(&taken).Error
+		if err != nil {
+			return fmt.Errorf("failed to find schedule: %w", err)
+		}
+		if taken > 0 {
+			return &catalogConflict{column: "start_time", message: "another schedule of this field starts at this time"}
+		}
+	}
+
+	err := tx.Model(&existing).Updates(map[string]any{
+		"day_of_week": schedule.DayOfWeek,
+		"start_time":  gormContract.NewTimeOfDay(schedule.StartTime),
+		"end_time":    gormContract.NewTimeOfDay(schedule.EndTime),
+		"price":       schedule.Price,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to update schedule: %w", err)
+	}
+
+	result.SchedulesUpdated++
+	return nil
+}
+
+func (r *gormCatalogRepository) Export(ctx context.Context) ([]domain.CatalogRow, error) {
+	db := dbFromContext(ctx, r.DB)
+
+	var gormVenues []gormContract.VenueGorm
+	if err := db.Order("name").Find(&gormVenues).Error; err != nil {
+		return nil, fmt.Errorf("failed to find venues: %w", err)
+	}
+
+	var gormFields []gormContract.FieldGorm
+	if err := db.Order("name").Find(&gormFields).Error; err != nil {
+		return nil, fmt.Errorf("failed to find fields: %w", err)
+	}
+
+	var gormSchedules []gormContract.ScheduleGorm
+	if err := db.Order("day_of_week, start_time").Find(&gormSchedules).Error; err != nil {
+		return nil, fmt.Errorf("failed to find schedules: %w", err)
+	}
+
+	fieldsByVenue := make(map[uint][]gormContract.FieldGorm)
+	for _, f := range gormFields {
+		fieldsByVenue[f.VenueID] = append(fieldsByVenue[f.VenueID], f)
+	}
+
+	schedulesByField := make(map[uint][]gormContract.ScheduleGorm)
+	for _, s := range gormSchedules {
+		schedulesByField[s.FieldID] = append(schedulesByField[s.FieldID], s)
+	}
+
+	var rows []domain.CatalogRow
+	for i := range gormVenues {
+		venue := gormVenues[i].ToDomain()
+
+		fields := fieldsByVenue[venue.ID]
+		if len(fields) == 0 {
+			rows = append(rows, domain.CatalogRow{Venue: venue})
+			continue
+		}
+
+		for j := range fields {
+			field := fields[j].ToDomain()
+			field.Venue = venue
+
+			schedules := schedulesByField[field.ID]
+			if len(schedules) == 0 {
+				rows = append(rows, domain.CatalogRow{Venue: venue, Field: &field})
+				continue
+			}
+
+			for k := range schedules {
+				schedule := schedules[k].ToDomain()
+				schedule.Field = field
+				rows = append(rows, domain.CatalogRow{Venue: venue, Field: &field, Schedule: &schedule})
+			}
+		}
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	gormContract "go-futsal-booking-api/internal/repository/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type FavouriteRepository interface {
+	// AddVenue and AddField do nothing when the favourite already exists.
+	AddVenue(ctx context.Context, userID, venueID uint) error
+	AddField(ctx context.Context, userID, fieldID uint) error
+	// RemoveVenue and RemoveField return domain.ErrFavouriteNotFound when there
+	// was nothing to remove.
+	RemoveVenue(ctx context.Context, userID, venueID uint) error
+	RemoveField(ctx context.Context, userID, fieldID uint) error
+	// FindByUserID returns the user's favourites, most recently added first.
+	FindByUserID(ctx context.Context, userID uint) (domain.Favourites, error)
+}
+
+type gormFavouriteRepository struct {
+	DB *gorm.DB
+}
+
+func NewFavouriteRepository(db *gorm.DB) FavouriteRepository {
+	return &gormFavouriteRepository{DB: db}
+}
+
+func (r *gormFavouriteRepository) AddVenue(ctx context.Context, userID, venueID uint) error {
+	favourite := gormContract.FavouriteVenueGorm{UserID: userID, VenueID: venueID}
+
+	err := dbFromContext(ctx, r.DB).Omit("Venue").Clauses(clause.OnConflict{DoNothing: true}).Create(&favourite).Error
+	if err != nil {
+		return fmt.Errorf("failed to add favourite venue: %w", err)
+	}
+
+	return nil
+}
+
+func (r *gormFavouriteRepository) AddField(ctx context.Context, userID, fieldID uint) error {
+	favourite := gormContract.FavouriteFieldGorm{UserID: userID, FieldID: fieldID}
+
+	err := dbFromContext(ctx, r.DB).Omit("Field").Clauses(clause.OnConflict{DoNothing: true}).Create(&favourite).Error
+	if err != nil {
+		return fmt.Errorf("failed to add favourite field: %w", err)
+	}
+
+	return nil
+}
+
+func (r *gormFavouriteRepository) RemoveVenue(ctx context.Context, userID, venueID uint) error {
+	result := dbFromContext(ctx, r.DB).
+		Where("user_id = ? AND venue_id = ?", userID, venueID).
+		Delete(&gormContract.FavouriteVenueGorm{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to remove favourite venue: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrFavouriteNotFound
+	}
+
+	return nil
+}
+
+func (r *gormFavouriteRepository) RemoveField(ctx context.Context, userID, fieldID uint) error {
+	result := dbFromContext(ctx, r.DB).
+		Where("user_id = ? AND field_id = ?", userID, fieldID).
+		Delete(&gormContract.FavouriteFieldGorm{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to remove favourite field: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrFavouriteNotFound
+	}
+
+	return nil
+}
+
+func (r *gormFavouriteRepository) FindByUserID(ctx context.Context, userID uint) (domain.Favourites, error) {
+	db := dbFromContext(ctx, r.DB)
+
+	var venueRows []gormContract.FavouriteVenueGorm
+	err := db.InnerJoins("Venue").
+		Where("favourite_venues.user_id = ?", userID).
+		Order("favourite_venues.created_at DESC").
+		Find(&venueRows).Error
+	if err != nil {
+		return domain.Favourites{}, fmt.Errorf("failed to find favourite venues: %w", err)
+	}
+
+	var fieldRows []gormContract.FavouriteFieldGorm
+	err = db.InnerJoins("Field").Preload("Field.Venue").
+		Where("favourite_fields.user_id = ?", userID).
+		Order("favourite_fields.created_at DESC").
+		Find(&fieldRows).Error
+	if err != nil {
+		return domain.Favourites{}, fmt.Errorf("failed to find favourite fields: %w", err)
+	}
+
+	favourites := domain.Favourites{
+		Venues: make([]domain.Venue, len(venueRows)),
+		Fields: make([]domain.Field, len(fieldRows)),
+	}
+
+	details := make([]*domain.Venue, len(venueRows))
+	for i := range venueRows {
+		favourites.Venues[i] = venueRows[i].Venue.ToDomain()
+		details[i] = &favourites.Venues[i]
+	}
+	if err := loadVenueDetails(db, details...); err != nil {
+		return domain.Favourites{}, err
+	}
+
+	for i := range fieldRows {
+		favourites.Fields[i] = fieldRows[i].Field.ToDomain()
+	}
+
+	return favourites, nil
+}
@@ -16,7 +16,16 @@ type FieldRepository interface {
 	FindByID(ctx context.Context, id uint) (domain.Field, error)
 	FindByVenueID(ctx context.Context, venueID uint) ([]domain.Field, error)
 	Update(ctx context.Context, field *domain.Field) error
-	Delete(ctx context.Context, id uint) error
+	// Delete soft-deletes the field together with its schedules, all stamped
+	// with at so Restore can bring back the same set.
+	Delete(ctx context.Context, id uint, at time.Time) error
+	// DeletionImpact counts the schedules Delete would take along.
+	DeletionImpact(ctx context.Context, id uint) (domain.DeletionImpact, error)
+	// FindDeletedByID returns domain.ErrNotDeleted for a field that is not
+	// deleted. The venue is loaded even when it is deleted too.
+	FindDeletedByID(ctx context.Context, id uint) (domain.Field, error)
+	// Restore undeletes the field and the schedules deleted with it.
+	Restore(ctx context.Context, id uint) error
 }
 
 type gormFieldRepository struct {
@@ -66,7 +75,7 @@ func (r *gormFieldRepository) FindByID(ctx context.Context, id uint) (domain.Fie
 	err := r.preloadVenue(ctx).Where("deleted_at IS NULL").First(&gormField, id).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return domain.Field{}, errors.New("field not found")
+			return domain.Field{}, domain.ErrFieldNotFound
 		}
 		return domain.Field{}, fmt.Errorf("failed to find field: %w", err)
 	}
@@ -103,9 +112,15 @@ func (r *gormFieldRepository) Update(ctx context.Context, field *domain.Field) e
 	gormField.FromDomain(*field)
 
 	updates := map[string]interface{}{
-		"name":       gormField.Name,
-		"type":       gormField.Type,
-		"updated_at": time.Now(),
+		"name":         gormField.Name,
+		"type":         gormField.Type,
+		"length_m":     gormField.LengthM,
+		"width_m":      gormField.WidthM,
+		"is_indoor":    gormField.Indoor,
+		"has_lighting": gormField.Lighting,
+		"max_players":  gormField.MaxPlayers,
+		"status":       gormField.Status,
+		"updated_at":   time.Now(),
 	}
 
 	result := r.DB.WithContext(ctx).Model(&gormField).Where("id = ? AND deleted_at IS NULL", field.ID).Updates(updates)
@@ -126,17 +141,91 @@ func (r *gormFieldRepository) Update(ctx context.Context, field *domain.Field) e
 	return nil
 }
 
-func (r *gormFieldRepository) Delete(ctx context.Context, id uint) error {
+func (r *gormFieldRepository) Delete(ctx context.Context, id uint, at time.Time) error {
 	if err := ctx.Err(); err != nil {
 		return fmt.Errorf("context error: %w", err)
 	}
 
-	result := r.DB.WithContext(ctx).Model(&gormContract.FieldGorm{}).Where("id = ? AND deleted_at IS NULL", id).Update("deleted_at", time.Now())
-	if result.Error != nil {
-		return fmt.Errorf("failed to delete field: %w", result.Error)
+	err := dbFromContext(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&gormContract.FieldGorm{}).Where("id = ?", id).Update("deleted_at", at)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrFieldNotFound
+		}
+
+		return tx.Model(&gormContract.ScheduleGorm{}).Where("field_id = ?", id).Update("deleted_at", at).Error
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrFieldNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to delete field: %w", err)
 	}
-	if result.RowsAffected == 0 {
-		return errors.New("field not found or already deleted")
+
+	return nil
+}
+
+func (r *gormFieldRepository) DeletionImpact(ctx context.Context, id uint) (domain.DeletionImpact, error) {
+	var impact domain.DeletionImpact
+
+	err := dbFromContext(ctx, r.DB).Model(&gormContract.ScheduleGorm{}).Where("field_id = ?", id).Count(&impact.Schedules).Error
+	if err != nil {
+		return domain.DeletionImpact{}, fmt.Errorf("failed to count field schedules: %w", err)
+	}
+
+	return impact, nil
+}
+
+func (r *gormFieldRepository) FindDeletedByID(ctx context.Context, id uint) (domain.Field, error) {
+	var gormField gormContract.FieldGorm
+
+	err := dbFromContext(ctx, r.DB).Unscoped().
+		Preload("Venue", func(db *gorm.DB) *gorm.DB { return db.Unscoped() }).
+		First(&gormField, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.Field{}, domain.ErrFieldNotFound
+		}
+		return domain.Field{}, fmt.Errorf("failed to find field: %w", err)
+	}
+
+	if !gormField.DeletedAt.Valid {
+		return domain.Field{}, domain.ErrNotDeleted
+	}
+
+	return gormField.ToDomain(), nil
+}
+
+func (r *gormFieldRepository) Restore(ctx context.Context, id uint) error {
+	err := dbFromContext(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		deletedAt := tx.Unscoped().Model(&gormContract.FieldGorm{}).Select("deleted_at").Where("id = ?", id)
+
+		err := tx.Unscoped().Model(&gormContract.ScheduleGorm{}).
+			Where("field_id = ? AND deleted_at = (?)", id, deletedAt).
+			Update("deleted_at", nil).Error
+		if err != nil {
+			return err
+		}
+
+		result := tx.Unscoped().Model(&gormContract.FieldGorm{}).
+			Where("id = ? AND deleted_at IS NOT NULL", id).
+			Update("deleted_at", nil)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrNotDeleted
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrNotDeleted) {
+			return err
+		}
+		return fmt.Errorf("failed to restore field: %w", err)
 	}
 
 	return nil
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	gormContract "go-futsal-booking-api/internal/repository/model"
+
+	"gorm.io/gorm"
+)
+
+type FieldTypeRepository interface {
+	FindAll(ctx context.Context) ([]domain.FieldType, error)
+	// FindByCode returns domain.ErrFieldTypeNotFound for unknown codes.
+	FindByCode(ctx context.Context, code string) (domain.FieldType, error)
+}
+
+type gormFieldTypeRepository struct {
+	DB *gorm.DB
+}
+
+func NewFieldTypeRepository(db *gorm.DB) FieldTypeRepository {
+	return &gormFieldTypeRepository{
+		DB: db,
+	}
+}
+
+func (r *gormFieldTypeRepository) FindAll(ctx context.Context) ([]domain.FieldType, error) {
+	var gormTypes []gormContract.FieldTypeGorm
+	if err := dbFromContext(ctx, r.DB).Order("sort_order, code").Find(&gormTypes).Error; err != nil {
+		return nil, fmt.Errorf("failed to find field types: %w", err)
+	}
+
+	types := make([]domain.FieldType, len(gormTypes))
+	for i := range gormTypes {
+		types[i] = gormTypes[i].ToDomain()
+	}
+
+	return types, nil
+}
+
+func (r *gormFieldTypeRepository) FindByCode(ctx context.Context, code string) (domain.FieldType, error) {
+	var gormType gormContract.FieldTypeGorm
+	if err := dbFromContext(ctx, r.DB).Where("code = ?", code).First(&gormType).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.FieldType{}, domain.ErrFieldTypeNotFound
+		}
+		return domain.FieldType{}, fmt.Errorf("failed to find field type: %w", err)
+	}
+
+	return gormType.ToDomain(), nil
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	gormContract "go-futsal-booking-api/internal/repository/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type InboxRepository interface {
+	Create(ctx context.Context, notification *domain.InboxNotification) error
+	// FindByUserID returns a page of the user's notifications, newest first, and
+	// how many match the filter in total.
+	FindByUserID(ctx context.Context, userID uint, filter domain.InboxFilter) ([]domain.InboxNotification, int64, error)
+	CountUnread(ctx context.Context, userID uint) (int64, error)
+	// MarkRead leaves notifications that are already read untouched.
+	MarkRead(ctx context.Context, userID, id uint, at time.Time) (domain.InboxNotification, error)
+	MarkAllRead(ctx context.Context, userID uint, at time.Time) (int64, error)
+	// DeleteReadBefore removes up to limit notifications read before the cutoff.
+	DeleteReadBefore(ctx context.Context, cutoff time.Time, limit int) (int64, error)
+}
+
+type gormInboxRepository struct {
+	DB *gorm.DB
+}
+
+func NewInboxRepository(db *gorm.DB) InboxRepository {
+	return &gormInboxRepository{
+		DB: db,
+	}
+}
+
+func (r *gormInboxRepository) Create(ctx context.Context, notification *domain.InboxNotification) error {
+	var gormNotification gormContract.InboxNotificationGorm
+	gormNotification.FromDomain(*notification)
+
+	if err := dbFromContext(ctx, r.DB).Create(&gormNotification).Error; err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+
+	*notification = gormNotification.ToDomain()
+
+	return nil
+}
+
+func (r *gormInboxRepository) FindByUserID(ctx context.Context, userID uint, filter domain.InboxFilter) ([]domain.InboxNotification, int64, error) {
+	query := dbFromContext(ctx, r.DB).Model(&gormContract.InboxNotificationGorm{}).Where("user_id = ?", userID)
+	if filter.UnreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count notifications: %w", err)
+	}
+
+	var gormNotifications []gormContract.InboxNotificationGorm
+	if err := query.Order("id DESC").Limit(filter.Limit).Offset(filter.Offset).Find(&gormNotifications).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to find notifications: %w", err)
+	}
+
+	notifications := make([]domain.InboxNotification, len(gormNotifications))
+	for i := range gormNotifications {
+		notifications[i] = gormNotifications[i].ToDomain()
+	}
+
+	return notifications, total, nil
+}
+
+func (r *gormInboxRepository) CountUnread(ctx context.Context, userID uint) (int64, error) {
+	var count int64
+
+	err := dbFromContext(ctx, r.DB).Model(&gormContract.InboxNotificationGorm{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *gormInboxRepository) MarkRead(ctx context.Context, userID, id uint, at time.Time) (domain.InboxNotification, error) {
+	db := dbFromContext(ctx, r.DB)
+
+	err := db.Model(&gormContract.InboxNotificationGorm{}).
+		Where("id = ? AND user_id = ? AND read_at IS NULL", id, userID).
+		Update("read_at", at).Error
+	if err != nil {
+		return domain.InboxNotification{}, fmt.Errorf("failed to mark notification read: %w", err)
+	}
+
+	var gormNotification gormContract.InboxNotificationGorm
+	if err := db.Where("user_id = ?", userID).First(&gormNotification, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.InboxNotification{}, domain.ErrInboxNotFound
+		}
+		return domain.InboxNotification{}, fmt.Errorf("failed to find notification: %w", err)
+	}
+
+	return gormNotification.ToDomain(), nil
+}
+
+func (r *gormInboxRepository) MarkAllRead(ctx context.Context, userID uint, at time.Time) (int64, error) {
+	result := dbFromContext(ctx, r.DB).Model(&gormContract.InboxNotificationGorm{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Update("read_at", at)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to mark notifications read: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (r *gormInboxRepository) DeleteReadBefore(ctx context.Context, cutoff time.Time, limit int) (int64, error) {
+	batch := dbFromContext(ctx, r.DB).Model(&gormContract.InboxNotificationGorm{}).
+		Select("id").
+		Where("read_at < ?", cutoff).
+		Order("id").
+		Limit(limit)
+
+	result := dbFromContext(ctx, r.DB).Where("id IN (?)", batch).Delete(&gormContract.InboxNotificationGorm{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete read notifications: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
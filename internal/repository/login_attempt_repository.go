@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	gormContract "go-futsal-booking-api/internal/repository/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type LoginAttemptRepository interface {
+	// Find returns an empty attempt for the scope and key when none has been recorded.
+	Find(ctx context.Context, scope, key string) (domain.LoginAttempt, error)
+	// Reserve counts an attempt at the given time as a failure unless the key is
+	// locked or still backing off, checking and counting in one statement so
+	// concurrent attempts cannot all pass the same check. It reports whether the
+	// attempt was counted and returns the stored attempt either way.
+	Reserve(ctx context.Context, scope, key string, at time.Time, backoff domain.LoginBackoff) (domain.LoginAttempt, bool, error)
+	// Release takes back one attempt counted by Reserve.
+	Release(ctx context.Context, scope, key string) error
+	// Lock locks the key until the given time if it has at least threshold
+	// failures, and reports whether it did. The failures are cleared so the key
+	// starts afresh once the lock ends.
+	Lock(ctx context.Context, scope, key string, threshold int, until time.Time) (bool, error)
+	Reset(ctx context.Context, scope, key string) error
+}
+
+type gormLoginAttemptRepository struct {
+	DB *gorm.DB
+}
+
+func NewLoginAttemptRepository(db *gorm.DB) LoginAttemptRepository {
+	return &gormLoginAttemptRepository{
+		DB: db,
+	}
+}
+
+func (r *gormLoginAttemptRepository) Find(ctx context.Context, scope, key string) (domain.LoginAttempt, error) {
+	var gormAttempt gormContract.LoginAttemptGorm
+
+	err := r.DB.WithContext(ctx).Where("scope = ? AND key = ?", scope, key).First(&gormAttempt).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.LoginAttempt{Scope: scope, Key: key}, nil
+		}
+		return domain.LoginAttempt{}, fmt.Errorf("failed to find login attempt: %w", err)
+	}
+
+	return gormAttempt.ToDomain(), nil
+}
+
+func (r *gormLoginAttemptRepository) Reserve(ctx context.Context, scope, key string, at time.Time, backoff domain.LoginBackoff) (domain.LoginAttempt, bool, error) {
+	var gormAttempt gormContract.LoginAttemptGorm
+
+	// The backoff matches domain.LoginBackoff.Delay; the exponent is capped so
+	// power() cannot overflow.
+	result := r.DB.WithContext(ctx).Raw(`
+		INSERT INTO login_attempts (scope, key, failed_count, last_failed_at, created_at, updated_at)
+		VALUES (@scope, @key, 1, @at, @at, @at)
+		ON CONFLICT (scope, key) DO UPDATE SET
+			failed_count = CASE
+				WHEN login_attempts.last_failed_at IS NULL OR login_attempts.last_failed_at < @window_start THEN 1
+				ELSE login_attempts.failed_count + 1
+			END,
+			last_failed_at = EXCLUDED.last_failed_at,
+			updated_at = EXCLUDED.updated_at
+		WHERE (login_attempts.locked_until IS NULL OR login_attempts.locked_until <= @at)
+			AND (
+				login_attempts.last_failed_at IS NULL
+				OR login_attempts.last_failed_at < @window_start
+				OR login_attempts.failed_count < @free_attempts
+				OR login_attempts.last_failed_at + LEAST(
+					@base_delay * power(2, LEAST(login_attempts.failed_count - @free_attempts, 32)),
+					@max_delay
+				) * interval '1 second' <= @at
+			)
+		RETURNING *`,
+		map[string]any{
+			"scope":         scope,
+			"key":           key,
+			"at":            at,
+			"window_start":  at.Add(-backoff.Window),
+			"free_attempts": backoff.FreeAttempts,
+			"base_delay":    backoff.BaseDelay.Seconds(),
+			"max_delay":     backoff.MaxDelay.Seconds(),
+		},
+	).Scan(&gormAttempt)
+	if result.Error != nil {
+		return domain.LoginAttempt{}, false, fmt.Errorf("failed to record login attempt: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		attempt, err := r.Find(ctx, scope, key)
+		return attempt, false, err
+	}
+
+	return gormAttempt.ToDomain(), true, nil
+}
+
+func (r *gormLoginAttemptRepository) Release(ctx context.Context, scope, key string) error {
+	err := r.DB.WithContext(ctx).Model(&gormContract.LoginAttemptGorm{}).
+		Where("scope = ? AND key = ? AND failed_count > 0", scope, key).
+		Update("failed_count", gorm.Expr("failed_count - 1")).Error
+	if err != nil {
+		return fmt.Errorf("failed to release login attempt: %w", err)
+	}
+
+	return nil
+}
+
+func (r *gormLoginAttemptRepository) Lock(ctx context.Context, scope, key string, threshold int, until time.Time) (bool, error) {
+	result := r.DB.WithContext(ctx).Model(&gormContract.LoginAttemptGorm{}).
+		Where("scope = ? AND key = ? AND failed_count >= ?", scope, key, threshold).
+		Updates(map[string]any{"locked_until": until, "failed_count": 0})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to lock login: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (r *gormLoginAttemptRepository) Reset(ctx context.Context, scope, key string) error {
+	err := r.DB.WithContext(ctx).Where("scope = ? AND key = ?", scope, key).Delete(&gormContract.LoginAttemptGorm{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to reset login attempts: %w", err)
+	}
+
+	return nil
+}
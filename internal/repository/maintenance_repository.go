@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	gormContract "go-futsal-booking-api/internal/repository/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type MaintenanceWindowRepository interface {
+	// Create returns domain.ErrMaintenanceOverlap when the field already has a
+	// window overlapping the new one.
+	Create(ctx context.Context, window *domain.MaintenanceWindow) error
+	FindByID(ctx context.Context, id uint) (domain.MaintenanceWindow, error)
+	// FindByField returns the windows of a field that end after from, earliest
+	// first.
+	FindByField(ctx context.Context, fieldID uint, from time.Time) ([]domain.MaintenanceWindow, error)
+	// FindOverlapping returns the windows of the fields that overlap [from, to).
+	FindOverlapping(ctx context.Context, fieldIDs []uint, from, to time.Time) ([]domain.MaintenanceWindow, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+type gormMaintenanceWindowRepository struct {
+	DB *gorm.DB
+}
+
+func NewMaintenanceWindowRepository(db *gorm.DB) MaintenanceWindowRepository {
+	return &gormMaintenanceWindowRepository{DB: db}
+}
+
+func (r *gormMaintenanceWindowRepository) Create(ctx context.Context, window *domain.MaintenanceWindow) error {
+	var gormWindow gormContract.MaintenanceWindowGorm
+	gormWindow.FromDomain(*window)
+
+	err := dbFromContext(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		// Locking the field keeps two overlapping windows from being
+		// created at once.
+		if err := tx.Exec("SELECT id FROM fields WHERE id = ? FOR UPDATE", window.FieldID).Error; err != nil {
+			return fmt.Errorf("failed to lock field: %w", err)
+		}
+
+		var overlapping int64
+		err := tx.Model(&gormContract.MaintenanceWindowGorm{}).
+			Where("field_id = ? AND starts_at < ? AND ends_at > ?", window.FieldID, gormWindow.EndsAt, gormWindow.StartsAt).
+			Count(&overlapping).Error
+		if err != nil {
+			return fmt.Errorf("failed to check overlapping maintenance: %w", err)
+		}
+
+		if overlapping > 0 {
+			return domain.ErrMaintenanceOverlap
+		}
+
+		if err := tx.Create(&gormWindow).Error; err != nil {
+			return fmt.Errorf("failed to create maintenance window: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	*window = gormWindow.ToDomain()
+
+	return nil
+}
+
+func (r *gormMaintenanceWindowRepository) FindByID(ctx context.Context, id uint) (domain.MaintenanceWindow, error) {
+	var gormWindow gormContract.MaintenanceWindowGorm
+
+	err := dbFromContext(ctx, r.DB).First(&gormWindow, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.MaintenanceWindow{}, domain.ErrMaintenanceNotFound
+		}
+		return domain.MaintenanceWindow{}, fmt.Errorf("failed to find maintenance window: %w", err)
+	}
+
+	return gormWindow.ToDomain(), nil
+}
+
+func (r *gormMaintenanceWindowRepository) FindByField(ctx context.Context, fieldID uint, from time.Time) ([]domain.MaintenanceWindow, error) {
+	var gormWindows []gormContract.MaintenanceWindowGorm
+
+	err := dbFromContext(ctx, r.DB).
+		Where("field_id = ? AND ends_at > ?", fieldID, from.UTC()).
+		Order("starts_at").
+		Find(&gormWindows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find maintenance windows: %w", err)
+	}
+
+	return toMaintenanceWindows(gormWindows), nil
+}
+
+func (r *gormMaintenanceWindowRepository) FindOverlapping(ctx context.Context, fieldIDs []uint, from, to time.Time) ([]domain.MaintenanceWindow, error) {
+	if len(fieldIDs) == 0 {
+		return nil, nil
+	}
+
+	var gormWindows []gormContract.MaintenanceWindowGorm
+
+	err := dbFromContext(ctx, r.DB).
+		Where("field_id IN ? AND starts_at < ? AND ends_at > ?", fieldIDs, to.UTC(), from.UTC()).
+		Order("starts_at").
+		Find(&gormWindows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find overlapping maintenance windows: %w", err)
+	}
+
+	return toMaintenanceWindows(gormWindows), nil
+}
+
+func (r *gormMaintenanceWindowRepository) Delete(ctx context.Context, id uint) error {
+	result := dbFromContext(ctx, r.DB).Delete(&gormContract.MaintenanceWindowGorm{}, id)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete maintenance window: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrMaintenanceNotFound
+	}
+
+	return nil
+}
+
+func toMaintenanceWindows(gormWindows []gormContract.MaintenanceWindowGorm) []domain.MaintenanceWindow {
+	windows := make([]domain.MaintenanceWindow, len(gormWindows))
+	for i := range gormWindows {
+		windows[i] = gormWindows[i].ToDomain()
+	}
+
+	return windows
+}
@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/amenity_repository.go
+
+package mock
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAmenityRepository is a mock of AmenityRepository interface.
+type MockAmenityRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAmenityRepositoryMockRecorder
+}
+
+// MockAmenityRepositoryMockRecorder is the mock recorder for MockAmenityRepository.
+type MockAmenityRepositoryMockRecorder struct {
+	mock *MockAmenityRepository
+}
+
+// NewMockAmenityRepository creates a new mock instance.
+func NewMockAmenityRepository(ctrl *gomock.Controller) *MockAmenityRepository {
+	mock := &MockAmenityRepository{ctrl: ctrl}
+	mock.recorder = &MockAmenityRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAmenityRepository) EXPECT() *MockAmenityRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAmenityRepository) Create(ctx context.Context, amenity *domain.Amenity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, amenity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAmenityRepositoryMockRecorder) Create(ctx, amenity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAmenityRepository)(nil).Create), ctx, amenity)
+}
+
+// Delete mocks base method.
+func (m *MockAmenityRepository) Delete(ctx context.Context, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAmenityRepositoryMockRecorder) Delete(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAmenityRepository)(nil).Delete), ctx, code)
+}
+
+// FindAll mocks base method.
+func (m *MockAmenityRepository) FindAll(ctx context.Context) ([]domain.Amenity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]domain.Amenity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockAmenityRepositoryMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockAmenityRepository)(nil).FindAll), ctx)
+}
+
+// FindByCodes mocks base method.
+func (m *MockAmenityRepository) FindByCodes(ctx context.Context, codes []string) ([]domain.Amenity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCodes", ctx, codes)
+	ret0, _ := ret[0].([]domain.Amenity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCodes indicates an expected call of FindByCodes.
+func (mr *MockAmenityRepositoryMockRecorder) FindByCodes(ctx, codes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCodes", reflect.TypeOf((*MockAmenityRepository)(nil).FindByCodes), ctx, codes)
+}
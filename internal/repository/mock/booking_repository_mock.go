@@ -7,6 +7,7 @@ import (
 	"context"
 	"go-futsal-booking-api/internal/domain"
 	reflect "reflect"
+	"time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -48,6 +49,51 @@ func (mr *MockBookingRepositoryMockRecorder) CancelBooking(ctx, bookingID interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelBooking", reflect.TypeOf((*MockBookingRepository)(nil).CancelBooking), ctx, bookingID)
 }
 
+// CheckIn mocks base method.
+func (m *MockBookingRepository) CheckIn(ctx context.Context, bookingID uint, at time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIn", ctx, bookingID, at)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIn indicates an expected call of CheckIn.
+func (mr *MockBookingRepositoryMockRecorder) CheckIn(ctx, bookingID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIn", reflect.TypeOf((*MockBookingRepository)(nil).CheckIn), ctx, bookingID, at)
+}
+
+// CompleteFinished mocks base method.
+func (m *MockBookingRepository) CompleteFinished(ctx context.Context, now time.Time, limit int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteFinished", ctx, now, limit)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteFinished indicates an expected call of CompleteFinished.
+func (mr *MockBookingRepositoryMockRecorder) CompleteFinished(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteFinished", reflect.TypeOf((*MockBookingRepository)(nil).CompleteFinished), ctx, now, limit)
+}
+
+// Confirm mocks base method.
+func (m *MockBookingRepository) Confirm(ctx context.Context, bookingID uint, at time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm", ctx, bookingID, at)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Confirm indicates an expected call of Confirm.
+func (mr *MockBookingRepositoryMockRecorder) Confirm(ctx, bookingID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockBookingRepository)(nil).Confirm), ctx, bookingID, at)
+}
+
 // Create mocks base method.
 func (m *MockBookingRepository) Create(ctx context.Context, booking *domain.Booking) error {
 	m.ctrl.T.Helper()
@@ -62,6 +108,21 @@ func (mr *MockBookingRepositoryMockRecorder) Create(ctx, booking interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBookingRepository)(nil).Create), ctx, booking)
 }
 
+// FindActiveByVenueBetween mocks base method.
+func (m *MockBookingRepository) FindActiveByVenueBetween(ctx context.Context, venueID uint, fromDate, toDate time.Time) ([]*domain.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveByVenueBetween", ctx, venueID, fromDate, toDate)
+	ret0, _ := ret[0].([]*domain.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveByVenueBetween indicates an expected call of FindActiveByVenueBetween.
+func (mr *MockBookingRepositoryMockRecorder) FindActiveByVenueBetween(ctx, venueID, fromDate, toDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveByVenueBetween", reflect.TypeOf((*MockBookingRepository)(nil).FindActiveByVenueBetween), ctx, venueID, fromDate, toDate)
+}
+
 // FindByID mocks base method.
 func (m *MockBookingRepository) FindByID(ctx context.Context, id uint) (domain.Booking, error) {
 	m.ctrl.T.Helper()
@@ -91,3 +152,77 @@ func (mr *MockBookingRepositoryMockRecorder) FindByUserID(ctx, userID interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockBookingRepository)(nil).FindByUserID), ctx, userID)
 }
+
+// FindByVenueID mocks base method.
+func (m *MockBookingRepository) FindByVenueID(ctx context.Context, venueID uint) ([]*domain.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByVenueID", ctx, venueID)
+	ret0, _ := ret[0].([]*domain.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByVenueID indicates an expected call of FindByVenueID.
+func (mr *MockBookingRepositoryMockRecorder) FindByVenueID(ctx, venueID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByVenueID", reflect.TypeOf((*MockBookingRepository)(nil).FindByVenueID), ctx, venueID)
+}
+
+// FindDueForReminder mocks base method.
+func (m *MockBookingRepository) FindDueForReminder(ctx context.Context, now time.Time, offset time.Duration, limit int) ([]*domain.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDueForReminder", ctx, now, offset, limit)
+	ret0, _ := ret[0].([]*domain.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDueForReminder indicates an expected call of FindDueForReminder.
+func (mr *MockBookingRepositoryMockRecorder) FindDueForReminder(ctx, now, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDueForReminder", reflect.TypeOf((*MockBookingRepository)(nil).FindDueForReminder), ctx, now, offset, limit)
+}
+
+// FindUpcoming mocks base method.
+func (m *MockBookingRepository) FindUpcoming(ctx context.Context, scope domain.DeletionScope, now time.Time) ([]*domain.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUpcoming", ctx, scope, now)
+	ret0, _ := ret[0].([]*domain.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUpcoming indicates an expected call of FindUpcoming.
+func (mr *MockBookingRepositoryMockRecorder) FindUpcoming(ctx, scope, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUpcoming", reflect.TypeOf((*MockBookingRepository)(nil).FindUpcoming), ctx, scope, now)
+}
+
+// MarkReminderSent mocks base method.
+func (m *MockBookingRepository) MarkReminderSent(ctx context.Context, bookingID uint, offset time.Duration, at time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkReminderSent", ctx, bookingID, offset, at)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkReminderSent indicates an expected call of MarkReminderSent.
+func (mr *MockBookingRepositoryMockRecorder) MarkReminderSent(ctx, bookingID, offset, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkReminderSent", reflect.TypeOf((*MockBookingRepository)(nil).MarkReminderSent), ctx, bookingID, offset, at)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockBookingRepository) UpdateStatus(ctx context.Context, bookingID uint, status string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, bookingID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockBookingRepositoryMockRecorder) UpdateStatus(ctx, bookingID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockBookingRepository)(nil).UpdateStatus), ctx, bookingID, status)
+}
@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/calendar_repository.go
+
+package mock
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCalendarRepository is a mock of CalendarRepository interface.
+type MockCalendarRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalendarRepositoryMockRecorder
+}
+
+// MockCalendarRepositoryMockRecorder is the mock recorder for MockCalendarRepository.
+type MockCalendarRepositoryMockRecorder struct {
+	mock *MockCalendarRepository
+}
+
+// NewMockCalendarRepository creates a new mock instance.
+func NewMockCalendarRepository(ctrl *gomock.Controller) *MockCalendarRepository {
+	mock := &MockCalendarRepository{ctrl: ctrl}
+	mock.recorder = &MockCalendarRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalendarRepository) EXPECT() *MockCalendarRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindFeedByTokenHash mocks base method.
+func (m *MockCalendarRepository) FindFeedByTokenHash(ctx context.Context, tokenHash string) (domain.CalendarFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFeedByTokenHash", ctx, tokenHash)
+	ret0, _ := ret[0].(domain.CalendarFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFeedByTokenHash indicates an expected call of FindFeedByTokenHash.
+func (mr *MockCalendarRepositoryMockRecorder) FindFeedByTokenHash(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFeedByTokenHash", reflect.TypeOf((*MockCalendarRepository)(nil).FindFeedByTokenHash), ctx, tokenHash)
+}
+
+// SaveFeed mocks base method.
+func (m *MockCalendarRepository) SaveFeed(ctx context.Context, feed *domain.CalendarFeed) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveFeed", ctx, feed)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveFeed indicates an expected call of SaveFeed.
+func (mr *MockCalendarRepositoryMockRecorder) SaveFeed(ctx, feed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFeed", reflect.TypeOf((*MockCalendarRepository)(nil).SaveFeed), ctx, feed)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/catalog_repository.go
+
+package mock
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCatalogRepository is a mock of CatalogRepository interface.
+type MockCatalogRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCatalogRepositoryMockRecorder
+}
+
+// MockCatalogRepositoryMockRecorder is the mock recorder for MockCatalogRepository.
+type MockCatalogRepositoryMockRecorder struct {
+	mock *MockCatalogRepository
+}
+
+// NewMockCatalogRepository creates a new mock instance.
+func NewMockCatalogRepository(ctrl *gomock.Controller) *MockCatalogRepository {
+	mock := &MockCatalogRepository{ctrl: ctrl}
+	mock.recorder = &MockCatalogRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCatalogRepository) EXPECT() *MockCatalogRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Export mocks base method.
+func (m *MockCatalogRepository) Export(ctx context.Context) ([]domain.CatalogRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx)
+	ret0, _ := ret[0].([]domain.CatalogRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockCatalogRepositoryMockRecorder) Export(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockCatalogRepository)(nil).Export), ctx)
+}
+
+// Import mocks base method.
+func (m *MockCatalogRepository) Import(ctx context.Context, rows []domain.CatalogRow, ownerID uint) (domain.CatalogImport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, rows, ownerID)
+	ret0, _ := ret[0].(domain.CatalogImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockCatalogRepositoryMockRecorder) Import(ctx, rows, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockCatalogRepository)(nil).Import), ctx, rows, ownerID)
+}
@@ -0,0 +1,106 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/favourite_repository.go
+
+package mock
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockFavouriteRepository is a mock of FavouriteRepository interface.
+type MockFavouriteRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFavouriteRepositoryMockRecorder
+}
+
+// MockFavouriteRepositoryMockRecorder is the mock recorder for MockFavouriteRepository.
+type MockFavouriteRepositoryMockRecorder struct {
+	mock *MockFavouriteRepository
+}
+
+// NewMockFavouriteRepository creates a new mock instance.
+func NewMockFavouriteRepository(ctrl *gomock.Controller) *MockFavouriteRepository {
+	mock := &MockFavouriteRepository{ctrl: ctrl}
+	mock.recorder = &MockFavouriteRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFavouriteRepository) EXPECT() *MockFavouriteRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddField mocks base method.
+func (m *MockFavouriteRepository) AddField(ctx context.Context, userID, fieldID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddField", ctx, userID, fieldID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddField indicates an expected call of AddField.
+func (mr *MockFavouriteRepositoryMockRecorder) AddField(ctx, userID, fieldID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddField", reflect.TypeOf((*MockFavouriteRepository)(nil).AddField), ctx, userID, fieldID)
+}
+
+// AddVenue mocks base method.
+func (m *MockFavouriteRepository) AddVenue(ctx context.Context, userID, venueID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddVenue", ctx, userID, venueID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddVenue indicates an expected call of AddVenue.
+func (mr *MockFavouriteRepositoryMockRecorder) AddVenue(ctx, userID, venueID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVenue", reflect.TypeOf((*MockFavouriteRepository)(nil).AddVenue), ctx, userID, venueID)
+}
+
+// FindByUserID mocks base method.
+func (m *MockFavouriteRepository) FindByUserID(ctx context.Context, userID uint) (domain.Favourites, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID)
+	ret0, _ := ret[0].(domain.Favourites)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockFavouriteRepositoryMockRecorder) FindByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockFavouriteRepository)(nil).FindByUserID), ctx, userID)
+}
+
+// RemoveField mocks base method.
+func (m *MockFavouriteRepository) RemoveField(ctx context.Context, userID, fieldID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveField", ctx, userID, fieldID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveField indicates an expected call of RemoveField.
+func (mr *MockFavouriteRepositoryMockRecorder) RemoveField(ctx, userID, fieldID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveField", reflect.TypeOf((*MockFavouriteRepository)(nil).RemoveField), ctx, userID, fieldID)
+}
+
+// RemoveVenue mocks base method.
+func (m *MockFavouriteRepository) RemoveVenue(ctx context.Context, userID, venueID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveVenue", ctx, userID, venueID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveVenue indicates an expected call of RemoveVenue.
+func (mr *MockFavouriteRepositoryMockRecorder) RemoveVenue(ctx, userID, venueID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVenue", reflect.TypeOf((*MockFavouriteRepository)(nil).RemoveVenue), ctx, userID, venueID)
+}
@@ -0,0 +1,152 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/field_repository.go
+
+package mock
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	reflect "reflect"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockFieldRepository is a mock of FieldRepository interface.
+type MockFieldRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFieldRepositoryMockRecorder
+}
+
+// MockFieldRepositoryMockRecorder is the mock recorder for MockFieldRepository.
+type MockFieldRepositoryMockRecorder struct {
+	mock *MockFieldRepository
+}
+
+// NewMockFieldRepository creates a new mock instance.
+func NewMockFieldRepository(ctrl *gomock.Controller) *MockFieldRepository {
+	mock := &MockFieldRepository{ctrl: ctrl}
+	mock.recorder = &MockFieldRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFieldRepository) EXPECT() *MockFieldRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockFieldRepository) Create(ctx context.Context, field *domain.Field) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, field)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockFieldRepositoryMockRecorder) Create(ctx, field interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFieldRepository)(nil).Create), ctx, field)
+}
+
+// Delete mocks base method.
+func (m *MockFieldRepository) Delete(ctx context.Context, id uint, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockFieldRepositoryMockRecorder) Delete(ctx, id, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFieldRepository)(nil).Delete), ctx, id, at)
+}
+
+// DeletionImpact mocks base method.
+func (m *MockFieldRepository) DeletionImpact(ctx context.Context, id uint) (domain.DeletionImpact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletionImpact", ctx, id)
+	ret0, _ := ret[0].(domain.DeletionImpact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletionImpact indicates an expected call of DeletionImpact.
+func (mr *MockFieldRepositoryMockRecorder) DeletionImpact(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletionImpact", reflect.TypeOf((*MockFieldRepository)(nil).DeletionImpact), ctx, id)
+}
+
+// FindByID mocks base method.
+func (m *MockFieldRepository) FindByID(ctx context.Context, id uint) (domain.Field, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(domain.Field)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockFieldRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockFieldRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByVenueID mocks base method.
+func (m *MockFieldRepository) FindByVenueID(ctx context.Context, venueID uint) ([]domain.Field, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByVenueID", ctx, venueID)
+	ret0, _ := ret[0].([]domain.Field)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByVenueID indicates an expected call of FindByVenueID.
+func (mr *MockFieldRepositoryMockRecorder) FindByVenueID(ctx, venueID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByVenueID", reflect.TypeOf((*MockFieldRepository)(nil).FindByVenueID), ctx, venueID)
+}
+
+// FindDeletedByID mocks base method.
+func (m *MockFieldRepository) FindDeletedByID(ctx context.Context, id uint) (domain.Field, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedByID", ctx, id)
+	ret0, _ := ret[0].(domain.Field)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedByID indicates an expected call of FindDeletedByID.
+func (mr *MockFieldRepositoryMockRecorder) FindDeletedByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByID", reflect.TypeOf((*MockFieldRepository)(nil).FindDeletedByID), ctx, id)
+}
+
+// Restore mocks base method.
+func (m *MockFieldRepository) Restore(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockFieldRepositoryMockRecorder) Restore(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockFieldRepository)(nil).Restore), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockFieldRepository) Update(ctx context.Context, field *domain.Field) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, field)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockFieldRepositoryMockRecorder) Update(ctx, field interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFieldRepository)(nil).Update), ctx, field)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/field_type_repository.go
+
+package mock
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockFieldTypeRepository is a mock of FieldTypeRepository interface.
+type MockFieldTypeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFieldTypeRepositoryMockRecorder
+}
+
+// MockFieldTypeRepositoryMockRecorder is the mock recorder for MockFieldTypeRepository.
+type MockFieldTypeRepositoryMockRecorder struct {
+	mock *MockFieldTypeRepository
+}
+
+// NewMockFieldTypeRepository creates a new mock instance.
+func NewMockFieldTypeRepository(ctrl *gomock.Controller) *MockFieldTypeRepository {
+	mock := &MockFieldTypeRepository{ctrl: ctrl}
+	mock.recorder = &MockFieldTypeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFieldTypeRepository) EXPECT() *MockFieldTypeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockFieldTypeRepository) FindAll(ctx context.Context) ([]domain.FieldType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]domain.FieldType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockFieldTypeRepositoryMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockFieldTypeRepository)(nil).FindAll), ctx)
+}
+
+// FindByCode mocks base method.
+func (m *MockFieldTypeRepository) FindByCode(ctx context.Context, code string) (domain.FieldType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCode", ctx, code)
+	ret0, _ := ret[0].(domain.FieldType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCode indicates an expected call of FindByCode.
+func (mr *MockFieldTypeRepositoryMockRecorder) FindByCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCode", reflect.TypeOf((*MockFieldTypeRepository)(nil).FindByCode), ctx, code)
+}
@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/inbox_repository.go
+
+package mock
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	reflect "reflect"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockInboxRepository is a mock of InboxRepository interface.
+type MockInboxRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockInboxRepositoryMockRecorder
+}
+
+// MockInboxRepositoryMockRecorder is the mock recorder for MockInboxRepository.
+type MockInboxRepositoryMockRecorder struct {
+	mock *MockInboxRepository
+}
+
+// NewMockInboxRepository creates a new mock instance.
+func NewMockInboxRepository(ctrl *gomock.Controller) *MockInboxRepository {
+	mock := &MockInboxRepository{ctrl: ctrl}
+	mock.recorder = &MockInboxRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInboxRepository) EXPECT() *MockInboxRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountUnread mocks base method.
+func (m *MockInboxRepository) CountUnread(ctx context.Context, userID uint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnread", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnread indicates an expected call of CountUnread.
+func (mr *MockInboxRepositoryMockRecorder) CountUnread(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnread", reflect.TypeOf((*MockInboxRepository)(nil).CountUnread), ctx, userID)
+}
+
+// Create mocks base method.
+func (m *MockInboxRepository) Create(ctx context.Context, notification *domain.InboxNotification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockInboxRepositoryMockRecorder) Create(ctx, notification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockInboxRepository)(nil).Create), ctx, notification)
+}
+
+// DeleteReadBefore mocks base method.
+func (m *MockInboxRepository) DeleteReadBefore(ctx context.Context, cutoff time.Time, limit int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReadBefore", ctx, cutoff, limit)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReadBefore indicates an expected call of DeleteReadBefore.
+func (mr *MockInboxRepositoryMockRecorder) DeleteReadBefore(ctx, cutoff, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReadBefore", reflect.TypeOf((*MockInboxRepository)(nil).DeleteReadBefore), ctx, cutoff, limit)
+}
+
+// FindByUserID mocks base method.
+func (m *MockInboxRepository) FindByUserID(ctx context.Context, userID uint, filter domain.InboxFilter) ([]domain.InboxNotification, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID, filter)
+	ret0, _ := ret[0].([]domain.InboxNotification)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockInboxRepositoryMockRecorder) FindByUserID(ctx, userID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockInboxRepository)(nil).FindByUserID), ctx, userID, filter)
+}
+
+// MarkAllRead mocks base method.
+func (m *MockInboxRepository) MarkAllRead(ctx context.Context, userID uint, at time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllRead", ctx, userID, at)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAllRead indicates an expected call of MarkAllRead.
+func (mr *MockInboxRepositoryMockRecorder) MarkAllRead(ctx, userID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllRead", reflect.TypeOf((*MockInboxRepository)(nil).MarkAllRead), ctx, userID, at)
+}
+
+// MarkRead mocks base method.
+func (m *MockInboxRepository) MarkRead(ctx context.Context, userID, id uint, at time.Time) (domain.InboxNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, userID, id, at)
+	ret0, _ := ret[0].(domain.InboxNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockInboxRepositoryMockRecorder) MarkRead(ctx, userID, id, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockInboxRepository)(nil).MarkRead), ctx, userID, id, at)
+}
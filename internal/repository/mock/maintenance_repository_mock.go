@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/maintenance_repository.go
+
+package mock
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	reflect "reflect"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockMaintenanceWindowRepository is a mock of MaintenanceWindowRepository interface.
+type MockMaintenanceWindowRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMaintenanceWindowRepositoryMockRecorder
+}
+
+// MockMaintenanceWindowRepositoryMockRecorder is the mock recorder for MockMaintenanceWindowRepository.
+type MockMaintenanceWindowRepositoryMockRecorder struct {
+	mock *MockMaintenanceWindowRepository
+}
+
+// NewMockMaintenanceWindowRepository creates a new mock instance.
+func NewMockMaintenanceWindowRepository(ctrl *gomock.Controller) *MockMaintenanceWindowRepository {
+	mock := &MockMaintenanceWindowRepository{ctrl: ctrl}
+	mock.recorder = &MockMaintenanceWindowRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMaintenanceWindowRepository) EXPECT() *MockMaintenanceWindowRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockMaintenanceWindowRepository) Create(ctx context.Context, window *domain.MaintenanceWindow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, window)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockMaintenanceWindowRepositoryMockRecorder) Create(ctx, window interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMaintenanceWindowRepository)(nil).Create), ctx, window)
+}
+
+// Delete mocks base method.
+func (m *MockMaintenanceWindowRepository) Delete(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMaintenanceWindowRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMaintenanceWindowRepository)(nil).Delete), ctx, id)
+}
+
+// FindByField mocks base method.
+func (m *MockMaintenanceWindowRepository) FindByField(ctx context.Context, fieldID uint, from time.Time) ([]domain.MaintenanceWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByField", ctx, fieldID, from)
+	ret0, _ := ret[0].([]domain.MaintenanceWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByField indicates an expected call of FindByField.
+func (mr *MockMaintenanceWindowRepositoryMockRecorder) FindByField(ctx, fieldID, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByField", reflect.TypeOf((*MockMaintenanceWindowRepository)(nil).FindByField), ctx, fieldID, from)
+}
+
+// FindByID mocks base method.
+func (m *MockMaintenanceWindowRepository) FindByID(ctx context.Context, id uint) (domain.MaintenanceWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(domain.MaintenanceWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockMaintenanceWindowRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockMaintenanceWindowRepository)(nil).FindByID), ctx, id)
+}
+
+// FindOverlapping mocks base method.
+func (m *MockMaintenanceWindowRepository) FindOverlapping(ctx context.Context, fieldIDs []uint, from, to time.Time) ([]domain.MaintenanceWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOverlapping", ctx, fieldIDs, from, to)
+	ret0, _ := ret[0].([]domain.MaintenanceWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOverlapping indicates an expected call of FindOverlapping.
+func (mr *MockMaintenanceWindowRepositoryMockRecorder) FindOverlapping(ctx, fieldIDs, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOverlapping", reflect.TypeOf((*MockMaintenanceWindowRepository)(nil).FindOverlapping), ctx, fieldIDs, from, to)
+}
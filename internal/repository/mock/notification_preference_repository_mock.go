@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/notification_preference_repository.go
+
+package mock
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockNotificationPreferenceRepository is a mock of NotificationPreferenceRepository interface.
+type MockNotificationPreferenceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationPreferenceRepositoryMockRecorder
+}
+
+// MockNotificationPreferenceRepositoryMockRecorder is the mock recorder for MockNotificationPreferenceRepository.
+type MockNotificationPreferenceRepositoryMockRecorder struct {
+	mock *MockNotificationPreferenceRepository
+}
+
+// NewMockNotificationPreferenceRepository creates a new mock instance.
+func NewMockNotificationPreferenceRepository(ctrl *gomock.Controller) *MockNotificationPreferenceRepository {
+	mock := &MockNotificationPreferenceRepository{ctrl: ctrl}
+	mock.recorder = &MockNotificationPreferenceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationPreferenceRepository) EXPECT() *MockNotificationPreferenceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByUserID mocks base method.
+func (m *MockNotificationPreferenceRepository) FindByUserID(ctx context.Context, userID uint) ([]domain.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID)
+	ret0, _ := ret[0].([]domain.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockNotificationPreferenceRepositoryMockRecorder) FindByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockNotificationPreferenceRepository)(nil).FindByUserID), ctx, userID)
+}
+
+// FindChannel mocks base method.
+func (m *MockNotificationPreferenceRepository) FindChannel(ctx context.Context, userID uint, event string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindChannel", ctx, userID, event)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindChannel indicates an expected call of FindChannel.
+func (mr *MockNotificationPreferenceRepositoryMockRecorder) FindChannel(ctx, userID, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindChannel", reflect.TypeOf((*MockNotificationPreferenceRepository)(nil).FindChannel), ctx, userID, event)
+}
+
+// Save mocks base method.
+func (m *MockNotificationPreferenceRepository) Save(ctx context.Context, preferences []domain.NotificationPreference) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, preferences)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockNotificationPreferenceRepositoryMockRecorder) Save(ctx, preferences interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockNotificationPreferenceRepository)(nil).Save), ctx, preferences)
+}
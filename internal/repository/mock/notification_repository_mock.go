@@ -4,6 +4,8 @@
 package mock
 
 import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -32,16 +34,16 @@ func (m *MockNotificationRepository) EXPECT() *MockNotificationRepositoryMockRec
 	return m.recorder
 }
 
-// SendEmail mocks base method.
-func (m *MockNotificationRepository) SendEmail(toName, toEmail, subject, message string) error {
+// Send mocks base method.
+func (m *MockNotificationRepository) Send(ctx context.Context, email domain.Email) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendEmail", toName, toEmail, subject, message)
+	ret := m.ctrl.Call(m, "Send", ctx, email)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SendEmail indicates an expected call of SendEmail.
-func (mr *MockNotificationRepositoryMockRecorder) SendEmail(toName, toEmail, subject, message interface{}) *gomock.Call {
+// Send indicates an expected call of Send.
+func (mr *MockNotificationRepositoryMockRecorder) Send(ctx, email interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEmail", reflect.TypeOf((*MockNotificationRepository)(nil).SendEmail), toName, toEmail, subject, message)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockNotificationRepository)(nil).Send), ctx, email)
 }
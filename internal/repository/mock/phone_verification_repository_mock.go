@@ -0,0 +1,92 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/phone_verification_repository.go
+
+package mock
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPhoneVerificationRepository is a mock of PhoneVerificationRepository interface.
+type MockPhoneVerificationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPhoneVerificationRepositoryMockRecorder
+}
+
+// MockPhoneVerificationRepositoryMockRecorder is the mock recorder for MockPhoneVerificationRepository.
+type MockPhoneVerificationRepositoryMockRecorder struct {
+	mock *MockPhoneVerificationRepository
+}
+
+// NewMockPhoneVerificationRepository creates a new mock instance.
+func NewMockPhoneVerificationRepository(ctrl *gomock.Controller) *MockPhoneVerificationRepository {
+	mock := &MockPhoneVerificationRepository{ctrl: ctrl}
+	mock.recorder = &MockPhoneVerificationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPhoneVerificationRepository) EXPECT() *MockPhoneVerificationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockPhoneVerificationRepository) Delete(ctx context.Context, userID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPhoneVerificationRepositoryMockRecorder) Delete(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPhoneVerificationRepository)(nil).Delete), ctx, userID)
+}
+
+// FindByUserID mocks base method.
+func (m *MockPhoneVerificationRepository) FindByUserID(ctx context.Context, userID uint) (domain.PhoneVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID)
+	ret0, _ := ret[0].(domain.PhoneVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockPhoneVerificationRepositoryMockRecorder) FindByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockPhoneVerificationRepository)(nil).FindByUserID), ctx, userID)
+}
+
+// IncrementAttempts mocks base method.
+func (m *MockPhoneVerificationRepository) IncrementAttempts(ctx context.Context, userID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementAttempts", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementAttempts indicates an expected call of IncrementAttempts.
+func (mr *MockPhoneVerificationRepositoryMockRecorder) IncrementAttempts(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementAttempts", reflect.TypeOf((*MockPhoneVerificationRepository)(nil).IncrementAttempts), ctx, userID)
+}
+
+// Save mocks base method.
+func (m *MockPhoneVerificationRepository) Save(ctx context.Context, verification *domain.PhoneVerification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, verification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockPhoneVerificationRepositoryMockRecorder) Save(ctx, verification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockPhoneVerificationRepository)(nil).Save), ctx, verification)
+}
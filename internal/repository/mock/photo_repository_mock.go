@@ -0,0 +1,121 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/photo_repository.go
+
+package mock
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPhotoRepository is a mock of PhotoRepository interface.
+type MockPhotoRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPhotoRepositoryMockRecorder
+}
+
+// MockPhotoRepositoryMockRecorder is the mock recorder for MockPhotoRepository.
+type MockPhotoRepositoryMockRecorder struct {
+	mock *MockPhotoRepository
+}
+
+// NewMockPhotoRepository creates a new mock instance.
+func NewMockPhotoRepository(ctrl *gomock.Controller) *MockPhotoRepository {
+	mock := &MockPhotoRepository{ctrl: ctrl}
+	mock.recorder = &MockPhotoRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPhotoRepository) EXPECT() *MockPhotoRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPhotoRepository) Create(ctx context.Context, photo *domain.Photo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, photo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPhotoRepositoryMockRecorder) Create(ctx, photo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPhotoRepository)(nil).Create), ctx, photo)
+}
+
+// Delete mocks base method.
+func (m *MockPhotoRepository) Delete(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPhotoRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPhotoRepository)(nil).Delete), ctx, id)
+}
+
+// FindByGallery mocks base method.
+func (m *MockPhotoRepository) FindByGallery(ctx context.Context, gallery domain.PhotoGallery) ([]domain.Photo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByGallery", ctx, gallery)
+	ret0, _ := ret[0].([]domain.Photo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByGallery indicates an expected call of FindByGallery.
+func (mr *MockPhotoRepositoryMockRecorder) FindByGallery(ctx, gallery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByGallery", reflect.TypeOf((*MockPhotoRepository)(nil).FindByGallery), ctx, gallery)
+}
+
+// FindByID mocks base method.
+func (m *MockPhotoRepository) FindByID(ctx context.Context, id uint) (domain.Photo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(domain.Photo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockPhotoRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPhotoRepository)(nil).FindByID), ctx, id)
+}
+
+// Reorder mocks base method.
+func (m *MockPhotoRepository) Reorder(ctx context.Context, gallery domain.PhotoGallery, photoIDs []uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reorder", ctx, gallery, photoIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reorder indicates an expected call of Reorder.
+func (mr *MockPhotoRepositoryMockRecorder) Reorder(ctx, gallery, photoIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reorder", reflect.TypeOf((*MockPhotoRepository)(nil).Reorder), ctx, gallery, photoIDs)
+}
+
+// SetCover mocks base method.
+func (m *MockPhotoRepository) SetCover(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCover", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCover indicates an expected call of SetCover.
+func (mr *MockPhotoRepositoryMockRecorder) SetCover(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCover", reflect.TypeOf((*MockPhotoRepository)(nil).SetCover), ctx, id)
+}
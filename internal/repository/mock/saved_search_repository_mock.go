@@ -0,0 +1,168 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/saved_search_repository.go
+
+package mock
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	reflect "reflect"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSavedSearchRepository is a mock of SavedSearchRepository interface.
+type MockSavedSearchRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSavedSearchRepositoryMockRecorder
+}
+
+// MockSavedSearchRepositoryMockRecorder is the mock recorder for MockSavedSearchRepository.
+type MockSavedSearchRepositoryMockRecorder struct {
+	mock *MockSavedSearchRepository
+}
+
+// NewMockSavedSearchRepository creates a new mock instance.
+func NewMockSavedSearchRepository(ctrl *gomock.Controller) *MockSavedSearchRepository {
+	mock := &MockSavedSearchRepository{ctrl: ctrl}
+	mock.recorder = &MockSavedSearchRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSavedSearchRepository) EXPECT() *MockSavedSearchRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimSlot mocks base method.
+func (m *MockSavedSearchRepository) ClaimSlot(ctx context.Context, searchID uint, slot domain.SavedSearchSlot, at time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimSlot", ctx, searchID, slot, at)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimSlot indicates an expected call of ClaimSlot.
+func (mr *MockSavedSearchRepositoryMockRecorder) ClaimSlot(ctx, searchID, slot, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimSlot", reflect.TypeOf((*MockSavedSearchRepository)(nil).ClaimSlot), ctx, searchID, slot, at)
+}
+
+// CountByUserID mocks base method.
+func (m *MockSavedSearchRepository) CountByUserID(ctx context.Context, userID uint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByUserID", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByUserID indicates an expected call of CountByUserID.
+func (mr *MockSavedSearchRepositoryMockRecorder) CountByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByUserID", reflect.TypeOf((*MockSavedSearchRepository)(nil).CountByUserID), ctx, userID)
+}
+
+// Create mocks base method.
+func (m *MockSavedSearchRepository) Create(ctx context.Context, search *domain.SavedSearch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, search)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSavedSearchRepositoryMockRecorder) Create(ctx, search interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSavedSearchRepository)(nil).Create), ctx, search)
+}
+
+// Delete mocks base method.
+func (m *MockSavedSearchRepository) Delete(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSavedSearchRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSavedSearchRepository)(nil).Delete), ctx, id)
+}
+
+// FindByID mocks base method.
+func (m *MockSavedSearchRepository) FindByID(ctx context.Context, id uint) (domain.SavedSearch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(domain.SavedSearch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockSavedSearchRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockSavedSearchRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByUserID mocks base method.
+func (m *MockSavedSearchRepository) FindByUserID(ctx context.Context, userID uint) ([]domain.SavedSearch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID)
+	ret0, _ := ret[0].([]domain.SavedSearch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockSavedSearchRepositoryMockRecorder) FindByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockSavedSearchRepository)(nil).FindByUserID), ctx, userID)
+}
+
+// FindNewSlots mocks base method.
+func (m *MockSavedSearchRepository) FindNewSlots(ctx context.Context, search domain.SavedSearch, now time.Time, days, limit int) ([]domain.SavedSearchSlot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNewSlots", ctx, search, now, days, limit)
+	ret0, _ := ret[0].([]domain.SavedSearchSlot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNewSlots indicates an expected call of FindNewSlots.
+func (mr *MockSavedSearchRepositoryMockRecorder) FindNewSlots(ctx, search, now, days, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNewSlots", reflect.TypeOf((*MockSavedSearchRepository)(nil).FindNewSlots), ctx, search, now, days, limit)
+}
+
+// FindNotifying mocks base method.
+func (m *MockSavedSearchRepository) FindNotifying(ctx context.Context, afterID uint, limit int) ([]domain.SavedSearch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNotifying", ctx, afterID, limit)
+	ret0, _ := ret[0].([]domain.SavedSearch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNotifying indicates an expected call of FindNotifying.
+func (mr *MockSavedSearchRepositoryMockRecorder) FindNotifying(ctx, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNotifying", reflect.TypeOf((*MockSavedSearchRepository)(nil).FindNotifying), ctx, afterID, limit)
+}
+
+// Update mocks base method.
+func (m *MockSavedSearchRepository) Update(ctx context.Context, search *domain.SavedSearch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, search)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSavedSearchRepositoryMockRecorder) Update(ctx, search interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSavedSearchRepository)(nil).Update), ctx, search)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"go-futsal-booking-api/internal/domain"
 	reflect "reflect"
+	"time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -49,17 +50,17 @@ func (mr *MockScheduleRepositoryMockRecorder) Create(ctx, schedule interface{})
 }
 
 // Delete mocks base method.
-func (m *MockScheduleRepository) Delete(ctx context.Context, id uint) error {
+func (m *MockScheduleRepository) Delete(ctx context.Context, id uint, at time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, at)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockScheduleRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+func (mr *MockScheduleRepositoryMockRecorder) Delete(ctx, id, at interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockScheduleRepository)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockScheduleRepository)(nil).Delete), ctx, id, at)
 }
 
 // FindByFieldID mocks base method.
@@ -92,6 +93,50 @@ func (mr *MockScheduleRepositoryMockRecorder) FindByID(ctx, id interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockScheduleRepository)(nil).FindByID), ctx, id)
 }
 
+// FindByVenueID mocks base method.
+func (m *MockScheduleRepository) FindByVenueID(ctx context.Context, venueID uint) ([]domain.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByVenueID", ctx, venueID)
+	ret0, _ := ret[0].([]domain.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByVenueID indicates an expected call of FindByVenueID.
+func (mr *MockScheduleRepositoryMockRecorder) FindByVenueID(ctx, venueID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByVenueID", reflect.TypeOf((*MockScheduleRepository)(nil).FindByVenueID), ctx, venueID)
+}
+
+// FindDeletedByID mocks base method.
+func (m *MockScheduleRepository) FindDeletedByID(ctx context.Context, id uint) (domain.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedByID", ctx, id)
+	ret0, _ := ret[0].(domain.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedByID indicates an expected call of FindDeletedByID.
+func (mr *MockScheduleRepositoryMockRecorder) FindDeletedByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByID", reflect.TypeOf((*MockScheduleRepository)(nil).FindDeletedByID), ctx, id)
+}
+
+// Restore mocks base method.
+func (m *MockScheduleRepository) Restore(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockScheduleRepositoryMockRecorder) Restore(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockScheduleRepository)(nil).Restore), ctx, id)
+}
+
 // Update mocks base method.
 func (m *MockScheduleRepository) Update(ctx context.Context, schedule *domain.Schedule) error {
 	m.ctrl.T.Helper()
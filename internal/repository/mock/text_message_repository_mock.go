@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/text_message_repository.go
+
+package mock
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTextMessageRepository is a mock of TextMessageRepository interface.
+type MockTextMessageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTextMessageRepositoryMockRecorder
+}
+
+// MockTextMessageRepositoryMockRecorder is the mock recorder for MockTextMessageRepository.
+type MockTextMessageRepositoryMockRecorder struct {
+	mock *MockTextMessageRepository
+}
+
+// NewMockTextMessageRepository creates a new mock instance.
+func NewMockTextMessageRepository(ctrl *gomock.Controller) *MockTextMessageRepository {
+	mock := &MockTextMessageRepository{ctrl: ctrl}
+	mock.recorder = &MockTextMessageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTextMessageRepository) EXPECT() *MockTextMessageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockTextMessageRepository) Send(ctx context.Context, message domain.TextMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockTextMessageRepositoryMockRecorder) Send(ctx, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockTextMessageRepository)(nil).Send), ctx, message)
+}
@@ -0,0 +1,122 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/venue_member_repository.go
+
+package mock
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockVenueMemberRepository is a mock of VenueMemberRepository interface.
+type MockVenueMemberRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockVenueMemberRepositoryMockRecorder
+}
+
+// MockVenueMemberRepositoryMockRecorder is the mock recorder for MockVenueMemberRepository.
+type MockVenueMemberRepositoryMockRecorder struct {
+	mock *MockVenueMemberRepository
+}
+
+// NewMockVenueMemberRepository creates a new mock instance.
+func NewMockVenueMemberRepository(ctrl *gomock.Controller) *MockVenueMemberRepository {
+	mock := &MockVenueMemberRepository{ctrl: ctrl}
+	mock.recorder = &MockVenueMemberRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVenueMemberRepository) EXPECT() *MockVenueMemberRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockVenueMemberRepository) Create(ctx context.Context, member *domain.VenueMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockVenueMemberRepositoryMockRecorder) Create(ctx, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockVenueMemberRepository)(nil).Create), ctx, member)
+}
+
+// Delete mocks base method.
+func (m *MockVenueMemberRepository) Delete(ctx context.Context, venueID, userID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, venueID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockVenueMemberRepositoryMockRecorder) Delete(ctx, venueID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockVenueMemberRepository)(nil).Delete), ctx, venueID, userID)
+}
+
+// FindByVenueAndUser mocks base method.
+func (m *MockVenueMemberRepository) FindByVenueAndUser(ctx context.Context, venueID, userID uint) (domain.VenueMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByVenueAndUser", ctx, venueID, userID)
+	ret0, _ := ret[0].(domain.VenueMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByVenueAndUser indicates an expected call of FindByVenueAndUser.
+func (mr *MockVenueMemberRepositoryMockRecorder) FindByVenueAndUser(ctx, venueID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByVenueAndUser", reflect.TypeOf((*MockVenueMemberRepository)(nil).FindByVenueAndUser), ctx, venueID, userID)
+}
+
+// FindByVenueID mocks base method.
+func (m *MockVenueMemberRepository) FindByVenueID(ctx context.Context, venueID uint) ([]domain.VenueMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByVenueID", ctx, venueID)
+	ret0, _ := ret[0].([]domain.VenueMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByVenueID indicates an expected call of FindByVenueID.
+func (mr *MockVenueMemberRepositoryMockRecorder) FindByVenueID(ctx, venueID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByVenueID", reflect.TypeOf((*MockVenueMemberRepository)(nil).FindByVenueID), ctx, venueID)
+}
+
+// LockUserIDsByRole mocks base method.
+func (m *MockVenueMemberRepository) LockUserIDsByRole(ctx context.Context, venueID uint, role string) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockUserIDsByRole", ctx, venueID, role)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockUserIDsByRole indicates an expected call of LockUserIDsByRole.
+func (mr *MockVenueMemberRepositoryMockRecorder) LockUserIDsByRole(ctx, venueID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUserIDsByRole", reflect.TypeOf((*MockVenueMemberRepository)(nil).LockUserIDsByRole), ctx, venueID, role)
+}
+
+// UpdateRole mocks base method.
+func (m *MockVenueMemberRepository) UpdateRole(ctx context.Context, venueID, userID uint, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRole", ctx, venueID, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRole indicates an expected call of UpdateRole.
+func (mr *MockVenueMemberRepositoryMockRecorder) UpdateRole(ctx, venueID, userID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockVenueMemberRepository)(nil).UpdateRole), ctx, venueID, userID, role)
+}
@@ -0,0 +1,183 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/venue_repository.go
+
+package mock
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	reflect "reflect"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockVenueRepository is a mock of VenueRepository interface.
+type MockVenueRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockVenueRepositoryMockRecorder
+}
+
+// MockVenueRepositoryMockRecorder is the mock recorder for MockVenueRepository.
+type MockVenueRepositoryMockRecorder struct {
+	mock *MockVenueRepository
+}
+
+// NewMockVenueRepository creates a new mock instance.
+func NewMockVenueRepository(ctrl *gomock.Controller) *MockVenueRepository {
+	mock := &MockVenueRepository{ctrl: ctrl}
+	mock.recorder = &MockVenueRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVenueRepository) EXPECT() *MockVenueRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockVenueRepository) Create(ctx context.Context, venue *domain.Venue) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, venue)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockVenueRepositoryMockRecorder) Create(ctx, venue interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockVenueRepository)(nil).Create), ctx, venue)
+}
+
+// Delete mocks base method.
+func (m *MockVenueRepository) Delete(ctx context.Context, id uint, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockVenueRepositoryMockRecorder) Delete(ctx, id, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockVenueRepository)(nil).Delete), ctx, id, at)
+}
+
+// DeletionImpact mocks base method.
+func (m *MockVenueRepository) DeletionImpact(ctx context.Context, id uint) (domain.DeletionImpact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletionImpact", ctx, id)
+	ret0, _ := ret[0].(domain.DeletionImpact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletionImpact indicates an expected call of DeletionImpact.
+func (mr *MockVenueRepositoryMockRecorder) DeletionImpact(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletionImpact", reflect.TypeOf((*MockVenueRepository)(nil).DeletionImpact), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockVenueRepository) FindAll(ctx context.Context) ([]domain.Venue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]domain.Venue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockVenueRepositoryMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockVenueRepository)(nil).FindAll), ctx)
+}
+
+// FindByID mocks base method.
+func (m *MockVenueRepository) FindByID(ctx context.Context, id uint) (domain.Venue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(domain.Venue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockVenueRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockVenueRepository)(nil).FindByID), ctx, id)
+}
+
+// FindDeletedByID mocks base method.
+func (m *MockVenueRepository) FindDeletedByID(ctx context.Context, id uint) (domain.Venue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedByID", ctx, id)
+	ret0, _ := ret[0].(domain.Venue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedByID indicates an expected call of FindDeletedByID.
+func (mr *MockVenueRepositoryMockRecorder) FindDeletedByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByID", reflect.TypeOf((*MockVenueRepository)(nil).FindDeletedByID), ctx, id)
+}
+
+// FindNearby mocks base method.
+func (m *MockVenueRepository) FindNearby(ctx context.Context, center domain.GeoPoint, radiusKm float64, limit int) ([]domain.NearbyVenue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNearby", ctx, center, radiusKm, limit)
+	ret0, _ := ret[0].([]domain.NearbyVenue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNearby indicates an expected call of FindNearby.
+func (mr *MockVenueRepositoryMockRecorder) FindNearby(ctx, center, radiusKm, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNearby", reflect.TypeOf((*MockVenueRepository)(nil).FindNearby), ctx, center, radiusKm, limit)
+}
+
+// Restore mocks base method.
+func (m *MockVenueRepository) Restore(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockVenueRepositoryMockRecorder) Restore(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockVenueRepository)(nil).Restore), ctx, id)
+}
+
+// Search mocks base method.
+func (m *MockVenueRepository) Search(ctx context.Context, filter domain.VenueFilter) ([]domain.VenueListing, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter)
+	ret0, _ := ret[0].([]domain.VenueListing)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockVenueRepositoryMockRecorder) Search(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockVenueRepository)(nil).Search), ctx, filter)
+}
+
+// Update mocks base method.
+func (m *MockVenueRepository) Update(ctx context.Context, venue *domain.Venue) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, venue)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockVenueRepositoryMockRecorder) Update(ctx, venue interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockVenueRepository)(nil).Update), ctx, venue)
+}
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"go-futsal-booking-api/internal/domain"
+	"time"
+)
+
+type AmenityGorm struct {
+	Code      string `gorm:"column:code;primaryKey"`
+	Name      string `gorm:"column:name;not null"`
+	CreatedAt time.Time
+}
+
+func (AmenityGorm) TableName() string {
+	return "amenities"
+}
+
+func (ag *AmenityGorm) ToDomain() domain.Amenity {
+	return domain.Amenity{
+		Code:      ag.Code,
+		Name:      ag.Name,
+		CreatedAt: ag.CreatedAt,
+	}
+}
+
+func (ag *AmenityGorm) FromDomain(amenity domain.Amenity) {
+	ag.Code = amenity.Code
+	ag.Name = amenity.Name
+	ag.CreatedAt = amenity.CreatedAt
+}
+
+type VenueAmenityGorm struct {
+	VenueID     uint   `gorm:"column:venue_id;primaryKey"`
+	AmenityCode string `gorm:"column:amenity_code;primaryKey"`
+
+	Amenity AmenityGorm `gorm:"foreignKey:AmenityCode;references:Code"`
+}
+
+func (VenueAmenityGorm) TableName() string {
+	return "venue_amenities"
+}
+
+type VenueOpeningHoursGorm struct {
+	VenueID   uint      `gorm:"column:venue_id;primaryKey"`
+	DayOfWeek int       `gorm:"column:day_of_week;primaryKey"`
+	OpensAt   TimeOfDay `gorm:"column:opens_at;type:time;not null"`
+	ClosesAt  TimeOfDay `gorm:"column:closes_at;type:time;not null"`
+}
+
+func (VenueOpeningHoursGorm) TableName() string {
+	return "venue_opening_hours"
+}
+
+func (oh *VenueOpeningHoursGorm) ToDomain() domain.OpeningHours {
+	return domain.OpeningHours{
+		DayOfWeek: oh.DayOfWeek,
+		OpensAt:   oh.OpensAt.ToTime(),
+		ClosesAt:  oh.ClosesAt.ToTime(),
+	}
+}
+
+func (oh *VenueOpeningHoursGorm) FromDomain(venueID uint, hours domain.OpeningHours) {
+	oh.VenueID = venueID
+	oh.DayOfWeek = hours.DayOfWeek
+	oh.OpensAt = NewTimeOfDay(hours.OpensAt)
+	oh.ClosesAt = NewTimeOfDay(hours.ClosesAt)
+}
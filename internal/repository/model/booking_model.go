@@ -8,12 +8,13 @@ import (
 )
 
 type BookingGorm struct {
-	ID          uint      `gorm:"primaryKey"`
-	UserID      uint      `gorm:"column:user_id;not null"`
-	ScheduleID  uint      `gorm:"column:schedule_id;not null"`
-	BookingDate time.Time `gorm:"column:booking_date;type:date;not null"`
-	Status      string    `gorm:"column:status; not null"`
-	TotalPrice  float64   `gorm:"column:total_price;type:numeric(10,2);not null"`
+	ID          uint       `gorm:"primaryKey"`
+	UserID      uint       `gorm:"column:user_id;not null"`
+	ScheduleID  uint       `gorm:"column:schedule_id;not null"`
+	BookingDate time.Time  `gorm:"column:booking_date;type:date;not null"`
+	Status      string     `gorm:"column:status; not null"`
+	TotalPrice  float64    `gorm:"column:total_price;type:numeric(10,2);not null"`
+	CheckedInAt *time.Time `gorm:"column:checked_in_at"`
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	DeletedAt   gorm.DeletedAt `gorm:"index"`
@@ -37,6 +38,7 @@ func (bg *BookingGorm) ToDomain() domain.Booking {
 		BookingDate: bg.BookingDate,
 		Status:      bg.Status,
 		TotalPrice:  bg.TotalPrice,
+		CheckedInAt: bg.CheckedInAt,
 		CreatedAt:   bg.CreatedAt,
 		UpdatedAt:   bg.UpdatedAt,
 		DeletedAt:   deletedAt,
@@ -52,4 +54,16 @@ func (bg *BookingGorm) FromDomain(b domain.Booking) {
 	bg.BookingDate = b.BookingDate
 	bg.Status = b.Status
 	bg.TotalPrice = b.TotalPrice
+	bg.CheckedInAt = b.CheckedInAt
+}
+
+type BookingReminderGorm struct {
+	ID            uint      `gorm:"primaryKey"`
+	BookingID     uint      `gorm:"column:booking_id;not null"`
+	OffsetMinutes int       `gorm:"column:offset_minutes;not null"`
+	SentAt        time.Time `gorm:"column:sent_at;not null"`
+}
+
+func (BookingReminderGorm) TableName() string {
+	return "booking_reminders"
 }
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"go-futsal-booking-api/internal/domain"
+	"time"
+)
+
+type CalendarFeedGorm struct {
+	UserID    uint   `gorm:"column:user_id;primaryKey"`
+	TokenHash string `gorm:"column:token_hash;not null;unique"`
+	CreatedAt time.Time
+}
+
+func (CalendarFeedGorm) TableName() string {
+	return "calendar_feeds"
+}
+
+func (cf *CalendarFeedGorm) ToDomain() domain.CalendarFeed {
+	return domain.CalendarFeed{
+		UserID:    cf.UserID,
+		TokenHash: cf.TokenHash,
+		CreatedAt: cf.CreatedAt,
+	}
+}
+
+func (cf *CalendarFeedGorm) FromDomain(feed domain.CalendarFeed) {
+	cf.UserID = feed.UserID
+	cf.TokenHash = feed.TokenHash
+	cf.CreatedAt = feed.CreatedAt
+}
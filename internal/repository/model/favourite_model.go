@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+type FavouriteVenueGorm struct {
+	UserID    uint `gorm:"column:user_id;primaryKey"`
+	VenueID   uint `gorm:"column:venue_id;primaryKey"`
+	CreatedAt time.Time
+
+	Venue VenueGorm `gorm:"foreignKey:VenueID"`
+}
+
+func (FavouriteVenueGorm) TableName() string {
+	return "favourite_venues"
+}
+
+type FavouriteFieldGorm struct {
+	UserID    uint `gorm:"column:user_id;primaryKey"`
+	FieldID   uint `gorm:"column:field_id;primaryKey"`
+	CreatedAt time.Time
+
+	Field FieldGorm `gorm:"foreignKey:FieldID"`
+}
+
+func (FavouriteFieldGorm) TableName() string {
+	return "favourite_fields"
+}
@@ -8,13 +8,19 @@ import (
 )
 
 type FieldGorm struct {
-	ID        uint   `gorm:"primaryKey"`
-	VenueID   uint   `gorm:"column:venue_id;not null"`
-	Name      string `gorm:"column:name;not null"`
-	Type      string `gorm:"column:type;not null"`
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt gorm.DeletedAt `gorm:"index"`
+	ID         uint     `gorm:"primaryKey"`
+	VenueID    uint     `gorm:"column:venue_id;not null"`
+	Name       string   `gorm:"column:name;not null"`
+	Type       string   `gorm:"column:type;not null"`
+	LengthM    *float64 `gorm:"column:length_m"`
+	WidthM     *float64 `gorm:"column:width_m"`
+	Indoor     bool     `gorm:"column:is_indoor;not null"`
+	Lighting   bool     `gorm:"column:has_lighting;not null"`
+	MaxPlayers int      `gorm:"column:max_players;not null"`
+	Status     string   `gorm:"column:status;not null"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	DeletedAt  gorm.DeletedAt `gorm:"index"`
 
 	Venue VenueGorm `gorm:"foreignKey:VenueID"`
 }
@@ -30,13 +36,19 @@ func (fg *FieldGorm) ToDomain() domain.Field {
 	}
 
 	return domain.Field{
-		ID:        fg.ID,
-		Name:      fg.Name,
-		Type:      fg.Type,
-		CreatedAt: fg.CreatedAt,
-		UpdatedAt: fg.UpdatedAt,
-		DeletedAt: deletedAt,
-		Venue:     fg.Venue.ToDomain(),
+		ID:           fg.ID,
+		Name:         fg.Name,
+		Type:         fg.Type,
+		LengthMeters: fg.LengthM,
+		WidthMeters:  fg.WidthM,
+		Indoor:       fg.Indoor,
+		HasLighting:  fg.Lighting,
+		MaxPlayers:   fg.MaxPlayers,
+		Status:       fg.Status,
+		CreatedAt:    fg.CreatedAt,
+		UpdatedAt:    fg.UpdatedAt,
+		DeletedAt:    deletedAt,
+		Venue:        fg.Venue.ToDomain(),
 	}
 }
 
@@ -44,5 +56,11 @@ func (fg *FieldGorm) FromDomain(field domain.Field) {
 	fg.ID = field.ID
 	fg.Name = field.Name
 	fg.Type = field.Type
+	fg.LengthM = field.LengthMeters
+	fg.WidthM = field.WidthMeters
+	fg.Indoor = field.Indoor
+	fg.Lighting = field.HasLighting
+	fg.MaxPlayers = field.MaxPlayers
+	fg.Status = field.Status
 	fg.VenueID = field.Venue.ID
 }
@@ -0,0 +1,22 @@
+package model
+
+import "go-futsal-booking-api/internal/domain"
+
+type FieldTypeGorm struct {
+	Code        string `gorm:"column:code;primaryKey"`
+	Name        string `gorm:"column:name;not null"`
+	Description string `gorm:"column:description;not null"`
+	SortOrder   int    `gorm:"column:sort_order;not null"`
+}
+
+func (FieldTypeGorm) TableName() string {
+	return "field_types"
+}
+
+func (ft *FieldTypeGorm) ToDomain() domain.FieldType {
+	return domain.FieldType{
+		Code:        ft.Code,
+		Name:        ft.Name,
+		Description: ft.Description,
+	}
+}
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"go-futsal-booking-api/internal/domain"
+	"time"
+)
+
+type InboxNotificationGorm struct {
+	ID        uint       `gorm:"primaryKey"`
+	UserID    uint       `gorm:"column:user_id;not null"`
+	Event     string     `gorm:"column:event;not null"`
+	Title     string     `gorm:"column:title;not null"`
+	Body      string     `gorm:"column:body;not null"`
+	ReadAt    *time.Time `gorm:"column:read_at"`
+	CreatedAt time.Time
+}
+
+func (InboxNotificationGorm) TableName() string {
+	return "notifications"
+}
+
+func (n *InboxNotificationGorm) ToDomain() domain.InboxNotification {
+	return domain.InboxNotification{
+		ID:        n.ID,
+		UserID:    n.UserID,
+		Event:     n.Event,
+		Title:     n.Title,
+		Body:      n.Body,
+		ReadAt:    n.ReadAt,
+		CreatedAt: n.CreatedAt,
+	}
+}
+
+func (n *InboxNotificationGorm) FromDomain(notification domain.InboxNotification) {
+	n.ID = notification.ID
+	n.UserID = notification.UserID
+	n.Event = notification.Event
+	n.Title = notification.Title
+	n.Body = notification.Body
+	n.ReadAt = notification.ReadAt
+	n.CreatedAt = notification.CreatedAt
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"go-futsal-booking-api/internal/domain"
+	"time"
+)
+
+type LoginAttemptGorm struct {
+	ID           uint       `gorm:"primaryKey"`
+	Scope        string     `gorm:"column:scope;not null"`
+	Key          string     `gorm:"column:key;not null"`
+	FailedCount  int        `gorm:"column:failed_count;not null"`
+	LastFailedAt *time.Time `gorm:"column:last_failed_at"`
+	LockedUntil  *time.Time `gorm:"column:locked_until"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (LoginAttemptGorm) TableName() string {
+	return "login_attempts"
+}
+
+func (la *LoginAttemptGorm) ToDomain() domain.LoginAttempt {
+	return domain.LoginAttempt{
+		Scope:        la.Scope,
+		Key:          la.Key,
+		FailedCount:  la.FailedCount,
+		LastFailedAt: la.LastFailedAt,
+		LockedUntil:  la.LockedUntil,
+	}
+}
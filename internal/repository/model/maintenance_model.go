@@ -0,0 +1,44 @@
+package model
+
+import (
+	"go-futsal-booking-api/internal/domain"
+	"time"
+)
+
+type MaintenanceWindowGorm struct {
+	ID        uint      `gorm:"primaryKey"`
+	FieldID   uint      `gorm:"column:field_id;not null"`
+	StartsAt  time.Time `gorm:"column:starts_at;not null"`
+	EndsAt    time.Time `gorm:"column:ends_at;not null"`
+	Reason    string    `gorm:"column:reason;not null"`
+	CreatedBy uint      `gorm:"column:created_by;not null"`
+	CreatedAt time.Time
+}
+
+func (MaintenanceWindowGorm) TableName() string {
+	return "maintenance_windows"
+}
+
+func (mg *MaintenanceWindowGorm) ToDomain() domain.MaintenanceWindow {
+	return domain.MaintenanceWindow{
+		ID:        mg.ID,
+		FieldID:   mg.FieldID,
+		StartsAt:  mg.StartsAt,
+		EndsAt:    mg.EndsAt,
+		Reason:    mg.Reason,
+		CreatedBy: mg.CreatedBy,
+		CreatedAt: mg.CreatedAt,
+	}
+}
+
+// FromDomain stores the window in UTC: the columns have no timezone, and the
+// driver writes the wall clock of whatever zone a time is in.
+func (mg *MaintenanceWindowGorm) FromDomain(window domain.MaintenanceWindow) {
+	mg.ID = window.ID
+	mg.FieldID = window.FieldID
+	mg.StartsAt = window.StartsAt.UTC()
+	mg.EndsAt = window.EndsAt.UTC()
+	mg.Reason = window.Reason
+	mg.CreatedBy = window.CreatedBy
+	mg.CreatedAt = window.CreatedAt
+}
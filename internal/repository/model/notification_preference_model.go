@@ -0,0 +1,65 @@
+package model
+
+import (
+	"go-futsal-booking-api/internal/domain"
+	"time"
+)
+
+type NotificationPreferenceGorm struct {
+	UserID    uint   `gorm:"column:user_id;primaryKey"`
+	Event     string `gorm:"column:event;primaryKey"`
+	Channel   string `gorm:"column:channel;not null"`
+	UpdatedAt time.Time
+}
+
+func (NotificationPreferenceGorm) TableName() string {
+	return "notification_preferences"
+}
+
+func (np *NotificationPreferenceGorm) ToDomain() domain.NotificationPreference {
+	return domain.NotificationPreference{
+		UserID:    np.UserID,
+		Event:     np.Event,
+		Channel:   np.Channel,
+		UpdatedAt: np.UpdatedAt,
+	}
+}
+
+func (np *NotificationPreferenceGorm) FromDomain(p domain.NotificationPreference) {
+	np.UserID = p.UserID
+	np.Event = p.Event
+	np.Channel = p.Channel
+}
+
+type PhoneVerificationGorm struct {
+	UserID      uint      `gorm:"column:user_id;primaryKey"`
+	PhoneNumber string    `gorm:"column:phone_number;not null"`
+	CodeHash    string    `gorm:"column:code_hash;not null"`
+	Attempts    int       `gorm:"column:attempts;not null;default:0"`
+	ExpiresAt   time.Time `gorm:"column:expires_at;not null"`
+	CreatedAt   time.Time
+}
+
+func (PhoneVerificationGorm) TableName() string {
+	return "phone_verifications"
+}
+
+func (pv *PhoneVerificationGorm) ToDomain() domain.PhoneVerification {
+	return domain.PhoneVerification{
+		UserID:      pv.UserID,
+		PhoneNumber: pv.PhoneNumber,
+		CodeHash:    pv.CodeHash,
+		Attempts:    pv.Attempts,
+		ExpiresAt:   pv.ExpiresAt,
+		CreatedAt:   pv.CreatedAt,
+	}
+}
+
+func (pv *PhoneVerificationGorm) FromDomain(v domain.PhoneVerification) {
+	pv.UserID = v.UserID
+	pv.PhoneNumber = v.PhoneNumber
+	pv.CodeHash = v.CodeHash
+	pv.Attempts = v.Attempts
+	pv.ExpiresAt = v.ExpiresAt
+	pv.CreatedAt = v.CreatedAt
+}
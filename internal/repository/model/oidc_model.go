@@ -0,0 +1,67 @@
+package model
+
+import (
+	"go-futsal-booking-api/internal/domain"
+	"time"
+)
+
+type OIDCStateGorm struct {
+	State        string    `gorm:"primaryKey;column:state"`
+	CodeVerifier string    `gorm:"column:code_verifier;not null"`
+	Nonce        string    `gorm:"column:nonce;not null"`
+	ExpiresAt    time.Time `gorm:"column:expires_at;not null"`
+	CreatedAt    time.Time
+}
+
+func (OIDCStateGorm) TableName() string {
+	return "oidc_states"
+}
+
+func (os *OIDCStateGorm) ToDomain() domain.OIDCState {
+	return domain.OIDCState{
+		State:        os.State,
+		CodeVerifier: os.CodeVerifier,
+		Nonce:        os.Nonce,
+		ExpiresAt:    os.ExpiresAt,
+	}
+}
+
+func (os *OIDCStateGorm) FromDomain(state domain.OIDCState) {
+	os.State = state.State
+	os.CodeVerifier = state.CodeVerifier
+	os.Nonce = state.Nonce
+	os.ExpiresAt = state.ExpiresAt
+}
+
+type UserIdentityGorm struct {
+	ID        uint   `gorm:"primaryKey"`
+	UserID    uint   `gorm:"column:user_id;not null"`
+	Provider  string `gorm:"column:provider;not null"`
+	Subject   string `gorm:"column:subject;not null"`
+	Email     string `gorm:"column:email;not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (UserIdentityGorm) TableName() string {
+	return "user_identities"
+}
+
+func (ui *UserIdentityGorm) ToDomain() domain.UserIdentity {
+	return domain.UserIdentity{
+		ID:        ui.ID,
+		UserID:    ui.UserID,
+		Provider:  ui.Provider,
+		Subject:   ui.Subject,
+		Email:     ui.Email,
+		CreatedAt: ui.CreatedAt,
+	}
+}
+
+func (ui *UserIdentityGorm) FromDomain(identity domain.UserIdentity) {
+	ui.ID = identity.ID
+	ui.UserID = identity.UserID
+	ui.Provider = identity.Provider
+	ui.Subject = identity.Subject
+	ui.Email = identity.Email
+}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"encoding/json"
+	"go-futsal-booking-api/internal/domain"
+	"time"
+)
+
+type OutboxMessageGorm struct {
+	ID            uint       `gorm:"primaryKey"`
+	Topic         string     `gorm:"column:topic;not null"`
+	Payload       string     `gorm:"column:payload;type:jsonb;not null"`
+	Status        string     `gorm:"column:status;not null"`
+	Attempts      int        `gorm:"column:attempts;not null"`
+	MaxAttempts   int        `gorm:"column:max_attempts;not null"`
+	NextAttemptAt time.Time  `gorm:"column:next_attempt_at;not null"`
+	LockedUntil   *time.Time `gorm:"column:locked_until"`
+	LastError     string     `gorm:"column:last_error"`
+	SentAt        *time.Time `gorm:"column:sent_at"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (OutboxMessageGorm) TableName() string {
+	return "outbox_messages"
+}
+
+func (om *OutboxMessageGorm) ToDomain() domain.OutboxMessage {
+	return domain.OutboxMessage{
+		ID:            om.ID,
+		Topic:         om.Topic,
+		Payload:       json.RawMessage(om.Payload),
+		Status:        om.Status,
+		Attempts:      om.Attempts,
+		MaxAttempts:   om.MaxAttempts,
+		NextAttemptAt: om.NextAttemptAt,
+		LockedUntil:   om.LockedUntil,
+		LastError:     om.LastError,
+		SentAt:        om.SentAt,
+		CreatedAt:     om.CreatedAt,
+		UpdatedAt:     om.UpdatedAt,
+	}
+}
+
+func (om *OutboxMessageGorm) FromDomain(message domain.OutboxMessage) {
+	om.ID = message.ID
+	om.Topic = message.Topic
+	om.Payload = string(message.Payload)
+	om.Status = message.Status
+	om.Attempts = message.Attempts
+	om.MaxAttempts = message.MaxAttempts
+	om.NextAttemptAt = message.NextAttemptAt
+	om.LockedUntil = message.LockedUntil
+	om.LastError = message.LastError
+	om.SentAt = message.SentAt
+	om.CreatedAt = message.CreatedAt
+	om.UpdatedAt = message.UpdatedAt
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"go-futsal-booking-api/internal/domain"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type PaymentGorm struct {
+	ID            uint    `gorm:"primaryKey"`
+	BookingID     uint    `gorm:"column:booking_id;not null"`
+	PaymentMethod string  `gorm:"column:payment_method;not null"`
+	Amount        float64 `gorm:"column:amount;type:numeric(10,2);not null"`
+	Status        string  `gorm:"column:status;not null"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	DeletedAt     gorm.DeletedAt `gorm:"index"`
+}
+
+func (PaymentGorm) TableName() string {
+	return "payments"
+}
+
+func (pg *PaymentGorm) ToDomain() domain.Payment {
+	return domain.Payment{
+		ID:            pg.ID,
+		BookingID:     pg.BookingID,
+		PaymentMethod: pg.PaymentMethod,
+		Amount:        pg.Amount,
+		Status:        pg.Status,
+		CreatedAt:     pg.CreatedAt,
+		UpdatedAt:     pg.UpdatedAt,
+	}
+}
+
+func (pg *PaymentGorm) FromDomain(p domain.Payment) {
+	pg.ID = p.ID
+	pg.BookingID = p.BookingID
+	pg.PaymentMethod = p.PaymentMethod
+	pg.Amount = p.Amount
+	pg.Status = p.Status
+}
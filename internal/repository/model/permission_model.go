@@ -0,0 +1,26 @@
+package model
+
+import (
+	"go-futsal-booking-api/internal/domain"
+	"time"
+)
+
+type PermissionGorm struct {
+	ID          uint   `gorm:"primaryKey"`
+	Name        string `gorm:"column:name;unique;not null"`
+	Description string `gorm:"column:description;not null"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (PermissionGorm) TableName() string {
+	return "permissions"
+}
+
+func (pg *PermissionGorm) ToDomain() domain.Permission {
+	return domain.Permission{
+		ID:          pg.ID,
+		Name:        pg.Name,
+		Description: pg.Description,
+	}
+}
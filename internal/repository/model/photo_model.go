@@ -0,0 +1,60 @@
+package model
+
+import (
+	"go-futsal-booking-api/internal/domain"
+	"time"
+)
+
+type PhotoGorm struct {
+	ID           uint   `gorm:"primaryKey"`
+	VenueID      uint   `gorm:"column:venue_id;not null"`
+	FieldID      *uint  `gorm:"column:field_id"`
+	StorageKey   string `gorm:"column:storage_key;not null"`
+	ThumbnailKey string `gorm:"column:thumbnail_key;not null"`
+	ContentType  string `gorm:"column:content_type;not null"`
+	Width        int    `gorm:"column:width;not null"`
+	Height       int    `gorm:"column:height;not null"`
+	SizeBytes    int64  `gorm:"column:size_bytes;not null"`
+	Position     int    `gorm:"column:position;not null"`
+	IsCover      bool   `gorm:"column:is_cover;not null"`
+	UploadedBy   uint   `gorm:"column:uploaded_by;not null"`
+	CreatedAt    time.Time
+}
+
+func (PhotoGorm) TableName() string {
+	return "photos"
+}
+
+func (pg *PhotoGorm) ToDomain() domain.Photo {
+	return domain.Photo{
+		ID:           pg.ID,
+		VenueID:      pg.VenueID,
+		FieldID:      pg.FieldID,
+		StorageKey:   pg.StorageKey,
+		ThumbnailKey: pg.ThumbnailKey,
+		ContentType:  pg.ContentType,
+		Width:        pg.Width,
+		Height:       pg.Height,
+		SizeBytes:    pg.SizeBytes,
+		Position:     pg.Position,
+		IsCover:      pg.IsCover,
+		UploadedBy:   pg.UploadedBy,
+		CreatedAt:    pg.CreatedAt,
+	}
+}
+
+func (pg *PhotoGorm) FromDomain(photo domain.Photo) {
+	pg.ID = photo.ID
+	pg.VenueID = photo.VenueID
+	pg.FieldID = photo.FieldID
+	pg.StorageKey = photo.StorageKey
+	pg.ThumbnailKey = photo.ThumbnailKey
+	pg.ContentType = photo.ContentType
+	pg.Width = photo.Width
+	pg.Height = photo.Height
+	pg.SizeBytes = photo.SizeBytes
+	pg.Position = photo.Position
+	pg.IsCover = photo.IsCover
+	pg.UploadedBy = photo.UploadedBy
+	pg.CreatedAt = photo.CreatedAt
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"go-futsal-booking-api/internal/domain"
+	"time"
+)
+
+type ReviewGorm struct {
+	ID        uint       `gorm:"primaryKey"`
+	VenueID   uint       `gorm:"column:venue_id;not null"`
+	BookingID uint       `gorm:"column:booking_id;unique;not null"`
+	UserID    uint       `gorm:"column:user_id;not null"`
+	Rating    int        `gorm:"column:rating;not null"`
+	Comment   string     `gorm:"column:comment;not null"`
+	Reply     string     `gorm:"column:reply;not null"`
+	RepliedBy *uint      `gorm:"column:replied_by"`
+	RepliedAt *time.Time `gorm:"column:replied_at"`
+	Status    string     `gorm:"column:status;not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	User UserGorm `gorm:"foreignKey:UserID"`
+}
+
+func (ReviewGorm) TableName() string {
+	return "reviews"
+}
+
+func (rg *ReviewGorm) ToDomain() domain.Review {
+	user := rg.User.ToDomain()
+	user.Password = ""
+	if user.ID == 0 {
+		user.ID = rg.UserID
+	}
+
+	return domain.Review{
+		ID:        rg.ID,
+		VenueID:   rg.VenueID,
+		BookingID: rg.BookingID,
+		User:      user,
+		Rating:    rg.Rating,
+		Comment:   rg.Comment,
+		Reply:     rg.Reply,
+		RepliedBy: rg.RepliedBy,
+		RepliedAt: rg.RepliedAt,
+		Status:    rg.Status,
+		CreatedAt: rg.CreatedAt,
+		UpdatedAt: rg.UpdatedAt,
+	}
+}
+
+func (rg *ReviewGorm) FromDomain(review domain.Review) {
+	rg.ID = review.ID
+	rg.VenueID = review.VenueID
+	rg.BookingID = review.BookingID
+	rg.UserID = review.User.ID
+	rg.Rating = review.Rating
+	rg.Comment = review.Comment
+	rg.Reply = review.Reply
+	rg.RepliedBy = review.RepliedBy
+	rg.RepliedAt = review.RepliedAt
+	rg.Status = review.Status
+}
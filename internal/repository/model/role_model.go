@@ -1,6 +1,7 @@
 package model
 
 import (
+	"go-futsal-booking-api/internal/domain"
 	"time"
 
 	"gorm.io/gorm"
@@ -8,12 +9,32 @@ import (
 
 type RoleGorm struct {
 	ID        uint   `gorm:"primaryKey"`
-	RoleName  string `gorm:"column:role_name;unique;not null"`
+	RoleName  string `gorm:"column:role_name;not null"`
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt gorm.DeletedAt `gorm:"index"`
+
+	Permissions []PermissionGorm `gorm:"many2many:role_permissions;joinForeignKey:RoleID;joinReferences:PermissionID"`
 }
 
 func (RoleGorm) TableName() string {
 	return "roles"
 }
+
+func (rg *RoleGorm) ToDomain() domain.Role {
+	permissions := make([]domain.Permission, len(rg.Permissions))
+	for i := range rg.Permissions {
+		permissions[i] = rg.Permissions[i].ToDomain()
+	}
+
+	return domain.Role{
+		ID:          rg.ID,
+		RoleName:    rg.RoleName,
+		Permissions: permissions,
+	}
+}
+
+func (rg *RoleGorm) FromDomain(role domain.Role) {
+	rg.ID = role.ID
+	rg.RoleName = role.RoleName
+}
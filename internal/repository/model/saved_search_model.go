@@ -0,0 +1,85 @@
+package model
+
+import (
+	"go-futsal-booking-api/internal/domain"
+	"time"
+)
+
+type SavedSearchGorm struct {
+	ID        uint       `gorm:"primaryKey"`
+	UserID    uint       `gorm:"column:user_id;not null"`
+	Name      string     `gorm:"column:name;not null"`
+	City      string     `gorm:"column:city;not null"`
+	FieldType string     `gorm:"column:field_type;not null"`
+	DayOfWeek int        `gorm:"column:day_of_week;not null"`
+	StartTime *TimeOfDay `gorm:"column:start_time;type:time"`
+	EndTime   *TimeOfDay `gorm:"column:end_time;type:time"`
+	Notify    bool       `gorm:"column:notify;not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	User UserGorm `gorm:"foreignKey:UserID"`
+}
+
+func (SavedSearchGorm) TableName() string {
+	return "saved_searches"
+}
+
+func (sg *SavedSearchGorm) ToDomain() domain.SavedSearch {
+	search := domain.SavedSearch{
+		ID:        sg.ID,
+		UserID:    sg.UserID,
+		Name:      sg.Name,
+		City:      sg.City,
+		FieldType: sg.FieldType,
+		DayOfWeek: sg.DayOfWeek,
+		Notify:    sg.Notify,
+		CreatedAt: sg.CreatedAt,
+		UpdatedAt: sg.UpdatedAt,
+	}
+	if sg.User.ID != 0 {
+		search.User = sg.User.ToDomain()
+		search.User.Password = ""
+	}
+	if sg.StartTime != nil {
+		start := sg.StartTime.ToTime()
+		search.StartTime = &start
+	}
+	if sg.EndTime != nil {
+		end := sg.EndTime.ToTime()
+		search.EndTime = &end
+	}
+
+	return search
+}
+
+func (sg *SavedSearchGorm) FromDomain(search domain.SavedSearch) {
+	sg.ID = search.ID
+	sg.UserID = search.UserID
+	sg.Name = search.Name
+	sg.City = search.City
+	sg.FieldType = search.FieldType
+	sg.DayOfWeek = search.DayOfWeek
+	sg.Notify = search.Notify
+	sg.StartTime, sg.EndTime = nil, nil
+	if search.StartTime != nil {
+		start := NewTimeOfDay(*search.StartTime)
+		sg.StartTime = &start
+	}
+	if search.EndTime != nil {
+		end := NewTimeOfDay(*search.EndTime)
+		sg.EndTime = &end
+	}
+}
+
+// SavedSearchAlertGorm claims a slot for a saved search, so it is alerted once.
+type SavedSearchAlertGorm struct {
+	SavedSearchID uint      `gorm:"column:saved_search_id;primaryKey"`
+	ScheduleID    uint      `gorm:"column:schedule_id;primaryKey"`
+	SlotDate      time.Time `gorm:"column:slot_date;type:date;primaryKey"`
+	NotifiedAt    time.Time `gorm:"column:notified_at;not null"`
+}
+
+func (SavedSearchAlertGorm) TableName() string {
+	return "saved_search_alerts"
+}
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"go-futsal-booking-api/internal/domain"
+	"time"
+)
+
+type TwoFactorGorm struct {
+	UserID          uint       `gorm:"primaryKey;column:user_id"`
+	SecretEncrypted string     `gorm:"column:secret_encrypted;not null"`
+	Enabled         bool       `gorm:"column:enabled;not null"`
+	LastUsedStep    int64      `gorm:"column:last_used_step;not null"`
+	EnabledAt       *time.Time `gorm:"column:enabled_at"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func (TwoFactorGorm) TableName() string {
+	return "user_two_factors"
+}
+
+func (tf *TwoFactorGorm) ToDomain() domain.TwoFactor {
+	return domain.TwoFactor{
+		UserID:       tf.UserID,
+		Secret:       tf.SecretEncrypted,
+		Enabled:      tf.Enabled,
+		LastUsedStep: tf.LastUsedStep,
+		EnabledAt:    tf.EnabledAt,
+		CreatedAt:    tf.CreatedAt,
+		UpdatedAt:    tf.UpdatedAt,
+	}
+}
+
+func (tf *TwoFactorGorm) FromDomain(twoFactor domain.TwoFactor) {
+	tf.UserID = twoFactor.UserID
+	tf.SecretEncrypted = twoFactor.Secret
+	tf.Enabled = twoFactor.Enabled
+	tf.LastUsedStep = twoFactor.LastUsedStep
+	tf.EnabledAt = twoFactor.EnabledAt
+}
+
+type RecoveryCodeGorm struct {
+	ID        uint       `gorm:"primaryKey"`
+	UserID    uint       `gorm:"column:user_id;not null"`
+	CodeHash  string     `gorm:"column:code_hash;not null"`
+	UsedAt    *time.Time `gorm:"column:used_at"`
+	CreatedAt time.Time
+}
+
+func (RecoveryCodeGorm) TableName() string {
+	return "user_recovery_codes"
+}
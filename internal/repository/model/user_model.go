@@ -16,9 +16,13 @@ type UserGorm struct {
 	Password   string `gorm:"column:password;not null"`
 	Age        int    `gorm:"column:age;not null"`
 	Address    string `gorm:"column:address;not null"`
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
-	DeletedAt  gorm.DeletedAt `gorm:"index"`
+	Language   string `gorm:"column:language;default:id"`
+	// PhoneNumber is nullable so the unique index only covers set numbers.
+	PhoneNumber     *string    `gorm:"column:phone_number"`
+	PhoneVerifiedAt *time.Time `gorm:"column:phone_verified_at"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       gorm.DeletedAt `gorm:"index"`
 
 	Role RoleGorm `gorm:"foreignKey:RoleID"`
 }
@@ -33,21 +37,26 @@ func (ug *UserGorm) ToDomain() domain.User {
 		deletedAt = &ug.DeletedAt.Time
 	}
 
+	var phoneNumber string
+	if ug.PhoneNumber != nil {
+		phoneNumber = *ug.PhoneNumber
+	}
+
 	return domain.User{
-		ID:         ug.ID,
-		FullName:   ug.FullName,
-		Email:      ug.Email,
-		IsVerified: ug.IsVerified,
-		Password:   ug.Password,
-		Age:        ug.Age,
-		Address:    ug.Address,
-		CreatedAt:  ug.CreatedAt,
-		UpdatedAt:  ug.UpdatedAt,
-		DeletedAt:  deletedAt,
-		Role: domain.Role{
-			ID:       ug.Role.ID,
-			RoleName: ug.Role.RoleName,
-		},
+		ID:              ug.ID,
+		FullName:        ug.FullName,
+		Email:           ug.Email,
+		IsVerified:      ug.IsVerified,
+		Password:        ug.Password,
+		Age:             ug.Age,
+		Address:         ug.Address,
+		Language:        ug.Language,
+		PhoneNumber:     phoneNumber,
+		PhoneVerifiedAt: ug.PhoneVerifiedAt,
+		CreatedAt:       ug.CreatedAt,
+		UpdatedAt:       ug.UpdatedAt,
+		DeletedAt:       deletedAt,
+		Role:            ug.Role.ToDomain(),
 	}
 }
 
@@ -55,9 +64,15 @@ func (ug *UserGorm) FromDomain(user domain.User) {
 	ug.ID = user.ID
 	ug.FullName = user.FullName
 	ug.Email = user.Email
+	ug.IsVerified = user.IsVerified
 	ug.Password = user.Password
 	ug.Age = user.Age
 	ug.Address = user.Address
+	ug.Language = user.Language
+	if user.PhoneNumber != "" {
+		ug.PhoneNumber = &user.PhoneNumber
+	}
+	ug.PhoneVerifiedAt = user.PhoneVerifiedAt
 	ug.CreatedAt = user.CreatedAt
 	ug.UpdatedAt = user.UpdatedAt
 	ug.RoleID = user.Role.ID
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"go-futsal-booking-api/internal/domain"
+	"time"
+)
+
+type VenueMemberGorm struct {
+	ID        uint   `gorm:"primaryKey"`
+	VenueID   uint   `gorm:"column:venue_id;not null"`
+	UserID    uint   `gorm:"column:user_id;not null"`
+	Role      string `gorm:"column:role;not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	Venue VenueGorm `gorm:"foreignKey:VenueID"`
+	User  UserGorm  `gorm:"foreignKey:UserID"`
+}
+
+func (VenueMemberGorm) TableName() string {
+	return "venue_members"
+}
+
+func (vm *VenueMemberGorm) ToDomain() domain.VenueMember {
+	user := vm.User.ToDomain()
+	user.Password = ""
+
+	return domain.VenueMember{
+		ID:        vm.ID,
+		Venue:     vm.Venue.ToDomain(),
+		User:      user,
+		Role:      vm.Role,
+		CreatedAt: vm.CreatedAt,
+		UpdatedAt: vm.UpdatedAt,
+	}
+}
+
+func (vm *VenueMemberGorm) FromDomain(member domain.VenueMember) {
+	vm.ID = member.ID
+	vm.VenueID = member.Venue.ID
+	vm.UserID = member.User.ID
+	vm.Role = member.Role
+}
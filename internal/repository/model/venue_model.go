@@ -8,13 +8,20 @@ import (
 )
 
 type VenueGorm struct {
-	ID        uint   `gorm:"primaryKey"`
-	Name      string `gorm:"column:name;unique;not null"`
-	Address   string `gorm:"column:address;not null"`
-	City      string `gorm:"column:city;not null"`
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt gorm.DeletedAt `gorm:"index"`
+	ID             uint     `gorm:"primaryKey"`
+	Name           string   `gorm:"column:name;unique;not null"`
+	Address        string   `gorm:"column:address;not null"`
+	City           string   `gorm:"column:city;not null"`
+	Timezone       string   `gorm:"column:timezone;not null"`
+	Latitude       *float64 `gorm:"column:latitude"`
+	Longitude      *float64 `gorm:"column:longitude"`
+	PhoneNumber    string   `gorm:"column:phone_number;not null"`
+	WhatsAppNumber string   `gorm:"column:whatsapp_number;not null"`
+	RatingAvg      float64  `gorm:"column:rating_avg;not null"`
+	RatingCount    int      `gorm:"column:rating_count;not null"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      gorm.DeletedAt `gorm:"index"`
 }
 
 func (VenueGorm) TableName() string {
@@ -27,14 +34,25 @@ func (vg *VenueGorm) ToDomain() domain.Venue {
 		deletedAt = &vg.DeletedAt.Time
 	}
 
+	var coordinates *domain.GeoPoint
+	if vg.Latitude != nil && vg.Longitude != nil {
+		coordinates = &domain.GeoPoint{Latitude: *vg.Latitude, Longitude: *vg.Longitude}
+	}
+
 	return domain.Venue{
-		ID:        vg.ID,
-		Name:      vg.Name,
-		Address:   vg.Address,
-		City:      vg.City,
-		CreatedAt: vg.CreatedAt,
-		UpdatedAt: vg.UpdatedAt,
-		DeletedAt: deletedAt,
+		ID:             vg.ID,
+		Coordinates:    coordinates,
+		PhoneNumber:    vg.PhoneNumber,
+		WhatsAppNumber: vg.WhatsAppNumber,
+		Name:           vg.Name,
+		Address:        vg.Address,
+		City:           vg.City,
+		Timezone:       vg.Timezone,
+		Rating:         vg.RatingAvg,
+		ReviewCount:    vg.RatingCount,
+		CreatedAt:      vg.CreatedAt,
+		UpdatedAt:      vg.UpdatedAt,
+		DeletedAt:      deletedAt,
 	}
 }
 
@@ -43,4 +61,42 @@ func (vg *VenueGorm) FromDomain(venue domain.Venue) {
 	vg.Name = venue.Name
 	vg.Address = venue.Address
 	vg.City = venue.City
+	vg.Timezone = venue.Timezone
+	vg.PhoneNumber = venue.PhoneNumber
+	vg.WhatsAppNumber = venue.WhatsAppNumber
+	vg.RatingAvg = venue.Rating
+	vg.RatingCount = venue.ReviewCount
+	vg.Latitude, vg.Longitude = nil, nil
+	if venue.Coordinates != nil {
+		vg.Latitude = &venue.Coordinates.Latitude
+		vg.Longitude = &venue.Coordinates.Longitude
+	}
+}
+
+// VenueListingGorm is a row of a venue search: the venue and the price of its
+// cheapest slot matching the search.
+type VenueListingGorm struct {
+	VenueGorm `gorm:"embedded"`
+	MinPrice  *float64 `gorm:"column:min_price"`
+}
+
+func (vl *VenueListingGorm) ToDomain() domain.VenueListing {
+	return domain.VenueListing{
+		Venue:    vl.VenueGorm.ToDomain(),
+		MinPrice: vl.MinPrice,
+	}
+}
+
+// NearbyVenueGorm is a row of a nearby search: the venue and its distance from
+// the search point.
+type NearbyVenueGorm struct {
+	VenueGorm  `gorm:"embedded"`
+	DistanceKm float64 `gorm:"column:distance_km"`
+}
+
+func (nv *NearbyVenueGorm) ToDomain() domain.NearbyVenue {
+	return domain.NearbyVenue{
+		Venue:      nv.VenueGorm.ToDomain(),
+		DistanceKm: nv.DistanceKm,
+	}
 }
@@ -0,0 +1,110 @@
+package model
+
+import (
+	"encoding/json"
+	"go-futsal-booking-api/internal/domain"
+	"time"
+)
+
+type WebhookSubscriptionGorm struct {
+	ID              uint   `gorm:"primaryKey"`
+	VenueID         *uint  `gorm:"column:venue_id"`
+	URL             string `gorm:"column:url;not null"`
+	Description     string `gorm:"column:description"`
+	EventTypes      string `gorm:"column:event_types;type:jsonb;not null"`
+	SecretEncrypted string `gorm:"column:secret_encrypted;not null"`
+	Active          bool   `gorm:"column:active;not null"`
+	CreatedBy       uint   `gorm:"column:created_by;not null"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func (WebhookSubscriptionGorm) TableName() string {
+	return "webhook_subscriptions"
+}
+
+func (ws *WebhookSubscriptionGorm) ToDomain() domain.WebhookSubscription {
+	var eventTypes []string
+	_ = json.Unmarshal([]byte(ws.EventTypes), &eventTypes)
+
+	return domain.WebhookSubscription{
+		ID:          ws.ID,
+		VenueID:     ws.VenueID,
+		URL:         ws.URL,
+		Description: ws.Description,
+		EventTypes:  eventTypes,
+		Secret:      ws.SecretEncrypted,
+		Active:      ws.Active,
+		CreatedBy:   ws.CreatedBy,
+		CreatedAt:   ws.CreatedAt,
+		UpdatedAt:   ws.UpdatedAt,
+	}
+}
+
+func (ws *WebhookSubscriptionGorm) FromDomain(s domain.WebhookSubscription) {
+	eventTypes, _ := json.Marshal(s.EventTypes)
+
+	ws.ID = s.ID
+	ws.VenueID = s.VenueID
+	ws.URL = s.URL
+	ws.Description = s.Description
+	ws.EventTypes = string(eventTypes)
+	ws.SecretEncrypted = s.Secret
+	ws.Active = s.Active
+	ws.CreatedBy = s.CreatedBy
+}
+
+type WebhookDeliveryGorm struct {
+	ID             uint       `gorm:"primaryKey"`
+	SubscriptionID uint       `gorm:"column:subscription_id;not null"`
+	EventID        string     `gorm:"column:event_id;not null"`
+	EventType      string     `gorm:"column:event_type;not null"`
+	Payload        string     `gorm:"column:payload;type:jsonb;not null"`
+	Status         string     `gorm:"column:status;not null"`
+	Attempts       int        `gorm:"column:attempts;not null"`
+	ResponseStatus int        `gorm:"column:response_status"`
+	LastError      string     `gorm:"column:last_error"`
+	DurationMs     int64      `gorm:"column:duration_ms"`
+	DeliveredAt    *time.Time `gorm:"column:delivered_at"`
+	ReplayOf       *uint      `gorm:"column:replay_of"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (WebhookDeliveryGorm) TableName() string {
+	return "webhook_deliveries"
+}
+
+func (wd *WebhookDeliveryGorm) ToDomain() domain.WebhookDelivery {
+	return domain.WebhookDelivery{
+		ID:             wd.ID,
+		SubscriptionID: wd.SubscriptionID,
+		EventID:        wd.EventID,
+		EventType:      wd.EventType,
+		Payload:        json.RawMessage(wd.Payload),
+		Status:         wd.Status,
+		Attempts:       wd.Attempts,
+		ResponseStatus: wd.ResponseStatus,
+		LastError:      wd.LastError,
+		DurationMs:     wd.DurationMs,
+		DeliveredAt:    wd.DeliveredAt,
+		ReplayOf:       wd.ReplayOf,
+		CreatedAt:      wd.CreatedAt,
+		UpdatedAt:      wd.UpdatedAt,
+	}
+}
+
+func (wd *WebhookDeliveryGorm) FromDomain(d domain.WebhookDelivery) {
+	wd.ID = d.ID
+	wd.SubscriptionID = d.SubscriptionID
+	wd.EventID = d.EventID
+	wd.EventType = d.EventType
+	wd.Payload = string(d.Payload)
+	wd.Status = d.Status
+	wd.Attempts = d.Attempts
+	wd.ResponseStatus = d.ResponseStatus
+	wd.LastError = d.LastError
+	wd.DurationMs = d.DurationMs
+	wd.DeliveredAt = d.DeliveredAt
+	wd.ReplayOf = d.ReplayOf
+}
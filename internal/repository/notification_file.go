@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// FileNotificationRepository writes each email as an .eml file, or to a writer
+// such as stdout, instead of delivering it. Intended for development and tests.
+type FileNotificationRepository struct {
+	out    io.Writer
+	dir    string
+	sender domain.EmailAddress
+	mu     sync.Mutex
+}
+
+// NewFileNotificationRepository writes to dir when it is set, otherwise to out.
+func NewFileNotificationRepository(out io.Writer, dir string, sender domain.EmailAddress) *FileNotificationRepository {
+	return &FileNotificationRepository{
+		out:    out,
+		dir:    dir,
+		sender: sender,
+	}
+}
+
+func (r *FileNotificationRepository) Send(ctx context.Context, email domain.Email) error {
+	if err := validateEmail(NotificationDriverFile, email); err != nil {
+		return err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return &domain.NotificationError{Provider: NotificationDriverFile, Err: err}
+	}
+
+	now := time.Now()
+	message, err := buildMIMEMessage(r.sender, email, now)
+	if err != nil {
+		return &domain.NotificationError{Provider: NotificationDriverFile, Err: fmt.Errorf("failed to build message: %w", err)}
+	}
+
+	if r.dir == "" {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		if _, err := fmt.Fprintf(r.out, "%s\r\n.\r\n", message); err != nil {
+			return &domain.NotificationError{Provider: NotificationDriverFile, Retryable: true, Err: err}
+		}
+		return nil
+	}
+
+	random := make([]byte, 4)
+	_, _ = rand.Read(random)
+	name := fmt.Sprintf("%s-%s.eml", now.UTC().Format("20060102T150405.000000000"), hex.EncodeToString(random))
+
+	if err := os.WriteFile(filepath.Join(r.dir, name), message, 0o644); err != nil {
+		return &domain.NotificationError{Provider: NotificationDriverFile, Retryable: true, Err: fmt.Errorf("failed to write message: %w", err)}
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pobyzaarif/goshortcute"
+)
+
+type MailjetConfig struct {
+	MailjetBaseURL           string
+	MailjetBasicAuthUsername string
+	MailjetBasicAuthPassword string
+	MailjetSenderEmail       string
+	MailjetSenderName        string
+}
+
+type MailjetRepository struct {
+	mailjetConfig MailjetConfig
+	sender        domain.EmailAddress
+	client        *http.Client
+}
+
+// NewMailjetRepository sends through the Mailjet v3.1 API. The Mailjet sender
+// settings take precedence over the shared sender when they are set.
+func NewMailjetRepository(cfg MailjetConfig, sender domain.EmailAddress) *MailjetRepository {
+	if cfg.MailjetSenderEmail != "" {
+		sender = domain.EmailAddress{Name: cfg.MailjetSenderName, Email: cfg.MailjetSenderEmail}
+	}
+
+	return &MailjetRepository{
+		mailjetConfig: cfg,
+		sender:        sender,
+		client:        &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type payloadSendEmail struct {
+	Messages []Messages `json:"Messages"`
+}
+
+type MailjetAddress struct {
+	Email string `json:"Email"`
+	Name  string `json:"Name,omitempty"`
+}
+
+type MailjetAttachment struct {
+	ContentType   string `json:"ContentType"`
+	Filename      string `json:"Filename"`
+	Base64Content string `json:"Base64Content"`
+}
+
+type Messages struct {
+	From        MailjetAddress      `json:"From"`
+	To          []MailjetAddress    `json:"To"`
+	Cc          []MailjetAddress    `json:"Cc,omitempty"`
+	Bcc         []MailjetAddress    `json:"Bcc,omitempty"`
+	Subject     string              `json:"Subject"`
+	TextPart    string              `json:"TextPart,omitempty"`
+	HTMLPart    string              `json:"HTMLPart,omitempty"`
+	Attachments []MailjetAttachment `json:"Attachments,omitempty"`
+}
+
+func toMailjetAddresses(addresses []domain.EmailAddress) []MailjetAddress {
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	result := make([]MailjetAddress, len(addresses))
+	for i, a := range addresses {
+		result[i] = MailjetAddress{Email: a.Email, Name: a.Name}
+	}
+
+	return result
+}
+
+func (r *MailjetRepository) Send(ctx context.Context, email domain.Email) error {
+	if err := validateEmail(NotificationDriverMailjet, email); err != nil {
+		return err
+	}
+
+	message := Messages{
+		From:     MailjetAddress{Email: r.sender.Email, Name: r.sender.Name},
+		To:       toMailjetAddresses(email.To),
+		Cc:       toMailjetAddresses(email.Cc),
+		Bcc:      toMailjetAddresses(email.Bcc),
+		Subject:  email.Subject,
+		TextPart: email.TextBody,
+		HTMLPart: email.HTMLBody,
+	}
+
+	for _, attachment := range email.Attachments {
+		message.Attachments = append(message.Attachments, MailjetAttachment{
+			ContentType:   attachmentContentType(attachment),
+			Filename:      attachment.Filename,
+			Base64Content: base64.StdEncoding.EncodeToString(attachment.Content),
+		})
+	}
+
+	payloadByte, err := json.Marshal(payloadSendEmail{Messages: []Messages{message}})
+	if err != nil {
+		return &domain.NotificationError{Provider: NotificationDriverMailjet, Err: fmt.Errorf("failed to marshal json payload: %w", err)}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.mailjetConfig.MailjetBaseURL+"/v3.1/send", bytes.NewReader(payloadByte))
+	if err != nil {
+		return &domain.NotificationError{Provider: NotificationDriverMailjet, Err: err}
+	}
+
+	buildBasicAuth := goshortcute.StringtoBase64Encode(r.mailjetConfig.MailjetBasicAuthUsername + ":" + r.mailjetConfig.MailjetBasicAuthPassword)
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Authorization", "Basic "+buildBasicAuth)
+
+	res, err := r.client.Do(req)
+	if err != nil {
+		// Network failures and timeouts are transient unless the caller gave up.
+		return &domain.NotificationError{
+			Provider:  NotificationDriverMailjet,
+			Retryable: !errors.Is(err, context.Canceled),
+			Err:       err,
+		}
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 200 && res.StatusCode <= 299 {
+		return nil
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+
+	return &domain.NotificationError{
+		Provider:  NotificationDriverMailjet,
+		Retryable: res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= 500,
+		Err:       fmt.Errorf("mailer service return negative response %v: %s", res.StatusCode, bytes.TrimSpace(body)),
+	}
+}
@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/http"
+	"net/mail"
+	"net/textproto"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// buildMIMEMessage renders an email as an RFC 5322 message. Bcc recipients are
+// left out of the headers; they only appear in the SMTP envelope.
+func buildMIMEMessage(from domain.EmailAddress, email domain.Email, now time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+
+	writeHeader := func(key, value string) {
+		fmt.Fprintf(&buf, "%s: %s\r\n", key, value)
+	}
+
+	writeHeader("From", formatAddress(from))
+	writeHeader("To", formatAddressList(email.To))
+	if len(email.Cc) > 0 {
+		writeHeader("Cc", formatAddressList(email.Cc))
+	}
+	writeHeader("Subject", mime.QEncoding.Encode("utf-8", email.Subject))
+	writeHeader("Date", now.Format(time.RFC1123Z))
+	writeHeader("Message-ID", newMessageID(from.Email))
+	writeHeader("MIME-Version", "1.0")
+
+	if len(email.Attachments) == 0 {
+		err := writeBody(email, func(header textproto.MIMEHeader) (io.Writer, error) {
+			for _, key := range []string{"Content-Type", "Content-Transfer-Encoding"} {
+				if value := header.Get(key); value != "" {
+					writeHeader(key, value)
+				}
+			}
+			buf.WriteString("\r\n")
+			return &buf, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	mixed := multipart.NewWriter(&buf)
+	writeHeader("Content-Type", `multipart/mixed; boundary="`+mixed.Boundary()+`"`)
+	buf.WriteString("\r\n")
+
+	if err := writeBody(email, mixed.CreatePart); err != nil {
+		return nil, err
+	}
+
+	for _, attachment := range email.Attachments {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", attachmentContentType(attachment))
+		header.Set("Content-Transfer-Encoding", "base64")
+		header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}))
+
+		part, err := mixed.CreatePart(header)
+		if err != nil {
+			return nil, err
+		}
+		if err := writeBase64Lines(part, attachment.Content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := mixed.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writeBody writes the text and/or HTML body into a part created by createPart,
+// which is either the message itself or the first part of a multipart/mixed body.
+func writeBody(email domain.Email, createPart func(textproto.MIMEHeader) (io.Writer, error)) error {
+	if email.TextBody != "" && email.HTMLBody != "" {
+		var alternativeBody bytes.Buffer
+		alternative := multipart.NewWriter(&alternativeBody)
+
+		for _, body := range []struct{ contentType, content string }{
+			{"text/plain; charset=utf-8", email.TextBody},
+			{"text/html; charset=utf-8", email.HTMLBody},
+		} {
+			header := textproto.MIMEHeader{}
+			header.Set("Content-Type", body.contentType)
+			header.Set("Content-Transfer-Encoding", "quoted-printable")
+
+			part, err := alternative.CreatePart(header)
+			if err != nil {
+				return err
+			}
+			if err := writeQuotedPrintable(part, body.content); err != nil {
+				return err
+			}
+		}
+
+		if err := alternative.Close(); err != nil {
+			return err
+		}
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", `multipart/alternative; boundary="`+alternative.Boundary()+`"`)
+
+		w, err := createPart(header)
+		if err != nil {
+			return err
+		}
+
+		_, err = w.Write(alternativeBody.Bytes())
+		return err
+	}
+
+	contentType, content := "text/plain; charset=utf-8", email.TextBody
+	if email.HTMLBody != "" {
+		contentType, content = "text/html; charset=utf-8", email.HTMLBody
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", contentType)
+	header.Set("Content-Transfer-Encoding", "quoted-printable")
+
+	w, err := createPart(header)
+	if err != nil {
+		return err
+	}
+
+	return writeQuotedPrintable(w, content)
+}
+
+func writeQuotedPrintable(w io.Writer, content string) error {
+	qp := quotedprintable.NewWriter(w)
+	if _, err := qp.Write([]byte(content)); err != nil {
+		return err
+	}
+
+	return qp.Close()
+}
+
+func writeBase64Lines(w io.Writer, content []byte) error {
+	encoded := base64.StdEncoding.EncodeToString(content)
+	for len(encoded) > 76 {
+		if _, err := io.WriteString(w, encoded[:76]+"\r\n"); err != nil {
+			return err
+		}
+		encoded = encoded[76:]
+	}
+
+	_, err := io.WriteString(w, encoded+"\r\n")
+	return err
+}
+
+func attachmentContentType(attachment domain.EmailAttachment) string {
+	if attachment.ContentType != "" {
+		return attachment.ContentType
+	}
+
+	if byExt := mime.TypeByExtension(filepath.Ext(attachment.Filename)); byExt != "" {
+		return byExt
+	}
+
+	return http.DetectContentType(attachment.Content)
+}
+
+func formatAddress(address domain.EmailAddress) string {
+	return (&mail.Address{Name: address.Name, Address: address.Email}).String()
+}
+
+func formatAddressList(addresses []domain.EmailAddress) string {
+	formatted := make([]string, len(addresses))
+	for i, address := range addresses {
+		formatted[i] = formatAddress(address)
+	}
+
+	return strings.Join(formatted, ", ")
+}
+
+func newMessageID(senderEmail string) string {
+	domainPart := "localhost"
+	if at := strings.LastIndex(senderEmail, "@"); at >= 0 && at < len(senderEmail)-1 {
+		domainPart = senderEmail[at+1:]
+	}
+
+	random := make([]byte, 12)
+	_, _ = rand.Read(random)
+
+	return fmt.Sprintf("<%d.%s@%s>", time.Now().UnixNano(), hex.EncodeToString(random), domainPart)
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	gormContract "go-futsal-booking-api/internal/repository/model"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NotificationPreferenceRepository interface {
+	FindByUserID(ctx context.Context, userID uint) ([]domain.NotificationPreference, error)
+	// FindChannel returns the channel chosen for an event, or "" when the user
+	// has no preference for it.
+	FindChannel(ctx context.Context, userID uint, event string) (string, error)
+	Save(ctx context.Context, preferences []domain.NotificationPreference) error
+}
+
+type gormNotificationPreferenceRepository struct {
+	DB *gorm.DB
+}
+
+func NewNotificationPreferenceRepository(db *gorm.DB) NotificationPreferenceRepository {
+	return &gormNotificationPreferenceRepository{
+		DB: db,
+	}
+}
+
+func (r *gormNotificationPreferenceRepository) FindByUserID(ctx context.Context, userID uint) ([]domain.NotificationPreference, error) {
+	var gormPreferences []gormContract.NotificationPreferenceGorm
+
+	if err := dbFromContext(ctx, r.DB).Where("user_id = ?", userID).Order("event").Find(&gormPreferences).Error; err != nil {
+		return nil, fmt.Errorf("failed to find notification preferences: %w", err)
+	}
+
+	preferences := make([]domain.NotificationPreference, len(gormPreferences))
+	for i := range gormPreferences {
+		preferences[i] = gormPreferences[i].ToDomain()
+	}
+
+	return preferences, nil
+}
+
+func (r *gormNotificationPreferenceRepository) FindChannel(ctx context.Context, userID uint, event string) (string, error) {
+	var gormPreference gormContract.NotificationPreferenceGorm
+
+	err := dbFromContext(ctx, r.DB).Where("user_id = ? AND event = ?", userID, event).First(&gormPreference).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to find notification preference: %w", err)
+	}
+
+	return gormPreference.Channel, nil
+}
+
+func (r *gormNotificationPreferenceRepository) Save(ctx context.Context, preferences []domain.NotificationPreference) error {
+	if len(preferences) == 0 {
+		return nil
+	}
+
+	gormPreferences := make([]gormContract.NotificationPreferenceGorm, len(preferences))
+	for i, p := range preferences {
+		gormPreferences[i].FromDomain(p)
+		gormPreferences[i].UpdatedAt = time.Now()
+	}
+
+	err := dbFromContext(ctx, r.DB).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "event"}},
+		DoUpdates: clause.AssignmentColumns([]string{"channel", "updated_at"}),
+	}).Create(&gormPreferences).Error
+	if err != nil {
+		return fmt.Errorf("failed to save notification preferences: %w", err)
+	}
+
+	return nil
+}
@@ -1,110 +1,61 @@
 package repository
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"net/http"
+	"go-futsal-booking-api/internal/domain"
+	"os"
 	"strings"
-	"time"
-
-	"github.com/pobyzaarif/goshortcute"
 )
 
 type NotificationRepository interface {
-	SendEmail(toName, toEmail, subject, message string) (err error)
-}
-
-type MailjetConfig struct {
-	MailjetBaseURL           string
-	MailjetBasicAuthUsername string
-	MailjetBasicAuthPassword string
-	MailjetSenderEmail       string
-	MailjetSenderName        string
+	// Send delivers an email. Failures are *domain.NotificationError values that
+	// say whether retrying may help.
+	Send(ctx context.Context, email domain.Email) error
 }
 
-type MailjetRepository struct {
-	mailjetConfig MailjetConfig
-}
+const (
+	NotificationDriverMailjet = "mailjet"
+	NotificationDriverSMTP    = "smtp"
+	NotificationDriverFile    = "file"
+)
 
-func NewMailjetRepository(cfg MailjetConfig) *MailjetRepository {
-	return &MailjetRepository{
-		cfg,
+type NotificationConfig struct {
+	Driver      string
+	SenderEmail string
+	SenderName  string
+	Mailjet     MailjetConfig
+	SMTP        SMTPConfig
+	// FileDir is where the file driver writes .eml files; empty means stdout.
+	FileDir string
+}
+
+// NewNotificationRepository returns the backend selected by cfg.Driver.
+func NewNotificationRepository(cfg NotificationConfig) (NotificationRepository, error) {
+	sender := domain.EmailAddress{Name: cfg.SenderName, Email: cfg.SenderEmail}
+
+	switch strings.ToLower(cfg.Driver) {
+	case NotificationDriverMailjet, "":
+		return NewMailjetRepository(cfg.Mailjet, sender), nil
+	case NotificationDriverSMTP:
+		return NewSMTPRepository(cfg.SMTP, sender), nil
+	case NotificationDriverFile:
+		if cfg.FileDir == "" {
+			return NewFileNotificationRepository(os.Stdout, "", sender), nil
+		}
+		if err := os.MkdirAll(cfg.FileDir, 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create notification directory: %w", err)
+		}
+		return NewFileNotificationRepository(nil, cfg.FileDir, sender), nil
 	}
-}
 
-type payloadSendEmail struct {
-	Messages []Messages `json:"Messages"`
+	return nil, fmt.Errorf("unknown notification driver %q", cfg.Driver)
 }
 
-type From struct {
-	Email string `json:"Email"`
-	Name  string `json:"Name"`
-}
-
-type To struct {
-	Email string `json:"Email"`
-	Name  string `json:"Name"`
-}
-
-type Messages struct {
-	From     From   `json:"From"`
-	To       []To   `json:"To"`
-	Subject  string `json:"Subject"`
-	TextPart string `json:"TextPart"`
-	HTMLPart string `json:"HTMLPart"`
-}
-
-func (r *MailjetRepository) SendEmail(toName, toEmail, subject, message string) (err error) {
-	url := r.mailjetConfig.MailjetBaseURL + "/v3.1/send"
-	method := http.MethodPost
-
-	toBody := []To{}
-	toBody = append(toBody, To{
-		Email: toEmail,
-		Name:  toName,
-	})
-
-	messageBody := Messages{
-		To: toBody,
-		From: From{
-			Email: r.mailjetConfig.MailjetSenderEmail,
-			Name:  r.mailjetConfig.MailjetSenderName,
-		},
-		Subject:  subject,
-		TextPart: message,
-		HTMLPart: message,
-	}
-	constructMessages := []Messages{}
-	constructMessages = append(constructMessages, messageBody)
-
-	payload := payloadSendEmail{
-		Messages: constructMessages,
-	}
-
-	payloadByte, err := json.Marshal(payload)
-	if err != nil {
-		return fmt.Errorf("failed to marshal json payload: %w", err)
-	}
-
-	client := &http.Client{Timeout: 5 * time.Second}
-	req, err := http.NewRequest(method, url, strings.NewReader(string(payloadByte)))
-	if err != nil {
-		return err
-	}
-
-	buildBasicAuth := goshortcute.StringtoBase64Encode(r.mailjetConfig.MailjetBasicAuthUsername + ":" + r.mailjetConfig.MailjetBasicAuthPassword)
-	req.Header.Add("Content-Type", "application/json")
-	req.Header.Add("Authorization", "Basic "+buildBasicAuth)
-
-	res, err := client.Do(req)
-	if err != nil {
-		return err
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode >= 200 && res.StatusCode <= 299 {
-		return nil
+func validateEmail(provider string, email domain.Email) error {
+	if len(email.Recipients()) == 0 || email.Subject == "" || (email.TextBody == "" && email.HTMLBody == "") {
+		return &domain.NotificationError{Provider: provider, Err: domain.ErrInvalidEmail}
 	}
 
-	return fmt.Errorf("mailer service return negative response %v", res.StatusCode)
+	return nil
 }
@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"time"
+)
+
+const (
+	SMTPTLSNone     = "none"
+	SMTPTLSStartTLS = "starttls"
+	SMTPTLSImplicit = "tls"
+)
+
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	// TLSMode is one of none, starttls (default) or tls.
+	TLSMode string
+	Timeout time.Duration
+}
+
+type SMTPRepository struct {
+	smtpConfig SMTPConfig
+	sender     domain.EmailAddress
+}
+
+func NewSMTPRepository(cfg SMTPConfig, sender domain.EmailAddress) *SMTPRepository {
+	if cfg.TLSMode == "" {
+		cfg.TLSMode = SMTPTLSStartTLS
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+
+	return &SMTPRepository{
+		smtpConfig: cfg,
+		sender:     sender,
+	}
+}
+
+func (r *SMTPRepository) Send(ctx context.Context, email domain.Email) error {
+	if err := validateEmail(NotificationDriverSMTP, email); err != nil {
+		return err
+	}
+
+	message, err := buildMIMEMessage(r.sender, email, time.Now())
+	if err != nil {
+		return &domain.NotificationError{Provider: NotificationDriverSMTP, Err: fmt.Errorf("failed to build message: %w", err)}
+	}
+
+	if err := r.deliver(ctx, email.Recipients(), message); err != nil {
+		return &domain.NotificationError{
+			Provider:  NotificationDriverSMTP,
+			Retryable: isRetryableSMTPError(err),
+			Err:       err,
+		}
+	}
+
+	return nil
+}
+
+func (r *SMTPRepository) deliver(ctx context.Context, recipients []domain.EmailAddress, message []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, r.smtpConfig.Timeout)
+	defer cancel()
+
+	addr := net.JoinHostPort(r.smtpConfig.Host, strconv.Itoa(r.smtpConfig.Port))
+	tlsConfig := &tls.Config{ServerName: r.smtpConfig.Host, MinVersion: tls.VersionTLS12}
+
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to smtp server: %w", err)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	if r.smtpConfig.TLSMode == SMTPTLSImplicit {
+		conn = tls.Client(conn, tlsConfig)
+	}
+
+	client, err := smtp.NewClient(conn, r.smtpConfig.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to start smtp session: %w", err)
+	}
+	defer client.Close()
+
+	if r.smtpConfig.TLSMode == SMTPTLSStartTLS {
+		if err := client.StartTLS(tlsConfig); err != nil {
+			return fmt.Errorf("failed to start tls: %w", err)
+		}
+	}
+
+	if r.smtpConfig.Username != "" {
+		auth := smtp.PlainAuth("", r.smtpConfig.Username, r.smtpConfig.Password, r.smtpConfig.Host)
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("failed to authenticate: %w", err)
+		}
+	}
+
+	if err := client.Mail(r.sender.Email); err != nil {
+		return fmt.Errorf("sender rejected: %w", err)
+	}
+
+	for _, recipient := range recipients {
+		if err := client.Rcpt(recipient.Email); err != nil {
+			return fmt.Errorf("recipient %s rejected: %w", recipient.Email, err)
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("failed to start data: %w", err)
+	}
+
+	if _, err := w.Write(message); err != nil {
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("message rejected: %w", err)
+	}
+
+	return client.Quit()
+}
+
+// isRetryableSMTPError treats 4xx replies and connection problems as transient
+// and 5xx replies as permanent, following RFC 5321.
+func isRetryableSMTPError(err error) bool {
+	var protoErr *textproto.Error
+	if errors.As(err, &protoErr) {
+		return protoErr.Code >= 400 && protoErr.Code < 500
+	}
+
+	var certErr *tls.CertificateVerificationError
+	if errors.As(err, &certErr) {
+		return false
+	}
+
+	return !errors.Is(err, context.Canceled)
+}
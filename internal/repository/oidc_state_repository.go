@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	gormContract "go-futsal-booking-api/internal/repository/model"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type OIDCStateRepository interface {
+	Create(ctx context.Context, state domain.OIDCState) error
+	// Consume deletes and returns an unexpired state, so each one can be used once.
+	Consume(ctx context.Context, state string) (domain.OIDCState, error)
+	DeleteExpired(ctx context.Context, before time.Time) error
+}
+
+type gormOIDCStateRepository struct {
+	DB *gorm.DB
+}
+
+func NewOIDCStateRepository(db *gorm.DB) OIDCStateRepository {
+	return &gormOIDCStateRepository{
+		DB: db,
+	}
+}
+
+func (r *gormOIDCStateRepository) Create(ctx context.Context, state domain.OIDCState) error {
+	var gormState gormContract.OIDCStateGorm
+	gormState.FromDomain(state)
+
+	if err := r.DB.WithContext(ctx).Create(&gormState).Error; err != nil {
+		return fmt.Errorf("failed to create oidc state: %w", err)
+	}
+
+	return nil
+}
+
+func (r *gormOIDCStateRepository) Consume(ctx context.Context, state string) (domain.OIDCState, error) {
+	var gormStates []gormContract.OIDCStateGorm
+
+	err := r.DB.WithContext(ctx).Clauses(clause.Returning{}).
+		Where("state = ?", state).
+		Delete(&gormStates).Error
+	if err != nil {
+		return domain.OIDCState{}, fmt.Errorf("failed to consume oidc state: %w", err)
+	}
+
+	if len(gormStates) == 0 || time.Now().After(gormStates[0].ExpiresAt) {
+		return domain.OIDCState{}, domain.ErrInvalidOIDCState
+	}
+
+	return gormStates[0].ToDomain(), nil
+}
+
+func (r *gormOIDCStateRepository) DeleteExpired(ctx context.Context, before time.Time) error {
+	return r.DB.WithContext(ctx).Where("expires_at < ?", before).Delete(&gormContract.OIDCStateGorm{}).Error
+}
@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	gormContract "go-futsal-booking-api/internal/repository/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type OutboxRepository interface {
+	// Create joins the caller's transaction when there is one, which is the point
+	// of the outbox: the message exists only if the business change committed.
+	Create(ctx context.Context, message *domain.OutboxMessage) error
+	// Claim leases up to limit due messages to the caller and counts the attempt.
+	// Rows leased by another dispatcher are skipped rather than waited on.
+	Claim(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]domain.OutboxMessage, error)
+	MarkSent(ctx context.Context, id uint, at time.Time) error
+	MarkRetry(ctx context.Context, id uint, nextAttemptAt time.Time, lastError string) error
+	MarkDead(ctx context.Context, id uint, lastError string) error
+	FindByID(ctx context.Context, id uint) (domain.OutboxMessage, error)
+	FindAll(ctx context.Context, filter domain.OutboxFilter) ([]domain.OutboxMessage, int64, error)
+	// Replay moves a DEAD message back to PENDING with a fresh attempt budget.
+	Replay(ctx context.Context, id uint, at time.Time) error
+}
+
+type gormOutboxRepository struct {
+	DB *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) OutboxRepository {
+	return &gormOutboxRepository{
+		DB: db,
+	}
+}
+
+func (r *gormOutboxRepository) Create(ctx context.Context, message *domain.OutboxMessage) error {
+	var gormMessage gormContract.OutboxMessageGorm
+	gormMessage.FromDomain(*message)
+
+	if err := dbFromContext(ctx, r.DB).Create(&gormMessage).Error; err != nil {
+		return fmt.Errorf("failed to create outbox message: %w", err)
+	}
+
+	*message = gormMessage.ToDomain()
+
+	return nil
+}
+
+func (r *gormOutboxRepository) Claim(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]domain.OutboxMessage, error) {
+	var gormMessages []gormContract.OutboxMessageGorm
+
+	err := r.DB.WithContext(ctx).Raw(`
+		UPDATE outbox_messages SET
+			attempts = attempts + 1,
+			locked_until = ?,
+			updated_at = ?
+		WHERE id IN (
+			SELECT id FROM outbox_messages
+			WHERE status = ? AND next_attempt_at <= ? AND (locked_until IS NULL OR locked_until < ?)
+			ORDER BY next_attempt_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		now.Add(lease), now, domain.OutboxStatusPending, now, now, limit,
+	).Scan(&gormMessages).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim outbox messages: %w", err)
+	}
+
+	messages := make([]domain.OutboxMessage, len(gormMessages))
+	for i := range gormMessages {
+		messages[i] = gormMessages[i].ToDomain()
+	}
+
+	return messages, nil
+}
+
+func (r *gormOutboxRepository) MarkSent(ctx context.Context, id uint, at time.Time) error {
+	return r.update(ctx, id, map[string]any{
+		"status":       domain.OutboxStatusSent,
+		"sent_at":      at,
+		"locked_until": nil,
+		"last_error":   "",
+	})
+}
+
+func (r *gormOutboxRepository) MarkRetry(ctx context.Context, id uint, nextAttemptAt time.Time, lastError string) error {
+	return r.update(ctx, id, map[string]any{
+		"next_attempt_at": nextAttemptAt,
+		"locked_until":    nil,
+		"last_error":      lastError,
+	})
+}
+
+func (r *gormOutboxRepository) MarkDead(ctx context.Context, id uint, lastError string) error {
+	return r.update(ctx, id, map[string]any{
+		"status":       domain.OutboxStatusDead,
+		"locked_until": nil,
+		"last_error":   lastError,
+	})
+}
+
+func (r *gormOutboxRepository) update(ctx context.Context, id uint, values map[string]any) error {
+	result := r.DB.WithContext(ctx).Model(&gormContract.OutboxMessageGorm{}).Where("id = ?", id).Updates(values)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update outbox message: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrOutboxMessageNotFound
+	}
+
+	return nil
+}
+
+func (r *gormOutboxRepository) FindByID(ctx context.Context, id uint) (domain.OutboxMessage, error) {
+	var gormMessage gormContract.OutboxMessageGorm
+
+	if err := r.DB.WithContext(ctx).First(&gormMessage, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.OutboxMessage{}, domain.ErrOutboxMessageNotFound
+		}
+		return domain.OutboxMessage{}, fmt.Errorf("failed to find outbox message: %w", err)
+	}
+
+	return gormMessage.ToDomain(), nil
+}
+
+func (r *gormOutboxRepository) FindAll(ctx context.Context, filter domain.OutboxFilter) ([]domain.OutboxMessage, int64, error) {
+	query := r.DB.WithContext(ctx).Model(&gormContract.OutboxMessageGorm{})
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Topic != "" {
+		query = query.Where("topic = ?", filter.Topic)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count outbox messages: %w", err)
+	}
+
+	var gormMessages []gormContract.OutboxMessageGorm
+	if err := query.Order("id DESC").Limit(filter.Limit).Offset(filter.Offset).Find(&gormMessages).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to find outbox messages: %w", err)
+	}
+
+	messages := make([]domain.OutboxMessage, len(gormMessages))
+	for i := range gormMessages {
+		messages[i] = gormMessages[i].ToDomain()
+	}
+
+	return messages, total, nil
+}
+
+func (r *gormOutboxRepository) Replay(ctx context.Context, id uint, at time.Time) error {
+	result := r.DB.WithContext(ctx).Model(&gormContract.OutboxMessageGorm{}).
+		Where("id = ? AND status = ?", id, domain.OutboxStatusDead).
+		Updates(map[string]any{
+			"status":          domain.OutboxStatusPending,
+			"attempts":        0,
+			"next_attempt_at": at,
+			"locked_until":    nil,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to replay outbox message: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		if _, err := r.FindByID(ctx, id); err != nil {
+			return err
+		}
+		return domain.ErrOutboxNotReplayable
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	gormContract "go-futsal-booking-api/internal/repository/model"
+
+	"gorm.io/gorm"
+)
+
+type PaymentRepository interface {
+	Create(ctx context.Context, payment *domain.Payment) error
+	FindByBookingID(ctx context.Context, bookingID uint) ([]domain.Payment, error)
+}
+
+type gormPaymentRepository struct {
+	DB *gorm.DB
+}
+
+func NewPaymentRepository(db *gorm.DB) PaymentRepository {
+	return &gormPaymentRepository{
+		DB: db,
+	}
+}
+
+func (r *gormPaymentRepository) Create(ctx context.Context, payment *domain.Payment) error {
+	var gormPayment gormContract.PaymentGorm
+	gormPayment.FromDomain(*payment)
+
+	if err := dbFromContext(ctx, r.DB).Create(&gormPayment).Error; err != nil {
+		return fmt.Errorf("failed to create payment: %w", err)
+	}
+
+	*payment = gormPayment.ToDomain()
+
+	return nil
+}
+
+func (r *gormPaymentRepository) FindByBookingID(ctx context.Context, bookingID uint) ([]domain.Payment, error) {
+	var gormPayments []gormContract.PaymentGorm
+
+	if err := dbFromContext(ctx, r.DB).Where("booking_id = ?", bookingID).Order("id").Find(&gormPayments).Error; err != nil {
+		return nil, fmt.Errorf("failed to find payments: %w", err)
+	}
+
+	payments := make([]domain.Payment, len(gormPayments))
+	for i := range gormPayments {
+		payments[i] = gormPayments[i].ToDomain()
+	}
+
+	return payments, nil
+}
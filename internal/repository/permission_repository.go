@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	gormContract "go-futsal-booking-api/internal/repository/model"
+
+	"gorm.io/gorm"
+)
+
+type PermissionRepository interface {
+	FindAll(ctx context.Context) ([]domain.Permission, error)
+	FindByNames(ctx context.Context, names []string) ([]domain.Permission, error)
+}
+
+type gormPermissionRepository struct {
+	DB *gorm.DB
+}
+
+func NewPermissionRepository(db *gorm.DB) PermissionRepository {
+	return &gormPermissionRepository{
+		DB: db,
+	}
+}
+
+func (r *gormPermissionRepository) FindAll(ctx context.Context) ([]domain.Permission, error) {
+	var gormPermissions []gormContract.PermissionGorm
+
+	if err := r.DB.WithContext(ctx).Order("name").Find(&gormPermissions).Error; err != nil {
+		return nil, err
+	}
+
+	permissions := make([]domain.Permission, len(gormPermissions))
+	for i := range gormPermissions {
+		permissions[i] = gormPermissions[i].ToDomain()
+	}
+
+	return permissions, nil
+}
+
+func (r *gormPermissionRepository) FindByNames(ctx context.Context, names []string) ([]domain.Permission, error) {
+	var gormPermissions []gormContract.PermissionGorm
+
+	if len(names) == 0 {
+		return []domain.Permission{}, nil
+	}
+
+	if err := r.DB.WithContext(ctx).Where("name IN ?", names).Find(&gormPermissions).Error; err != nil {
+		return nil, err
+	}
+
+	permissions := make([]domain.Permission, len(gormPermissions))
+	for i := range gormPermissions {
+		permissions[i] = gormPermissions[i].ToDomain()
+	}
+
+	return permissions, nil
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	gormContract "go-futsal-booking-api/internal/repository/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PhoneVerificationRepository interface {
+	FindByUserID(ctx context.Context, userID uint) (domain.PhoneVerification, error)
+	// Save replaces any pending verification of the user.
+	Save(ctx context.Context, verification *domain.PhoneVerification) error
+	IncrementAttempts(ctx context.Context, userID uint) error
+	Delete(ctx context.Context, userID uint) error
+}
+
+type gormPhoneVerificationRepository struct {
+	DB *gorm.DB
+}
+
+func NewPhoneVerificationRepository(db *gorm.DB) PhoneVerificationRepository {
+	return &gormPhoneVerificationRepository{
+		DB: db,
+	}
+}
+
+func (r *gormPhoneVerificationRepository) FindByUserID(ctx context.Context, userID uint) (domain.PhoneVerification, error) {
+	var gormVerification gormContract.PhoneVerificationGorm
+
+	err := dbFromContext(ctx, r.DB).Where("user_id = ?", userID).First(&gormVerification).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.PhoneVerification{}, domain.ErrInvalidPhoneCode
+		}
+		return domain.PhoneVerification{}, fmt.Errorf("failed to find phone verification: %w", err)
+	}
+
+	return gormVerification.ToDomain(), nil
+}
+
+func (r *gormPhoneVerificationRepository) Save(ctx context.Context, verification *domain.PhoneVerification) error {
+	var gormVerification gormContract.PhoneVerificationGorm
+	gormVerification.FromDomain(*verification)
+
+	err := dbFromContext(ctx, r.DB).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"phone_number", "code_hash", "attempts", "expires_at", "created_at"}),
+	}).Create(&gormVerification).Error
+	if err != nil {
+		return fmt.Errorf("failed to save phone verification: %w", err)
+	}
+
+	*verification = gormVerification.ToDomain()
+
+	return nil
+}
+
+func (r *gormPhoneVerificationRepository) IncrementAttempts(ctx context.Context, userID uint) error {
+	err := dbFromContext(ctx, r.DB).Model(&gormContract.PhoneVerificationGorm{}).
+		Where("user_id = ?", userID).
+		Update("attempts", gorm.Expr("attempts + 1")).Error
+	if err != nil {
+		return fmt.Errorf("failed to record phone verification attempt: %w", err)
+	}
+
+	return nil
+}
+
+func (r *gormPhoneVerificationRepository) Delete(ctx context.Context, userID uint) error {
+	if err := dbFromContext(ctx, r.DB).Where("user_id = ?", userID).Delete(&gormContract.PhoneVerificationGorm{}).Error; err != nil {
+		return fmt.Errorf("failed to delete phone verification: %w", err)
+	}
+
+	return nil
+}
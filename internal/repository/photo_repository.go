@@ -0,0 +1,226 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	gormContract "go-futsal-booking-api/internal/repository/model"
+
+	"gorm.io/gorm"
+)
+
+type PhotoRepository interface {
+	// Create appends the photo to the end of its gallery. The first photo of a
+	// gallery becomes its cover.
+	Create(ctx context.Context, photo *domain.Photo) error
+	FindByID(ctx context.Context, id uint) (domain.Photo, error)
+	// FindByGallery returns the photos of a gallery in display order.
+	FindByGallery(ctx context.Context, gallery domain.PhotoGallery) ([]domain.Photo, error)
+	// Reorder sets the display order to photoIDs, which must hold every photo
+	// of the gallery once, or domain.ErrInvalidPhotoOrder is returned.
+	Reorder(ctx context.Context, gallery domain.PhotoGallery, photoIDs []uint) error
+	// SetCover makes the photo the cover of its gallery.
+	SetCover(ctx context.Context, id uint) error
+	// Delete removes the photo. When it was the cover, the first remaining
+	// photo of the gallery becomes the cover.
+	Delete(ctx context.Context, id uint) error
+}
+
+type gormPhotoRepository struct {
+	DB *gorm.DB
+}
+
+func NewPhotoRepository(db *gorm.DB) PhotoRepository {
+	return &gormPhotoRepository{
+		DB: db,
+	}
+}
+
+// inGallery scopes a query to the photos of one gallery.
+func inGallery(db *gorm.DB, gallery domain.PhotoGallery) *gorm.DB {
+	db = db.Where("venue_id = ?", gallery.VenueID)
+	if gallery.FieldID == nil {
+		return db.Where("field_id IS NULL")
+	}
+
+	return db.Where("field_id = ?", *gallery.FieldID)
+}
+
+func galleryOf(photo domain.Photo) domain.PhotoGallery {
+	return domain.PhotoGallery{VenueID: photo.VenueID, FieldID: photo.FieldID}
+}
+
+// lockGallery serialises changes to the galleries of a venue, so positions and
+// the cover stay consistent under concurrent uploads.
+func lockGallery(tx *gorm.DB, venueID uint) error {
+	if err := tx.Exec("SELECT id FROM venues WHERE id = ? FOR UPDATE", venueID).Error; err != nil {
+		return fmt.Errorf("failed to lock venue photos: %w", err)
+	}
+
+	return nil
+}
+
+func (r *gormPhotoRepository) Create(ctx context.Context, photo *domain.Photo) error {
+	return dbFromContext(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		if err := lockGallery(tx, photo.VenueID); err != nil {
+			return err
+		}
+
+		var last struct {
+			Count    int64
+			Position int
+		}
+		err := inGallery(tx.Model(&gormContract.PhotoGorm{}), galleryOf(*photo)).
+			Select("COUNT(*) AS count, COALESCE(MAX(position), 0) AS position").
+			Scan(&last).Error
+		if err != nil {
+			return fmt.Errorf("failed to find last photo position: %w", err)
+		}
+
+		photo.Position = last.Position + 1
+		photo.IsCover = last.Count == 0
+
+		var gormPhoto gormContract.PhotoGorm
+		gormPhoto.FromDomain(*photo)
+
+		if err := tx.Create(&gormPhoto).Error; err != nil {
+			return fmt.Errorf("failed to create photo: %w", err)
+		}
+
+		*photo = gormPhoto.ToDomain()
+
+		return nil
+	})
+}
+
+func (r *gormPhotoRepository) FindByID(ctx context.Context, id uint) (domain.Photo, error) {
+	var gormPhoto gormContract.PhotoGorm
+	if err := dbFromContext(ctx, r.DB).First(&gormPhoto, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.Photo{}, domain.ErrPhotoNotFound
+		}
+		return domain.Photo{}, fmt.Errorf("failed to find photo: %w", err)
+	}
+
+	return gormPhoto.ToDomain(), nil
+}
+
+func (r *gormPhotoRepository) FindByGallery(ctx context.Context, gallery domain.PhotoGallery) ([]domain.Photo, error) {
+	var gormPhotos []gormContract.PhotoGorm
+	if err := inGallery(dbFromContext(ctx, r.DB), gallery).Order("position, id").Find(&gormPhotos).Error; err != nil {
+		return nil, fmt.Errorf("failed to find photos: %w", err)
+	}
+
+	photos := make([]domain.Photo, len(gormPhotos))
+	for i := range gormPhotos {
+		photos[i] = gormPhotos[i].ToDomain()
+	}
+
+	return photos, nil
+}
+
+func (r *gormPhotoRepository) Reorder(ctx context.Context, gallery domain.PhotoGallery, photoIDs []uint) error {
+	return dbFromContext(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		if err := lockGallery(tx, gallery.VenueID); err != nil {
+			return err
+		}
+
+		var ids []uint
+		if err := inGallery(tx.Model(&gormContract.PhotoGorm{}), gallery).Pluck("id", &ids).Error; err != nil {
+			return fmt.Errorf("failed to find photos: %w", err)
+		}
+
+		if len(ids) != len(photoIDs) {
+			return domain.ErrInvalidPhotoOrder
+		}
+		remaining := make(map[uint]bool, len(ids))
+		for _, id := range ids {
+			remaining[id] = true
+		}
+		for _, id := range photoIDs {
+			if !remaining[id] {
+				return domain.ErrInvalidPhotoOrder
+			}
+			delete(remaining, id)
+		}
+
+		for i, id := range photoIDs {
+			if err := tx.Model(&gormContract.PhotoGorm{}).Where("id = ?", id).Update("position", i+1).Error; err != nil {
+				return fmt.Errorf("failed to reorder photos: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *gormPhotoRepository) SetCover(ctx context.Context, id uint) error {
+	return dbFromContext(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		var gormPhoto gormContract.PhotoGorm
+		if err := tx.First(&gormPhoto, id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrPhotoNotFound
+			}
+			return fmt.Errorf("failed to find photo: %w", err)
+		}
+
+		if err := lockGallery(tx, gormPhoto.VenueID); err != nil {
+			return err
+		}
+
+		// The partial unique index allows one cover per gallery, so clear the
+		// old one first.
+		err := inGallery(tx.Model(&gormContract.PhotoGorm{}), galleryOf(gormPhoto.ToDomain())).
+			Where("is_cover").
+			Update("is_cover", false).Error
+		if err != nil {
+			return fmt.Errorf("failed to clear cover photo: %w", err)
+		}
+
+		if err := tx.Model(&gormPhoto).Update("is_cover", true).Error; err != nil {
+			return fmt.Errorf("failed to set cover photo: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *gormPhotoRepository) Delete(ctx context.Context, id uint) error {
+	return dbFromContext(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		var gormPhoto gormContract.PhotoGorm
+		if err := tx.First(&gormPhoto, id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrPhotoNotFound
+			}
+			return fmt.Errorf("failed to find photo: %w", err)
+		}
+
+		if err := lockGallery(tx, gormPhoto.VenueID); err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&gormPhoto).Error; err != nil {
+			return fmt.Errorf("failed to delete photo: %w", err)
+		}
+
+		if !gormPhoto.IsCover {
+			return nil
+		}
+
+		var next gormContract.PhotoGorm
+		err := inGallery(tx, galleryOf(gormPhoto.ToDomain())).Order("position, id").Limit(1).Find(&next).Error
+		if err != nil {
+			return fmt.Errorf("failed to find next cover photo: %w", err)
+		}
+		if next.ID == 0 {
+			return nil
+		}
+
+		if err := tx.Model(&next).Update("is_cover", true).Error; err != nil {
+			return fmt.Errorf("failed to set cover photo: %w", err)
+		}
+
+		return nil
+	})
+}
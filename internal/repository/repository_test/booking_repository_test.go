@@ -0,0 +1,65 @@
+package repository_test
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/repository"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// sqlRecorder keeps the statements gorm builds in dry-run mode.
+type sqlRecorder struct {
+	gormlogger.Interface
+	statements []string
+}
+
+func (r *sqlRecorder) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	sql, _ := fc()
+	r.statements = append(r.statements, sql)
+}
+
+// dryRunDB returns a database that builds statements without a server.
+func dryRunDB(t *testing.T) (*gorm.DB, *sqlRecorder) {
+	recorder := &sqlRecorder{Interface: gormlogger.Discard}
+
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost dbname=futsal"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+		Logger:               recorder,
+	})
+	require.NoError(t, err)
+
+	return db, recorder
+}
+
+func TestBookingRepository_FindDueForReminder(t *testing.T) {
+	t.Run("Success - Slot start is read in the venue's timezone", func(t *testing.T) {
+		db, recorder := dryRunDB(t)
+		bookingRepo := repository.NewBookingRepository(db)
+
+		// 10:30 UTC is 17:30 in Jakarta (UTC+7), so a 19:00 booking there
+		// starts within two hours even though 19:00 UTC does not.
+		now := time.Date(2026, 3, 14, 10, 30, 0, 0, time.UTC)
+
+		_, err := bookingRepo.FindDueForReminder(context.Background(), now, 2*time.Hour, 10)
+		require.NoError(t, err)
+		require.NotEmpty(t, recorder.statements)
+
+		query := recorder.statements[0]
+		startsAt := "((bookings.booking_date + schedules.start_time::time) AT TIME ZONE venues.timezone)"
+
+		assert.Contains(t, query, "JOIN venues ON venues.id = fields.venue_id")
+		assert.Contains(t, query, startsAt+" > '2026-03-14 10:30:00'")
+		assert.Contains(t, query, startsAt+" <= '2026-03-14 12:30:00'")
+		assert.Contains(t, query, "bookings.created_at < ("+startsAt+" - '120 minutes'::interval) AT TIME ZONE 'UTC'")
+		assert.Equal(t, strings.Count(query, "bookings.booking_date + schedules.start_time::time"), strings.Count(query, startsAt),
+			"every slot start must be read in the venue's timezone")
+	})
+}
@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	gormContract "go-futsal-booking-api/internal/repository/model"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReviewRepository keeps the rating_avg and rating_count of venues in step
+// with their published reviews: every change that adds, hides or removes one
+// recomputes them in the same transaction.
+type ReviewRepository interface {
+	// Create returns domain.ErrReviewExists if the booking already has a review.
+	Create(ctx context.Context, review *domain.Review) error
+	FindByID(ctx context.Context, id uint) (domain.Review, error)
+	// FindByVenueID returns a page of the venue's published reviews, newest
+	// first, and how many there are in total.
+	FindByVenueID(ctx context.Context, venueID uint, filter domain.ReviewFilter) ([]domain.Review, int64, error)
+	SaveReply(ctx context.Context, id uint, reply string, repliedBy uint, at time.Time) error
+	UpdateStatus(ctx context.Context, id uint, status string) error
+	Delete(ctx context.Context, id uint) error
+}
+
+type gormReviewRepository struct {
+	DB *gorm.DB
+}
+
+func NewReviewRepository(db *gorm.DB) ReviewRepository {
+	return &gormReviewRepository{DB: db}
+}
+
+func (r *gormReviewRepository) Create(ctx context.Context, review *domain.Review) error {
+	var gormReview gormContract.ReviewGorm
+	gormReview.FromDomain(*review)
+
+	err := dbFromContext(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		result := tx.Omit("User").Clauses(clause.OnConflict{DoNothing: true}).Create(&gormReview)
+		if result.Error != nil {
+			return fmt.Errorf("failed to create review: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrReviewExists
+		}
+
+		return updateVenueRating(tx, gormReview.VenueID)
+	})
+	if err != nil {
+		return err
+	}
+
+	user := review.User
+	*review = gormReview.ToDomain()
+	review.User = user
+
+	return nil
+}
+
+func (r *gormReviewRepository) FindByID(ctx context.Context, id uint) (domain.Review, error) {
+	var gormReview gormContract.ReviewGorm
+
+	err := dbFromContext(ctx, r.DB).Preload("User").First(&gormReview, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.Review{}, domain.ErrReviewNotFound
+		}
+		return domain.Review{}, fmt.Errorf("failed to find review: %w", err)
+	}
+
+	return gormReview.ToDomain(), nil
+}
+
+func (r *gormReviewRepository) FindByVenueID(ctx context.Context, venueID uint, filter domain.ReviewFilter) ([]domain.Review, int64, error) {
+	query := dbFromContext(ctx, r.DB).Model(&gormContract.ReviewGorm{}).
+		Where("venue_id = ? AND status = ?", venueID, domain.ReviewStatusPublished)
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count reviews: %w", err)
+	}
+
+	var gormReviews []gormContract.ReviewGorm
+	err := query.Preload("User").
+		Order("created_at DESC, id DESC").
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		Find(&gormReviews).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to find reviews: %w", err)
+	}
+
+	reviews := make([]domain.Review, len(gormReviews))
+	for i := range gormReviews {
+		reviews[i] = gormReviews[i].ToDomain()
+	}
+
+	return reviews, total, nil
+}
+
+func (r *gormReviewRepository) SaveReply(ctx context.Context, id uint, reply string, repliedBy uint, at time.Time) error {
+	result := dbFromContext(ctx, r.DB).Model(&gormContract.ReviewGorm{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"reply":      reply,
+			"replied_by": repliedBy,
+			"replied_at": at,
+			"updated_at": at,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to save review reply: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrReviewNotFound
+	}
+
+	return nil
+}
+
+func (r *gormReviewRepository) UpdateStatus(ctx context.Context, id uint, status string) error {
+	return dbFromContext(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		var gormReview gormContract.ReviewGorm
+		if err := tx.Clauses(clause.Returning{}).Model(&gormReview).
+			Where("id = ?", id).
+			Updates(map[string]any{"status": status, "updated_at": time.Now()}).Error; err != nil {
+			return fmt.Errorf("failed to update review status: %w", err)
+		}
+
+		if gormReview.ID == 0 {
+			return domain.ErrReviewNotFound
+		}
+
+		return updateVenueRating(tx, gormReview.VenueID)
+	})
+}
+
+func (r *gormReviewRepository) Delete(ctx context.Context, id uint) error {
+	return dbFromContext(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		var gormReviews []gormContract.ReviewGorm
+		if err := tx.Clauses(clause.Returning{}).Where("id = ?", id).Delete(&gormReviews).Error; err != nil {
+			return fmt.Errorf("failed to delete review: %w", err)
+		}
+
+		if len(gormReviews) == 0 {
+			return domain.ErrReviewNotFound
+		}
+
+		return updateVenueRating(tx, gormReviews[0].VenueID)
+	})
+}
+
+// updateVenueRating recomputes the rating of a venue from its published
+// reviews. The venue row is locked first so that the aggregate is read after
+// any concurrent change to the venue's reviews has committed.
+func updateVenueRating(tx *gorm.DB, venueID uint) error {
+	if err := tx.Exec("SELECT id FROM venues WHERE id = ? FOR UPDATE", venueID).Error; err != nil {
+		return fmt.Errorf("failed to lock venue: %w", err)
+	}
+
+	err := tx.Exec(`UPDATE venues SET
+		rating_avg = COALESCE((SELECT ROUND(AVG(rating), 2) FROM reviews WHERE venue_id = ? AND status = ?), 0),
+		rating_count = (SELECT COUNT(*) FROM reviews WHERE venue_id = ? AND status = ?)
+		WHERE id = ?`,
+		venueID, domain.ReviewStatusPublished, venueID, domain.ReviewStatusPublished, venueID).Error
+	if err != nil {
+		return fmt.Errorf("failed to update venue rating: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	gormContract "go-futsal-booking-api/internal/repository/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RoleRepository interface {
+	Create(ctx context.Context, role *domain.Role) error
+	FindByID(ctx context.Context, id uint) (domain.Role, error)
+	FindByName(ctx context.Context, name string) (domain.Role, error)
+	FindAll(ctx context.Context) ([]domain.Role, error)
+	Update(ctx context.Context, role *domain.Role) error
+	Delete(ctx context.Context, id uint) error
+	ReplacePermissions(ctx context.Context, roleID uint, permissionIDs []uint) error
+	CountUsers(ctx context.Context, roleID uint) (int64, error)
+	HasPermission(ctx context.Context, roleID uint, permission string) (bool, error)
+	// LockUsersWithPermission returns the users whose role holds permission
+	// and locks them until the transaction ends, so that two role changes
+	// cannot both take the permission from its last holders.
+	LockUsersWithPermission(ctx context.Context, permission string) ([]uint, error)
+}
+
+type gormRoleRepository struct {
+	DB *gorm.DB
+}
+
+func NewRoleRepository(db *gorm.DB) RoleRepository {
+	return &gormRoleRepository{
+		DB: db,
+	}
+}
+
+func (r *gormRoleRepository) preload(ctx context.Context) *gorm.DB {
+	return r.DB.WithContext(ctx).Preload("Permissions")
+}
+
+func (r *gormRoleRepository) Create(ctx context.Context, role *domain.Role) error {
+	var gormRole gormContract.RoleGorm
+	gormRole.FromDomain(*role)
+
+	if err := dbFromContext(ctx, r.DB).Omit("Permissions").Create(&gormRole).Error; err != nil {
+		return fmt.Errorf("failed to create role: %w", err)
+	}
+
+	*role = gormRole.ToDomain()
+
+	return nil
+}
+
+func (r *gormRoleRepository) FindByID(ctx context.Context, id uint) (domain.Role, error) {
+	var gormRole gormContract.RoleGorm
+
+	err := r.preload(ctx).First(&gormRole, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.Role{}, domain.ErrRoleNotFound
+		}
+		return domain.Role{}, err
+	}
+
+	return gormRole.ToDomain(), nil
+}
+
+func (r *gormRoleRepository) FindByName(ctx context.Context, name string) (domain.Role, error) {
+	var gormRole gormContract.RoleGorm
+
+	err := r.preload(ctx).Where("role_name = ?", name).First(&gormRole).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.Role{}, domain.ErrRoleNotFound
+		}
+		return domain.Role{}, err
+	}
+
+	return gormRole.ToDomain(), nil
+}
+
+func (r *gormRoleRepository) FindAll(ctx context.Context) ([]domain.Role, error) {
+	var gormRoles []gormContract.RoleGorm
+
+	if err := r.preload(ctx).Order("id").Find(&gormRoles).Error; err != nil {
+		return nil, err
+	}
+
+	roles := make([]domain.Role, len(gormRoles))
+	for i := range gormRoles {
+		roles[i] = gormRoles[i].ToDomain()
+	}
+
+	return roles, nil
+}
+
+func (r *gormRoleRepository) Update(ctx context.Context, role *domain.Role) error {
+	result := r.DB.WithContext(ctx).Model(&gormContract.RoleGorm{}).Where("id = ?", role.ID).Update("role_name", role.RoleName)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update role: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrRoleNotFound
+	}
+
+	updated, err := r.FindByID(ctx, role.ID)
+	if err != nil {
+		return err
+	}
+
+	*role = updated
+
+	return nil
+}
+
+func (r *gormRoleRepository) Delete(ctx context.Context, id uint) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM role_permissions WHERE role_id = ?", id).Error; err != nil {
+			return err
+		}
+
+		result := tx.Delete(&gormContract.RoleGorm{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return domain.ErrRoleNotFound
+		}
+
+		return nil
+	})
+}
+
+func (r *gormRoleRepository) ReplacePermissions(ctx context.Context, roleID uint, permissionIDs []uint) error {
+	return dbFromContext(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM role_permissions WHERE role_id = ?", roleID).Error; err != nil {
+			return err
+		}
+
+		for _, permissionID := range permissionIDs {
+			if err := tx.Exec("INSERT INTO role_permissions (role_id, permission_id) VALUES (?, ?)", roleID, permissionID).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *gormRoleRepository) CountUsers(ctx context.Context, roleID uint) (int64, error) {
+	var count int64
+
+	err := r.DB.WithContext(ctx).Model(&gormContract.UserGorm{}).Where("role_id = ?", roleID).Count(&count).Error
+
+	return count, err
+}
+
+func (r *gormRoleRepository) HasPermission(ctx context.Context, roleID uint, permission string) (bool, error) {
+	var count int64
+
+	err := r.DB.WithContext(ctx).
+		Table("role_permissions").
+		Joins("JOIN roles ON roles.id = role_permissions.role_id AND roles.deleted_at IS NULL").
+		Joins("JOIN permissions ON permissions.id = role_permissions.permission_id").
+		Where("roles.id = ? AND permissions.name = ?", roleID, permission).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *gormRoleRepository) LockUsersWithPermission(ctx context.Context, permission string) ([]uint, error) {
+	var userIDs []uint
+
+	err := dbFromContext(ctx, r.DB).
+		Model(&gormContract.UserGorm{}).
+		Joins("JOIN roles ON roles.id = users.role_id AND roles.deleted_at IS NULL").
+		Joins("JOIN role_permissions ON role_permissions.role_id = roles.id").
+		Joins("JOIN permissions ON permissions.id = role_permissions.permission_id").
+		Where("permissions.name = ?", permission).
+		Clauses(clause.Locking{Strength: "UPDATE", Table: clause.Table{Name: "users"}}).
+		Pluck("users.id", &userIDs).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find users with permission: %w", err)
+	}
+
+	return userIDs, nil
+}
@@ -0,0 +1,258 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	gormContract "go-futsal-booking-api/internal/repository/model"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SavedSearchRepository interface {
+	Create(ctx context.Context, search *domain.SavedSearch) error
+	FindByID(ctx context.Context, id uint) (domain.SavedSearch, error)
+	// FindByUserID returns the user's saved searches, oldest first.
+	FindByUserID(ctx context.Context, userID uint) ([]domain.SavedSearch, error)
+	CountByUserID(ctx context.Context, userID uint) (int64, error)
+	Update(ctx context.Context, search *domain.SavedSearch) error
+	Delete(ctx context.Context, id uint) error
+	// FindNotifying returns up to limit searches with alerts on and an id
+	// above afterID, in id order, with their users loaded.
+	FindNotifying(ctx context.Context, afterID uint, limit int) ([]domain.SavedSearch, error)
+	// FindNewSlots returns up to limit free slots matching search that start
+	// after now and within days, earliest first. Slots already claimed for the
+	// search are left out.
+	FindNewSlots(ctx context.Context, search domain.SavedSearch, now time.Time, days, limit int) ([]domain.SavedSearchSlot, error)
+	// ClaimSlot records that slot was alerted for the search. It returns false
+	// when it was already claimed, by this or another instance.
+	ClaimSlot(ctx context.Context, searchID uint, slot domain.SavedSearchSlot, at time.Time) (bool, error)
+}
+
+type gormSavedSearchRepository struct {
+	DB *gorm.DB
+}
+
+func NewSavedSearchRepository(db *gorm.DB) SavedSearchRepository {
+	return &gormSavedSearchRepository{DB: db}
+}
+
+func (r *gormSavedSearchRepository) Create(ctx context.Context, search *domain.SavedSearch) error {
+	var gormSearch gormContract.SavedSearchGorm
+	gormSearch.FromDomain(*search)
+
+	if err := dbFromContext(ctx, r.DB).Omit("User").Create(&gormSearch).Error; err != nil {
+		return fmt.Errorf("failed to create saved search: %w", err)
+	}
+
+	*search = gormSearch.ToDomain()
+
+	return nil
+}
+
+func (r *gormSavedSearchRepository) FindByID(ctx context.Context, id uint) (domain.SavedSearch, error) {
+	var gormSearch gormContract.SavedSearchGorm
+
+	err := dbFromContext(ctx, r.DB).First(&gormSearch, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.SavedSearch{}, domain.ErrSavedSearchNotFound
+		}
+		return domain.SavedSearch{}, fmt.Errorf("failed to find saved search: %w", err)
+	}
+
+	return gormSearch.ToDomain(), nil
+}
+
+func (r *gormSavedSearchRepository) FindByUserID(ctx context.Context, userID uint) ([]domain.SavedSearch, error) {
+	var gormSearches []gormContract.SavedSearchGorm
+
+	err := dbFromContext(ctx, r.DB).
+		Where("user_id = ?", userID).
+		Order("id").
+		Find(&gormSearches).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find saved searches: %w", err)
+	}
+
+	return toSavedSearches(gormSearches), nil
+}
+
+func (r *gormSavedSearchRepository) CountByUserID(ctx context.Context, userID uint) (int64, error) {
+	var count int64
+
+	err := dbFromContext(ctx, r.DB).Model(&gormContract.SavedSearchGorm{}).
+		Where("user_id = ?", userID).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to count saved searches: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *gormSavedSearchRepository) Update(ctx context.Context, search *domain.SavedSearch) error {
+	var gormSearch gormContract.SavedSearchGorm
+	gormSearch.FromDomain(*search)
+
+	result := dbFromContext(ctx, r.DB).Model(&gormContract.SavedSearchGorm{}).
+		Where("id = ?", search.ID).
+		Updates(map[string]any{
+			"name":        gormSearch.Name,
+			"city":        gormSearch.City,
+			"field_type":  gormSearch.FieldType,
+			"day_of_week": gormSearch.DayOfWeek,
+			"start_time":  gormSearch.StartTime,
+			"end_time":    gormSearch.EndTime,
+			"notify":      gormSearch.Notify,
+			"updated_at":  time.Now(),
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update saved search: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrSavedSearchNotFound
+	}
+
+	return nil
+}
+
+func (r *gormSavedSearchRepository) Delete(ctx context.Context, id uint) error {
+	result := dbFromContext(ctx, r.DB).Delete(&gormContract.SavedSearchGorm{}, id)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete saved search: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrSavedSearchNotFound
+	}
+
+	return nil
+}
+
+func (r *gormSavedSearchRepository) FindNotifying(ctx context.Context, afterID uint, limit int) ([]domain.SavedSearch, error) {
+	var gormSearches []gormContract.SavedSearchGorm
+
+	err := dbFromContext(ctx, r.DB).
+		Preload("User").
+		Where("notify AND id > ?", afterID).
+		Order("id").
+		Limit(limit).
+		Find(&gormSearches).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find notifying saved searches: %w", err)
+	}
+
+	return toSavedSearches(gormSearches), nil
+}
+
+func (r *gormSavedSearchRepository) FindNewSlots(ctx context.Context, search domain.SavedSearch, now time.Time, days, limit int) ([]domain.SavedSearchSlot, error) {
+	// Dates are compared in the venue's timezone, which may be a day behind
+	// or ahead of now's, so the series starts a day early and the slot start
+	// is checked against now.
+	from := now.UTC().AddDate(0, 0, -1).Format("2006-01-02")
+	to := now.UTC().AddDate(0, 0, days).Format("2006-01-02")
+
+	const slotDate = "d.day::date"
+
+	query := dbFromContext(ctx, r.DB).
+		Table("schedules s").
+		Select("s.id AS schedule_id, "+slotDate+" AS slot_date").
+		Joins("CROSS JOIN generate_series(?::date, ?::date, interval '1 day') AS d(day)", from, to).
+		Joins("JOIN fields f ON f.id = s.field_id").
+		Joins("JOIN venues v ON v.id = f.venue_id").
+		Where("s.deleted_at IS NULL AND f.deleted_at IS NULL AND f.status = ? AND v.deleted_at IS NULL", domain.FieldStatusActive).
+		// Schedules number the days 1 (Monday) to 7 (Sunday), like ISODOW.
+		Where("s.day_of_week = EXTRACT(ISODOW FROM d.day)").
+		Where("("+slotDate+" + s.start_time) AT TIME ZONE v.timezone > ?", now).
+		Where("("+slotDate+" + s.start_time) AT TIME ZONE v.timezone <= ?", now.AddDate(0, 0, days)).
+		Where("NOT EXISTS (SELECT 1 FROM bookings b WHERE b.schedule_id = s.id AND b.booking_date = "+slotDate+" AND b.status IN ?)",
+			[]string{domain.BookingStatusPending, domain.BookingStatusConfirmed, domain.BookingStatusCheckedIn}).
+		// Maintenance windows are stored in UTC, schedules in the venue's time.
+		Where("NOT EXISTS (SELECT 1 FROM maintenance_windows mw WHERE mw.field_id = f.id"+
+			" AND mw.starts_at < (("+slotDate+" + s.end_time) AT TIME ZONE v.timezone) AT TIME ZONE 'UTC'"+
+			" AND mw.ends_at > (("+slotDate+" + s.start_time) AT TIME ZONE v.timezone) AT TIME ZONE 'UTC')").
+		Where("NOT EXISTS (SELECT 1 FROM saved_search_alerts a WHERE a.saved_search_id = ? AND a.schedule_id = s.id AND a.slot_date = "+slotDate+")", search.ID)
+
+	if search.City != "" {
+		query = query.Where("LOWER(v.city) = LOWER(?)", search.City)
+	}
+	if search.FieldType != "" {
+		query = query.Where("f.type = ?", search.FieldType)
+	}
+	if search.DayOfWeek != 0 {
+		query = query.Where("s.day_of_week = ?", search.DayOfWeek)
+	}
+	if search.StartTime != nil {
+		query = query.Where("s.start_time >= ?::time", search.StartTime.Format("15:04:05"))
+	}
+	if search.EndTime != nil {
+		query = query.Where("s.end_time <= ?::time", search.EndTime.Format("15:04:05"))
+	}
+
+	var rows []struct {
+		ScheduleID uint
+		SlotDate   time.Time
+	}
+	err := query.Order(slotDate + ", s.start_time, s.id").Limit(limit).Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find saved search slots: %w", err)
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]uint, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ScheduleID
+	}
+
+	var gormSchedules []gormContract.ScheduleGorm
+	if err := dbFromContext(ctx, r.DB).Preload("Field.Venue").Where("id IN ?", ids).Find(&gormSchedules).Error; err != nil {
+		return nil, fmt.Errorf("failed to load saved search slots: %w", err)
+	}
+
+	schedules := make(map[uint]domain.Schedule, len(gormSchedules))
+	for i := range gormSchedules {
+		schedules[gormSchedules[i].ID] = gormSchedules[i].ToDomain()
+	}
+
+	slots := make([]domain.SavedSearchSlot, 0, len(rows))
+	for _, row := range rows {
+		if schedule, ok := schedules[row.ScheduleID]; ok {
+			slots = append(slots, domain.SavedSearchSlot{Schedule: schedule, Date: row.SlotDate})
+		}
+	}
+
+	return slots, nil
+}
+
+func (r *gormSavedSearchRepository) ClaimSlot(ctx context.Context, searchID uint, slot domain.SavedSearchSlot, at time.Time) (bool, error) {
+	alert := gormContract.SavedSearchAlertGorm{
+		SavedSearchID: searchID,
+		ScheduleID:    slot.Schedule.ID,
+		SlotDate:      slot.Date,
+		NotifiedAt:    at,
+	}
+
+	result := dbFromContext(ctx, r.DB).Clauses(clause.OnConflict{DoNothing: true}).Create(&alert)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to claim saved search slot: %w", result.Error)
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+func toSavedSearches(gormSearches []gormContract.SavedSearchGorm) []domain.SavedSearch {
+	searches := make([]domain.SavedSearch, len(gormSearches))
+	for i := range gormSearches {
+		searches[i] = gormSearches[i].ToDomain()
+	}
+
+	return searches
+}
@@ -3,8 +3,10 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
 	"go-futsal-booking-api/internal/domain"
 	gormContract "go-futsal-booking-api/internal/repository/model"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -13,8 +15,14 @@ type ScheduleRepository interface {
 	Create(ctx context.Context, schedule *domain.Schedule) error
 	FindByID(ctx context.Context, id uint) (domain.Schedule, error)
 	FindByFieldID(ctx context.Context, fieldID uint) ([]domain.Schedule, error) // Metode kustom
+	// FindByVenueID returns the schedules of every field of the venue.
+	FindByVenueID(ctx context.Context, venueID uint) ([]domain.Schedule, error)
 	Update(ctx context.Context, schedule *domain.Schedule) error
-	Delete(ctx context.Context, id uint) error
+	Delete(ctx context.Context, id uint, at time.Time) error
+	// FindDeletedByID returns domain.ErrNotDeleted for a schedule that is not
+	// deleted. The field and venue are loaded even when they are deleted too.
+	FindDeletedByID(ctx context.Context, id uint) (domain.Schedule, error)
+	Restore(ctx context.Context, id uint) error
 }
 
 type gormScheduleRepository struct {
@@ -76,6 +84,26 @@ func (r *gormScheduleRepository) FindByFieldID(ctx context.Context, fieldID uint
 	return domainSchedules, nil
 }
 
+func (r *gormScheduleRepository) FindByVenueID(ctx context.Context, venueID uint) ([]domain.Schedule, error) {
+	var gormSchedules []gormContract.ScheduleGorm
+
+	err := r.preload(ctx).
+		Joins("JOIN fields ON fields.id = schedules.field_id AND fields.deleted_at IS NULL").
+		Where("fields.venue_id = ?", venueID).
+		Order("schedules.day_of_week, schedules.start_time, schedules.id").
+		Find(&gormSchedules).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find venue schedules: %w", err)
+	}
+
+	schedules := make([]domain.Schedule, len(gormSchedules))
+	for i := range gormSchedules {
+		schedules[i] = gormSchedules[i].ToDomain()
+	}
+
+	return schedules, nil
+}
+
 func (r *gormScheduleRepository) Update(ctx context.Context, schedule *domain.Schedule) error {
 	var gormSchedule gormContract.ScheduleGorm
 	gormSchedule.FromDomain(*schedule)
@@ -98,11 +126,11 @@ func (r *gormScheduleRepository) Update(ctx context.Context, schedule *domain.Sc
 	return nil
 }
 
-func (r *gormScheduleRepository) Delete(ctx context.Context, id uint) error {
-	result := r.DB.WithContext(ctx).Delete(&gormContract.ScheduleGorm{}, id)
+func (r *gormScheduleRepository) Delete(ctx context.Context, id uint, at time.Time) error {
+	result := dbFromContext(ctx, r.DB).Model(&gormContract.ScheduleGorm{}).Where("id = ?", id).Update("deleted_at", at)
 
 	if result.Error != nil {
-		return result.Error
+		return fmt.Errorf("failed to delete schedule: %w", result.Error)
 	}
 
 	if result.RowsAffected == 0 {
@@ -111,3 +139,40 @@ func (r *gormScheduleRepository) Delete(ctx context.Context, id uint) error {
 
 	return nil
 }
+
+func (r *gormScheduleRepository) FindDeletedByID(ctx context.Context, id uint) (domain.Schedule, error) {
+	var gormSchedule gormContract.ScheduleGorm
+
+	unscoped := func(db *gorm.DB) *gorm.DB { return db.Unscoped() }
+	err := dbFromContext(ctx, r.DB).Unscoped().
+		Preload("Field", unscoped).
+		Preload("Field.Venue", unscoped).
+		First(&gormSchedule, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.Schedule{}, domain.ErrScheduleNotFound
+		}
+		return domain.Schedule{}, fmt.Errorf("failed to find schedule: %w", err)
+	}
+
+	if !gormSchedule.DeletedAt.Valid {
+		return domain.Schedule{}, domain.ErrNotDeleted
+	}
+
+	return gormSchedule.ToDomain(), nil
+}
+
+func (r *gormScheduleRepository) Restore(ctx context.Context, id uint) error {
+	result := dbFromContext(ctx, r.DB).Unscoped().Model(&gormContract.ScheduleGorm{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		return fmt.Errorf("failed to restore schedule: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrNotDeleted
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"io"
+	"sync"
+)
+
+// FakeTextMessageRepository prints messages instead of sending them. Intended
+// for development and tests.
+type FakeTextMessageRepository struct {
+	out io.Writer
+	mu  sync.Mutex
+}
+
+func NewFakeTextMessageRepository(out io.Writer) *FakeTextMessageRepository {
+	return &FakeTextMessageRepository{out: out}
+}
+
+func (r *FakeTextMessageRepository) Send(ctx context.Context, message domain.TextMessage) error {
+	if err := validateTextMessage(TextMessageDriverFake, message); err != nil {
+		return err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return &domain.NotificationError{Provider: TextMessageDriverFake, Err: err}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := fmt.Fprintf(r.out, "[%s] to %s\n%s\n.\n", message.Channel, message.To, message.Body); err != nil {
+		return &domain.NotificationError{Provider: TextMessageDriverFake, Retryable: true, Err: err}
+	}
+
+	return nil
+}
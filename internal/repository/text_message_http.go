@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type TextMessageHTTPConfig struct {
+	// BaseURL of the gateway; messages are POSTed to BaseURL + "/messages".
+	BaseURL string
+	APIKey  string
+	// Sender is the registered sender ID or WhatsApp business number.
+	Sender  string
+	Timeout time.Duration
+}
+
+// HTTPTextMessageRepository sends through a JSON SMS/WhatsApp gateway, which is
+// the shape most Indonesian providers offer.
+type HTTPTextMessageRepository struct {
+	config TextMessageHTTPConfig
+	client *http.Client
+}
+
+func NewHTTPTextMessageRepository(cfg TextMessageHTTPConfig) *HTTPTextMessageRepository {
+	return &HTTPTextMessageRepository{
+		config: cfg,
+		client: &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+type textMessagePayload struct {
+	Channel string `json:"channel"`
+	From    string `json:"from,omitempty"`
+	To      string `json:"to"`
+	Body    string `json:"body"`
+}
+
+func (r *HTTPTextMessageRepository) Send(ctx context.Context, message domain.TextMessage) error {
+	if err := validateTextMessage(TextMessageDriverHTTP, message); err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(textMessagePayload{
+		Channel: strings.ToLower(message.Channel),
+		From:    r.config.Sender,
+		To:      message.To,
+		Body:    message.Body,
+	})
+	if err != nil {
+		return &domain.NotificationError{Provider: TextMessageDriverHTTP, Err: fmt.Errorf("failed to marshal json payload: %w", err)}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimRight(r.config.BaseURL, "/")+"/messages", bytes.NewReader(payload))
+	if err != nil {
+		return &domain.NotificationError{Provider: TextMessageDriverHTTP, Err: err}
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+r.config.APIKey)
+
+	res, err := r.client.Do(req)
+	if err != nil {
+		return &domain.NotificationError{
+			Provider:  TextMessageDriverHTTP,
+			Retryable: !errors.Is(err, context.Canceled),
+			Err:       err,
+		}
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 200 && res.StatusCode <= 299 {
+		return nil
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+
+	return &domain.NotificationError{
+		Provider:  TextMessageDriverHTTP,
+		Retryable: res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= 500,
+		Err:       fmt.Errorf("text message gateway return negative response %v: %s", res.StatusCode, bytes.TrimSpace(body)),
+	}
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"os"
+	"strings"
+	"time"
+)
+
+type TextMessageRepository interface {
+	// Send delivers an SMS or WhatsApp message. Failures are
+	// *domain.NotificationError values that say whether retrying may help.
+	Send(ctx context.Context, message domain.TextMessage) error
+}
+
+const (
+	TextMessageDriverHTTP = "http"
+	TextMessageDriverFake = "fake"
+)
+
+type TextMessageConfig struct {
+	Driver string
+	HTTP   TextMessageHTTPConfig
+}
+
+// NewTextMessageRepository returns the backend selected by cfg.Driver. The fake
+// driver prints messages to stdout.
+func NewTextMessageRepository(cfg TextMessageConfig) (TextMessageRepository, error) {
+	switch strings.ToLower(cfg.Driver) {
+	case TextMessageDriverFake, "":
+		return NewFakeTextMessageRepository(os.Stdout), nil
+	case TextMessageDriverHTTP:
+		if cfg.HTTP.BaseURL == "" {
+			return nil, fmt.Errorf("text message driver %q needs a base url", cfg.Driver)
+		}
+		if cfg.HTTP.Timeout == 0 {
+			cfg.HTTP.Timeout = 10 * time.Second
+		}
+		return NewHTTPTextMessageRepository(cfg.HTTP), nil
+	}
+
+	return nil, fmt.Errorf("unknown text message driver %q", cfg.Driver)
+}
+
+func validateTextMessage(provider string, message domain.TextMessage) error {
+	if message.To == "" || message.Body == "" ||
+		(message.Channel != domain.NotificationChannelSMS && message.Channel != domain.NotificationChannelWhatsApp) {
+		return &domain.NotificationError{Provider: provider, Err: domain.ErrInvalidTextMessage}
+	}
+
+	return nil
+}
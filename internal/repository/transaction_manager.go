@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// TransactionManager runs a function inside a database transaction. Repositories
+// pick the transaction up from the context, so services can combine writes to
+// several repositories without knowing about GORM.
+type TransactionManager interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type txContextKey struct{}
+
+type gormTransactionManager struct {
+	DB *gorm.DB
+}
+
+func NewTransactionManager(db *gorm.DB) TransactionManager {
+	return &gormTransactionManager{
+		DB: db,
+	}
+}
+
+// WithinTransaction commits when fn returns nil and rolls back otherwise. A call
+// made inside another transaction joins it instead of starting a new one.
+func (m *gormTransactionManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+
+	return m.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txContextKey{}, tx))
+	})
+}
+
+// dbFromContext returns the transaction started by WithinTransaction, or db when
+// the call is not part of one.
+func dbFromContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+
+	return db.WithContext(ctx)
+}
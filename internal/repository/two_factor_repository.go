@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	gormContract "go-futsal-booking-api/internal/repository/model"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TwoFactorRepository interface {
+	FindByUserID(ctx context.Context, userID uint) (domain.TwoFactor, error)
+	Save(ctx context.Context, twoFactor *domain.TwoFactor) error
+	Delete(ctx context.Context, userID uint) error
+	// AdvanceStep records the time step of an accepted code. It fails with
+	// ErrInvalidTwoFactorCode when the step is not newer than the last one used.
+	AdvanceStep(ctx context.Context, userID uint, step int64) error
+	ReplaceRecoveryCodes(ctx context.Context, userID uint, codeHashes []string) error
+	// UseRecoveryCode consumes an unused code and fails with ErrInvalidTwoFactorCode otherwise.
+	UseRecoveryCode(ctx context.Context, userID uint, codeHash string) error
+}
+
+type gormTwoFactorRepository struct {
+	DB *gorm.DB
+}
+
+func NewTwoFactorRepository(db *gorm.DB) TwoFactorRepository {
+	return &gormTwoFactorRepository{
+		DB: db,
+	}
+}
+
+func (r *gormTwoFactorRepository) FindByUserID(ctx context.Context, userID uint) (domain.TwoFactor, error) {
+	var gormTwoFactor gormContract.TwoFactorGorm
+
+	err := r.DB.WithContext(ctx).Where("user_id = ?", userID).First(&gormTwoFactor).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.TwoFactor{}, domain.ErrTwoFactorNotFound
+		}
+		return domain.TwoFactor{}, err
+	}
+
+	return gormTwoFactor.ToDomain(), nil
+}
+
+func (r *gormTwoFactorRepository) Save(ctx context.Context, twoFactor *domain.TwoFactor) error {
+	var gormTwoFactor gormContract.TwoFactorGorm
+	gormTwoFactor.FromDomain(*twoFactor)
+
+	// last_used_step is only ever moved forward by AdvanceStep; saving a copy
+	// read before a code was accepted must not let that code be used again.
+	err := r.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"secret_encrypted", "enabled", "enabled_at", "updated_at"}),
+	}).Create(&gormTwoFactor).Error
+	if err != nil {
+		return fmt.Errorf("failed to save two-factor settings: %w", err)
+	}
+
+	*twoFactor = gormTwoFactor.ToDomain()
+
+	return nil
+}
+
+func (r *gormTwoFactorRepository) Delete(ctx context.Context, userID uint) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&gormContract.RecoveryCodeGorm{}).Error; err != nil {
+			return fmt.Errorf("failed to delete recovery codes: %w", err)
+		}
+
+		if err := tx.Where("user_id = ?", userID).Delete(&gormContract.TwoFactorGorm{}).Error; err != nil {
+			return fmt.Errorf("failed to delete two-factor settings: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *gormTwoFactorRepository) AdvanceStep(ctx context.Context, userID uint, step int64) error {
+	result := r.DB.WithContext(ctx).Model(&gormContract.TwoFactorGorm{}).
+		Where("user_id = ? AND last_used_step < ?", userID, step).
+		Update("last_used_step", step)
+	if result.Error != nil {
+		return fmt.Errorf("failed to record two-factor step: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrInvalidTwoFactorCode
+	}
+
+	return nil
+}
+
+func (r *gormTwoFactorRepository) ReplaceRecoveryCodes(ctx context.Context, userID uint, codeHashes []string) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&gormContract.RecoveryCodeGorm{}).Error; err != nil {
+			return fmt.Errorf("failed to delete recovery codes: %w", err)
+		}
+
+		if len(codeHashes) == 0 {
+			return nil
+		}
+
+		codes := make([]gormContract.RecoveryCodeGorm, len(codeHashes))
+		for i, hash := range codeHashes {
+			codes[i] = gormContract.RecoveryCodeGorm{UserID: userID, CodeHash: hash}
+		}
+
+		if err := tx.Create(&codes).Error; err != nil {
+			return fmt.Errorf("failed to create recovery codes: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *gormTwoFactorRepository) UseRecoveryCode(ctx context.Context, userID uint, codeHash string) error {
+	result := r.DB.WithContext(ctx).Model(&gormContract.RecoveryCodeGorm{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return fmt.Errorf("failed to use recovery code: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrInvalidTwoFactorCode
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	gormContract "go-futsal-booking-api/internal/repository/model"
+
+	"gorm.io/gorm"
+)
+
+type UserIdentityRepository interface {
+	FindByProviderSubject(ctx context.Context, provider, subject string) (domain.UserIdentity, error)
+	Create(ctx context.Context, identity *domain.UserIdentity) error
+}
+
+type gormUserIdentityRepository struct {
+	DB *gorm.DB
+}
+
+func NewUserIdentityRepository(db *gorm.DB) UserIdentityRepository {
+	return &gormUserIdentityRepository{
+		DB: db,
+	}
+}
+
+func (r *gormUserIdentityRepository) FindByProviderSubject(ctx context.Context, provider, subject string) (domain.UserIdentity, error) {
+	var gormIdentity gormContract.UserIdentityGorm
+
+	err := r.DB.WithContext(ctx).Where("provider = ? AND subject = ?", provider, subject).First(&gormIdentity).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.UserIdentity{}, domain.ErrIdentityNotFound
+		}
+		return domain.UserIdentity{}, err
+	}
+
+	return gormIdentity.ToDomain(), nil
+}
+
+func (r *gormUserIdentityRepository) Create(ctx context.Context, identity *domain.UserIdentity) error {
+	var gormIdentity gormContract.UserIdentityGorm
+	gormIdentity.FromDomain(*identity)
+
+	if err := r.DB.WithContext(ctx).Create(&gormIdentity).Error; err != nil {
+		return fmt.Errorf("failed to link identity: %w", err)
+	}
+
+	identity.ID = gormIdentity.ID
+	identity.CreatedAt = gormIdentity.CreatedAt
+
+	return nil
+}
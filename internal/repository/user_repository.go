@@ -3,8 +3,10 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
 	"go-futsal-booking-api/internal/domain"
 	gormContract "go-futsal-booking-api/internal/repository/model"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -17,6 +19,12 @@ type UserRepository interface {
 	Update(ctx context.Context, user *domain.User) error
 	Delete(ctx context.Context, id uint) error
 	UpdateEmailVerification(ctx context.Context, id uint, isVerified bool) error
+	// FindByPhoneNumber looks up the user who verified a phone number.
+	FindByPhoneNumber(ctx context.Context, phoneNumber string) (domain.User, error)
+	// UpdatePhone sets a verified phone number, or clears it when phoneNumber is empty.
+	UpdatePhone(ctx context.Context, id uint, phoneNumber string, verifiedAt *time.Time) error
+	// UpdateRole gives the user another role.
+	UpdateRole(ctx context.Context, id uint, roleID uint) error
 }
 
 type gormUserRepository struct {
@@ -33,7 +41,7 @@ func (r *gormUserRepository) Create(ctx context.Context, user *domain.User) erro
 	var gormUser gormContract.UserGorm
 	gormUser.FromDomain(*user)
 
-	if err := r.DB.WithContext(ctx).Create(&gormUser).Error; err != nil {
+	if err := dbFromContext(ctx, r.DB).Create(&gormUser).Error; err != nil {
 		return err
 	}
 
@@ -46,10 +54,10 @@ func (r *gormUserRepository) Create(ctx context.Context, user *domain.User) erro
 func (r *gormUserRepository) FindByID(ctx context.Context, id uint) (domain.User, error) {
 	var gormUser gormContract.UserGorm
 
-	err := r.DB.WithContext(ctx).Preload("Role").First(&gormUser, id).Error
+	err := dbFromContext(ctx, r.DB).Preload("Role").First(&gormUser, id).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return domain.User{}, errors.New("user not found")
+			return domain.User{}, domain.ErrUserNotFound
 		}
 		return domain.User{}, err
 	}
@@ -60,7 +68,7 @@ func (r *gormUserRepository) FindByID(ctx context.Context, id uint) (domain.User
 func (r *gormUserRepository) FindByEmail(ctx context.Context, email string) (domain.User, error) {
 	var gormUser gormContract.UserGorm
 
-	err := r.DB.WithContext(ctx).Preload("Role").Where("email = ?", email).First(&gormUser).Error
+	err := dbFromContext(ctx, r.DB).Preload("Role").Where("email = ?", email).First(&gormUser).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return domain.User{}, errors.New("user not found")
@@ -74,7 +82,7 @@ func (r *gormUserRepository) FindByEmail(ctx context.Context, email string) (dom
 func (r *gormUserRepository) FindAll(ctx context.Context) ([]domain.User, error) {
 	var gormUsers []gormContract.UserGorm
 
-	if err := r.DB.WithContext(ctx).Preload("Role").Find(&gormUsers).Error; err != nil {
+	if err := dbFromContext(ctx, r.DB).Preload("Role").Find(&gormUsers).Error; err != nil {
 		return nil, err
 	}
 
@@ -89,7 +97,7 @@ func (r *gormUserRepository) FindAll(ctx context.Context) ([]domain.User, error)
 func (r *gormUserRepository) Update(ctx context.Context, user *domain.User) error {
 	var gormUser gormContract.UserGorm
 
-	if err := r.DB.WithContext(ctx).First(&gormUser, user.ID).Error; err != nil {
+	if err := dbFromContext(ctx, r.DB).First(&gormUser, user.ID).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return errors.New("user not found")
 		}
@@ -99,8 +107,11 @@ func (r *gormUserRepository) Update(ctx context.Context, user *domain.User) erro
 	gormUser.FullName = user.FullName
 	gormUser.Age = user.Age
 	gormUser.Address = user.Address
+	if user.Language != "" {
+		gormUser.Language = user.Language
+	}
 
-	if err := r.DB.WithContext(ctx).Save(&gormUser).Error; err != nil {
+	if err := dbFromContext(ctx, r.DB).Save(&gormUser).Error; err != nil {
 		return err
 	}
 
@@ -110,7 +121,7 @@ func (r *gormUserRepository) Update(ctx context.Context, user *domain.User) erro
 }
 
 func (r *gormUserRepository) Delete(ctx context.Context, id uint) error {
-	result := r.DB.WithContext(ctx).Delete(&gormContract.UserGorm{}, id)
+	result := dbFromContext(ctx, r.DB).Delete(&gormContract.UserGorm{}, id)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -123,7 +134,7 @@ func (r *gormUserRepository) Delete(ctx context.Context, id uint) error {
 }
 
 func (r *gormUserRepository) UpdateEmailVerification(ctx context.Context, id uint, isVerified bool) error {
-	result := r.DB.WithContext(ctx).Model(&gormContract.UserGorm{}).Where("id = ?", id).Update("is_verified", isVerified)
+	result := dbFromContext(ctx, r.DB).Model(&gormContract.UserGorm{}).Where("id = ?", id).Update("is_verified", isVerified)
 
 	if result.Error != nil {
 		return result.Error
@@ -135,3 +146,51 @@ func (r *gormUserRepository) UpdateEmailVerification(ctx context.Context, id uin
 
 	return nil
 }
+
+func (r *gormUserRepository) FindByPhoneNumber(ctx context.Context, phoneNumber string) (domain.User, error) {
+	var gormUser gormContract.UserGorm
+
+	err := dbFromContext(ctx, r.DB).Preload("Role").Where("phone_number = ?", phoneNumber).First(&gormUser).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.User{}, domain.ErrUserNotFound
+		}
+		return domain.User{}, err
+	}
+
+	return gormUser.ToDomain(), nil
+}
+
+func (r *gormUserRepository) UpdatePhone(ctx context.Context, id uint, phoneNumber string, verifiedAt *time.Time) error {
+	var phone *string
+	if phoneNumber != "" {
+		phone = &phoneNumber
+	}
+
+	result := dbFromContext(ctx, r.DB).Model(&gormContract.UserGorm{}).Where("id = ?", id).Updates(map[string]any{
+		"phone_number":      phone,
+		"phone_verified_at": verifiedAt,
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	return nil
+}
+
+func (r *gormUserRepository) UpdateRole(ctx context.Context, id uint, roleID uint) error {
+	result := dbFromContext(ctx, r.DB).Model(&gormContract.UserGorm{}).Where("id = ?", id).Update("role_id", roleID)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update user role: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	gormContract "go-futsal-booking-api/internal/repository/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type VenueMemberRepository interface {
+	Create(ctx context.Context, member *domain.VenueMember) error
+	FindByVenueAndUser(ctx context.Context, venueID, userID uint) (domain.VenueMember, error)
+	FindByVenueID(ctx context.Context, venueID uint) ([]domain.VenueMember, error)
+	UpdateRole(ctx context.Context, venueID, userID uint, role string) error
+	Delete(ctx context.Context, venueID, userID uint) error
+	LockUserIDsByRole(ctx context.Context, venueID uint, role string) ([]uint, error)
+}
+
+type gormVenueMemberRepository struct {
+	DB *gorm.DB
+}
+
+func NewVenueMemberRepository(db *gorm.DB) VenueMemberRepository {
+	return &gormVenueMemberRepository{
+		DB: db,
+	}
+}
+
+func (r *gormVenueMemberRepository) preload(ctx context.Context) *gorm.DB {
+	return dbFromContext(ctx, r.DB).Preload("Venue").Preload("User.Role")
+}
+
+func (r *gormVenueMemberRepository) Create(ctx context.Context, member *domain.VenueMember) error {
+	var gormMember gormContract.VenueMemberGorm
+	gormMember.FromDomain(*member)
+
+	if err := dbFromContext(ctx, r.DB).Omit("Venue", "User").Create(&gormMember).Error; err != nil {
+		return fmt.Errorf("failed to create venue member: %w", err)
+	}
+
+	if err := r.preload(ctx).First(&gormMember, gormMember.ID).Error; err != nil {
+		return fmt.Errorf("failed to preload venue member: %w", err)
+	}
+
+	*member = gormMember.ToDomain()
+
+	return nil
+}
+
+func (r *gormVenueMemberRepository) FindByVenueAndUser(ctx context.Context, venueID, userID uint) (domain.VenueMember, error) {
+	var gormMember gormContract.VenueMemberGorm
+
+	err := r.preload(ctx).Where("venue_id = ? AND user_id = ?", venueID, userID).First(&gormMember).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.VenueMember{}, domain.ErrVenueMemberNotFound
+		}
+		return domain.VenueMember{}, err
+	}
+
+	return gormMember.ToDomain(), nil
+}
+
+func (r *gormVenueMemberRepository) FindByVenueID(ctx context.Context, venueID uint) ([]domain.VenueMember, error) {
+	var gormMembers []gormContract.VenueMemberGorm
+
+	if err := r.preload(ctx).Where("venue_id = ?", venueID).Order("id").Find(&gormMembers).Error; err != nil {
+		return nil, fmt.Errorf("failed to find venue members: %w", err)
+	}
+
+	members := make([]domain.VenueMember, len(gormMembers))
+	for i := range gormMembers {
+		members[i] = gormMembers[i].ToDomain()
+	}
+
+	return members, nil
+}
+
+func (r *gormVenueMemberRepository) UpdateRole(ctx context.Context, venueID, userID uint, role string) error {
+	result := dbFromContext(ctx, r.DB).Model(&gormContract.VenueMemberGorm{}).
+		Where("venue_id = ? AND user_id = ?", venueID, userID).
+		Update("role", role)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update venue member: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrVenueMemberNotFound
+	}
+
+	return nil
+}
+
+func (r *gormVenueMemberRepository) Delete(ctx context.Context, venueID, userID uint) error {
+	result := dbFromContext(ctx, r.DB).Where("venue_id = ? AND user_id = ?", venueID, userID).Delete(&gormContract.VenueMemberGorm{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete venue member: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrVenueMemberNotFound
+	}
+
+	return nil
+}
+
+func (r *gormVenueMemberRepository) LockUserIDsByRole(ctx context.Context, venueID uint, role string) ([]uint, error) {
+	var userIDs []uint
+
+	err := dbFromContext(ctx, r.DB).Model(&gormContract.VenueMemberGorm{}).
+		Where("venue_id = ? AND role = ?", venueID, role).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Pluck("user_id", &userIDs).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find venue members by role: %w", err)
+	}
+
+	return userIDs, nil
+}
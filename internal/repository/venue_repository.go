@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"go-futsal-booking-api/internal/domain"
 	gormContract "go-futsal-booking-api/internal/repository/model"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
@@ -15,8 +16,23 @@ type VenueRepository interface {
 	Create(ctx context.Context, venue *domain.Venue) error
 	FindByID(ctx context.Context, id uint) (domain.Venue, error)
 	FindAll(ctx context.Context) ([]domain.Venue, error)
+	// Search returns one page of venues matching filter and the number of
+	// venues matching it in total.
+	Search(ctx context.Context, filter domain.VenueFilter) ([]domain.VenueListing, int64, error)
+	// FindNearby returns up to limit venues within radiusKm of center, nearest
+	// first. Venues without coordinates are never returned.
+	FindNearby(ctx context.Context, center domain.GeoPoint, radiusKm float64, limit int) ([]domain.NearbyVenue, error)
 	Update(ctx context.Context, venue *domain.Venue) error
-	Delete(ctx context.Context, id uint) error
+	// Delete soft-deletes the venue together with its fields and their
+	// schedules, all stamped with at so Restore can bring back the same set.
+	Delete(ctx context.Context, id uint, at time.Time) error
+	// DeletionImpact counts the fields and schedules Delete would take along.
+	DeletionImpact(ctx context.Context, id uint) (domain.DeletionImpact, error)
+	// FindDeletedByID returns domain.ErrNotDeleted for a venue that is not
+	// deleted.
+	FindDeletedByID(ctx context.Context, id uint) (domain.Venue, error)
+	// Restore undeletes the venue and the fields and schedules deleted with it.
+	Restore(ctx context.Context, id uint) error
 }
 
 type gormVenueRepository struct {
@@ -41,11 +57,20 @@ func (r *gormVenueRepository) Create(ctx context.Context, venue *domain.Venue) e
 	gormVenue.CreatedAt = now
 	gormVenue.UpdatedAt = now
 
-	if err := r.DB.WithContext(ctx).Create(&gormVenue).Error; err != nil {
+	err := dbFromContext(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&gormVenue).Error; err != nil {
+			return err
+		}
+
+		return saveVenueDetails(tx, gormVenue.ID, *venue)
+	})
+	if err != nil {
 		return fmt.Errorf("failed to create venue: %w", err)
 	}
 
+	hours, amenities := venue.OpeningHours, venue.Amenities
 	*venue = gormVenue.ToDomain()
+	venue.OpeningHours, venue.Amenities = hours, amenities
 
 	return nil
 }
@@ -65,6 +90,9 @@ func (r *gormVenueRepository) FindByID(ctx context.Context, id uint) (domain.Ven
 	}
 
 	venue := gormVenue.ToDomain()
+	if err := loadVenueDetails(r.DB.WithContext(ctx), &venue); err != nil {
+		return domain.Venue{}, err
+	}
 
 	return venue, nil
 }
@@ -85,9 +113,236 @@ func (r *gormVenueRepository) FindAll(ctx context.Context) ([]domain.Venue, erro
 		domainVenues = append(domainVenues, v.ToDomain())
 	}
 
+	details := make([]*domain.Venue, len(domainVenues))
+	for i := range domainVenues {
+		details[i] = &domainVenues[i]
+	}
+	if err := loadVenueDetails(r.DB.WithContext(ctx), details...); err != nil {
+		return nil, err
+	}
+
 	return domainVenues, nil
 }
 
+func (r *gormVenueRepository) Search(ctx context.Context, filter domain.VenueFilter) ([]domain.VenueListing, int64, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, 0, fmt.Errorf("context error: %w", err)
+	}
+
+	slots, slotArgs := venueSlotCondition(filter)
+
+	// min_price is computed in a derived table so it can be filtered, sorted
+	// and paged on like a column.
+	venues := r.DB.WithContext(ctx).
+		Table("venues").
+		Select("venues.*, (SELECT MIN(s.price) FROM schedules s JOIN fields f ON f.id = s.field_id WHERE "+slots+") AS min_price", slotArgs...).
+		Where("venues.deleted_at IS NULL")
+
+	if filter.City != "" {
+		venues = venues.Where("LOWER(venues.city) = LOWER(?)", filter.City)
+	}
+	if filter.Query != "" {
+		like := "%" + likeEscaper.Replace(filter.Query) + "%"
+		venues = venues.Where("(venues.name ILIKE ? OR venues.address ILIKE ? OR venues.city ILIKE ?)", like, like, like)
+	}
+	if len(filter.Amenities) > 0 {
+		venues = venues.Where("(SELECT COUNT(*) FROM venue_amenities va WHERE va.venue_id = venues.id AND va.amenity_code IN ?) = ?",
+			filter.Amenities, len(filter.Amenities))
+	}
+
+	query := r.DB.WithContext(ctx).Table("(?) AS v", venues)
+	if filter.FieldType != "" || filter.MinPrice != nil || filter.MaxPrice != nil || filter.Date != nil {
+		query = query.Where("v.min_price IS NOT NULL")
+	}
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count venues: %w", err)
+	}
+
+	sort := venueSortKeys[filter.Sort]
+	if sort.column == "" {
+		sort = venueSortKeys[domain.VenueSortName]
+	}
+
+	page := query.Session(&gorm.Session{})
+	if filter.After != nil {
+		condition, args := sort.after(*filter.After)
+		page = page.Where(condition, args...)
+	} else if filter.Offset > 0 {
+		page = page.Offset(filter.Offset)
+	}
+
+	var rows []gormContract.VenueListingGorm
+	err := page.Order(sort.orderBy()).Limit(filter.Limit).Find(&rows).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search venues: %w", err)
+	}
+
+	listings := make([]domain.VenueListing, len(rows))
+	details := make([]*domain.Venue, len(rows))
+	for i := range rows {
+		listings[i] = rows[i].ToDomain()
+		details[i] = &listings[i].Venue
+	}
+	if err := loadVenueDetails(r.DB.WithContext(ctx), details...); err != nil {
+		return nil, 0, err
+	}
+
+	return listings, total, nil
+}
+
+// venueDistance is the haversine distance in km from the point bound to its
+// parameters (earth radius, latitude, latitude, longitude). LEAST guards ASIN
+// against rounding just above 1.
+const venueDistance = `(2 * ? * ASIN(SQRT(LEAST(1,
+	POWER(SIN(RADIANS(venues.latitude - ?) / 2), 2) +
+	COS(RADIANS(?)) * COS(RADIANS(venues.latitude)) * POWER(SIN(RADIANS(venues.longitude - ?) / 2), 2)))))`
+
+func (r *gormVenueRepository) FindNearby(ctx context.Context, center domain.GeoPoint, radiusKm float64, limit int) ([]domain.NearbyVenue, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	// The bounding box lets the coordinates index skip far-away venues before
+	// distances are computed.
+	box := center.BoundingBox(radiusKm)
+
+	venues := r.DB.WithContext(ctx).
+		Table("venues").
+		Select("venues.*, "+venueDistance+" AS distance_km", domain.EarthRadiusKm, center.Latitude, center.Latitude, center.Longitude).
+		Where("venues.deleted_at IS NULL").
+		Where("venues.latitude BETWEEN ? AND ?", box.MinLatitude, box.MaxLatitude).
+		Where("venues.longitude BETWEEN ? AND ?", box.MinLongitude, box.MaxLongitude)
+
+	var rows []gormContract.NearbyVenueGorm
+	err := r.DB.WithContext(ctx).
+		Table("(?) AS v", venues).
+		Where("v.distance_km <= ?", radiusKm).
+		Order("v.distance_km, v.id").
+		Limit(limit).
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find nearby venues: %w", err)
+	}
+
+	nearby := make([]domain.NearbyVenue, len(rows))
+	details := make([]*domain.Venue, len(rows))
+	for i := range rows {
+		nearby[i] = rows[i].ToDomain()
+		details[i] = &nearby[i].Venue
+	}
+	if err := loadVenueDetails(r.DB.WithContext(ctx), details...); err != nil {
+		return nil, err
+	}
+
+	return nearby, nil
+}
+
+// venueSlotCondition matches the schedules s, joined to their field f, of the
+// venue in the outer query that satisfy the slot parts of filter.
+func venueSlotCondition(filter domain.VenueFilter) (string, []any) {
+	conditions := []string{"f.venue_id = venues.id", "f.deleted_at IS NULL", "f.status = 'ACTIVE'", "s.deleted_at IS NULL"}
+	var args []any
+
+	if filter.FieldType != "" {
+		conditions = append(conditions, "f.type = ?")
+		args = append(args, strings.ToUpper(filter.FieldType))
+	}
+	if filter.MinPrice != nil {
+		conditions = append(conditions, "s.price >= ?")
+		args = append(args, *filter.MinPrice)
+	}
+	if filter.MaxPrice != nil {
+		conditions = append(conditions, "s.price <= ?")
+		args = append(args, *filter.MaxPrice)
+	}
+	if filter.Date != nil {
+		// Schedules number the days 1 (Monday) to 7 (Sunday).
+		day := int(filter.Date.Weekday())
+		if day == 0 {
+			day = 7
+		}
+
+		conditions = append(conditions,
+			"s.day_of_week = ?",
+			"NOT EXISTS (SELECT 1 FROM bookings b WHERE b.schedule_id = s.id AND b.booking_date = ?::date AND b.status IN ?)",
+			// Maintenance windows are stored in UTC, schedules in the venue's time.
+			"NOT EXISTS (SELECT 1 FROM maintenance_windows mw WHERE mw.field_id = f.id"+
+				" AND mw.starts_at < ((?::date + s.end_time) AT TIME ZONE venues.timezone) AT TIME ZONE 'UTC'"+
+				" AND mw.ends_at > ((?::date + s.start_time) AT TIME ZONE venues.timezone) AT TIME ZONE 'UTC')",
+		)
+		date := filter.Date.Format("2006-01-02")
+		args = append(args, day, date,
+			[]string{domain.BookingStatusPending, domain.BookingStatusConfirmed, domain.BookingStatusCheckedIn},
+			date, date)
+
+		if filter.StartTime != nil {
+			at := filter.StartTime.Format("15:04:05")
+			conditions = append(conditions, "s.start_time <= ?::time AND s.end_time > ?::time")
+			args = append(args, at, at)
+		}
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
+
+// venueSortKey is a sort order of venue searches. The venue id breaks ties so
+// that cursors always point at one position.
+type venueSortKey struct {
+	column string
+	desc   bool
+}
+
+var venueSortKeys = map[string]venueSortKey{
+	domain.VenueSortName:       {column: "v.name"},
+	domain.VenueSortNameDesc:   {column: "v.name", desc: true},
+	domain.VenueSortPrice:      {column: "v.min_price"},
+	domain.VenueSortPriceDesc:  {column: "v.min_price", desc: true},
+	domain.VenueSortRating:     {column: "v.rating_avg"},
+	domain.VenueSortRatingDesc: {column: "v.rating_avg", desc: true},
+	domain.VenueSortNewest:     {column: "v.created_at", desc: true},
+}
+
+func (k venueSortKey) orderBy() string {
+	if k.desc {
+		return k.column + " DESC NULLS LAST, v.id DESC"
+	}
+
+	return k.column + " ASC NULLS LAST, v.id ASC"
+}
+
+// after matches the venues that come after cursor in this order. Only
+// min_price can be NULL; those venues sort last in either direction.
+func (k venueSortKey) after(cursor domain.VenueCursor) (string, []any) {
+	op := ">"
+	if k.desc {
+		op = "<"
+	}
+
+	var value any
+	switch k.column {
+	case "v.name":
+		value = cursor.Name
+	case "v.created_at":
+		value = cursor.CreatedAt
+	case "v.rating_avg":
+		value = cursor.Rating
+	case "v.min_price":
+		if cursor.MinPrice == nil {
+			return "v.min_price IS NULL AND v.id " + op + " ?", []any{cursor.ID}
+		}
+		value = *cursor.MinPrice
+
+		return fmt.Sprintf("(v.min_price %[1]s ? OR (v.min_price = ? AND v.id %[1]s ?) OR v.min_price IS NULL)", op),
+			[]any{value, value, cursor.ID}
+	}
+
+	return fmt.Sprintf("(%s, v.id) %s (?, ?)", k.column, op), []any{value, cursor.ID}
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 func (r *gormVenueRepository) Update(ctx context.Context, venue *domain.Venue) error {
 	if err := ctx.Err(); err != nil {
 		return fmt.Errorf("context error: %w", err)
@@ -97,36 +352,215 @@ func (r *gormVenueRepository) Update(ctx context.Context, venue *domain.Venue) e
 	gormVenue.FromDomain(*venue)
 
 	updateVenue := map[string]interface{}{
-		"name":       gormVenue.Name,
-		"address":    gormVenue.Address,
-		"city":       gormVenue.City,
-		"updated_at": time.Now(),
+		"name":            gormVenue.Name,
+		"address":         gormVenue.Address,
+		"city":            gormVenue.City,
+		"timezone":        gormVenue.Timezone,
+		"latitude":        gormVenue.Latitude,
+		"longitude":       gormVenue.Longitude,
+		"phone_number":    gormVenue.PhoneNumber,
+		"whatsapp_number": gormVenue.WhatsAppNumber,
+		"updated_at":      time.Now(),
 	}
 
-	result := r.DB.WithContext(ctx).Model(&gormVenue).Where("id = ? AND deleted_at IS NULL", venue.ID).Updates(updateVenue)
-	if result.Error != nil {
-		return fmt.Errorf("failed to update venue: %w", result.Error)
+	errNotFound := errors.New("venue not found or already deleted")
+	err := dbFromContext(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&gormVenue).Where("id = ? AND deleted_at IS NULL", venue.ID).Updates(updateVenue)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errNotFound
+		}
+
+		return saveVenueDetails(tx, venue.ID, *venue)
+	})
+	if errors.Is(err, errNotFound) {
+		return err
 	}
-	if result.RowsAffected == 0 {
-		return errors.New("venue not found or already deleted")
+	if err != nil {
+		return fmt.Errorf("failed to update venue: %w", err)
 	}
 
+	hours, amenities := venue.OpeningHours, venue.Amenities
 	*venue = gormVenue.ToDomain()
+	venue.OpeningHours, venue.Amenities = hours, amenities
+
+	return nil
+}
+
+// saveVenueDetails replaces the opening hours and amenities of a venue with
+// those of venue.
+func saveVenueDetails(tx *gorm.DB, venueID uint, venue domain.Venue) error {
+	if err := tx.Where("venue_id = ?", venueID).Delete(&gormContract.VenueOpeningHoursGorm{}).Error; err != nil {
+		return err
+	}
+	if len(venue.OpeningHours) > 0 {
+		hours := make([]gormContract.VenueOpeningHoursGorm, len(venue.OpeningHours))
+		for i, h := range venue.OpeningHours {
+			hours[i].FromDomain(venueID, h)
+		}
+		if err := tx.Create(&hours).Error; err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Where("venue_id = ?", venueID).Delete(&gormContract.VenueAmenityGorm{}).Error; err != nil {
+		return err
+	}
+	if len(venue.Amenities) > 0 {
+		amenities := make([]gormContract.VenueAmenityGorm, len(venue.Amenities))
+		for i, a := range venue.Amenities {
+			amenities[i] = gormContract.VenueAmenityGorm{VenueID: venueID, AmenityCode: a.Code}
+		}
+		if err := tx.Omit("Amenity").Create(&amenities).Error; err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
-func (r *gormVenueRepository) Delete(ctx context.Context, id uint) error {
+// loadVenueDetails fills in the opening hours and amenities of venues with one
+// query each.
+func loadVenueDetails(db *gorm.DB, venues ...*domain.Venue) error {
+	if len(venues) == 0 {
+		return nil
+	}
+
+	byID := make(map[uint]*domain.Venue, len(venues))
+	ids := make([]uint, len(venues))
+	for i, v := range venues {
+		byID[v.ID] = v
+		ids[i] = v.ID
+	}
+
+	var hours []gormContract.VenueOpeningHoursGorm
+	if err := db.Where("venue_id IN ?", ids).Order("venue_id, day_of_week").Find(&hours).Error; err != nil {
+		return fmt.Errorf("failed to find venue opening hours: %w", err)
+	}
+	for i := range hours {
+		v := byID[hours[i].VenueID]
+		v.OpeningHours = append(v.OpeningHours, hours[i].ToDomain())
+	}
+
+	var amenities []gormContract.VenueAmenityGorm
+	err := db.Preload("Amenity").Where("venue_id IN ?", ids).Order("venue_id, amenity_code").Find(&amenities).Error
+	if err != nil {
+		return fmt.Errorf("failed to find venue amenities: %w", err)
+	}
+	for i := range amenities {
+		v := byID[amenities[i].VenueID]
+		v.Amenities = append(v.Amenities, amenities[i].Amenity.ToDomain())
+	}
+
+	return nil
+}
+
+func (r *gormVenueRepository) Delete(ctx context.Context, id uint, at time.Time) error {
 	if err := ctx.Err(); err != nil {
 		return fmt.Errorf("context error: %w", err)
 	}
 
-	result := r.DB.WithContext(ctx).Model(&gormContract.VenueGorm{}).Where("id = ? AND deleted_at IS NULL", id).Update("deleted_at", time.Now())
-	if result.Error != nil {
-		return fmt.Errorf("failed to delete field: %w", result.Error)
+	err := dbFromContext(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&gormContract.VenueGorm{}).Where("id = ?", id).Update("deleted_at", at)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrVenueNotFound
+		}
+
+		fields := tx.Model(&gormContract.FieldGorm{}).Select("id").Where("venue_id = ?", id)
+		if err := tx.Model(&gormContract.ScheduleGorm{}).Where("field_id IN (?)", fields).Update("deleted_at", at).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&gormContract.FieldGorm{}).Where("venue_id = ?", id).Update("deleted_at", at).Error
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrVenueNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to delete venue: %w", err)
+	}
+
+	return nil
+}
+
+func (r *gormVenueRepository) DeletionImpact(ctx context.Context, id uint) (domain.DeletionImpact, error) {
+	var impact domain.DeletionImpact
+	db := dbFromContext(ctx, r.DB)
+
+	fields := db.Model(&gormContract.FieldGorm{}).Where("venue_id = ?", id)
+	if err := fields.Count(&impact.Fields).Error; err != nil {
+		return domain.DeletionImpact{}, fmt.Errorf("failed to count venue fields: %w", err)
+	}
+
+	err := db.Model(&gormContract.ScheduleGorm{}).
+		Where("field_id IN (?)", db.Model(&gormContract.FieldGorm{}).Select("id").Where("venue_id = ?", id)).
+		Count(&impact.Schedules).Error
+	if err != nil {
+		return domain.DeletionImpact{}, fmt.Errorf("failed to count venue schedules: %w", err)
+	}
+
+	return impact, nil
+}
+
+func (r *gormVenueRepository) FindDeletedByID(ctx context.Context, id uint) (domain.Venue, error) {
+	var gormVenue gormContract.VenueGorm
+
+	err := dbFromContext(ctx, r.DB).Unscoped().First(&gormVenue, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.Venue{}, domain.ErrVenueNotFound
+		}
+		return domain.Venue{}, fmt.Errorf("failed to find venue: %w", err)
+	}
+
+	if !gormVenue.DeletedAt.Valid {
+		return domain.Venue{}, domain.ErrNotDeleted
 	}
-	if result.RowsAffected == 0 {
-		return errors.New("venue not found or already deleted")
+
+	return gormVenue.ToDomain(), nil
+}
+
+func (r *gormVenueRepository) Restore(ctx context.Context, id uint) error {
+	err := dbFromContext(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		deletedAt := tx.Unscoped().Model(&gormContract.VenueGorm{}).Select("deleted_at").Where("id = ?", id)
+		fields := tx.Unscoped().Model(&gormContract.FieldGorm{}).Select("id").Where("venue_id = ? AND deleted_at = (?)", id, deletedAt)
+
+		err := tx.Unscoped().Model(&gormContract.ScheduleGorm{}).
+			Where("field_id IN (?) AND deleted_at = (?)", fields, deletedAt).
+			Update("deleted_at", nil).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Unscoped().Model(&gormContract.FieldGorm{}).
+			Where("venue_id = ? AND deleted_at = (?)", id, deletedAt).
+			Update("deleted_at", nil).Error
+		if err != nil {
+			return err
+		}
+
+		result := tx.Unscoped().Model(&gormContract.VenueGorm{}).
+			Where("id = ? AND deleted_at IS NOT NULL", id).
+			Update("deleted_at", nil)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrNotDeleted
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrNotDeleted) {
+			return err
+		}
+		return fmt.Errorf("failed to restore venue: %w", err)
 	}
 
 	return nil
@@ -0,0 +1,219 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	gormContract "go-futsal-booking-api/internal/repository/model"
+
+	"gorm.io/gorm"
+)
+
+type WebhookRepository interface {
+	CreateSubscription(ctx context.Context, subscription *domain.WebhookSubscription) error
+	FindSubscriptionByID(ctx context.Context, id uint) (domain.WebhookSubscription, error)
+	// FindSubscriptions lists the subscriptions of a venue, or the global ones
+	// when venueID is nil.
+	FindSubscriptions(ctx context.Context, venueID *uint) ([]domain.WebhookSubscription, error)
+	// FindActiveSubscriptions returns active subscriptions to eventType that are
+	// global or belong to venueID.
+	FindActiveSubscriptions(ctx context.Context, eventType string, venueID uint) ([]domain.WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, subscription *domain.WebhookSubscription) error
+	DeleteSubscription(ctx context.Context, id uint) error
+
+	CreateDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error
+	FindDeliveryByID(ctx context.Context, id uint) (domain.WebhookDelivery, error)
+	FindDeliveries(ctx context.Context, subscriptionID uint, filter domain.WebhookDeliveryFilter) ([]domain.WebhookDelivery, int64, error)
+	// RecordAttempt counts an attempt and stores its outcome in the delivery log.
+	RecordAttempt(ctx context.Context, id uint, attempt domain.WebhookAttempt) error
+}
+
+type gormWebhookRepository struct {
+	DB *gorm.DB
+}
+
+func NewWebhookRepository(db *gorm.DB) WebhookRepository {
+	return &gormWebhookRepository{
+		DB: db,
+	}
+}
+
+func (r *gormWebhookRepository) CreateSubscription(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	var gormSubscription gormContract.WebhookSubscriptionGorm
+	gormSubscription.FromDomain(*subscription)
+
+	if err := dbFromContext(ctx, r.DB).Create(&gormSubscription).Error; err != nil {
+		return fmt.Errorf("failed to create webhook subscription: %w", err)
+	}
+
+	*subscription = gormSubscription.ToDomain()
+
+	return nil
+}
+
+func (r *gormWebhookRepository) FindSubscriptionByID(ctx context.Context, id uint) (domain.WebhookSubscription, error) {
+	var gormSubscription gormContract.WebhookSubscriptionGorm
+
+	if err := dbFromContext(ctx, r.DB).First(&gormSubscription, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.WebhookSubscription{}, domain.ErrWebhookNotFound
+		}
+		return domain.WebhookSubscription{}, fmt.Errorf("failed to find webhook subscription: %w", err)
+	}
+
+	return gormSubscription.ToDomain(), nil
+}
+
+func (r *gormWebhookRepository) FindSubscriptions(ctx context.Context, venueID *uint) ([]domain.WebhookSubscription, error) {
+	query := dbFromContext(ctx, r.DB)
+	if venueID != nil {
+		query = query.Where("venue_id = ?", *venueID)
+	} else {
+		query = query.Where("venue_id IS NULL")
+	}
+
+	var gormSubscriptions []gormContract.WebhookSubscriptionGorm
+	if err := query.Order("id").Find(&gormSubscriptions).Error; err != nil {
+		return nil, fmt.Errorf("failed to find webhook subscriptions: %w", err)
+	}
+
+	return toWebhookSubscriptions(gormSubscriptions), nil
+}
+
+func (r *gormWebhookRepository) FindActiveSubscriptions(ctx context.Context, eventType string, venueID uint) ([]domain.WebhookSubscription, error) {
+	eventTypes, err := json.Marshal([]string{eventType})
+	if err != nil {
+		return nil, err
+	}
+
+	var gormSubscriptions []gormContract.WebhookSubscriptionGorm
+	err = dbFromContext(ctx, r.DB).
+		Where("active AND event_types @> ?::jsonb", string(eventTypes)).
+		Where("venue_id IS NULL OR venue_id = ?", venueID).
+		Order("id").
+		Find(&gormSubscriptions).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find webhook subscriptions: %w", err)
+	}
+
+	return toWebhookSubscriptions(gormSubscriptions), nil
+}
+
+func (r *gormWebhookRepository) UpdateSubscription(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	var gormSubscription gormContract.WebhookSubscriptionGorm
+	gormSubscription.FromDomain(*subscription)
+
+	result := dbFromContext(ctx, r.DB).Model(&gormContract.WebhookSubscriptionGorm{ID: subscription.ID}).
+		Select("url", "description", "event_types", "secret_encrypted", "active", "updated_at").
+		Updates(&gormSubscription)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update webhook subscription: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrWebhookNotFound
+	}
+
+	subscription.UpdatedAt = gormSubscription.UpdatedAt
+
+	return nil
+}
+
+func (r *gormWebhookRepository) DeleteSubscription(ctx context.Context, id uint) error {
+	result := dbFromContext(ctx, r.DB).Delete(&gormContract.WebhookSubscriptionGorm{}, id)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete webhook subscription: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+func (r *gormWebhookRepository) CreateDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	var gormDelivery gormContract.WebhookDeliveryGorm
+	gormDelivery.FromDomain(*delivery)
+
+	if err := dbFromContext(ctx, r.DB).Create(&gormDelivery).Error; err != nil {
+		return fmt.Errorf("failed to create webhook delivery: %w", err)
+	}
+
+	*delivery = gormDelivery.ToDomain()
+
+	return nil
+}
+
+func (r *gormWebhookRepository) FindDeliveryByID(ctx context.Context, id uint) (domain.WebhookDelivery, error) {
+	var gormDelivery gormContract.WebhookDeliveryGorm
+
+	if err := dbFromContext(ctx, r.DB).First(&gormDelivery, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.WebhookDelivery{}, domain.ErrDeliveryNotFound
+		}
+		return domain.WebhookDelivery{}, fmt.Errorf("failed to find webhook delivery: %w", err)
+	}
+
+	return gormDelivery.ToDomain(), nil
+}
+
+func (r *gormWebhookRepository) FindDeliveries(ctx context.Context, subscriptionID uint, filter domain.WebhookDeliveryFilter) ([]domain.WebhookDelivery, int64, error) {
+	query := dbFromContext(ctx, r.DB).Model(&gormContract.WebhookDeliveryGorm{}).Where("subscription_id = ?", subscriptionID)
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count webhook deliveries: %w", err)
+	}
+
+	var gormDeliveries []gormContract.WebhookDeliveryGorm
+	if err := query.Order("id DESC").Limit(filter.Limit).Offset(filter.Offset).Find(&gormDeliveries).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to find webhook deliveries: %w", err)
+	}
+
+	deliveries := make([]domain.WebhookDelivery, len(gormDeliveries))
+	for i := range gormDeliveries {
+		deliveries[i] = gormDeliveries[i].ToDomain()
+	}
+
+	return deliveries, total, nil
+}
+
+func (r *gormWebhookRepository) RecordAttempt(ctx context.Context, id uint, attempt domain.WebhookAttempt) error {
+	values := map[string]any{
+		"status":          attempt.Status,
+		"attempts":        gorm.Expr("attempts + 1"),
+		"response_status": attempt.ResponseStatus,
+		"last_error":      attempt.Error,
+		"duration_ms":     attempt.DurationMs,
+		"updated_at":      attempt.At,
+	}
+	if attempt.Status == domain.WebhookDeliverySucceeded {
+		values["delivered_at"] = attempt.At
+	}
+
+	result := dbFromContext(ctx, r.DB).Model(&gormContract.WebhookDeliveryGorm{}).Where("id = ?", id).Updates(values)
+	if result.Error != nil {
+		return fmt.Errorf("failed to record webhook attempt: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrDeliveryNotFound
+	}
+
+	return nil
+}
+
+func toWebhookSubscriptions(gormSubscriptions []gormContract.WebhookSubscriptionGorm) []domain.WebhookSubscription {
+	subscriptions := make([]domain.WebhookSubscription, len(gormSubscriptions))
+	for i := range gormSubscriptions {
+		subscriptions[i] = gormSubscriptions[i].ToDomain()
+	}
+
+	return subscriptions
+}
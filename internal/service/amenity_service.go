@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+	"strings"
+	"time"
+)
+
+// AmenityService manages the list of amenities venues can pick from.
+type AmenityService interface {
+	GetAmenities(ctx context.Context) ([]domain.Amenity, error)
+	CreateAmenity(ctx context.Context, code, name string) (*domain.Amenity, error)
+	// DeleteAmenity also removes the amenity from every venue that has it.
+	DeleteAmenity(ctx context.Context, code string) error
+}
+
+type amenityService struct {
+	amenityRepo repository.AmenityRepository
+}
+
+func NewAmenityService(amenityRepo repository.AmenityRepository) AmenityService {
+	return &amenityService{
+		amenityRepo: amenityRepo,
+	}
+}
+
+func (s *amenityService) GetAmenities(ctx context.Context) ([]domain.Amenity, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	amenities, err := s.amenityRepo.FindAll(ctx)
+	if err != nil {
+		logger.Error("failed to find amenities", err)
+		return nil, err
+	}
+
+	return amenities, nil
+}
+
+func (s *amenityService) CreateAmenity(ctx context.Context, code, name string) (*domain.Amenity, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if !domain.IsValidAmenityCode(code) {
+		return nil, domain.ErrInvalidAmenityCode
+	}
+
+	amenity := &domain.Amenity{
+		Code:      code,
+		Name:      strings.TrimSpace(name),
+		CreatedAt: time.Now(),
+	}
+
+	if err := s.amenityRepo.Create(ctx, amenity); err != nil {
+		return nil, err
+	}
+
+	logger.Info("amenity created", "code", code)
+
+	return amenity, nil
+}
+
+func (s *amenityService) DeleteAmenity(ctx context.Context, code string) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("context error: %w", err)
+	}
+
+	if err := s.amenityRepo.Delete(ctx, strings.ToUpper(code)); err != nil {
+		return err
+	}
+
+	logger.Info("amenity deleted", "code", code)
+
+	return nil
+}
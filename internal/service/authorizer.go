@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+)
+
+// Authorizer answers resource-level access questions that route-level permission
+// checks cannot, such as whether a user belongs to the venue they are modifying.
+type Authorizer interface {
+	HasPermission(ctx context.Context, userID uint, permission string) (bool, error)
+	AuthorizeVenue(ctx context.Context, userID, venueID uint, minRole string) error
+}
+
+type authorizer struct {
+	userRepo   repository.UserRepository
+	roleRepo   repository.RoleRepository
+	memberRepo repository.VenueMemberRepository
+}
+
+func NewAuthorizer(userRepo repository.UserRepository, roleRepo repository.RoleRepository, memberRepo repository.VenueMemberRepository) Authorizer {
+	return &authorizer{
+		userRepo:   userRepo,
+		roleRepo:   roleRepo,
+		memberRepo: memberRepo,
+	}
+}
+
+func (a *authorizer) HasPermission(ctx context.Context, userID uint, permission string) (bool, error) {
+	if userID == 0 {
+		return false, nil
+	}
+
+	user, err := a.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	return a.roleRepo.HasPermission(ctx, user.Role.ID, permission)
+}
+
+// AuthorizeVenue returns nil when the user holds at least minRole at the venue,
+// or when their platform role carries venue:manage:any.
+func (a *authorizer) AuthorizeVenue(ctx context.Context, userID, venueID uint, minRole string) error {
+	if userID == 0 || venueID == 0 {
+		return domain.ErrForbidden
+	}
+
+	member, err := a.memberRepo.FindByVenueAndUser(ctx, venueID, userID)
+	if err == nil && domain.VenueRoleAtLeast(member.Role, minRole) {
+		return nil
+	}
+
+	if err != nil && !errors.Is(err, domain.ErrVenueMemberNotFound) {
+		logger.Error("failed to find venue membership", err)
+		return err
+	}
+
+	superAdmin, err := a.HasPermission(ctx, userID, domain.PermVenueManageAny)
+	if err != nil {
+		logger.Error("failed to check venue:manage:any permission", err)
+		return err
+	}
+
+	if !superAdmin {
+		logger.Warn("venue access denied", "user_id", userID, "venue_id", venueID, "required_role", minRole)
+		return domain.ErrForbidden
+	}
+
+	return nil
+}
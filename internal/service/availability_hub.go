@@ -0,0 +1,131 @@
+package service
+
+import (
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/pkg/logger"
+	"sync"
+)
+
+// AvailabilityPublisher is told about slots that were booked, freed or closed.
+type AvailabilityPublisher interface {
+	Publish(update domain.SlotUpdate)
+}
+
+// AvailabilityHub fans slot updates out to the streams watching a field. It
+// only reaches clients connected to this process.
+type AvailabilityHub struct {
+	mu          sync.Mutex
+	subscribers map[uint]map[*AvailabilitySubscription]struct{}
+	bufferSize  int
+	closed      bool
+}
+
+// AvailabilitySubscription receives the updates of one field on Updates until
+// it is closed. Updates is also closed when the subscriber falls behind by more
+// than the hub's buffer or the hub shuts down; clients are expected to
+// reconnect and reload availability.
+type AvailabilitySubscription struct {
+	Updates <-chan domain.SlotUpdate
+
+	hub     *AvailabilityHub
+	fieldID uint
+	updates chan domain.SlotUpdate
+}
+
+func NewAvailabilityHub(bufferSize int) *AvailabilityHub {
+	return &AvailabilityHub{
+		subscribers: make(map[uint]map[*AvailabilitySubscription]struct{}),
+		bufferSize:  bufferSize,
+	}
+}
+
+// Subscribe returns domain.ErrStreamClosed once Close has been called.
+func (h *AvailabilityHub) Subscribe(fieldID uint) (*AvailabilitySubscription, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil, domain.ErrStreamClosed
+	}
+
+	updates := make(chan domain.SlotUpdate, h.bufferSize)
+	sub := &AvailabilitySubscription{
+		Updates: updates,
+		hub:     h,
+		fieldID: fieldID,
+		updates: updates,
+	}
+
+	if h.subscribers[fieldID] == nil {
+		h.subscribers[fieldID] = make(map[*AvailabilitySubscription]struct{})
+	}
+	h.subscribers[fieldID][sub] = struct{}{}
+
+	return sub, nil
+}
+
+// Publish never blocks: subscribers whose buffer is full are disconnected.
+func (h *AvailabilityHub) Publish(update domain.SlotUpdate) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subscribers[update.FieldID] {
+		select {
+		case sub.updates <- update:
+		default:
+			logger.Warn("availability subscriber too slow, disconnecting", "field_id", update.FieldID)
+			h.remove(sub)
+		}
+	}
+}
+
+// Subscribers returns how many streams are watching the field.
+func (h *AvailabilityHub) Subscribers(fieldID uint) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return len(h.subscribers[fieldID])
+}
+
+// Close ends every subscription and rejects new ones. Call it before shutting
+// the HTTP server down, which otherwise waits for open streams.
+func (h *AvailabilityHub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return
+	}
+	h.closed = true
+
+	for _, subs := range h.subscribers {
+		for sub := range subs {
+			h.remove(sub)
+		}
+	}
+}
+
+// Close stops the subscription. It is safe to call more than once.
+func (s *AvailabilitySubscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	s.hub.remove(s)
+}
+
+// remove must be called with h.mu held.
+func (h *AvailabilityHub) remove(sub *AvailabilitySubscription) {
+	subs, ok := h.subscribers[sub.fieldID]
+	if !ok {
+		return
+	}
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(h.subscribers, sub.fieldID)
+	}
+	close(sub.updates)
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+	"time"
+)
+
+type BookingCompletionConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+// BookingCompletionJob marks confirmed and checked-in bookings COMPLETED once
+// their slot has ended, which is what lets customers review the venue.
+// Updates go in batches like InboxRetentionJob's deletes.
+type BookingCompletionJob struct {
+	bookingRepo repository.BookingRepository
+	config      BookingCompletionConfig
+	now         func() time.Time
+}
+
+func NewBookingCompletionJob(bookingRepo repository.BookingRepository, config BookingCompletionConfig) *BookingCompletionJob {
+	return &BookingCompletionJob{
+		bookingRepo: bookingRepo,
+		config:      config,
+		now:         time.Now,
+	}
+}
+
+// Run completes finished bookings until ctx is cancelled.
+func (j *BookingCompletionJob) Run(ctx context.Context) {
+	logger.Info("booking completion job started", "poll_interval", j.config.PollInterval.String())
+
+	ticker := time.NewTicker(j.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := j.CompleteFinished(ctx); err != nil {
+			logger.Error("booking completion failed", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info("booking completion job stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CompleteFinished completes every booking whose slot has ended and returns
+// how many were completed.
+func (j *BookingCompletionJob) CompleteFinished(ctx context.Context) (int64, error) {
+	now := j.now()
+
+	var completed int64
+	for ctx.Err() == nil {
+		n, err := j.bookingRepo.CompleteFinished(ctx, now, j.config.BatchSize)
+		if err != nil {
+			return completed, err
+		}
+
+		completed += n
+		if n < int64(j.config.BatchSize) {
+			break
+		}
+	}
+
+	if completed > 0 {
+		logger.Info("finished bookings completed", "count", completed)
+	}
+
+	return completed, nil
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	"time"
+)
+
+// BookingNotifier queues the customer notifications for booking lifecycle
+// events on the channel each customer chose, and publishes the matching
+// webhook events. Confirmation emails carry the booking as a calendar file.
+// It writes to the outbox, so calling it inside a transaction
+// makes the notifications part of that transaction.
+type BookingNotifier interface {
+	// BookingReceived tells the customer a new booking awaits payment.
+	BookingReceived(ctx context.Context, booking domain.Booking) error
+	// BookingConfirmed tells the customer a paid booking is confirmed.
+	BookingConfirmed(ctx context.Context, booking domain.Booking) error
+	BookingCancelled(ctx context.Context, booking domain.Booking, reason string) error
+	PaymentReceived(ctx context.Context, booking domain.Booking, payment domain.Payment) error
+	BookingReminder(ctx context.Context, booking domain.Booking) error
+	// BookingMaintenance tells the customer that the field is closed during
+	// the booking and suggests the alternatives.
+	BookingMaintenance(ctx context.Context, booking domain.Booking, window domain.MaintenanceWindow, alternatives []domain.AlternativeSlot) error
+}
+
+// BookingWebhookData is the data of booking.* webhook events.
+type BookingWebhookData struct {
+	BookingID  uint      `json:"booking_id"`
+	Status     string    `json:"status"`
+	VenueID    uint      `json:"venue_id"`
+	FieldID    uint      `json:"field_id"`
+	ScheduleID uint      `json:"schedule_id"`
+	UserID     uint      `json:"user_id"`
+	StartTime  time.Time `json:"start_time"`
+	EndTime    time.Time `json:"end_time"`
+	TotalPrice float64   `json:"total_price"`
+	Reason     string    `json:"reason,omitempty"`
+}
+
+// PaymentWebhookData is the data of payment.* webhook events.
+type PaymentWebhookData struct {
+	PaymentID     uint               `json:"payment_id"`
+	ReceiptNumber string             `json:"receipt_number"`
+	PaymentMethod string             `json:"payment_method"`
+	Amount        float64            `json:"amount"`
+	Status        string             `json:"status"`
+	PaidAt        time.Time          `json:"paid_at"`
+	Booking       BookingWebhookData `json:"booking"`
+}
+
+type bookingNotifier struct {
+	router   NotificationRouter
+	webhooks WebhookPublisher
+	calendar CalendarService
+}
+
+func NewBookingNotifier(router NotificationRouter, webhooks WebhookPublisher, calendar CalendarService) BookingNotifier {
+	return &bookingNotifier{
+		router:   router,
+		webhooks: webhooks,
+		calendar: calendar,
+	}
+}
+
+func (n *bookingNotifier) BookingReceived(ctx context.Context, booking domain.Booking) error {
+	if err := n.router.Notify(ctx, booking.User, domain.EmailTemplateBookingReceived, newBookingEmailData(booking, "")); err != nil {
+		return err
+	}
+
+	return n.webhooks.Publish(ctx, domain.WebhookEventBookingCreated, booking.Schedule.Field.Venue.ID, newBookingWebhookData(booking, ""))
+}
+
+func (n *bookingNotifier) BookingConfirmed(ctx context.Context, booking domain.Booking) error {
+	err := n.router.Notify(ctx, booking.User, domain.EmailTemplateBookingConfirmation, newBookingEmailData(booking, ""),
+		n.calendar.BookingAttachment(booking),
+	)
+	if err != nil {
+		return err
+	}
+
+	return n.webhooks.Publish(ctx, domain.WebhookEventBookingConfirmed, booking.Schedule.Field.Venue.ID, newBookingWebhookData(booking, ""))
+}
+
+func (n *bookingNotifier) BookingCancelled(ctx context.Context, booking domain.Booking, reason string) error {
+	if err := n.router.Notify(ctx, booking.User, domain.EmailTemplateBookingCancellation, newBookingEmailData(booking, reason)); err != nil {
+		return err
+	}
+
+	return n.webhooks.Publish(ctx, domain.WebhookEventBookingCancelled, booking.Schedule.Field.Venue.ID, newBookingWebhookData(booking, reason))
+}
+
+func (n *bookingNotifier) PaymentReceived(ctx context.Context, booking domain.Booking, payment domain.Payment) error {
+	err := n.router.Notify(ctx, booking.User, domain.EmailTemplatePaymentReceipt, PaymentReceiptEmailData{
+		FullName:      booking.User.FullName,
+		ReceiptNumber: payment.ReceiptNumber(),
+		PaymentMethod: payment.PaymentMethod,
+		Amount:        payment.Amount,
+		PaidAt:        payment.CreatedAt,
+		Booking:       newBookingEmailData(booking, ""),
+	})
+	if err != nil {
+		return err
+	}
+
+	return n.webhooks.Publish(ctx, domain.WebhookEventPaymentSucceeded, booking.Schedule.Field.Venue.ID, PaymentWebhookData{
+		PaymentID:     payment.ID,
+		ReceiptNumber: payment.ReceiptNumber(),
+		PaymentMethod: payment.PaymentMethod,
+		Amount:        payment.Amount,
+		Status:        payment.Status,
+		PaidAt:        payment.CreatedAt,
+		Booking:       newBookingWebhookData(booking, ""),
+	})
+}
+
+func (n *bookingNotifier) BookingReminder(ctx context.Context, booking domain.Booking) error {
+	return n.router.Notify(ctx, booking.User, domain.EmailTemplateBookingReminder, newBookingEmailData(booking, ""))
+}
+
+func (n *bookingNotifier) BookingMaintenance(ctx context.Context, booking domain.Booking, window domain.MaintenanceWindow, alternatives []domain.AlternativeSlot) error {
+	loc := booking.Schedule.Field.Venue.Location()
+
+	data := BookingMaintenanceEmailData{
+		BookingEmailData: newBookingEmailData(booking, window.Reason),
+		MaintenanceStart: window.StartsAt.In(loc),
+		MaintenanceEnd:   window.EndsAt.In(loc),
+		Alternatives:     make([]AlternativeSlotEmailData, len(alternatives)),
+	}
+	for i, slot := range alternatives {
+		data.Alternatives[i] = AlternativeSlotEmailData{
+			FieldName: slot.Schedule.Field.Name,
+			StartTime: slot.StartsAt(),
+			EndTime:   slot.EndsAt(),
+			Price:     slot.Schedule.Price,
+		}
+	}
+
+	return n.router.Notify(ctx, booking.User, domain.EmailTemplateBookingMaintenance, data)
+}
+
+func newBookingEmailData(booking domain.Booking, reason string) BookingEmailData {
+	return BookingEmailData{
+		FullName:   booking.User.FullName,
+		BookingID:  booking.ID,
+		VenueName:  booking.Schedule.Field.Venue.Name,
+		FieldName:  booking.Schedule.Field.Name,
+		StartTime:  booking.StartsAt(),
+		EndTime:    booking.EndsAt(),
+		TotalPrice: booking.TotalPrice,
+		Reason:     reason,
+	}
+}
+
+func newBookingWebhookData(booking domain.Booking, reason string) BookingWebhookData {
+	return BookingWebhookData{
+		BookingID:  booking.ID,
+		Status:     booking.Status,
+		VenueID:    booking.Schedule.Field.Venue.ID,
+		FieldID:    booking.Schedule.Field.ID,
+		ScheduleID: booking.Schedule.ID,
+		UserID:     booking.User.ID,
+		StartTime:  booking.StartsAt(),
+		EndTime:    booking.EndsAt(),
+		TotalPrice: booking.TotalPrice,
+		Reason:     reason,
+	}
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+	"time"
+)
+
+type ReminderConfig struct {
+	// Offsets are how long before a booking starts each reminder goes out.
+	Offsets      []time.Duration
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+// BookingReminderScheduler queues reminder emails for upcoming bookings. Each
+// reminder is claimed in booking_reminders in the same transaction that queues
+// the email, so restarts and extra replicas never send it twice.
+type BookingReminderScheduler struct {
+	bookingRepo repository.BookingRepository
+	txManager   repository.TransactionManager
+	notifier    BookingNotifier
+	config      ReminderConfig
+	now         func() time.Time
+}
+
+func NewBookingReminderScheduler(bookingRepo repository.BookingRepository, txManager repository.TransactionManager, notifier BookingNotifier, config ReminderConfig) *BookingReminderScheduler {
+	return &BookingReminderScheduler{
+		bookingRepo: bookingRepo,
+		txManager:   txManager,
+		notifier:    notifier,
+		config:      config,
+		now:         time.Now,
+	}
+}
+
+// Run sends due reminders until ctx is cancelled.
+func (s *BookingReminderScheduler) Run(ctx context.Context) {
+	if len(s.config.Offsets) == 0 {
+		logger.Info("booking reminders disabled")
+		return
+	}
+
+	logger.Info("booking reminder scheduler started", "poll_interval", s.config.PollInterval.String())
+
+	ticker := time.NewTicker(s.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.SendDueReminders(ctx); err != nil {
+			logger.Error("booking reminders failed", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info("booking reminder scheduler stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendDueReminders queues every reminder that is due, for each offset, and
+// returns how many were queued.
+func (s *BookingReminderScheduler) SendDueReminders(ctx context.Context) (int, error) {
+	sent := 0
+
+	for _, offset := range s.config.Offsets {
+		for ctx.Err() == nil {
+			now := s.now()
+
+			bookings, err := s.bookingRepo.FindDueForReminder(ctx, now, offset, s.config.BatchSize)
+			if err != nil {
+				return sent, err
+			}
+
+			for _, booking := range bookings {
+				ok, err := s.sendReminder(ctx, *booking, offset, now)
+				if err != nil {
+					return sent, err
+				}
+				if ok {
+					sent++
+				}
+			}
+
+			if len(bookings) < s.config.BatchSize {
+				break
+			}
+		}
+	}
+
+	return sent, nil
+}
+
+// sendReminder returns false when another instance already claimed the reminder.
+func (s *BookingReminderScheduler) sendReminder(ctx context.Context, booking domain.Booking, offset time.Duration, now time.Time) (bool, error) {
+	claimed := false
+
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		ok, err := s.bookingRepo.MarkReminderSent(ctx, booking.ID, offset, now)
+		if err != nil || !ok {
+			return err
+		}
+
+		claimed = true
+		return s.notifier.BookingReminder(ctx, booking)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if claimed {
+		logger.Info("booking reminder queued", "booking_id", booking.ID, "offset", offset.String())
+	}
+
+	return claimed, nil
+}
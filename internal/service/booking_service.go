@@ -14,14 +14,22 @@ import (
 type BookingService interface {
 	CreateBooking(ctx context.Context, req *request.CreateBookingRequest, userID uint) (*domain.Booking, error)
 	GetMyBookings(ctx context.Context, userID uint) ([]*domain.Booking, error)
-	GetBookingByID(ctx context.Context, bookingID uint) (*domain.Booking, error)
-	CancelBooking(ctx context.Context, bookingID uint, userID uint) error
+	GetBookingByID(ctx context.Context, bookingID uint, userID uint) (*domain.Booking, error)
+	GetVenueBookings(ctx context.Context, venueID uint, userID uint) ([]*domain.Booking, error)
+	CancelBooking(ctx context.Context, bookingID uint, userID uint, reason string) error
+	RecordPayment(ctx context.Context, bookingID uint, userID uint, paymentMethod string) (*domain.Payment, error)
 }
 
 type bookingService struct {
 	bookingRepo  repository.BookingRepository
 	scheduleRepo repository.ScheduleRepository
+	windowRepo   repository.MaintenanceWindowRepository
 	userRepo     repository.UserRepository
+	paymentRepo  repository.PaymentRepository
+	authorizer   Authorizer
+	txManager    repository.TransactionManager
+	notifier     BookingNotifier
+	availability AvailabilityPublisher
 }
 
 // type CreateBookingRequest struct {
@@ -30,11 +38,17 @@ type bookingService struct {
 // 	BookingDate string
 // }
 
-func NewBookingService(bookingRepo repository.BookingRepository, scheduleRepo repository.ScheduleRepository, userRepo repository.UserRepository) BookingService {
+func NewBookingService(bookingRepo repository.BookingRepository, scheduleRepo repository.ScheduleRepository, windowRepo repository.MaintenanceWindowRepository, userRepo repository.UserRepository, paymentRepo repository.PaymentRepository, authorizer Authorizer, txManager repository.TransactionManager, notifier BookingNotifier, availability AvailabilityPublisher) BookingService {
 	return &bookingService{
 		bookingRepo:  bookingRepo,
 		scheduleRepo: scheduleRepo,
+		windowRepo:   windowRepo,
 		userRepo:     userRepo,
+		paymentRepo:  paymentRepo,
+		authorizer:   authorizer,
+		txManager:    txManager,
+		notifier:     notifier,
+		availability: availability,
 	}
 }
 
@@ -70,6 +84,21 @@ func (s *bookingService) CreateBooking(ctx context.Context, req *request.CreateB
 		return nil, domain.ErrScheduleNotFound
 	}
 
+	if !schedule.Field.IsActive() {
+		return nil, domain.ErrFieldInactive
+	}
+
+	slotStart, slotEnd := domain.ScheduleSlotAt(schedule, bookDate)
+	windows, err := s.windowRepo.FindOverlapping(ctx, []uint{schedule.Field.ID}, slotStart, slotEnd)
+	if err != nil {
+		logger.Error("failed to check field maintenance", err.Error())
+		return nil, err
+	}
+
+	if len(windows) > 0 {
+		return nil, domain.ErrFieldInMaintenance
+	}
+
 	bookingDayOfWeek := int(bookDate.Weekday())
 	if bookingDayOfWeek == 0 {
 		bookingDayOfWeek = 7
@@ -94,19 +123,28 @@ func (s *bookingService) CreateBooking(ctx context.Context, req *request.CreateB
 		User:        user,
 		Schedule:    schedule,
 		BookingDate: bookDate,
-		Status:      "PENDING",
+		Status:      domain.BookingStatusPending,
 		TotalPrice:  schedule.Price,
 	}
 
-	if err := s.bookingRepo.Create(ctx, newBooking); err != nil {
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.bookingRepo.Create(ctx, newBooking); err != nil {
+			return fmt.Errorf("failed to create booking: %w", err)
+		}
+
+		return s.notifier.BookingReceived(ctx, *newBooking)
+	})
+	if err != nil {
 		logger.Error("failed to create booking", err.Error())
-		return nil, fmt.Errorf("failed to create booking: %w", err)
+		return nil, err
 	}
 
 	logger.Info("booking created successfully", map[string]any{
 		"booking_id": newBooking.ID,
 	})
 
+	s.availability.Publish(domain.NewSlotUpdate(domain.SlotUpdateBooked, *newBooking, time.Now()))
+
 	return newBooking, nil
 }
 
@@ -128,7 +166,7 @@ func (s *bookingService) GetMyBookings(ctx context.Context, userID uint) ([]*dom
 	return booking, nil
 }
 
-func (s *bookingService) GetBookingByID(ctx context.Context, bookingID uint) (*domain.Booking, error) {
+func (s *bookingService) GetBookingByID(ctx context.Context, bookingID uint, userID uint) (*domain.Booking, error) {
 	if bookingID == 0 {
 		return nil, errors.New("invalid booking id")
 	}
@@ -143,10 +181,45 @@ func (s *bookingService) GetBookingByID(ctx context.Context, bookingID uint) (*d
 		return nil, domain.ErrBookingNotFound
 	}
 
+	if booking.User.ID != userID {
+		readAny, err := s.authorizer.HasPermission(ctx, userID, domain.PermBookingReadAny)
+		if err != nil {
+			return nil, err
+		}
+
+		if !readAny {
+			if err := s.authorizer.AuthorizeVenue(ctx, userID, booking.Schedule.Field.Venue.ID, domain.VenueRoleStaff); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	return &booking, nil
 }
 
-func (s *bookingService) CancelBooking(ctx context.Context, bookingID uint, userID uint) error {
+func (s *bookingService) GetVenueBookings(ctx context.Context, venueID uint, userID uint) ([]*domain.Booking, error) {
+	if venueID == 0 {
+		return nil, errors.New("invalid venue id")
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	if err := s.authorizer.AuthorizeVenue(ctx, userID, venueID, domain.VenueRoleStaff); err != nil {
+		return nil, err
+	}
+
+	bookings, err := s.bookingRepo.FindByVenueID(ctx, venueID)
+	if err != nil {
+		logger.Error("failed to get venue bookings", err.Error())
+		return nil, err
+	}
+
+	return bookings, nil
+}
+
+func (s *bookingService) CancelBooking(ctx context.Context, bookingID uint, userID uint, reason string) error {
 	if bookingID == 0 || userID == 0 {
 		return errors.New("invalid booking or user id")
 	}
@@ -160,22 +233,101 @@ func (s *bookingService) CancelBooking(ctx context.Context, bookingID uint, user
 		return domain.ErrBookingNotFound
 	}
 
+	// Besides the customer, venue managers may cancel bookings at their venue.
 	if booking.User.ID != userID {
-		return domain.ErrForbidden
+		if err := s.authorizer.AuthorizeVenue(ctx, userID, booking.Schedule.Field.Venue.ID, domain.VenueRoleManager); err != nil {
+			return err
+		}
 	}
 
-	if booking.Status == "CANCELLED" || booking.Status == "COMPLETED" {
-		return errors.New("cannot cancel booking with status: " + booking.Status)
+	if booking.Status == domain.BookingStatusCancelled || booking.Status == domain.BookingStatusCheckedIn || booking.Status == domain.BookingStatusCompleted {
+		return fmt.Errorf("%w: %s", domain.ErrBookingNotCancellable, booking.Status)
 	}
 
-	if err := s.bookingRepo.CancelBooking(ctx, bookingID); err != nil {
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.bookingRepo.CancelBooking(ctx, bookingID); err != nil {
+			return fmt.Errorf("failed to cancel booking: %w", err)
+		}
+
+		booking.Status = domain.BookingStatusCancelled
+		return s.notifier.BookingCancelled(ctx, booking, reason)
+	})
+	if err != nil {
 		logger.Error("failed to cancel booking", err.Error())
-		return fmt.Errorf("failed to cancel booking: %w", err)
+		return err
 	}
 
 	logger.Info("booking cancelled", map[string]any{
 		"booking_id": bookingID,
 	})
 
+	s.availability.Publish(domain.NewSlotUpdate(domain.SlotUpdateReleased, booking, time.Now()))
+
 	return nil
 }
+
+// RecordPayment records a successful payment taken by venue staff and confirms
+// the booking.
+func (s *bookingService) RecordPayment(ctx context.Context, bookingID uint, userID uint, paymentMethod string) (*domain.Payment, error) {
+	if bookingID == 0 || userID == 0 {
+		return nil, errors.New("invalid booking or user id")
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	booking, err := s.bookingRepo.FindByID(ctx, bookingID)
+	if err != nil {
+		return nil, domain.ErrBookingNotFound
+	}
+
+	if err := s.authorizer.AuthorizeVenue(ctx, userID, booking.Schedule.Field.Venue.ID, domain.VenueRoleStaff); err != nil {
+		return nil, err
+	}
+
+	if booking.Status != domain.BookingStatusPending {
+		return nil, domain.ErrBookingNotPayable
+	}
+
+	payment := &domain.Payment{
+		BookingID:     booking.ID,
+		PaymentMethod: paymentMethod,
+		Amount:        booking.TotalPrice,
+		Status:        domain.PaymentStatusSuccess,
+	}
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		// The status is checked again as part of the update, so a payment
+		// recorded at the same time by another desk cannot be taken twice.
+		confirmed, err := s.bookingRepo.Confirm(ctx, booking.ID, time.Now())
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return domain.ErrBookingNotPayable
+		}
+
+		if err := s.paymentRepo.Create(ctx, payment); err != nil {
+			return err
+		}
+
+		booking.Status = domain.BookingStatusConfirmed
+		if err := s.notifier.BookingConfirmed(ctx, booking); err != nil {
+			return err
+		}
+
+		return s.notifier.PaymentReceived(ctx, booking, *payment)
+	})
+	if err != nil {
+		logger.Error("failed to record payment", err.Error())
+		return nil, err
+	}
+
+	logger.Info("payment recorded", map[string]any{
+		"booking_id": booking.ID,
+		"payment_id": payment.ID,
+	})
+
+	return payment, nil
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+type CalendarConfig struct {
+	// ProductID names the app in the PRODID of generated calendars.
+	ProductID string
+	// BaseURL is the public address of the API. Subscription URLs point at it
+	// and its host makes event UIDs globally unique.
+	BaseURL string
+	// FeedLookback is how long past bookings stay in subscription feeds.
+	FeedLookback time.Duration
+}
+
+// CalendarService turns bookings into iCalendar files, either one booking at a
+// time or as a private feed of all of a user's bookings that calendar apps can
+// subscribe to. Feed URLs carry a token instead of a login, since calendar apps
+// cannot send one.
+type CalendarService interface {
+	BookingCalendar(booking domain.Booking) []byte
+	// BookingAttachment is BookingCalendar as an email attachment.
+	BookingAttachment(booking domain.Booking) domain.EmailAttachment
+	// CreateFeed returns a new subscription URL for the user. Any earlier URL
+	// stops working.
+	CreateFeed(ctx context.Context, userID uint) (string, error)
+	GetFeed(ctx context.Context, token string) ([]byte, error)
+}
+
+type calendarService struct {
+	calendarRepo repository.CalendarRepository
+	bookingRepo  repository.BookingRepository
+	config       CalendarConfig
+	uidDomain    string
+	now          func() time.Time
+}
+
+func NewCalendarService(calendarRepo repository.CalendarRepository, bookingRepo repository.BookingRepository, config CalendarConfig) CalendarService {
+	uidDomain := "futsal-booking"
+	if u, err := url.Parse(config.BaseURL); err == nil && u.Hostname() != "" {
+		uidDomain = u.Hostname()
+	}
+
+	return &calendarService{
+		calendarRepo: calendarRepo,
+		bookingRepo:  bookingRepo,
+		config:       config,
+		uidDomain:    uidDomain,
+		now:          time.Now,
+	}
+}
+
+func (s *calendarService) BookingCalendar(booking domain.Booking) []byte {
+	return EncodeCalendar(s.config.ProductID, "", []domain.CalendarEvent{s.bookingEvent(booking)}, s.now())
+}
+
+func (s *calendarService) BookingAttachment(booking domain.Booking) domain.EmailAttachment {
+	return domain.EmailAttachment{
+		Filename:    fmt.Sprintf("booking-%d.ics", booking.ID),
+		ContentType: "text/calendar; charset=utf-8; method=PUBLISH",
+		Content:     s.BookingCalendar(booking),
+	}
+}
+
+func (s *calendarService) CreateFeed(ctx context.Context, userID uint) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", fmt.Errorf("context error: %w", err)
+	}
+
+	token, err := generateCalendarToken()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate calendar token: %w", err)
+	}
+
+	feed := &domain.CalendarFeed{
+		UserID:    userID,
+		TokenHash: hashCalendarToken(token),
+		CreatedAt: s.now(),
+	}
+
+	if err := s.calendarRepo.SaveFeed(ctx, feed); err != nil {
+		logger.Error("failed to save calendar feed", err, "user_id", userID)
+		return "", err
+	}
+
+	logger.Info("calendar feed created", "user_id", userID)
+
+	return strings.TrimRight(s.config.BaseURL, "/") + "/api/v1/users/me/calendar.ics?token=" + url.QueryEscape(token), nil
+}
+
+func (s *calendarService) GetFeed(ctx context.Context, token string) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	if token == "" {
+		return nil, domain.ErrInvalidCalendarToken
+	}
+
+	feed, err := s.calendarRepo.FindFeedByTokenHash(ctx, hashCalendarToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	bookings, err := s.bookingRepo.FindByUserID(ctx, feed.UserID)
+	if err != nil {
+		logger.Error("failed to get bookings for calendar feed", err, "user_id", feed.UserID)
+		return nil, err
+	}
+
+	now := s.now()
+	since := now.Add(-s.config.FeedLookback)
+
+	events := make([]domain.CalendarEvent, 0, len(bookings))
+	for _, booking := range bookings {
+		if booking.EndsAtVenue().Before(since) {
+			continue
+		}
+		events = append(events, s.bookingEvent(*booking))
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Start.Before(events[j].Start)
+	})
+
+	return EncodeCalendar(s.config.ProductID, s.config.ProductID+" bookings", events, now), nil
+}
+
+func (s *calendarService) bookingEvent(booking domain.Booking) domain.CalendarEvent {
+	field := booking.Schedule.Field
+	venue := field.Venue
+
+	return domain.CalendarEvent{
+		UID:          fmt.Sprintf("booking-%d@%s", booking.ID, s.uidDomain),
+		Summary:      fmt.Sprintf("Futsal: %s, %s", field.Name, venue.Name),
+		Description:  fmt.Sprintf("Booking #%d (%s)", booking.ID, booking.Status),
+		Location:     venue.FullAddress(),
+		Status:       domain.CalendarStatusOf(booking.Status),
+		Start:        booking.StartsAtVenue(),
+		End:          booking.EndsAtVenue(),
+		Created:      booking.CreatedAt,
+		LastModified: booking.UpdatedAt,
+	}
+}
+
+func generateCalendarToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return "cal_" + hex.EncodeToString(b), nil
+}
+
+// hashCalendarToken is what is stored for a feed token. The token is random
+// and long, so a plain hash is enough to make a leaked table useless.
+func hashCalendarToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,615 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// maxCatalogFileBytes bounds catalog uploads, which are read into memory.
+const maxCatalogFileBytes = 5 << 20
+
+const catalogSheet = "catalog"
+
+// CatalogService imports and exports venues, fields and schedules in bulk as
+// CSV or XLSX files with the columns of domain.CatalogColumns.
+type CatalogService interface {
+	// Import reads a catalog file, taking its format from the file name, and
+	// applies all of it or none of it. Every row is checked first and the
+	// invalid ones are reported together in a *domain.CatalogImportError. A
+	// dry run does the same checks but keeps none of the changes.
+	Import(ctx context.Context, filename string, r io.Reader, userID uint, dryRun bool) (*domain.CatalogImport, error)
+	// Export returns every venue, field and schedule as a file in format, one
+	// of domain.CatalogFormatCSV and domain.CatalogFormatXLSX.
+	Export(ctx context.Context, format string) ([]byte, error)
+}
+
+type catalogService struct {
+	catalogRepo   repository.CatalogRepository
+	fieldTypeRepo repository.FieldTypeRepository
+	txManager     repository.TransactionManager
+}
+
+func NewCatalogService(catalogRepo repository.CatalogRepository, fieldTypeRepo repository.FieldTypeRepository, txManager repository.TransactionManager) CatalogService {
+	return &catalogService{
+		catalogRepo:   catalogRepo,
+		fieldTypeRepo: fieldTypeRepo,
+		txManager:     txManager,
+	}
+}
+
+// errCatalogDryRun rolls back the transaction of a dry run.
+var errCatalogDryRun = errors.New("catalog dry run")
+
+func (s *catalogService) Import(ctx context.Context, filename string, r io.Reader, userID uint, dryRun bool) (*domain.CatalogImport, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	format, err := catalogFormat(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r, maxCatalogFileBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read catalog file: %w", err)
+	}
+	if len(data) > maxCatalogFileBytes {
+		return nil, domain.ErrCatalogTooLarge
+	}
+
+	records, err := readCatalogRecords(format, data)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldTypes, err := s.fieldTypeRepo.FindAll(ctx)
+	if err != nil {
+		logger.Error("failed to find field types", err)
+		return nil, err
+	}
+
+	rows, err := parseCatalog(records, fieldTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	var result domain.CatalogImport
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if result, err = s.catalogRepo.Import(ctx, rows, userID); err != nil {
+			return err
+		}
+		if dryRun {
+			return errCatalogDryRun
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errCatalogDryRun) {
+		if !errors.Is(err, domain.ErrInvalidCatalog) {
+			logger.Error("failed to import catalog", err, "user_id", userID)
+		}
+		return nil, err
+	}
+	result.DryRun = dryRun
+
+	logger.Info("catalog imported", "user_id", userID, "dry_run", dryRun,
+		"venues_created", result.VenuesCreated, "venues_updated", result.VenuesUpdated,
+		"fields_created", result.FieldsCreated, "fields_updated", result.FieldsUpdated,
+		"schedules_created", result.SchedulesCreated, "schedules_updated", result.SchedulesUpdated)
+
+	return &result, nil
+}
+
+func (s *catalogService) Export(ctx context.Context, format string) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	if format != domain.CatalogFormatCSV && format != domain.CatalogFormatXLSX {
+		return nil, domain.ErrUnsupportedCatalog
+	}
+
+	rows, err := s.catalogRepo.Export(ctx)
+	if err != nil {
+		logger.Error("failed to export catalog", err)
+		return nil, err
+	}
+
+	records := make([][]string, 0, len(rows)+1)
+	records = append(records, domain.CatalogColumns)
+	for _, row := range rows {
+		records = append(records, catalogRecord(row))
+	}
+
+	if format == domain.CatalogFormatXLSX {
+		return writeCatalogXLSX(records)
+	}
+
+	for _, record := range records[1:] {
+		for i := range record {
+			record[i] = escapeCatalogCell(record[i])
+		}
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.WriteAll(records); err != nil {
+		return nil, fmt.Errorf("failed to write catalog: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func catalogFormat(filename string) (string, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv":
+		return domain.CatalogFormatCSV, nil
+	case ".xlsx":
+		return domain.CatalogFormatXLSX, nil
+	}
+
+	return "", domain.ErrUnsupportedCatalog
+}
+
+// readCatalogRecords returns the lines of a CSV file or of the first sheet of
+// an XLSX workbook, header included.
+func readCatalogRecords(format string, data []byte) ([][]string, error) {
+	if format == domain.CatalogFormatXLSX {
+		book, err := excelize.OpenReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", domain.ErrUnsupportedCatalog, err)
+		}
+		defer book.Close()
+
+		records, err := book.GetRows(book.GetSheetName(0))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", domain.ErrUnsupportedCatalog, err)
+		}
+
+		return records, nil
+	}
+
+	// Spreadsheets often save CSV files with a byte order mark.
+	r := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	r.FieldsPerRecord = -1
+
+	var records [][]string
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				return nil, &domain.CatalogImportError{Rows: []domain.CatalogRowError{
+					{Row: parseErr.Line, Message: parseErr.Err.Error()},
+				}}
+			}
+			return nil, fmt.Errorf("failed to read catalog file: %w", err)
+		}
+
+		// The reader skips blank lines; keep them so rows are numbered by
+		// line, as in the XLSX sheet.
+		line, _ := r.FieldPos(0)
+		for len(records) < line-1 {
+			records = append(records, nil)
+		}
+		for i := range record {
+			record[i] = unescapeCatalogCell(record[i])
+		}
+		records = append(records, record)
+	}
+}
+
+func writeCatalogXLSX(records [][]string) ([]byte, error) {
+	book := excelize.NewFile()
+	defer book.Close()
+
+	if err := book.SetSheetName(book.GetSheetName(0), catalogSheet); err != nil {
+		return nil, fmt.Errorf("failed to write catalog: %w", err)
+	}
+
+	for i, record := range records {
+		cell, err := excelize.CoordinatesToCellName(1, i+1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write catalog: %w", err)
+		}
+		if err := book.SetSheetRow(catalogSheet, cell, &record); err != nil {
+			return nil, fmt.Errorf("failed to write catalog: %w", err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := book.Write(&buf); err != nil {
+		return nil, fmt.Errorf("failed to write catalog: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// catalogFormulaPrefixes start a formula in spreadsheet programs when they
+// lead a cell.
+const catalogFormulaPrefixes = "=+-@\t\r"
+
+// escapeCatalogCell keeps spreadsheet programs from running a venue or field
+// name in a CSV file as a formula by quoting it. CSV imports take the quote off
+// again. XLSX cells are written as text and need no quoting.
+func escapeCatalogCell(value string) string {
+	if value != "" && strings.ContainsRune(catalogFormulaPrefixes, rune(value[0])) {
+		return "'" + value
+	}
+
+	return value
+}
+
+func unescapeCatalogCell(value string) string {
+	if len(value) > 1 && value[0] == '\'' && strings.ContainsRune(catalogFormulaPrefixes, rune(value[1])) {
+		return value[1:]
+	}
+
+	return value
+}
+
+// catalogID writes a zero ID, which the row does not have, as an empty cell.
+func catalogID(id uint) string {
+	if id == 0 {
+		return ""
+	}
+
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+func catalogRecord(row domain.CatalogRow) []string {
+	record := []string{catalogID(row.Venue.ID), row.Venue.Name, row.Venue.Address, row.Venue.City, row.Venue.Timezone}
+
+	if row.Field == nil {
+		return append(record, make([]string, len(domain.CatalogColumns)-len(record))...)
+	}
+	record = append(record,
+		catalogID(row.Field.ID),
+		row.Field.Name,
+		row.Field.Type,
+		strconv.FormatBool(row.Field.Indoor),
+		strconv.FormatBool(row.Field.HasLighting),
+		strconv.Itoa(row.Field.MaxPlayers),
+	)
+
+	if row.Schedule == nil {
+		return append(record, make([]string, len(domain.CatalogColumns)-len(record))...)
+	}
+
+	return append(record,
+		catalogID(row.Schedule.ID),
+		strconv.Itoa(row.Schedule.DayOfWeek),
+		row.Schedule.StartTime.Format("15:04"),
+		row.Schedule.EndTime.Format("15:04"),
+		strconv.FormatFloat(row.Schedule.Price, 'f', -1, 64),
+	)
+}
+
+// parseCatalog checks every line of a catalog file and returns its rows, or a
+// *domain.CatalogImportError listing all invalid lines. Lines describing the
+// same venue or field must agree with each other, IDs included, and an ID
+// may only be used for one venue, field or schedule.
+func parseCatalog(records [][]string, fieldTypes []domain.FieldType) ([]domain.CatalogRow, error) {
+	if len(records) == 0 {
+		return nil, &domain.CatalogImportError{Rows: []domain.CatalogRowError{
+			{Row: 1, Message: "missing header row"},
+		}}
+	}
+
+	columns, errs := catalogHeader(records[0])
+	if len(errs) > 0 {
+		return nil, &domain.CatalogImportError{Rows: errs}
+	}
+
+	knownTypes := make(map[string]bool, len(fieldTypes))
+	for _, t := range fieldTypes {
+		knownTypes[t.Code] = true
+	}
+
+	type fieldKey struct{ venue, field string }
+	type scheduleKey struct {
+		fieldKey
+		day   int
+		start string
+	}
+	venueRows := make(map[string]domain.CatalogRow)
+	fieldRows := make(map[fieldKey]domain.CatalogRow)
+	scheduleRows := make(map[scheduleKey]int)
+	venueIDs := make(map[uint]domain.CatalogRow)
+	fieldIDs := make(map[uint]domain.CatalogRow)
+	scheduleIDs := make(map[uint]int)
+
+	var rows []domain.CatalogRow
+	for i, record := range records[1:] {
+		p := catalogRowParser{row: i + 2, cells: make(map[string]string, len(columns))}
+		blank := true
+		for j, cell := range record {
+			if j < len(columns) {
+				p.cells[columns[j]] = strings.TrimSpace(cell)
+				blank = blank && p.cells[columns[j]] == ""
+			}
+		}
+		if blank {
+			continue
+		}
+
+		row := p.parse(knownTypes)
+		if len(p.errs) > 0 {
+			errs = append(errs, p.errs...)
+			continue
+		}
+
+		if first, ok := venueRows[row.Venue.Name]; !ok {
+			venueRows[row.Venue.Name] = row
+		} else if first.Venue.ID != row.Venue.ID || first.Venue.Address != row.Venue.Address || first.Venue.City != row.Venue.City || first.Venue.Timezone != row.Venue.Timezone {
+			errs = append(errs, domain.CatalogRowError{Row: row.Row, Message: fmt.Sprintf("venue details differ from row %d", first.Row)})
+			continue
+		}
+		if row.Venue.ID != 0 {
+			if first, ok := venueIDs[row.Venue.ID]; !ok {
+				venueIDs[row.Venue.ID] = row
+			} else if first.Venue.Name != row.Venue.Name {
+				errs = append(errs, domain.CatalogRowError{Row: row.Row, Column: "venue_id", Message: fmt.Sprintf("same venue as row %d under another name", first.Row)})
+				continue
+			}
+		}
+
+		if row.Field != nil {
+			key := fieldKey{row.Venue.Name, row.Field.Name}
+			if first, ok := fieldRows[key]; !ok {
+				fieldRows[key] = row
+			} else if first.Field.ID != row.Field.ID || first.Field.Type != row.Field.Type || first.Field.Indoor != row.Field.Indoor ||
+				first.Field.HasLighting != row.Field.HasLighting || first.Field.MaxPlayers != row.Field.MaxPlayers {
+				errs = append(errs, domain.CatalogRowError{Row: row.Row, Message: fmt.Sprintf("field details differ from row %d", first.Row)})
+				continue
+			}
+			if row.Field.ID != 0 {
+				if first, ok := fieldIDs[row.Field.ID]; !ok {
+					fieldIDs[row.Field.ID] = row
+				} else if first.Venue.Name != row.Venue.Name || first.Field.Name != row.Field.Name {
+					errs = append(errs, domain.CatalogRowError{Row: row.Row, Column: "field_id", Message: fmt.Sprintf("same field as row %d under another name", first.Row)})
+					continue
+				}
+			}
+
+			if row.Schedule != nil {
+				key := scheduleKey{key, row.Schedule.DayOfWeek, row.Schedule.StartTime.Format("15:04:05")}
+				if first, ok := scheduleRows[key]; ok {
+					errs = append(errs, domain.CatalogRowError{Row: row.Row, Column: "start_time", Message: fmt.Sprintf("same schedule as row %d", first)})
+					continue
+				}
+				scheduleRows[key] = row.Row
+
+				if id := row.Schedule.ID; id != 0 {
+					if first, ok := scheduleIDs[id]; ok {
+						errs = append(errs, domain.CatalogRowError{Row: row.Row, Column: "schedule_id", Message: fmt.Sprintf("same schedule as row %d", first)})
+						continue
+					}
+					scheduleIDs[id] = row.Row
+				}
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	if len(errs) > 0 {
+		return nil, &domain.CatalogImportError{Rows: errs}
+	}
+
+	return rows, nil
+}
+
+// catalogHeader maps the header cells to catalog columns. Columns may come in
+// any order, but all of them but the ID columns must be there and no others.
+func catalogHeader(header []string) ([]string, []domain.CatalogRowError) {
+	known := make(map[string]bool, len(domain.CatalogColumns))
+	for _, column := range domain.CatalogColumns {
+		known[column] = true
+	}
+
+	var errs []domain.CatalogRowError
+	columns := make([]string, len(header))
+	seen := make(map[string]bool, len(header))
+	for i, cell := range header {
+		column := strings.ToLower(strings.TrimSpace(strings.TrimPrefix(cell, "\ufeff")))
+		switch {
+		case !known[column]:
+			errs = append(errs, domain.CatalogRowError{Row: 1, Column: cell, Message: "unknown column"})
+		case seen[column]:
+			errs = append(errs, domain.CatalogRowError{Row: 1, Column: column, Message: "duplicate column"})
+		}
+		columns[i] = column
+		seen[column] = true
+	}
+
+	optional := make(map[string]bool, len(domain.CatalogIDColumns))
+	for _, column := range domain.CatalogIDColumns {
+		optional[column] = true
+	}
+
+	for _, column := range domain.CatalogColumns {
+		if !seen[column] && !optional[column] {
+			errs = append(errs, domain.CatalogRowError{Row: 1, Column: column, Message: "missing column"})
+		}
+	}
+
+	return columns, errs
+}
+
+// catalogRowParser collects the problems of one line of a catalog file.
+type catalogRowParser struct {
+	row   int
+	cells map[string]string
+	errs  []domain.CatalogRowError
+}
+
+func (p *catalogRowParser) fail(column, message string) {
+	p.errs = append(p.errs, domain.CatalogRowError{Row: p.row, Column: column, Message: message})
+}
+
+// required returns the cell of column, reporting it when it is empty or
+// longer than a non-zero maxLen.
+func (p *catalogRowParser) required(column string, maxLen int) string {
+	value := p.cells[column]
+	switch {
+	case value == "":
+		p.fail(column, "is required")
+	case maxLen > 0 && len([]rune(value)) > maxLen:
+		p.fail(column, fmt.Sprintf("must be at most %d characters", maxLen))
+	}
+
+	return value
+}
+
+// bool reads true/false, yes/no or 1/0 as spreadsheets write them. An empty
+// cell is false.
+func (p *catalogRowParser) bool(column string) bool {
+	switch strings.ToLower(p.cells[column]) {
+	case "", "false", "no", "0":
+		return false
+	case "true", "yes", "1":
+		return true
+	}
+
+	p.fail(column, "must be true or false")
+	return false
+}
+
+// id reads an ID cell. An empty cell is zero, for rows matched by name.
+func (p *catalogRowParser) id(column string) uint {
+	value := p.cells[column]
+	if value == "" {
+		return 0
+	}
+
+	id, err := strconv.ParseUint(value, 10, 32)
+	if err != nil || id == 0 {
+		p.fail(column, "must be a positive whole number")
+		return 0
+	}
+
+	return uint(id)
+}
+
+func (p *catalogRowParser) time(column string) (time.Time, bool) {
+	value, err := parseTime(p.cells[column])
+	if err != nil {
+		p.fail(column, "must be a time as HH:MM")
+		return time.Time{}, false
+	}
+
+	return value, true
+}
+
+// mustBeEmpty reports the given columns that have a value, for lines that
+// leave out the field or schedule they belong to.
+func (p *catalogRowParser) mustBeEmpty(columns []string, reason string) {
+	for _, column := range columns {
+		if p.cells[column] != "" {
+			p.fail(column, reason)
+		}
+	}
+}
+
+var (
+	catalogFieldColumns    = []string{"field_id", "field_type", "field_indoor", "field_lighting", "field_max_players"}
+	catalogScheduleColumns = []string{"schedule_id", "day_of_week", "start_time", "end_time", "price"}
+)
+
+func (p *catalogRowParser) parse(knownTypes map[string]bool) domain.CatalogRow {
+	row := domain.CatalogRow{
+		Row: p.row,
+		Venue: domain.Venue{
+			ID:       p.id("venue_id"),
+			Name:     p.required("venue_name", 100),
+			Address:  p.required("venue_address", 0),
+			City:     p.required("venue_city", 100),
+			Timezone: p.cells["venue_timezone"],
+		},
+	}
+
+	if row.Venue.Timezone == "" {
+		row.Venue.Timezone = domain.DefaultVenueTimezone
+	} else if _, err := time.LoadLocation(row.Venue.Timezone); err != nil {
+		p.fail("venue_timezone", domain.ErrInvalidTimezone.Error())
+	}
+
+	if p.cells["field_name"] == "" {
+		p.mustBeEmpty(catalogFieldColumns, "must be empty without a field_name")
+		p.mustBeEmpty(catalogScheduleColumns, "must be empty without a field_name")
+		return row
+	}
+
+	field := domain.Field{
+		ID:          p.id("field_id"),
+		Name:        p.required("field_name", 100),
+		Type:        strings.ToUpper(p.required("field_type", 20)),
+		Indoor:      p.bool("field_indoor"),
+		HasLighting: p.bool("field_lighting"),
+		MaxPlayers:  domain.DefaultFieldMaxPlayers,
+		Status:      domain.FieldStatusActive,
+	}
+	if field.Type != "" && !knownTypes[field.Type] {
+		p.fail("field_type", domain.ErrFieldTypeNotFound.Error())
+	}
+	if value := p.cells["field_max_players"]; value != "" {
+		maxPlayers, err := strconv.Atoi(value)
+		if err != nil {
+			p.fail("field_max_players", "must be a whole number")
+		}
+		field.MaxPlayers = maxPlayers
+	}
+	if err := field.ValidateDetails(); err != nil {
+		p.fail("field_max_players", "must be between 2 and 50")
+	}
+	row.Field = &field
+
+	scheduled := false
+	for _, column := range catalogScheduleColumns {
+		scheduled = scheduled || p.cells[column] != ""
+	}
+	if !scheduled {
+		return row
+	}
+
+	schedule := domain.Schedule{ID: p.id("schedule_id")}
+	var startOK, endOK bool
+	schedule.StartTime, startOK = p.time("start_time")
+	schedule.EndTime, endOK = p.time("end_time")
+	if startOK && endOK && !schedule.EndTime.After(schedule.StartTime) {
+		p.fail("end_time", "must be after start_time")
+	}
+
+	day, err := strconv.Atoi(p.cells["day_of_week"])
+	if err != nil || day < 1 || day > 7 {
+		p.fail("day_of_week", domain.ErrInvalidDayOfWeek.Error())
+	}
+	schedule.DayOfWeek = day
+
+	price, err := strconv.ParseFloat(p.cells["price"], 64)
+	if err != nil || price <= 0 || price >= 1e8 {
+		p.fail("price", domain.ErrInvalidPrice.Error())
+	}
+	schedule.Price = price
+	row.Schedule = &schedule
+
+	return row
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/skip2/go-qrcode"
+)
+
+const (
+	checkInDateLayout = "20060102"
+	checkInQRSize     = 256
+)
+
+// CheckInService lets front-desk staff confirm that the person at the counter
+// owns a booking. Customers show a QR code holding a check-in token signed by
+// the server; staff scan it and the booking moves to CHECKED_IN.
+type CheckInService interface {
+	// QRCode returns the check-in token of the caller's confirmed booking as a
+	// PNG image.
+	QRCode(ctx context.Context, bookingID, userID uint) ([]byte, error)
+	// CheckIn verifies a scanned token for a booking at venueID today. The
+	// caller must be staff of that venue.
+	CheckIn(ctx context.Context, token string, venueID, staffID uint) (*domain.Booking, error)
+}
+
+type checkInService struct {
+	bookingRepo repository.BookingRepository
+	authorizer  Authorizer
+	signingKey  []byte
+	now         func() time.Time
+}
+
+func NewCheckInService(bookingRepo repository.BookingRepository, authorizer Authorizer, signingKey string) CheckInService {
+	return &checkInService{
+		bookingRepo: bookingRepo,
+		authorizer:  authorizer,
+		signingKey:  []byte(signingKey),
+		now:         time.Now,
+	}
+}
+
+func (s *checkInService) QRCode(ctx context.Context, bookingID, userID uint) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	booking, err := s.bookingRepo.FindByID(ctx, bookingID)
+	if err != nil {
+		return nil, domain.ErrBookingNotFound
+	}
+
+	// Only the customer gets the code; anyone holding it can check in.
+	if booking.User.ID != userID {
+		return nil, domain.ErrForbidden
+	}
+
+	if err := checkInStatusError(booking.Status); err != nil {
+		return nil, err
+	}
+
+	png, err := qrcode.Encode(s.token(booking), qrcode.Medium, checkInQRSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render check-in qr code: %w", err)
+	}
+
+	return png, nil
+}
+
+func (s *checkInService) CheckIn(ctx context.Context, token string, venueID, staffID uint) (*domain.Booking, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	if err := s.authorizer.AuthorizeVenue(ctx, staffID, venueID, domain.VenueRoleStaff); err != nil {
+		return nil, err
+	}
+
+	bookingID, date, err := s.verifyToken(token)
+	if err != nil {
+		logger.Warn("invalid check-in token", "venue_id", venueID, "staff_id", staffID)
+		return nil, err
+	}
+
+	booking, err := s.bookingRepo.FindByID(ctx, bookingID)
+	if err != nil {
+		return nil, domain.ErrInvalidCheckInToken
+	}
+
+	// A token for a booking that has since moved to another date is stale.
+	if booking.BookingDate.Format(checkInDateLayout) != date {
+		return nil, domain.ErrInvalidCheckInToken
+	}
+
+	venue := booking.Schedule.Field.Venue
+	if venue.ID != venueID {
+		return nil, domain.ErrCheckInWrongVenue
+	}
+
+	if err := checkInStatusError(booking.Status); err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	if now.In(venue.Location()).Format(checkInDateLayout) != date {
+		return nil, domain.ErrCheckInWrongDate
+	}
+
+	ok, err := s.bookingRepo.CheckIn(ctx, booking.ID, now)
+	if err != nil {
+		logger.Error("failed to check in booking", err, "booking_id", booking.ID)
+		return nil, err
+	}
+	if !ok {
+		return nil, domain.ErrAlreadyCheckedIn
+	}
+
+	booking.Status = domain.BookingStatusCheckedIn
+	booking.CheckedInAt = &now
+
+	logger.Info("booking checked in", "booking_id", booking.ID, "venue_id", venueID, "staff_id", staffID)
+
+	return &booking, nil
+}
+
+func checkInStatusError(status string) error {
+	switch status {
+	case domain.BookingStatusConfirmed:
+		return nil
+	case domain.BookingStatusCheckedIn:
+		return domain.ErrAlreadyCheckedIn
+	}
+
+	return domain.ErrBookingNotConfirmed
+}
+
+// token is "<booking id>.<booking date>.<signature>". The date is part of the
+// signed message so a code stops working if the booking is moved.
+func (s *checkInService) token(booking domain.Booking) string {
+	id := strconv.FormatUint(uint64(booking.ID), 10)
+	date := booking.BookingDate.Format(checkInDateLayout)
+
+	return id + "." + date + "." + s.sign(id, date)
+}
+
+func (s *checkInService) verifyToken(token string) (uint, string, error) {
+	parts := strings.Split(strings.TrimSpace(token), ".")
+	if len(parts) != 3 {
+		return 0, "", domain.ErrInvalidCheckInToken
+	}
+
+	id, date, signature := parts[0], parts[1], parts[2]
+	if !hmac.Equal([]byte(signature), []byte(s.sign(id, date))) {
+		return 0, "", domain.ErrInvalidCheckInToken
+	}
+
+	bookingID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil || bookingID == 0 {
+		return 0, "", domain.ErrInvalidCheckInToken
+	}
+
+	return uint(bookingID), date, nil
+}
+
+func (s *checkInService) sign(id, date string) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte("check-in:" + id + ":" + date))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
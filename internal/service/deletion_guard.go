@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+	"time"
+)
+
+// DeletionGuard keeps venues, fields and schedules with upcoming bookings from
+// being deleted by accident. A forced deletion cancels those bookings and
+// tells the customers, in the same transaction as the deletion, then tells
+// availability streams that their slots are gone.
+type DeletionGuard interface {
+	// Preview adds the upcoming bookings in scope to impact.
+	Preview(ctx context.Context, scope domain.DeletionScope, impact domain.DeletionImpact) (domain.DeletionImpact, error)
+	// Delete calls del with the deletion time when scope has no upcoming
+	// bookings, or when force is set after cancelling them with reason.
+	// Otherwise it returns domain.ErrHasFutureBookings. The impact is
+	// returned either way.
+	Delete(ctx context.Context, scope domain.DeletionScope, impact domain.DeletionImpact, force bool, reason string, del func(ctx context.Context, at time.Time) error) (domain.DeletionImpact, error)
+	// CheckRestorable returns domain.ErrRestoreExpired once deletedAt is
+	// further back than domain.RestoreWindow.
+	CheckRestorable(deletedAt *time.Time) error
+}
+
+type deletionGuard struct {
+	bookingRepo  repository.BookingRepository
+	txManager    repository.TransactionManager
+	notifier     BookingNotifier
+	availability AvailabilityPublisher
+	now          func() time.Time
+}
+
+func NewDeletionGuard(bookingRepo repository.BookingRepository, txManager repository.TransactionManager, notifier BookingNotifier, availability AvailabilityPublisher) DeletionGuard {
+	return &deletionGuard{
+		bookingRepo:  bookingRepo,
+		txManager:    txManager,
+		notifier:     notifier,
+		availability: availability,
+		now:          time.Now,
+	}
+}
+
+func (g *deletionGuard) Preview(ctx context.Context, scope domain.DeletionScope, impact domain.DeletionImpact) (domain.DeletionImpact, error) {
+	bookings, err := g.bookingRepo.FindUpcoming(ctx, scope, g.now())
+	if err != nil {
+		return domain.DeletionImpact{}, err
+	}
+
+	impact.FutureBookings = int64(len(bookings))
+
+	return impact, nil
+}
+
+func (g *deletionGuard) Delete(ctx context.Context, scope domain.DeletionScope, impact domain.DeletionImpact, force bool, reason string, del func(ctx context.Context, at time.Time) error) (domain.DeletionImpact, error) {
+	now := g.now()
+	var cancelled []domain.Booking
+
+	err := g.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		bookings, err := g.bookingRepo.FindUpcoming(ctx, scope, now)
+		if err != nil {
+			return err
+		}
+
+		impact.FutureBookings = int64(len(bookings))
+		if len(bookings) > 0 && !force {
+			return domain.ErrHasFutureBookings
+		}
+
+		for _, booking := range bookings {
+			if err := g.bookingRepo.CancelBooking(ctx, booking.ID); err != nil {
+				return err
+			}
+
+			booking.Status = domain.BookingStatusCancelled
+			if err := g.notifier.BookingCancelled(ctx, *booking, reason); err != nil {
+				return err
+			}
+			cancelled = append(cancelled, *booking)
+		}
+
+		return del(ctx, now)
+	})
+	if err != nil {
+		return impact, err
+	}
+
+	if impact.FutureBookings > 0 {
+		logger.Info("bookings cancelled by deletion", "venue_id", scope.VenueID, "field_id", scope.FieldID, "schedule_id", scope.ScheduleID, "bookings", impact.FutureBookings)
+	}
+
+	// The booking is gone, and so is the slot it held.
+	for _, booking := range cancelled {
+		g.availability.Publish(domain.NewSlotUpdate(domain.SlotUpdateReleased, booking, now))
+		g.availability.Publish(domain.NewSlotUpdate(domain.SlotUpdateClosed, booking, now))
+	}
+
+	return impact, nil
+}
+
+func (g *deletionGuard) CheckRestorable(deletedAt *time.Time) error {
+	if !domain.Restorable(deletedAt, g.now()) {
+		return domain.ErrRestoreExpired
+	}
+
+	return nil
+}
@@ -0,0 +1,399 @@
+package service
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	htmltemplate "html/template"
+	"math"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+//go:embed templates/email templates/message templates/inbox
+var emailTemplateFS embed.FS
+
+// EmailTemplateService renders notification emails from the embedded templates.
+// Each template has a .txt file defining "subject" and "text" and an .html file
+// defining "content", which is wrapped in the shared layout. Templates that can
+// also go out over SMS or WhatsApp have a short "message" under templates/message,
+// and in-app notifications a "title" and "body" under templates/inbox.
+type EmailTemplateService interface {
+	Render(name, language string, data any) (domain.Email, error)
+	RenderTextMessage(name, language string, data any) (string, error)
+	RenderInbox(name, language string, data any) (title, body string, err error)
+	// Preview renders a template with built-in sample data.
+	Preview(name, language string) (domain.Email, error)
+	Templates() []string
+}
+
+type AccountActivationEmailData struct {
+	FullName         string
+	ActivationLink   string
+	ExpiresInMinutes int
+}
+
+type AccountLockedEmailData struct {
+	FullName    string
+	LockedUntil time.Time
+}
+
+type PasswordResetEmailData struct {
+	FullName         string
+	ResetLink        string
+	ExpiresInMinutes int
+}
+
+// BookingEmailData is shared by the booking confirmation, cancellation and
+// reminder templates. Reason is only shown on cancellations.
+type BookingEmailData struct {
+	FullName   string
+	BookingID  uint
+	VenueName  string
+	FieldName  string
+	StartTime  time.Time
+	EndTime    time.Time
+	TotalPrice float64
+	Reason     string
+}
+
+// BookingMaintenanceEmailData tells a customer that the field of their booking
+// is closed for maintenance, with free slots they could move to.
+type BookingMaintenanceEmailData struct {
+	BookingEmailData
+	MaintenanceStart time.Time
+	MaintenanceEnd   time.Time
+	Alternatives     []AlternativeSlotEmailData
+}
+
+type AlternativeSlotEmailData struct {
+	FieldName string
+	StartTime time.Time
+	EndTime   time.Time
+	Price     float64
+}
+
+// SavedSearchMatchEmailData tells a user about free slots matching one of their
+// saved searches.
+type SavedSearchMatchEmailData struct {
+	FullName   string
+	SearchName string
+	Slots      []AvailableSlotEmailData
+}
+
+type AvailableSlotEmailData struct {
+	VenueName string
+	FieldName string
+	StartTime time.Time
+	EndTime   time.Time
+	Price     float64
+}
+
+type PhoneVerificationMessageData struct {
+	AppName          string
+	Code             string
+	ExpiresInMinutes int
+}
+
+// AccountInboxData is used by the account inbox notifications. PhoneNumber is
+// only set for phone_verified.
+type AccountInboxData struct {
+	FullName    string
+	PhoneNumber string
+}
+
+type PaymentReceiptEmailData struct {
+	FullName      string
+	ReceiptNumber string
+	PaymentMethod string
+	Amount        float64
+	PaidAt        time.Time
+	Booking       BookingEmailData
+}
+
+type emailLayoutData struct {
+	AppName  string
+	Language string
+	Subject  string
+	Data     any
+}
+
+type emailTemplateSet struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+type emailTemplateService struct {
+	appName   string
+	templates map[string]emailTemplateSet
+	messages  map[string]*texttemplate.Template
+	inbox     map[string]*texttemplate.Template
+}
+
+// NewEmailTemplateService parses every template up front so that a broken
+// template fails at startup rather than when the first email is sent.
+func NewEmailTemplateService(appName string) (EmailTemplateService, error) {
+	s := &emailTemplateService{
+		appName:   appName,
+		templates: make(map[string]emailTemplateSet),
+		messages:  make(map[string]*texttemplate.Template),
+		inbox:     make(map[string]*texttemplate.Template),
+	}
+
+	for _, language := range domain.SupportedLanguages {
+		funcs := emailTemplateFuncs(language)
+
+		for _, name := range domain.EmailTemplates {
+			text, err := texttemplate.New(name).Funcs(funcs).ParseFS(emailTemplateFS,
+				"templates/email/"+language+"/partials.txt",
+				"templates/email/"+language+"/"+name+".txt",
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s/%s text template: %w", language, name, err)
+			}
+
+			html, err := htmltemplate.New(name).Funcs(htmltemplate.FuncMap(funcs)).ParseFS(emailTemplateFS,
+				"templates/email/layout.html",
+				"templates/email/"+language+"/footer.html",
+				"templates/email/"+language+"/partials.html",
+				"templates/email/"+language+"/"+name+".html",
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s/%s html template: %w", language, name, err)
+			}
+
+			s.templates[emailTemplateKey(name, language)] = emailTemplateSet{text: text, html: html}
+		}
+
+		for _, name := range domain.TextMessageTemplates {
+			message, err := texttemplate.New(name).Funcs(funcs).ParseFS(emailTemplateFS,
+				"templates/message/"+language+"/"+name+".txt",
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s/%s message template: %w", language, name, err)
+			}
+
+			s.messages[emailTemplateKey(name, language)] = message
+		}
+
+		for _, name := range domain.InboxTemplates {
+			inbox, err := texttemplate.New(name).Funcs(funcs).ParseFS(emailTemplateFS,
+				"templates/inbox/"+language+"/"+name+".txt",
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s/%s inbox template: %w", language, name, err)
+			}
+
+			s.inbox[emailTemplateKey(name, language)] = inbox
+		}
+	}
+
+	return s, nil
+}
+
+func emailTemplateKey(name, language string) string {
+	return language + "/" + name
+}
+
+// Render falls back to the default language when language is empty or unsupported,
+// so a user with a stale preference still gets an email.
+func (s *emailTemplateService) Render(name, language string, data any) (domain.Email, error) {
+	language = domain.NormalizeLanguage(language)
+
+	set, ok := s.templates[emailTemplateKey(name, language)]
+	if !ok {
+		return domain.Email{}, domain.ErrEmailTemplateNotFound
+	}
+
+	var subject, text, html bytes.Buffer
+
+	if err := set.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return domain.Email{}, fmt.Errorf("failed to render %s subject: %w", name, err)
+	}
+
+	if err := set.text.ExecuteTemplate(&text, "text", data); err != nil {
+		return domain.Email{}, fmt.Errorf("failed to render %s text body: %w", name, err)
+	}
+
+	layout := emailLayoutData{
+		AppName:  s.appName,
+		Language: language,
+		Subject:  strings.TrimSpace(subject.String()),
+		Data:     data,
+	}
+
+	if err := set.html.ExecuteTemplate(&html, "layout", layout); err != nil {
+		return domain.Email{}, fmt.Errorf("failed to render %s html body: %w", name, err)
+	}
+
+	return domain.Email{
+		Subject:  layout.Subject,
+		TextBody: strings.TrimSpace(text.String()) + "\n",
+		HTMLBody: html.String(),
+	}, nil
+}
+
+// RenderTextMessage renders the short SMS/WhatsApp form of a template, with the
+// same language fallback as Render.
+func (s *emailTemplateService) RenderTextMessage(name, language string, data any) (string, error) {
+	message, ok := s.messages[emailTemplateKey(name, domain.NormalizeLanguage(language))]
+	if !ok {
+		return "", domain.ErrEmailTemplateNotFound
+	}
+
+	var body bytes.Buffer
+	if err := message.ExecuteTemplate(&body, "message", data); err != nil {
+		return "", fmt.Errorf("failed to render %s message: %w", name, err)
+	}
+
+	return strings.TrimSpace(body.String()), nil
+}
+
+// RenderInbox renders the in-app form of a template, with the same language
+// fallback as Render.
+func (s *emailTemplateService) RenderInbox(name, language string, data any) (string, string, error) {
+	inbox, ok := s.inbox[emailTemplateKey(name, domain.NormalizeLanguage(language))]
+	if !ok {
+		return "", "", domain.ErrEmailTemplateNotFound
+	}
+
+	var title, body bytes.Buffer
+	if err := inbox.ExecuteTemplate(&title, "title", data); err != nil {
+		return "", "", fmt.Errorf("failed to render %s inbox title: %w", name, err)
+	}
+
+	if err := inbox.ExecuteTemplate(&body, "body", data); err != nil {
+		return "", "", fmt.Errorf("failed to render %s inbox body: %w", name, err)
+	}
+
+	return strings.TrimSpace(title.String()), strings.TrimSpace(body.String()), nil
+}
+
+func (s *emailTemplateService) Preview(name, language string) (domain.Email, error) {
+	if language != "" && !domain.IsSupportedLanguage(language) {
+		return domain.Email{}, domain.ErrUnsupportedLanguage
+	}
+
+	data, ok := emailTemplateSampleData()[name]
+	if !ok {
+		return domain.Email{}, domain.ErrEmailTemplateNotFound
+	}
+
+	return s.Render(name, language, data)
+}
+
+func (s *emailTemplateService) Templates() []string {
+	return domain.EmailTemplates
+}
+
+func emailTemplateSampleData() map[string]any {
+	start := time.Date(2025, time.March, 14, 19, 0, 0, 0, time.FixedZone("WIB", 7*60*60))
+
+	booking := BookingEmailData{
+		FullName:   "Budi Santoso",
+		BookingID:  1024,
+		VenueName:  "Arena Futsal Senayan",
+		FieldName:  "Lapangan A (Vinyl)",
+		StartTime:  start,
+		EndTime:    start.Add(time.Hour),
+		TotalPrice: 150000,
+	}
+
+	cancelled := booking
+	cancelled.Reason = "Lapangan sedang dalam perbaikan"
+
+	return map[string]any{
+		domain.EmailTemplateAccountActivation: AccountActivationEmailData{
+			FullName:         booking.FullName,
+			ActivationLink:   "https://example.com/users/email-verification/sample-code",
+			ExpiresInMinutes: verificationCodeTTL,
+		},
+		domain.EmailTemplateAccountLocked: AccountLockedEmailData{
+			FullName:    booking.FullName,
+			LockedUntil: start.Add(15 * time.Minute),
+		},
+		domain.EmailTemplatePasswordReset: PasswordResetEmailData{
+			FullName:         booking.FullName,
+			ResetLink:        "https://example.com/users/password-reset/sample-token",
+			ExpiresInMinutes: 30,
+		},
+		domain.EmailTemplateBookingReceived:     booking,
+		domain.EmailTemplateBookingConfirmation: booking,
+		domain.EmailTemplateBookingCancellation: cancelled,
+		domain.EmailTemplateBookingReminder:     booking,
+		domain.EmailTemplateBookingMaintenance: BookingMaintenanceEmailData{
+			BookingEmailData: cancelled,
+			MaintenanceStart: start.Add(-2 * time.Hour),
+			MaintenanceEnd:   start.Add(46 * time.Hour),
+			Alternatives: []AlternativeSlotEmailData{
+				{FieldName: "Lapangan B (Sintetis)", StartTime: start, EndTime: start.Add(time.Hour), Price: 130000},
+				{FieldName: "Lapangan A (Vinyl)", StartTime: start.Add(72 * time.Hour), EndTime: start.Add(73 * time.Hour), Price: 150000},
+			},
+		},
+		domain.EmailTemplatePaymentReceipt: PaymentReceiptEmailData{
+			FullName:      booking.FullName,
+			ReceiptNumber: "INV-20250314-1024",
+			PaymentMethod: "QRIS",
+			Amount:        booking.TotalPrice,
+			PaidAt:        start.Add(-48 * time.Hour),
+			Booking:       booking,
+		},
+		domain.EmailTemplateSavedSearchMatch: SavedSearchMatchEmailData{
+			FullName:   booking.FullName,
+			SearchName: "Jumat malam di Jakarta",
+			Slots: []AvailableSlotEmailData{
+				{VenueName: booking.VenueName, FieldName: booking.FieldName, StartTime: start, EndTime: start.Add(time.Hour), Price: 150000},
+				{VenueName: "Futsal Center Kemang", FieldName: "Lapangan 2 (Sintetis)", StartTime: start.Add(time.Hour), EndTime: start.Add(2 * time.Hour), Price: 120000},
+			},
+		},
+	}
+}
+
+var (
+	indonesianDays   = [...]string{"Minggu", "Senin", "Selasa", "Rabu", "Kamis", "Jumat", "Sabtu"}
+	indonesianMonths = [...]string{"Januari", "Februari", "Maret", "April", "Mei", "Juni", "Juli", "Agustus", "September", "Oktober", "November", "Desember"}
+)
+
+func emailTemplateFuncs(language string) texttemplate.FuncMap {
+	date := func(t time.Time) string {
+		if language == domain.LanguageIndonesian {
+			return fmt.Sprintf("%s, %d %s %d", indonesianDays[t.Weekday()], t.Day(), indonesianMonths[t.Month()-1], t.Year())
+		}
+		return t.Format("Monday, 2 January 2006")
+	}
+
+	separator := ","
+	if language == domain.LanguageIndonesian {
+		separator = "."
+	}
+
+	return texttemplate.FuncMap{
+		"date": date,
+		"datetime": func(t time.Time) string {
+			return date(t) + " " + t.Format("15:04 MST")
+		},
+		"clock": func(t time.Time) string {
+			return t.Format("15:04")
+		},
+		"money": func(amount float64) string {
+			return "Rp" + groupThousands(int64(math.Round(amount)), separator)
+		},
+	}
+}
+
+func groupThousands(n int64, separator string) string {
+	sign := ""
+	if n < 0 {
+		sign, n = "-", -n
+	}
+
+	digits := strconv.FormatInt(n, 10)
+	for i := len(digits) - 3; i > 0; i -= 3 {
+		digits = digits[:i] + separator + digits[i:]
+	}
+
+	return sign + digits
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+)
+
+// FavouriteService keeps the venues and fields a user has marked as
+// favourites.
+type FavouriteService interface {
+	GetFavourites(ctx context.Context, userID uint) (*domain.Favourites, error)
+	// AddVenue and AddField succeed when the favourite already exists.
+	AddVenue(ctx context.Context, userID, venueID uint) error
+	RemoveVenue(ctx context.Context, userID, venueID uint) error
+	AddField(ctx context.Context, userID, fieldID uint) error
+	RemoveField(ctx context.Context, userID, fieldID uint) error
+}
+
+type favouriteService struct {
+	favouriteRepo repository.FavouriteRepository
+	venueRepo     repository.VenueRepository
+	fieldRepo     repository.FieldRepository
+}
+
+func NewFavouriteService(favouriteRepo repository.FavouriteRepository, venueRepo repository.VenueRepository, fieldRepo repository.FieldRepository) FavouriteService {
+	return &favouriteService{
+		favouriteRepo: favouriteRepo,
+		venueRepo:     venueRepo,
+		fieldRepo:     fieldRepo,
+	}
+}
+
+func (s *favouriteService) GetFavourites(ctx context.Context, userID uint) (*domain.Favourites, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	favourites, err := s.favouriteRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("failed to find favourites", err, "user_id", userID)
+		return nil, err
+	}
+
+	return &favourites, nil
+}
+
+func (s *favouriteService) AddVenue(ctx context.Context, userID, venueID uint) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("context error: %w", err)
+	}
+
+	if _, err := s.venueRepo.FindByID(ctx, venueID); err != nil {
+		return domain.ErrVenueNotFound
+	}
+
+	if err := s.favouriteRepo.AddVenue(ctx, userID, venueID); err != nil {
+		logger.Error("failed to add favourite venue", err, "user_id", userID, "venue_id", venueID)
+		return err
+	}
+
+	return nil
+}
+
+func (s *favouriteService) RemoveVenue(ctx context.Context, userID, venueID uint) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("context error: %w", err)
+	}
+
+	if err := s.favouriteRepo.RemoveVenue(ctx, userID, venueID); err != nil {
+		if !errors.Is(err, domain.ErrFavouriteNotFound) {
+			logger.Error("failed to remove favourite venue", err, "user_id", userID, "venue_id", venueID)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *favouriteService) AddField(ctx context.Context, userID, fieldID uint) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("context error: %w", err)
+	}
+
+	if _, err := s.fieldRepo.FindByID(ctx, fieldID); err != nil {
+		return err
+	}
+
+	if err := s.favouriteRepo.AddField(ctx, userID, fieldID); err != nil {
+		logger.Error("failed to add favourite field", err, "user_id", userID, "field_id", fieldID)
+		return err
+	}
+
+	return nil
+}
+
+func (s *favouriteService) RemoveField(ctx context.Context, userID, fieldID uint) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("context error: %w", err)
+	}
+
+	if err := s.favouriteRepo.RemoveField(ctx, userID, fieldID); err != nil {
+		if !errors.Is(err, domain.ErrFavouriteNotFound) {
+			logger.Error("failed to remove favourite field", err, "user_id", userID, "field_id", fieldID)
+		}
+		return err
+	}
+
+	return nil
+}
@@ -2,25 +2,40 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"go-futsal-booking-api/internal/domain"
 	"go-futsal-booking-api/internal/dto/request"
 	"go-futsal-booking-api/internal/repository"
 	"go-futsal-booking-api/pkg/logger"
+	"strings"
+	"time"
 )
 
 type FieldService interface {
 	GetFieldByID(ctx context.Context, id uint) (*domain.Field, error)
 	GetFieldsByVenue(ctx context.Context, venueID uint) ([]*domain.Field, error)
-	CreateField(ctx context.Context, req *request.CreateFieldRequest) (*domain.Field, error)
-	UpdateField(ctx context.Context, id uint, name, fieldType string) (*domain.Field, error)
-	DeleteField(ctx context.Context, id uint) error
+	CreateField(ctx context.Context, req *request.CreateFieldRequest, userID uint) (*domain.Field, error)
+	UpdateField(ctx context.Context, id uint, req *request.UpdateFieldRequest, userID uint) (*domain.Field, error)
+	// GetFieldDeletionPreview counts what DeleteField would affect. Requires
+	// MANAGER membership of the venue.
+	GetFieldDeletionPreview(ctx context.Context, id uint, userID uint) (*domain.DeletionImpact, error)
+	// DeleteField soft-deletes the field with its schedules. Upcoming bookings
+	// block it unless force is set, which cancels them.
+	DeleteField(ctx context.Context, id uint, userID uint, force bool) (*domain.DeletionImpact, error)
+	// RestoreField brings back a field deleted within domain.RestoreWindow,
+	// with the schedules deleted along with it. Its venue must not be deleted.
+	RestoreField(ctx context.Context, id uint) (*domain.Field, error)
+	GetFieldTypes(ctx context.Context) ([]domain.FieldType, error)
 }
 
 type fieldService struct {
 	fieldRepo    repository.FieldRepository
 	venueRepo    repository.VenueRepository
 	scheduleRepo repository.ScheduleRepository
+	typeRepo     repository.FieldTypeRepository
+	authorizer   Authorizer
+	guard        DeletionGuard
 }
 
 // type CreateFieldRequest struct {
@@ -29,11 +44,14 @@ type fieldService struct {
 // 	Name      string
 // }
 
-func NewFieldService(fieldRepo repository.FieldRepository, venueRepo repository.VenueRepository, scheduleRepo repository.ScheduleRepository) FieldService {
+func NewFieldService(fieldRepo repository.FieldRepository, venueRepo repository.VenueRepository, scheduleRepo repository.ScheduleRepository, typeRepo repository.FieldTypeRepository, authorizer Authorizer, guard DeletionGuard) FieldService {
 	return &fieldService{
 		fieldRepo:    fieldRepo,
 		venueRepo:    venueRepo,
 		scheduleRepo: scheduleRepo,
+		typeRepo:     typeRepo,
+		authorizer:   authorizer,
+		guard:        guard,
 	}
 }
 
@@ -88,8 +106,8 @@ func (s *fieldService) GetFieldsByVenue(ctx context.Context, venueID uint) ([]*d
 	return field, nil
 }
 
-func (s *fieldService) CreateField(ctx context.Context, req *request.CreateFieldRequest) (*domain.Field, error) {
-	if req.VenueID == 0 || req.FieldType == "" {
+func (s *fieldService) CreateField(ctx context.Context, req *request.CreateFieldRequest, userID uint) (*domain.Field, error) {
+	if req.VenueID == 0 || req.Name == "" || req.FieldType == "" {
 		logger.Error("Invalid venue id and field type")
 		return nil, domain.ErrInvalidFieldData
 	}
@@ -105,10 +123,18 @@ func (s *fieldService) CreateField(ctx context.Context, req *request.CreateField
 		return nil, domain.ErrVenueNotFound
 	}
 
+	if err := s.authorizer.AuthorizeVenue(ctx, userID, venue.ID, domain.VenueRoleManager); err != nil {
+		return nil, err
+	}
+
 	newField := &domain.Field{
-		Name:  req.Name,
-		Type:  req.FieldType,
-		Venue: venue,
+		Name:       req.Name,
+		Venue:      venue,
+		MaxPlayers: domain.DefaultFieldMaxPlayers,
+		Status:     domain.FieldStatusActive,
+	}
+	if err := s.applyFieldData(ctx, newField, req.FieldType, req.FieldDetailsRequest); err != nil {
+		return nil, err
 	}
 
 	if err := s.fieldRepo.Create(ctx, newField); err != nil {
@@ -121,8 +147,8 @@ func (s *fieldService) CreateField(ctx context.Context, req *request.CreateField
 	return newField, nil
 }
 
-func (s *fieldService) UpdateField(ctx context.Context, id uint, name, fieldType string) (*domain.Field, error) {
-	if id == 0 || name == "" || fieldType == "" {
+func (s *fieldService) UpdateField(ctx context.Context, id uint, req *request.UpdateFieldRequest, userID uint) (*domain.Field, error) {
+	if id == 0 || req.Name == "" || req.FieldType == "" {
 		logger.Error("Invalid field data")
 		return nil, domain.ErrInvalidFieldData
 	}
@@ -138,8 +164,14 @@ func (s *fieldService) UpdateField(ctx context.Context, id uint, name, fieldType
 		return nil, domain.ErrFieldNotFound
 	}
 
-	fieldUpdate.Name = name
-	fieldUpdate.Type = fieldType
+	if err := s.authorizer.AuthorizeVenue(ctx, userID, fieldUpdate.Venue.ID, domain.VenueRoleManager); err != nil {
+		return nil, err
+	}
+
+	fieldUpdate.Name = req.Name
+	if err := s.applyFieldData(ctx, &fieldUpdate, req.FieldType, req.FieldDetailsRequest); err != nil {
+		return nil, err
+	}
 
 	if err := s.fieldRepo.Update(ctx, &fieldUpdate); err != nil {
 		logger.Error("failed to update field", err)
@@ -151,29 +183,154 @@ func (s *fieldService) UpdateField(ctx context.Context, id uint, name, fieldType
 	return &fieldUpdate, nil
 }
 
-func (s *fieldService) DeleteField(ctx context.Context, id uint) error {
+func (s *fieldService) GetFieldDeletionPreview(ctx context.Context, id uint, userID uint) (*domain.DeletionImpact, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	field, err := s.fieldRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizer.AuthorizeVenue(ctx, userID, field.Venue.ID, domain.VenueRoleManager); err != nil {
+		return nil, err
+	}
+
+	impact, err := s.fieldRepo.DeletionImpact(ctx, id)
+	if err != nil {
+		logger.Error("failed to count field deletion impact", err, "field_id", id)
+		return nil, err
+	}
+
+	impact, err = s.guard.Preview(ctx, domain.DeletionScope{FieldID: id}, impact)
+	if err != nil {
+		logger.Error("failed to find upcoming field bookings", err, "field_id", id)
+		return nil, err
+	}
+
+	return &impact, nil
+}
+
+func (s *fieldService) DeleteField(ctx context.Context, id uint, userID uint, force bool) (*domain.DeletionImpact, error) {
 	if id == 0 {
 		logger.Error("Invalid field id when deleting field")
-		return domain.ErrInvalidFieldData
+		return nil, domain.ErrInvalidFieldData
 	}
 
 	if err := ctx.Err(); err != nil {
 		logger.Error("context error when deleting field")
-		return fmt.Errorf("context error: %w", err)
+		return nil, fmt.Errorf("context error: %w", err)
 	}
 
-	_, err := s.fieldRepo.FindByID(ctx, id)
+	field, err := s.fieldRepo.FindByID(ctx, id)
 	if err != nil {
 		logger.Error("field not found", err)
-		return domain.ErrFieldNotFound
+		return nil, domain.ErrFieldNotFound
+	}
+
+	if err := s.authorizer.AuthorizeVenue(ctx, userID, field.Venue.ID, domain.VenueRoleManager); err != nil {
+		return nil, err
 	}
 
-	if err := s.fieldRepo.Delete(ctx, id); err != nil {
+	impact, err := s.fieldRepo.DeletionImpact(ctx, id)
+	if err != nil {
+		logger.Error("failed to count field deletion impact", err, "field_id", id)
+		return nil, err
+	}
+
+	impact, err = s.guard.Delete(ctx, domain.DeletionScope{FieldID: id}, impact, force, "The field has been removed", func(ctx context.Context, at time.Time) error {
+		return s.fieldRepo.Delete(ctx, id, at)
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrHasFutureBookings) {
+			return &impact, err
+		}
 		logger.Error("failed to delete field", err)
-		return fmt.Errorf("failed to delete field: %w", err)
+		return nil, fmt.Errorf("failed to delete field: %w", err)
 	}
 
-	logger.Info("field deleted success")
+	logger.Info("field deleted success", "field_id", id, "schedules", impact.Schedules, "cancelled_bookings", impact.FutureBookings)
+
+	return &impact, nil
+}
+
+func (s *fieldService) RestoreField(ctx context.Context, id uint) (*domain.Field, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	field, err := s.fieldRepo.FindDeletedByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.guard.CheckRestorable(field.DeletedAt); err != nil {
+		return nil, err
+	}
+
+	if field.Venue.DeletedAt != nil {
+		return nil, domain.ErrParentDeleted
+	}
+
+	if err := s.fieldRepo.Restore(ctx, id); err != nil {
+		if !errors.Is(err, domain.ErrNotDeleted) {
+			logger.Error("failed to restore field", err, "field_id", id)
+		}
+		return nil, err
+	}
+
+	logger.Info("field restored", "field_id", id)
+
+	restored, err := s.fieldRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &restored, nil
+}
+
+func (s *fieldService) GetFieldTypes(ctx context.Context) ([]domain.FieldType, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	types, err := s.typeRepo.FindAll(ctx)
+	if err != nil {
+		logger.Error("failed to find field types", err)
+		return nil, err
+	}
+
+	return types, nil
+}
+
+// applyFieldData checks the field type against the field_types table and sets
+// it, with the details that were given, on field.
+func (s *fieldService) applyFieldData(ctx context.Context, field *domain.Field, fieldType string, details request.FieldDetailsRequest) error {
+	fieldTypeCode := strings.ToUpper(strings.TrimSpace(fieldType))
+	if _, err := s.typeRepo.FindByCode(ctx, fieldTypeCode); err != nil {
+		return err
+	}
+	field.Type = fieldTypeCode
+
+	if details.LengthMeters != nil {
+		field.LengthMeters = details.LengthMeters
+	}
+	if details.WidthMeters != nil {
+		field.WidthMeters = details.WidthMeters
+	}
+	if details.Indoor != nil {
+		field.Indoor = *details.Indoor
+	}
+	if details.HasLighting != nil {
+		field.HasLighting = *details.HasLighting
+	}
+	if details.MaxPlayers != nil {
+		field.MaxPlayers = *details.MaxPlayers
+	}
+	if details.Status != nil {
+		field.Status = strings.ToUpper(*details.Status)
+	}
 
-	return nil
+	return field.ValidateDetails()
 }
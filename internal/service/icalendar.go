@@ -0,0 +1,159 @@
+package service
+
+import (
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	icalDateTime    = "20060102T150405"
+	icalDateTimeUTC = "20060102T150405Z"
+	// icalLineLimit is the longest content line allowed, in octets, before it
+	// must be folded (RFC 5545 section 3.1).
+	icalLineLimit = 75
+)
+
+// EncodeCalendar renders events as an RFC 5545 VCALENDAR. Events in a
+// fixed-offset zone, which covers every Indonesian zone, are written with a
+// TZID and a matching VTIMEZONE so calendar apps show the venue's time. Other
+// zones are written in UTC, which is always correct if less readable.
+func EncodeCalendar(productID, name string, events []domain.CalendarEvent, now time.Time) []byte {
+	var w icalWriter
+
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:-//" + productID + "//Bookings//EN")
+	w.line("CALSCALE:GREGORIAN")
+	w.line("METHOD:PUBLISH")
+	if name != "" {
+		w.line("X-WR-CALNAME:" + icalEscape(name))
+	}
+
+	written := make(map[string]bool)
+	for _, event := range events {
+		loc := event.Start.Location()
+		if !icalUsesTZID(event.Start) || written[loc.String()] {
+			continue
+		}
+
+		written[loc.String()] = true
+		abbr, offset := event.Start.Zone()
+
+		w.line("BEGIN:VTIMEZONE")
+		w.line("TZID:" + loc.String())
+		w.line("BEGIN:STANDARD")
+		w.line("DTSTART:19700101T000000")
+		w.line("TZOFFSETFROM:" + icalOffset(offset))
+		w.line("TZOFFSETTO:" + icalOffset(offset))
+		w.line("TZNAME:" + abbr)
+		w.line("END:STANDARD")
+		w.line("END:VTIMEZONE")
+	}
+
+	for _, event := range events {
+		w.line("BEGIN:VEVENT")
+		w.line("UID:" + event.UID)
+		w.line("DTSTAMP:" + now.UTC().Format(icalDateTimeUTC))
+		w.line("DTSTART" + icalTime(event.Start))
+		w.line("DTEND" + icalTime(event.End))
+		w.line("SUMMARY:" + icalEscape(event.Summary))
+		if event.Location != "" {
+			w.line("LOCATION:" + icalEscape(event.Location))
+		}
+		if event.Description != "" {
+			w.line("DESCRIPTION:" + icalEscape(event.Description))
+		}
+		if event.Status != "" {
+			w.line("STATUS:" + event.Status)
+		}
+		if !event.Created.IsZero() {
+			w.line("CREATED:" + event.Created.UTC().Format(icalDateTimeUTC))
+		}
+		if !event.LastModified.IsZero() {
+			w.line("LAST-MODIFIED:" + event.LastModified.UTC().Format(icalDateTimeUTC))
+		}
+		w.line("END:VEVENT")
+	}
+
+	w.line("END:VCALENDAR")
+
+	return []byte(w.String())
+}
+
+// icalUsesTZID reports whether t can be written with a TZID backed by a single
+// STANDARD rule, i.e. its zone has a name and the same offset all year.
+func icalUsesTZID(t time.Time) bool {
+	loc := t.Location()
+	if loc == time.UTC || loc == time.Local || loc.String() == "" {
+		return false
+	}
+
+	_, winter := time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, loc).Zone()
+	_, summer := time.Date(t.Year(), time.July, 1, 0, 0, 0, 0, loc).Zone()
+	_, now := t.Zone()
+
+	return winter == summer && summer == now
+}
+
+// icalTime returns the parameters and value of a DTSTART or DTEND property.
+func icalTime(t time.Time) string {
+	if icalUsesTZID(t) {
+		return ";TZID=" + t.Location().String() + ":" + t.Format(icalDateTime)
+	}
+
+	return ":" + t.UTC().Format(icalDateTimeUTC)
+}
+
+func icalOffset(seconds int) string {
+	sign := '+'
+	if seconds < 0 {
+		sign = '-'
+		seconds = -seconds
+	}
+
+	return fmt.Sprintf("%c%02d%02d", sign, seconds/3600, seconds%3600/60)
+}
+
+var icalEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+// icalEscape escapes a TEXT value (RFC 5545 section 3.3.11).
+func icalEscape(s string) string {
+	return icalEscaper.Replace(s)
+}
+
+type icalWriter struct {
+	strings.Builder
+}
+
+// line writes a content line, folding it into CRLF + space continuations so no
+// line is longer than icalLineLimit octets. Multi-byte characters are never
+// split across lines.
+func (w *icalWriter) line(s string) {
+	limit := icalLineLimit
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+
+		w.WriteString(s[:cut])
+		w.WriteString("\r\n ")
+		s = s[cut:]
+
+		// The leading space of a continuation counts towards its length.
+		limit = icalLineLimit - 1
+	}
+
+	w.WriteString(s)
+	w.WriteString("\r\n")
+}
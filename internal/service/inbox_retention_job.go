@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+	"time"
+)
+
+type InboxRetentionConfig struct {
+	// RetainRead is how long a notification is kept after it was read. Unread
+	// notifications are never removed.
+	RetainRead   time.Duration
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+// InboxRetentionJob removes read inbox notifications once they are older than
+// the retention period. Deletes go in batches so a large backlog never holds a
+// long lock on the table.
+type InboxRetentionJob struct {
+	inboxRepo repository.InboxRepository
+	config    InboxRetentionConfig
+	now       func() time.Time
+}
+
+func NewInboxRetentionJob(inboxRepo repository.InboxRepository, config InboxRetentionConfig) *InboxRetentionJob {
+	return &InboxRetentionJob{
+		inboxRepo: inboxRepo,
+		config:    config,
+		now:       time.Now,
+	}
+}
+
+// Run removes expired notifications until ctx is cancelled.
+func (j *InboxRetentionJob) Run(ctx context.Context) {
+	if j.config.RetainRead <= 0 {
+		logger.Info("inbox retention disabled")
+		return
+	}
+
+	logger.Info("inbox retention job started", "poll_interval", j.config.PollInterval.String())
+
+	ticker := time.NewTicker(j.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := j.DeleteExpired(ctx); err != nil {
+			logger.Error("inbox retention failed", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info("inbox retention job stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeleteExpired removes every notification read before the retention cutoff
+// and returns how many were removed.
+func (j *InboxRetentionJob) DeleteExpired(ctx context.Context) (int64, error) {
+	cutoff := j.now().Add(-j.config.RetainRead)
+
+	var deleted int64
+	for ctx.Err() == nil {
+		n, err := j.inboxRepo.DeleteReadBefore(ctx, cutoff, j.config.BatchSize)
+		if err != nil {
+			return deleted, err
+		}
+
+		deleted += n
+		if n < int64(j.config.BatchSize) {
+			break
+		}
+	}
+
+	if deleted > 0 {
+		logger.Info("expired inbox notifications removed", "count", deleted)
+	}
+
+	return deleted, nil
+}
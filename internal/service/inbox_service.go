@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+	"time"
+)
+
+// InboxService keeps the in-app notifications a user sees under the
+// notification bell, next to whatever went out by email or text message.
+type InboxService interface {
+	// Notify renders the event's inbox template in the user's language and
+	// stores it. It joins the caller's transaction, if any.
+	Notify(ctx context.Context, user domain.User, event string, data any) error
+	GetNotifications(ctx context.Context, userID uint, filter domain.InboxFilter) ([]domain.InboxNotification, int64, error)
+	CountUnread(ctx context.Context, userID uint) (int64, error)
+	MarkRead(ctx context.Context, userID, id uint) (domain.InboxNotification, error)
+	// MarkAllRead returns how many notifications were marked.
+	MarkAllRead(ctx context.Context, userID uint) (int64, error)
+}
+
+type inboxService struct {
+	inboxRepo      repository.InboxRepository
+	emailTemplates EmailTemplateService
+	now            func() time.Time
+}
+
+func NewInboxService(inboxRepo repository.InboxRepository, emailTemplates EmailTemplateService) InboxService {
+	return &inboxService{
+		inboxRepo:      inboxRepo,
+		emailTemplates: emailTemplates,
+		now:            time.Now,
+	}
+}
+
+func (s *inboxService) Notify(ctx context.Context, user domain.User, event string, data any) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("context error: %w", err)
+	}
+
+	title, body, err := s.emailTemplates.RenderInbox(event, user.Language, data)
+	if err != nil {
+		return err
+	}
+
+	notification := &domain.InboxNotification{
+		UserID:    user.ID,
+		Event:     event,
+		Title:     title,
+		Body:      body,
+		CreatedAt: s.now(),
+	}
+
+	if err := s.inboxRepo.Create(ctx, notification); err != nil {
+		logger.Error("failed to store inbox notification", err, "user_id", user.ID, "event", event)
+		return err
+	}
+
+	return nil
+}
+
+func (s *inboxService) GetNotifications(ctx context.Context, userID uint, filter domain.InboxFilter) ([]domain.InboxNotification, int64, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, 0, fmt.Errorf("context error: %w", err)
+	}
+
+	notifications, total, err := s.inboxRepo.FindByUserID(ctx, userID, filter)
+	if err != nil {
+		logger.Error("failed to get inbox notifications", err, "user_id", userID)
+		return nil, 0, err
+	}
+
+	return notifications, total, nil
+}
+
+func (s *inboxService) CountUnread(ctx context.Context, userID uint) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, fmt.Errorf("context error: %w", err)
+	}
+
+	count, err := s.inboxRepo.CountUnread(ctx, userID)
+	if err != nil {
+		logger.Error("failed to count unread notifications", err, "user_id", userID)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (s *inboxService) MarkRead(ctx context.Context, userID, id uint) (domain.InboxNotification, error) {
+	if err := ctx.Err(); err != nil {
+		return domain.InboxNotification{}, fmt.Errorf("context error: %w", err)
+	}
+
+	return s.inboxRepo.MarkRead(ctx, userID, id, s.now())
+}
+
+func (s *inboxService) MarkAllRead(ctx context.Context, userID uint) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, fmt.Errorf("context error: %w", err)
+	}
+
+	marked, err := s.inboxRepo.MarkAllRead(ctx, userID, s.now())
+	if err != nil {
+		logger.Error("failed to mark notifications read", err, "user_id", userID)
+		return 0, err
+	}
+
+	return marked, nil
+}
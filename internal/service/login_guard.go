@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+	"strings"
+	"time"
+)
+
+// LoginGuard throttles password logins per account and per client IP. Each key gets
+// a few free attempts, then must wait an exponentially growing delay between tries,
+// and is locked out for a while once it reaches its threshold.
+type LoginGuard interface {
+	// Check counts the attempt against both keys up front, in the same step that
+	// checks them, so concurrent attempts cannot all slip through one check. It
+	// refuses the login with a *domain.LoginThrottleError, counting nothing, while
+	// either key is backing off or locked.
+	Check(ctx context.Context, email, ip string) error
+	// Release takes back the attempt Check counted once the credentials turned out
+	// to be right.
+	Release(ctx context.Context, email, ip string) error
+	// RecordFailure locks the keys that have reached their threshold and returns
+	// when the account lock ends if this failure has just locked it.
+	RecordFailure(ctx context.Context, email, ip string) (lockedUntil *time.Time, err error)
+	RecordSuccess(ctx context.Context, email string) error
+	Unlock(ctx context.Context, email string) error
+}
+
+type LoginGuardConfig struct {
+	AccountFreeAttempts  int
+	AccountLockThreshold int
+	IPFreeAttempts       int
+	IPLockThreshold      int
+	BaseDelay            time.Duration
+	MaxDelay             time.Duration
+	LockDuration         time.Duration
+	Window               time.Duration
+}
+
+type loginGuard struct {
+	attemptRepo repository.LoginAttemptRepository
+	cfg         LoginGuardConfig
+	now         func() time.Time
+}
+
+func NewLoginGuard(attemptRepo repository.LoginAttemptRepository, cfg LoginGuardConfig) LoginGuard {
+	return &loginGuard{
+		attemptRepo: attemptRepo,
+		cfg:         cfg,
+		now:         time.Now,
+	}
+}
+
+func normalizeLoginEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func (g *loginGuard) Check(ctx context.Context, email, ip string) error {
+	account := normalizeLoginEmail(email)
+	if err := g.reserve(ctx, domain.LoginScopeAccount, account, g.cfg.AccountFreeAttempts); err != nil {
+		return err
+	}
+
+	if ip == "" {
+		return nil
+	}
+
+	if err := g.reserve(ctx, domain.LoginScopeIP, ip, g.cfg.IPFreeAttempts); err != nil {
+		if releaseErr := g.attemptRepo.Release(ctx, domain.LoginScopeAccount, account); releaseErr != nil {
+			logger.Warn("Failed to release login attempt", releaseErr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (g *loginGuard) reserve(ctx context.Context, scope, key string, freeAttempts int) error {
+	now := g.now()
+	backoff := g.backoff(freeAttempts)
+
+	attempt, counted, err := g.attemptRepo.Reserve(ctx, scope, key, now, backoff)
+	if err != nil || counted {
+		return err
+	}
+
+	if attempt.LockedUntil != nil && now.Before(*attempt.LockedUntil) {
+		return &domain.LoginThrottleError{
+			Err:        domain.ErrAccountLocked,
+			RetryAfter: attempt.LockedUntil.Sub(now),
+		}
+	}
+
+	// The key is backing off; without a last failure the wait has just ended.
+	retryAfter := time.Duration(0)
+	if attempt.LastFailedAt != nil {
+		retryAfter = max(attempt.LastFailedAt.Add(backoff.Delay(attempt.FailedCount)).Sub(now), 0)
+	}
+
+	return &domain.LoginThrottleError{
+		Err:        domain.ErrTooManyLoginAttempts,
+		RetryAfter: retryAfter,
+	}
+}
+
+func (g *loginGuard) backoff(freeAttempts int) domain.LoginBackoff {
+	return domain.LoginBackoff{
+		FreeAttempts: freeAttempts,
+		BaseDelay:    g.cfg.BaseDelay,
+		MaxDelay:     g.cfg.MaxDelay,
+		Window:       g.cfg.Window,
+	}
+}
+
+func (g *loginGuard) Release(ctx context.Context, email, ip string) error {
+	if err := g.attemptRepo.Release(ctx, domain.LoginScopeAccount, normalizeLoginEmail(email)); err != nil {
+		return err
+	}
+
+	if ip == "" {
+		return nil
+	}
+
+	return g.attemptRepo.Release(ctx, domain.LoginScopeIP, ip)
+}
+
+func (g *loginGuard) RecordFailure(ctx context.Context, email, ip string) (*time.Time, error) {
+	now := g.now()
+
+	lockedUntil, err := g.lock(ctx, domain.LoginScopeAccount, normalizeLoginEmail(email), g.cfg.AccountLockThreshold, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if ip != "" {
+		if _, err := g.lock(ctx, domain.LoginScopeIP, ip, g.cfg.IPLockThreshold, now); err != nil {
+			return lockedUntil, err
+		}
+	}
+
+	return lockedUntil, nil
+}
+
+// lock locks the key once Check has counted threshold failures against it. Only
+// the failure that reaches the threshold gets the lock back.
+func (g *loginGuard) lock(ctx context.Context, scope, key string, threshold int, now time.Time) (*time.Time, error) {
+	lockedUntil := now.Add(g.cfg.LockDuration)
+
+	locked, err := g.attemptRepo.Lock(ctx, scope, key, threshold, lockedUntil)
+	if err != nil || !locked {
+		return nil, err
+	}
+
+	logger.Warn("login locked after repeated failures", "scope", scope, "key", key, "failures", threshold)
+
+	return &lockedUntil, nil
+}
+
+func (g *loginGuard) RecordSuccess(ctx context.Context, email string) error {
+	// The IP counter is deliberately kept: one valid account must not let an
+	// attacker clear the throttle for the passwords they are guessing.
+	return g.attemptRepo.Reset(ctx, domain.LoginScopeAccount, normalizeLoginEmail(email))
+}
+
+func (g *loginGuard) Unlock(ctx context.Context, email string) error {
+	return g.attemptRepo.Reset(ctx, domain.LoginScopeAccount, normalizeLoginEmail(email))
+}
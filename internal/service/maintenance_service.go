@@ -0,0 +1,364 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/dto/request"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MaintenanceService manages the maintenance windows that close a field for
+// booking, for example while it is resurfaced. Bookings already made inside a
+// new window are kept, and their customers are told and offered free slots
+// they could move to. Availability streams are told the window's slots are
+// closed.
+type MaintenanceService interface {
+	// GetMaintenanceWindows returns the current and upcoming windows of a field.
+	GetMaintenanceWindows(ctx context.Context, fieldID uint) ([]domain.MaintenanceWindow, error)
+	// CreateMaintenanceWindow returns the window with the bookings inside it.
+	CreateMaintenanceWindow(ctx context.Context, fieldID uint, req *request.CreateMaintenanceWindowRequest, userID uint) (*domain.MaintenanceWindow, []domain.MaintenanceImpact, error)
+	GetAffectedBookings(ctx context.Context, windowID uint, userID uint) ([]domain.MaintenanceImpact, error)
+	DeleteMaintenanceWindow(ctx context.Context, windowID uint, userID uint) error
+}
+
+type maintenanceService struct {
+	windowRepo   repository.MaintenanceWindowRepository
+	fieldRepo    repository.FieldRepository
+	scheduleRepo repository.ScheduleRepository
+	bookingRepo  repository.BookingRepository
+	authorizer   Authorizer
+	txManager    repository.TransactionManager
+	notifier     BookingNotifier
+	availability AvailabilityPublisher
+	now          func() time.Time
+}
+
+func NewMaintenanceService(windowRepo repository.MaintenanceWindowRepository, fieldRepo repository.FieldRepository, scheduleRepo repository.ScheduleRepository, bookingRepo repository.BookingRepository, authorizer Authorizer, txManager repository.TransactionManager, notifier BookingNotifier, availability AvailabilityPublisher) MaintenanceService {
+	return &maintenanceService{
+		windowRepo:   windowRepo,
+		fieldRepo:    fieldRepo,
+		scheduleRepo: scheduleRepo,
+		bookingRepo:  bookingRepo,
+		authorizer:   authorizer,
+		txManager:    txManager,
+		notifier:     notifier,
+		availability: availability,
+		now:          time.Now,
+	}
+}
+
+func (s *maintenanceService) GetMaintenanceWindows(ctx context.Context, fieldID uint) ([]domain.MaintenanceWindow, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	if _, err := s.fieldRepo.FindByID(ctx, fieldID); err != nil {
+		return nil, err
+	}
+
+	windows, err := s.windowRepo.FindByField(ctx, fieldID, s.now())
+	if err != nil {
+		logger.Error("failed to find maintenance windows", err, "field_id", fieldID)
+		return nil, err
+	}
+
+	return windows, nil
+}
+
+func (s *maintenanceService) CreateMaintenanceWindow(ctx context.Context, fieldID uint, req *request.CreateMaintenanceWindowRequest, userID uint) (*domain.MaintenanceWindow, []domain.MaintenanceImpact, error) {
+	if req == nil {
+		return nil, nil, errors.New("invalid maintenance window request")
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, nil, fmt.Errorf("context error: %w", err)
+	}
+
+	field, err := s.fieldRepo.FindByID(ctx, fieldID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.authorizer.AuthorizeVenue(ctx, userID, field.Venue.ID, domain.VenueRoleManager); err != nil {
+		return nil, nil, err
+	}
+
+	window := &domain.MaintenanceWindow{
+		FieldID:   field.ID,
+		StartsAt:  req.StartsAt,
+		EndsAt:    req.EndsAt,
+		Reason:    strings.TrimSpace(req.Reason),
+		CreatedBy: userID,
+	}
+
+	if !window.EndsAt.After(window.StartsAt) || !window.EndsAt.After(s.now()) || window.Reason == "" {
+		return nil, nil, domain.ErrInvalidMaintenance
+	}
+
+	var impacts []domain.MaintenanceImpact
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.windowRepo.Create(ctx, window); err != nil {
+			return err
+		}
+
+		impacts, err = s.impacts(ctx, field, *window)
+		if err != nil {
+			return err
+		}
+
+		for _, impact := range impacts {
+			if err := s.notifier.BookingMaintenance(ctx, impact.Booking, *window, impact.Alternatives); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		if !errors.Is(err, domain.ErrMaintenanceOverlap) {
+			logger.Error("failed to create maintenance window", err, "field_id", fieldID)
+		}
+		return nil, nil, err
+	}
+
+	logger.Info("maintenance window created", "maintenance_window_id", window.ID, "field_id", fieldID, "affected_bookings", len(impacts))
+
+	s.publishClosedSlots(ctx, field, *window)
+
+	return window, impacts, nil
+}
+
+func (s *maintenanceService) GetAffectedBookings(ctx context.Context, windowID uint, userID uint) ([]domain.MaintenanceImpact, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	window, field, err := s.findWindow(ctx, windowID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizer.AuthorizeVenue(ctx, userID, field.Venue.ID, domain.VenueRoleStaff); err != nil {
+		return nil, err
+	}
+
+	return s.impacts(ctx, field, window)
+}
+
+func (s *maintenanceService) DeleteMaintenanceWindow(ctx context.Context, windowID uint, userID uint) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("context error: %w", err)
+	}
+
+	_, field, err := s.findWindow(ctx, windowID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.authorizer.AuthorizeVenue(ctx, userID, field.Venue.ID, domain.VenueRoleManager); err != nil {
+		return err
+	}
+
+	if err := s.windowRepo.Delete(ctx, windowID); err != nil {
+		if !errors.Is(err, domain.ErrMaintenanceNotFound) {
+			logger.Error("failed to delete maintenance window", err, "maintenance_window_id", windowID)
+		}
+		return err
+	}
+
+	logger.Info("maintenance window deleted", "maintenance_window_id", windowID, "field_id", field.ID)
+
+	return nil
+}
+
+// publishClosedSlots tells availability streams about every slot of field that
+// has not ended yet and overlaps window. The window is already saved, so a
+// failure is only logged; streams catch up when clients reload.
+func (s *maintenanceService) publishClosedSlots(ctx context.Context, field domain.Field, window domain.MaintenanceWindow) {
+	schedules, err := s.scheduleRepo.FindByFieldID(ctx, field.ID)
+	if err != nil {
+		logger.Error("failed to find schedules closed by maintenance", err, "field_id", field.ID)
+		return
+	}
+
+	now := s.now()
+	loc := field.Venue.Location()
+	from := window.StartsAt
+	if now.After(from) {
+		from = now
+	}
+
+	// A slot starting the day before can run into the window.
+	first := from.In(loc).AddDate(0, 0, -1)
+	last := window.EndsAt.In(loc)
+	for date := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, loc); !date.After(last); date = date.AddDate(0, 0, 1) {
+		day := domain.ScheduleDay(date)
+
+		for _, schedule := range schedules {
+			if schedule.DayOfWeek != day {
+				continue
+			}
+
+			schedule.Field = field
+			slot := domain.AlternativeSlot{Schedule: schedule, Date: date}
+			if !slot.EndsAt().After(now) || !window.Overlaps(slot.StartsAt(), slot.EndsAt()) {
+				continue
+			}
+
+			s.availability.Publish(domain.NewScheduleSlotUpdate(domain.SlotUpdateClosed, schedule, date, now))
+		}
+	}
+}
+
+func (s *maintenanceService) findWindow(ctx context.Context, windowID uint) (domain.MaintenanceWindow, domain.Field, error) {
+	window, err := s.windowRepo.FindByID(ctx, windowID)
+	if err != nil {
+		return domain.MaintenanceWindow{}, domain.Field{}, err
+	}
+
+	field, err := s.fieldRepo.FindByID(ctx, window.FieldID)
+	if err != nil {
+		return domain.MaintenanceWindow{}, domain.Field{}, err
+	}
+
+	return window, field, nil
+}
+
+// impacts finds the pending and confirmed bookings of the field inside window
+// and suggests alternatives for each: free slots of the venue's active fields
+// up to domain.MaintenanceSuggestionDays around the booking, closest in time
+// first.
+func (s *maintenanceService) impacts(ctx context.Context, field domain.Field, window domain.MaintenanceWindow) ([]domain.MaintenanceImpact, error) {
+	loc := field.Venue.Location()
+	fromDate := window.StartsAt.In(loc).AddDate(0, 0, -domain.MaintenanceSuggestionDays)
+	toDate := window.EndsAt.In(loc).AddDate(0, 0, domain.MaintenanceSuggestionDays)
+
+	bookings, err := s.bookingRepo.FindActiveByVenueBetween(ctx, field.Venue.ID, fromDate, toDate)
+	if err != nil {
+		return nil, err
+	}
+
+	var affected []domain.Booking
+	taken := make(map[string]bool, len(bookings))
+	for _, booking := range bookings {
+		taken[slotKey(booking.Schedule.ID, booking.BookingDate)] = true
+
+		if booking.Schedule.Field.ID == field.ID &&
+			(booking.Status == domain.BookingStatusPending || booking.Status == domain.BookingStatusConfirmed) &&
+			window.Overlaps(booking.StartsAtVenue(), booking.EndsAtVenue()) {
+			affected = append(affected, *booking)
+		}
+	}
+
+	if len(affected) == 0 {
+		return []domain.MaintenanceImpact{}, nil
+	}
+
+	schedules, err := s.scheduleRepo.FindByVenueID(ctx, field.Venue.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldIDs := make([]uint, 0)
+	seen := make(map[uint]bool)
+	for _, schedule := range schedules {
+		if !seen[schedule.Field.ID] {
+			seen[schedule.Field.ID] = true
+			fieldIDs = append(fieldIDs, schedule.Field.ID)
+		}
+	}
+
+	// A day of margin on both sides covers the timezone offset of the dates.
+	blocked, err := s.windowRepo.FindOverlapping(ctx, fieldIDs, fromDate.AddDate(0, 0, -1), toDate.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+	blocked = append(blocked, window)
+
+	now := s.now()
+	impacts := make([]domain.MaintenanceImpact, len(affected))
+	for i, booking := range affected {
+		impacts[i] = domain.MaintenanceImpact{
+			Booking:      booking,
+			Alternatives: suggestAlternatives(booking, schedules, taken, blocked, now),
+		}
+	}
+
+	return impacts, nil
+}
+
+func suggestAlternatives(booking domain.Booking, schedules []domain.Schedule, taken map[string]bool, blocked []domain.MaintenanceWindow, now time.Time) []domain.AlternativeSlot {
+	type candidate struct {
+		slot     domain.AlternativeSlot
+		distance time.Duration
+		sameType bool
+	}
+
+	start := booking.StartsAtVenue()
+
+	var candidates []candidate
+	for offset := -domain.MaintenanceSuggestionDays; offset <= domain.MaintenanceSuggestionDays; offset++ {
+		date := booking.BookingDate.AddDate(0, 0, offset)
+		day := domain.ScheduleDay(date)
+
+		for _, schedule := range schedules {
+			if schedule.DayOfWeek != day || !schedule.Field.IsActive() || taken[slotKey(schedule.ID, date)] {
+				continue
+			}
+
+			slot := domain.AlternativeSlot{Schedule: schedule, Date: date}
+			if !slot.StartsAt().After(now) || inMaintenance(blocked, schedule.Field.ID, slot.StartsAt(), slot.EndsAt()) {
+				continue
+			}
+
+			distance := slot.StartsAt().Sub(start)
+			if distance < 0 {
+				distance = -distance
+			}
+
+			candidates = append(candidates, candidate{
+				slot:     slot,
+				distance: distance,
+				sameType: schedule.Field.Type == booking.Schedule.Field.Type,
+			})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].sameType && !candidates[j].sameType
+	})
+
+	alternatives := make([]domain.AlternativeSlot, 0, domain.MaintenanceSuggestionLimit)
+	for _, c := range candidates {
+		if len(alternatives) == domain.MaintenanceSuggestionLimit {
+			break
+		}
+		alternatives = append(alternatives, c.slot)
+	}
+
+	return alternatives
+}
+
+func inMaintenance(windows []domain.MaintenanceWindow, fieldID uint, start, end time.Time) bool {
+	for _, window := range windows {
+		if window.FieldID == fieldID && window.Overlaps(start, end) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func slotKey(scheduleID uint, date time.Time) string {
+	return fmt.Sprintf("%d/%s", scheduleID, date.Format("2006-01-02"))
+}
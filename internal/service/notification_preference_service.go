@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+)
+
+// NotificationPreferenceService manages which channel each notification event
+// is sent on for a user.
+type NotificationPreferenceService interface {
+	// GetPreferences returns one preference per event, EMAIL where none is set.
+	GetPreferences(ctx context.Context, userID uint) ([]domain.NotificationPreference, error)
+	UpdatePreferences(ctx context.Context, userID uint, preferences []domain.NotificationPreference) ([]domain.NotificationPreference, error)
+}
+
+type notificationPreferenceService struct {
+	preferenceRepo repository.NotificationPreferenceRepository
+}
+
+func NewNotificationPreferenceService(preferenceRepo repository.NotificationPreferenceRepository) NotificationPreferenceService {
+	return &notificationPreferenceService{
+		preferenceRepo: preferenceRepo,
+	}
+}
+
+func (s *notificationPreferenceService) GetPreferences(ctx context.Context, userID uint) ([]domain.NotificationPreference, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	saved, err := s.preferenceRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("failed to get notification preferences", err, "user_id", userID)
+		return nil, err
+	}
+
+	channels := make(map[string]string, len(saved))
+	for _, p := range saved {
+		channels[p.Event] = p.Channel
+	}
+
+	preferences := make([]domain.NotificationPreference, len(domain.NotificationEvents))
+	for i, event := range domain.NotificationEvents {
+		channel := channels[event]
+		if channel == "" {
+			channel = domain.NotificationChannelEmail
+		}
+
+		preferences[i] = domain.NotificationPreference{UserID: userID, Event: event, Channel: channel}
+	}
+
+	return preferences, nil
+}
+
+// UpdatePreferences changes only the events given. Choosing WHATSAPP or SMS is
+// allowed before a phone number is verified; email is used until then.
+func (s *notificationPreferenceService) UpdatePreferences(ctx context.Context, userID uint, preferences []domain.NotificationPreference) ([]domain.NotificationPreference, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	for i := range preferences {
+		if !domain.IsNotificationEvent(preferences[i].Event) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrUnknownEvent, preferences[i].Event)
+		}
+		if !domain.IsNotificationChannel(preferences[i].Channel) {
+			return nil, domain.ErrUnknownChannel
+		}
+
+		preferences[i].UserID = userID
+	}
+
+	if err := s.preferenceRepo.Save(ctx, preferences); err != nil {
+		logger.Error("failed to update notification preferences", err, "user_id", userID)
+		return nil, err
+	}
+
+	return s.GetPreferences(ctx, userID)
+}
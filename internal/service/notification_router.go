@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+)
+
+// NotificationRouter queues a user notification on the channel the user chose
+// for the event. It falls back to email when the user has no verified phone
+// number, so choosing WhatsApp or SMS never means missing a notification. Every
+// notification is also stored in the user's in-app inbox.
+type NotificationRouter interface {
+	// Notify sends attachments only when the notification goes by email.
+	Notify(ctx context.Context, user domain.User, event string, data any, attachments ...domain.EmailAttachment) error
+}
+
+type notificationRouter struct {
+	outbox         OutboxService
+	emailTemplates EmailTemplateService
+	preferenceRepo repository.NotificationPreferenceRepository
+	inbox          InboxService
+}
+
+func NewNotificationRouter(outbox OutboxService, emailTemplates EmailTemplateService, preferenceRepo repository.NotificationPreferenceRepository, inbox InboxService) NotificationRouter {
+	return &notificationRouter{
+		outbox:         outbox,
+		emailTemplates: emailTemplates,
+		preferenceRepo: preferenceRepo,
+		inbox:          inbox,
+	}
+}
+
+func (r *notificationRouter) Notify(ctx context.Context, user domain.User, event string, data any, attachments ...domain.EmailAttachment) error {
+	if err := r.send(ctx, user, event, data, attachments); err != nil {
+		return err
+	}
+
+	return r.inbox.Notify(ctx, user, event, data)
+}
+
+func (r *notificationRouter) send(ctx context.Context, user domain.User, event string, data any, attachments []domain.EmailAttachment) error {
+	channel, err := r.channelFor(ctx, user, event)
+	if err != nil {
+		return err
+	}
+
+	if channel != domain.NotificationChannelEmail {
+		body, err := r.emailTemplates.RenderTextMessage(event, user.Language, data)
+		if err != nil {
+			return err
+		}
+
+		return r.outbox.EnqueueTextMessage(ctx, domain.TextMessage{
+			Channel: channel,
+			To:      user.PhoneNumber,
+			Body:    body,
+		})
+	}
+
+	email, err := r.emailTemplates.Render(event, user.Language, data)
+	if err != nil {
+		return err
+	}
+
+	email.To = []domain.EmailAddress{{Name: user.FullName, Email: user.Email}}
+	email.Attachments = attachments
+
+	return r.outbox.EnqueueEmail(ctx, email)
+}
+
+func (r *notificationRouter) channelFor(ctx context.Context, user domain.User, event string) (string, error) {
+	if !domain.IsNotificationEvent(event) || !user.HasVerifiedPhone() {
+		return domain.NotificationChannelEmail, nil
+	}
+
+	channel, err := r.preferenceRepo.FindChannel(ctx, user.ID, event)
+	if err != nil {
+		logger.Error("failed to find notification preference", err, "user_id", user.ID, "event", event)
+		return "", err
+	}
+
+	if channel == "" {
+		return domain.NotificationChannelEmail, nil
+	}
+
+	return channel, nil
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+	"go-futsal-booking-api/pkg/utils"
+	"strings"
+	"time"
+)
+
+type OIDCService interface {
+	// BeginLogin stores a fresh state, nonce and PKCE verifier and returns the
+	// provider URL the user agent should be sent to.
+	BeginLogin(ctx context.Context) (string, error)
+	CompleteLogin(ctx context.Context, state, code string) (*LoginResult, error)
+}
+
+const oidcStateTTL = 10 * time.Minute
+
+type oidcService struct {
+	provider     repository.OIDCProvider
+	stateRepo    repository.OIDCStateRepository
+	identityRepo repository.UserIdentityRepository
+	userRepo     repository.UserRepository
+	roleRepo     repository.RoleRepository
+	userService  UserService
+}
+
+// NewOIDCService builds the single sign-on flow. provider may be nil when no
+// issuer is configured, in which case every call fails with ErrOIDCNotConfigured.
+func NewOIDCService(
+	provider repository.OIDCProvider,
+	stateRepo repository.OIDCStateRepository,
+	identityRepo repository.UserIdentityRepository,
+	userRepo repository.UserRepository,
+	roleRepo repository.RoleRepository,
+	userService UserService,
+) OIDCService {
+	return &oidcService{
+		provider:     provider,
+		stateRepo:    stateRepo,
+		identityRepo: identityRepo,
+		userRepo:     userRepo,
+		roleRepo:     roleRepo,
+		userService:  userService,
+	}
+}
+
+func (s *oidcService) BeginLogin(ctx context.Context) (string, error) {
+	if s.provider == nil {
+		return "", domain.ErrOIDCNotConfigured
+	}
+
+	if err := ctx.Err(); err != nil {
+		return "", fmt.Errorf("context error: %w", err)
+	}
+
+	if err := s.stateRepo.DeleteExpired(ctx, time.Now()); err != nil {
+		logger.Warn("failed to prune expired oidc states", err)
+	}
+
+	state, err := randomURLToken(32)
+	if err != nil {
+		return "", err
+	}
+	nonce, err := randomURLToken(32)
+	if err != nil {
+		return "", err
+	}
+	codeVerifier, err := randomURLToken(32)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.stateRepo.Create(ctx, domain.OIDCState{
+		State:        state,
+		CodeVerifier: codeVerifier,
+		Nonce:        nonce,
+		ExpiresAt:    time.Now().Add(oidcStateTTL),
+	}); err != nil {
+		logger.Error("failed to store oidc state", err)
+		return "", err
+	}
+
+	authURL, err := s.provider.AuthCodeURL(ctx, state, nonce, pkceChallenge(codeVerifier))
+	if err != nil {
+		logger.Error("failed to build oidc authorization url", err)
+		return "", err
+	}
+
+	return authURL, nil
+}
+
+func (s *oidcService) CompleteLogin(ctx context.Context, state, code string) (*LoginResult, error) {
+	if s.provider == nil {
+		return nil, domain.ErrOIDCNotConfigured
+	}
+
+	if state == "" || code == "" {
+		return nil, domain.ErrInvalidOIDCState
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	storedState, err := s.stateRepo.Consume(ctx, state)
+	if err != nil {
+		logger.Warn("oidc state rejected", "error", err)
+		return nil, domain.ErrInvalidOIDCState
+	}
+
+	claims, err := s.provider.Exchange(ctx, code, storedState.CodeVerifier)
+	if err != nil {
+		logger.Error("failed to exchange oidc code", err)
+		return nil, errors.New("failed to sign in with identity provider")
+	}
+
+	if claims.Nonce != storedState.Nonce {
+		logger.Warn("oidc nonce mismatch", "subject", claims.Subject)
+		return nil, domain.ErrInvalidOIDCState
+	}
+
+	user, err := s.resolveUser(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("oidc login", "provider", s.provider.Name(), "user_id", user.ID)
+
+	return s.userService.IssueLogin(ctx, user)
+}
+
+// resolveUser returns the user linked to the identity, linking it to an existing
+// verified account with the same email or creating a new verified account first.
+func (s *oidcService) resolveUser(ctx context.Context, claims domain.OIDCClaims) (domain.User, error) {
+	provider := s.provider.Name()
+
+	identity, err := s.identityRepo.FindByProviderSubject(ctx, provider, claims.Subject)
+	if err == nil {
+		return s.userRepo.FindByID(ctx, identity.UserID)
+	}
+	if !errors.Is(err, domain.ErrIdentityNotFound) {
+		logger.Error("failed to find linked identity", err)
+		return domain.User{}, err
+	}
+
+	// Only an address the provider has verified may claim an existing account.
+	if claims.Email == "" || !claims.EmailVerified {
+		return domain.User{}, domain.ErrOIDCEmailNotVerified
+	}
+
+	user, err := s.userRepo.FindByEmail(ctx, claims.Email)
+	if err != nil {
+		user, err = s.createUser(ctx, claims)
+		if err != nil {
+			return domain.User{}, err
+		}
+	} else if !user.IsVerified {
+		// Anyone can register an address they do not own; linking that account
+		// would hand its password holder the identity owner's login.
+		logger.Warn("refused to link unverified account", "provider", provider, "user_id", user.ID)
+		return domain.User{}, domain.ErrOIDCAccountUnverified
+	}
+
+	if err := s.identityRepo.Create(ctx, &domain.UserIdentity{
+		UserID:   user.ID,
+		Provider: provider,
+		Subject:  claims.Subject,
+		Email:    claims.Email,
+	}); err != nil {
+		logger.Error("failed to link identity", err)
+		return domain.User{}, err
+	}
+
+	return user, nil
+}
+
+func (s *oidcService) createUser(ctx context.Context, claims domain.OIDCClaims) (domain.User, error) {
+	customerRole, err := s.roleRepo.FindByName(ctx, domain.RoleCustomer)
+	if err != nil {
+		logger.Error("Failed to find default customer role", err)
+		return domain.User{}, errors.New("failed to assign default role")
+	}
+
+	// The account has no usable password until the user sets one.
+	unusable, err := randomURLToken(32)
+	if err != nil {
+		return domain.User{}, err
+	}
+	passwordHash, err := utils.HashPassword(unusable)
+	if err != nil {
+		logger.Error("Failed to hash password", err)
+		return domain.User{}, errors.New("failed to hash password")
+	}
+
+	fullName := strings.TrimSpace(claims.Name)
+	if fullName == "" {
+		fullName = strings.Split(claims.Email, "@")[0]
+	}
+
+	newUser := domain.User{
+		FullName:   fullName,
+		Email:      claims.Email,
+		Password:   passwordHash,
+		IsVerified: true,
+		Role:       customerRole,
+	}
+
+	if err := s.userRepo.Create(ctx, &newUser); err != nil {
+		logger.Error("Failed to create new user", err)
+		return domain.User{}, err
+	}
+
+	return newUser, nil
+}
+
+func randomURLToken(size int) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate random token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// pkceChallenge derives the S256 code challenge from a PKCE code verifier.
+func pkceChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+	"time"
+)
+
+// OutboxHandler delivers one message. Returning a *domain.NotificationError with
+// Retryable false sends the message straight to DEAD; any other error is retried.
+type OutboxHandler func(ctx context.Context, payload json.RawMessage) error
+
+// OutboxDispatcher polls the outbox and hands due messages to the handler
+// registered for their topic. Several instances can run side by side.
+type OutboxDispatcher struct {
+	outboxRepo repository.OutboxRepository
+	config     OutboxConfig
+	handlers   map[string]OutboxHandler
+	now        func() time.Time
+}
+
+func NewOutboxDispatcher(outboxRepo repository.OutboxRepository, config OutboxConfig) *OutboxDispatcher {
+	return &OutboxDispatcher{
+		outboxRepo: outboxRepo,
+		config:     config,
+		handlers:   make(map[string]OutboxHandler),
+		now:        time.Now,
+	}
+}
+
+// Handle registers the handler for a topic. It must be called before Run.
+func (d *OutboxDispatcher) Handle(topic string, handler OutboxHandler) {
+	d.handlers[topic] = handler
+}
+
+// Run dispatches until ctx is cancelled. A message being delivered when that
+// happens is allowed to finish.
+func (d *OutboxDispatcher) Run(ctx context.Context) {
+	logger.Info("outbox dispatcher started", "poll_interval", d.config.PollInterval.String())
+
+	ticker := time.NewTicker(d.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Keep draining while batches come back full.
+		for {
+			n, err := d.DispatchBatch(ctx)
+			if err != nil {
+				logger.Error("outbox dispatch failed", err)
+			}
+			if err != nil || n < d.config.BatchSize || ctx.Err() != nil {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info("outbox dispatcher stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchBatch claims and delivers one batch, returning how many were claimed.
+func (d *OutboxDispatcher) DispatchBatch(ctx context.Context) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, nil
+	}
+
+	messages, err := d.outboxRepo.Claim(ctx, d.now(), d.config.BatchSize, d.config.Lease)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, message := range messages {
+		d.dispatch(context.WithoutCancel(ctx), message)
+	}
+
+	return len(messages), nil
+}
+
+func (d *OutboxDispatcher) dispatch(ctx context.Context, message domain.OutboxMessage) {
+	handler, ok := d.handlers[message.Topic]
+	if !ok {
+		d.markDead(ctx, message, domain.ErrUnknownOutboxTopic)
+		return
+	}
+
+	deliveryCtx, cancel := context.WithTimeout(ctx, d.config.Lease)
+	err := handler(deliveryCtx, message.Payload)
+	cancel()
+
+	if err == nil {
+		if err := d.outboxRepo.MarkSent(ctx, message.ID, d.now()); err != nil {
+			logger.Error("failed to mark outbox message sent", err, "message_id", message.ID)
+		}
+		return
+	}
+
+	if !domain.IsRetryableNotification(err) || message.Attempts >= message.MaxAttempts {
+		d.markDead(ctx, message, err)
+		return
+	}
+
+	nextAttemptAt := d.now().Add(d.backoff(message.Attempts))
+	logger.Warn("outbox delivery failed, will retry",
+		"message_id", message.ID, "topic", message.Topic, "attempt", message.Attempts,
+		"next_attempt_at", nextAttemptAt, "error", err)
+
+	if err := d.outboxRepo.MarkRetry(ctx, message.ID, nextAttemptAt, err.Error()); err != nil {
+		logger.Error("failed to reschedule outbox message", err, "message_id", message.ID)
+	}
+}
+
+func (d *OutboxDispatcher) markDead(ctx context.Context, message domain.OutboxMessage, cause error) {
+	logger.Error("outbox message moved to dead letter", cause,
+		"message_id", message.ID, "topic", message.Topic, "attempts", message.Attempts)
+
+	if err := d.outboxRepo.MarkDead(ctx, message.ID, cause.Error()); err != nil {
+		logger.Error("failed to mark outbox message dead", err, "message_id", message.ID)
+	}
+}
+
+// backoff doubles BaseDelay for every attempt after the first, capped at MaxDelay.
+func (d *OutboxDispatcher) backoff(attempt int) time.Duration {
+	delay := d.config.BaseDelay
+	for i := 1; i < attempt && delay < d.config.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, d.config.MaxDelay)
+}
+
+// NewEmailOutboxHandler delivers domain.OutboxTopicEmail messages.
+func NewEmailOutboxHandler(notifRepo repository.NotificationRepository) OutboxHandler {
+	return func(ctx context.Context, payload json.RawMessage) error {
+		var email domain.Email
+		if err := json.Unmarshal(payload, &email); err != nil {
+			return &domain.NotificationError{Provider: "outbox", Err: fmt.Errorf("invalid email payload: %w", err)}
+		}
+
+		return notifRepo.Send(ctx, email)
+	}
+}
+
+// NewTextMessageOutboxHandler delivers domain.OutboxTopicTextMessage messages.
+func NewTextMessageOutboxHandler(textRepo repository.TextMessageRepository) OutboxHandler {
+	return func(ctx context.Context, payload json.RawMessage) error {
+		var message domain.TextMessage
+		if err := json.Unmarshal(payload, &message); err != nil {
+			return &domain.NotificationError{Provider: "outbox", Err: fmt.Errorf("invalid text message payload: %w", err)}
+		}
+
+		return textRepo.Send(ctx, message)
+	}
+}
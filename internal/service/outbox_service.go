@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+	"time"
+)
+
+type OutboxConfig struct {
+	MaxAttempts  int
+	BaseDelay    time.Duration
+	MaxDelay     time.Duration
+	PollInterval time.Duration
+	BatchSize    int
+	// Lease is how long a claimed message is hidden from other dispatchers. It
+	// also bounds how long a single delivery may take.
+	Lease time.Duration
+}
+
+// OutboxService queues messages for the dispatcher and lets admins inspect and
+// replay them.
+type OutboxService interface {
+	// Enqueue stores payload as JSON. Call it with the context given by
+	// TransactionManager.WithinTransaction to tie the message to that transaction.
+	Enqueue(ctx context.Context, topic string, payload any) error
+	EnqueueEmail(ctx context.Context, email domain.Email) error
+	EnqueueTextMessage(ctx context.Context, message domain.TextMessage) error
+	GetMessages(ctx context.Context, filter domain.OutboxFilter) ([]domain.OutboxMessage, int64, error)
+	GetMessageByID(ctx context.Context, id uint) (*domain.OutboxMessage, error)
+	Replay(ctx context.Context, id uint) (*domain.OutboxMessage, error)
+}
+
+type outboxService struct {
+	outboxRepo repository.OutboxRepository
+	config     OutboxConfig
+}
+
+func NewOutboxService(outboxRepo repository.OutboxRepository, config OutboxConfig) OutboxService {
+	return &outboxService{
+		outboxRepo: outboxRepo,
+		config:     config,
+	}
+}
+
+func (s *outboxService) Enqueue(ctx context.Context, topic string, payload any) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("context error: %w", err)
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode outbox payload: %w", err)
+	}
+
+	message := &domain.OutboxMessage{
+		Topic:         topic,
+		Payload:       body,
+		Status:        domain.OutboxStatusPending,
+		MaxAttempts:   s.config.MaxAttempts,
+		NextAttemptAt: time.Now(),
+	}
+
+	if err := s.outboxRepo.Create(ctx, message); err != nil {
+		logger.Error("failed to enqueue outbox message", err, "topic", topic)
+		return err
+	}
+
+	return nil
+}
+
+func (s *outboxService) EnqueueEmail(ctx context.Context, email domain.Email) error {
+	if len(email.Recipients()) == 0 {
+		return domain.ErrInvalidEmail
+	}
+
+	return s.Enqueue(ctx, domain.OutboxTopicEmail, email)
+}
+
+func (s *outboxService) EnqueueTextMessage(ctx context.Context, message domain.TextMessage) error {
+	if message.To == "" || message.Body == "" {
+		return domain.ErrInvalidTextMessage
+	}
+
+	return s.Enqueue(ctx, domain.OutboxTopicTextMessage, message)
+}
+
+func (s *outboxService) GetMessages(ctx context.Context, filter domain.OutboxFilter) ([]domain.OutboxMessage, int64, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, 0, fmt.Errorf("context error: %w", err)
+	}
+
+	if filter.Status != "" && filter.Status != domain.OutboxStatusPending &&
+		filter.Status != domain.OutboxStatusSent && filter.Status != domain.OutboxStatusDead {
+		return nil, 0, errors.New("invalid outbox status")
+	}
+
+	return s.outboxRepo.FindAll(ctx, filter)
+}
+
+func (s *outboxService) GetMessageByID(ctx context.Context, id uint) (*domain.OutboxMessage, error) {
+	if id == 0 {
+		return nil, errors.New("invalid outbox message id")
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	message, err := s.outboxRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &message, nil
+}
+
+func (s *outboxService) Replay(ctx context.Context, id uint) (*domain.OutboxMessage, error) {
+	if id == 0 {
+		return nil, errors.New("invalid outbox message id")
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	if err := s.outboxRepo.Replay(ctx, id, time.Now()); err != nil {
+		return nil, err
+	}
+
+	logger.Info("outbox message replayed", "message_id", id)
+
+	return s.GetMessageByID(ctx, id)
+}
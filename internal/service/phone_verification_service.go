@@ -0,0 +1,242 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+	"math/big"
+	"time"
+)
+
+type PhoneVerificationConfig struct {
+	// Channel is how codes are sent, WHATSAPP or SMS.
+	Channel        string
+	CodeTTL        time.Duration
+	ResendCooldown time.Duration
+	// MaxAttempts is how many wrong codes are accepted before a new one is needed.
+	MaxAttempts int
+}
+
+// PhoneVerificationService adds a phone number to a user once they prove they
+// own it with a one-time code sent to that number.
+type PhoneVerificationService interface {
+	RequestCode(ctx context.Context, userID uint, phoneNumber string) (*domain.PhoneVerification, error)
+	VerifyCode(ctx context.Context, userID uint, code string) (domain.User, error)
+	RemovePhone(ctx context.Context, userID uint) error
+}
+
+type phoneVerificationService struct {
+	userRepo         repository.UserRepository
+	verificationRepo repository.PhoneVerificationRepository
+	txManager        repository.TransactionManager
+	outbox           OutboxService
+	emailTemplates   EmailTemplateService
+	inbox            InboxService
+	appName          string
+	config           PhoneVerificationConfig
+	now              func() time.Time
+}
+
+func NewPhoneVerificationService(
+	userRepo repository.UserRepository,
+	verificationRepo repository.PhoneVerificationRepository,
+	txManager repository.TransactionManager,
+	outbox OutboxService,
+	emailTemplates EmailTemplateService,
+	inbox InboxService,
+	appName string,
+	config PhoneVerificationConfig,
+) PhoneVerificationService {
+	return &phoneVerificationService{
+		userRepo:         userRepo,
+		verificationRepo: verificationRepo,
+		txManager:        txManager,
+		outbox:           outbox,
+		emailTemplates:   emailTemplates,
+		inbox:            inbox,
+		appName:          appName,
+		config:           config,
+		now:              time.Now,
+	}
+}
+
+func (s *phoneVerificationService) RequestCode(ctx context.Context, userID uint, phoneNumber string) (*domain.PhoneVerification, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	phoneNumber, err := domain.NormalizePhoneNumber(phoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		logger.Error("user not found", err)
+		return nil, domain.ErrUserNotFound
+	}
+
+	if err := s.ensurePhoneAvailable(ctx, userID, phoneNumber); err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	pending, err := s.verificationRepo.FindByUserID(ctx, userID)
+	if err == nil && now.Before(pending.CreatedAt.Add(s.config.ResendCooldown)) {
+		return nil, domain.ErrPhoneCodeCooldown
+	}
+	if err != nil && !errors.Is(err, domain.ErrInvalidPhoneCode) {
+		return nil, err
+	}
+
+	code, err := generatePhoneCode()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate verification code: %w", err)
+	}
+
+	body, err := s.emailTemplates.RenderTextMessage(domain.TextMessageTemplatePhoneVerification, user.Language, PhoneVerificationMessageData{
+		AppName:          s.appName,
+		Code:             code,
+		ExpiresInMinutes: int(s.config.CodeTTL.Minutes()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	verification := &domain.PhoneVerification{
+		UserID:      userID,
+		PhoneNumber: phoneNumber,
+		CodeHash:    hashPhoneCode(userID, phoneNumber, code),
+		ExpiresAt:   now.Add(s.config.CodeTTL),
+		CreatedAt:   now,
+	}
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.verificationRepo.Save(ctx, verification); err != nil {
+			return err
+		}
+
+		return s.outbox.EnqueueTextMessage(ctx, domain.TextMessage{
+			Channel: s.config.Channel,
+			To:      phoneNumber,
+			Body:    body,
+		})
+	})
+	if err != nil {
+		logger.Error("failed to send phone verification code", err, "user_id", userID)
+		return nil, err
+	}
+
+	return verification, nil
+}
+
+func (s *phoneVerificationService) VerifyCode(ctx context.Context, userID uint, code string) (domain.User, error) {
+	if err := ctx.Err(); err != nil {
+		return domain.User{}, fmt.Errorf("context error: %w", err)
+	}
+
+	verification, err := s.verificationRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	now := s.now()
+	if now.After(verification.ExpiresAt) || verification.Attempts >= s.config.MaxAttempts {
+		return domain.User{}, domain.ErrInvalidPhoneCode
+	}
+
+	expected := hashPhoneCode(userID, verification.PhoneNumber, code)
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(verification.CodeHash)) != 1 {
+		if err := s.verificationRepo.IncrementAttempts(ctx, userID); err != nil {
+			logger.Error("failed to record phone verification attempt", err)
+		}
+		logger.Warn("invalid phone verification code", "user_id", userID)
+		return domain.User{}, domain.ErrInvalidPhoneCode
+	}
+
+	if err := s.ensurePhoneAvailable(ctx, userID, verification.PhoneNumber); err != nil {
+		return domain.User{}, err
+	}
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.userRepo.UpdatePhone(ctx, userID, verification.PhoneNumber, &now); err != nil {
+			return err
+		}
+
+		return s.verificationRepo.Delete(ctx, userID)
+	})
+	if err != nil {
+		logger.Error("failed to save verified phone number", err, "user_id", userID)
+		return domain.User{}, err
+	}
+
+	logger.Info("phone number verified", "user_id", userID)
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	err = s.inbox.Notify(ctx, user, domain.InboxEventPhoneVerified, AccountInboxData{
+		FullName:    user.FullName,
+		PhoneNumber: user.PhoneNumber,
+	})
+	if err != nil {
+		logger.Warn("failed to store phone verified notification", "user_id", userID, "error", err)
+	}
+
+	return user, nil
+}
+
+func (s *phoneVerificationService) RemovePhone(ctx context.Context, userID uint) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("context error: %w", err)
+	}
+
+	if err := s.userRepo.UpdatePhone(ctx, userID, "", nil); err != nil {
+		logger.Error("failed to remove phone number", err, "user_id", userID)
+		return err
+	}
+
+	return nil
+}
+
+func (s *phoneVerificationService) ensurePhoneAvailable(ctx context.Context, userID uint, phoneNumber string) error {
+	owner, err := s.userRepo.FindByPhoneNumber(ctx, phoneNumber)
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if owner.ID != userID {
+		return domain.ErrPhoneNumberTaken
+	}
+
+	return nil
+}
+
+func generatePhoneCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+// hashPhoneCode binds a code to the user and number it was sent for. Six digits
+// are guessable offline, so MaxAttempts and CodeTTL are what protect them.
+func hashPhoneCode(userID uint, phoneNumber, code string) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d:%s:%s", userID, phoneNumber, code)))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"bytes"
+	"go-futsal-booking-api/internal/domain"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	_ "image/png"
+	"net/http"
+)
+
+const thumbnailQuality = 82
+
+// decodePhoto checks that data is a JPEG or PNG image of a sensible size by its
+// content, not by what the client claims, and decodes it.
+func decodePhoto(data []byte) (image.Image, string, error) {
+	contentType := http.DetectContentType(data)
+	if _, ok := domain.PhotoExtensions[contentType]; !ok {
+		return nil, "", domain.ErrUnsupportedPhoto
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || config.Width <= 0 || config.Height <= 0 ||
+		config.Width*config.Height > domain.PhotoMaxPixels {
+		return nil, "", domain.ErrUnsupportedPhoto
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", domain.ErrUnsupportedPhoto
+	}
+
+	return img, contentType, nil
+}
+
+// thumbnailJPEG scales img down so its longest side is at most size pixels and
+// encodes it as JPEG. Transparent areas become white.
+func thumbnailJPEG(img image.Image, size int) ([]byte, error) {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width > size || height > size {
+		if width >= height {
+			width, height = size, max(1, height*size/width)
+		} else {
+			width, height = max(1, width*size/height), size
+		}
+	}
+
+	src := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(src, src.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(src, src.Bounds(), img, bounds.Min, draw.Over)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, shrink(src, width, height), &jpeg.Options{Quality: thumbnailQuality}); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// shrink resizes src to width x height by averaging the source pixels that
+// fall in each target pixel, which keeps downscaled photos free of aliasing.
+func shrink(src *image.RGBA, width, height int) *image.RGBA {
+	srcWidth, srcHeight := src.Bounds().Dx(), src.Bounds().Dy()
+	if width == srcWidth && height == srcHeight {
+		return src
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0, y1 := y*srcHeight/height, max((y+1)*srcHeight/height, y*srcHeight/height+1)
+		for x := 0; x < width; x++ {
+			x0, x1 := x*srcWidth/width, max((x+1)*srcWidth/width, x*srcWidth/width+1)
+
+			var sum [4]int
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride+x0*4 : sy*src.Stride+x1*4]
+				for i := 0; i < len(row); i += 4 {
+					sum[0] += int(row[i])
+					sum[1] += int(row[i+1])
+					sum[2] += int(row[i+2])
+					sum[3] += int(row[i+3])
+				}
+			}
+
+			n := (y1 - y0) * (x1 - x0)
+			offset := y*dst.Stride + x*4
+			for i := range sum {
+				dst.Pix[offset+i] = uint8(sum[i] / n)
+			}
+		}
+	}
+
+	return dst
+}
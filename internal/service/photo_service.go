@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+	"io"
+	"time"
+)
+
+// PhotoService manages the photo galleries of venues and fields. Originals
+// and thumbnails are kept in blob storage; the database holds their keys and
+// the gallery order.
+type PhotoService interface {
+	// VenueGallery and FieldGallery resolve the gallery of an existing venue
+	// or field.
+	VenueGallery(ctx context.Context, venueID uint) (domain.PhotoGallery, error)
+	FieldGallery(ctx context.Context, fieldID uint) (domain.PhotoGallery, error)
+	GetPhotos(ctx context.Context, gallery domain.PhotoGallery) ([]domain.Photo, error)
+	// UploadPhoto reads a JPEG or PNG image from r and appends it to the
+	// gallery. The caller must be a manager of the venue.
+	UploadPhoto(ctx context.Context, gallery domain.PhotoGallery, r io.Reader, userID uint) (*domain.Photo, error)
+	ReorderPhotos(ctx context.Context, gallery domain.PhotoGallery, photoIDs []uint, userID uint) ([]domain.Photo, error)
+	SetCoverPhoto(ctx context.Context, photoID, userID uint) (*domain.Photo, error)
+	DeletePhoto(ctx context.Context, photoID, userID uint) error
+}
+
+// PhotoConfig limits uploads. MaxBytes defaults to 10 MiB.
+type PhotoConfig struct {
+	MaxBytes int64
+}
+
+type photoService struct {
+	photoRepo  repository.PhotoRepository
+	venueRepo  repository.VenueRepository
+	fieldRepo  repository.FieldRepository
+	storage    repository.BlobStorage
+	authorizer Authorizer
+	config     PhotoConfig
+}
+
+func NewPhotoService(photoRepo repository.PhotoRepository, venueRepo repository.VenueRepository, fieldRepo repository.FieldRepository, storage repository.BlobStorage, authorizer Authorizer, config PhotoConfig) PhotoService {
+	if config.MaxBytes <= 0 {
+		config.MaxBytes = 10 << 20
+	}
+
+	return &photoService{
+		photoRepo:  photoRepo,
+		venueRepo:  venueRepo,
+		fieldRepo:  fieldRepo,
+		storage:    storage,
+		authorizer: authorizer,
+		config:     config,
+	}
+}
+
+func (s *photoService) VenueGallery(ctx context.Context, venueID uint) (domain.PhotoGallery, error) {
+	if err := ctx.Err(); err != nil {
+		return domain.PhotoGallery{}, fmt.Errorf("context error: %w", err)
+	}
+
+	if _, err := s.venueRepo.FindByID(ctx, venueID); err != nil {
+		return domain.PhotoGallery{}, domain.ErrVenueNotFound
+	}
+
+	return domain.PhotoGallery{VenueID: venueID}, nil
+}
+
+func (s *photoService) FieldGallery(ctx context.Context, fieldID uint) (domain.PhotoGallery, error) {
+	if err := ctx.Err(); err != nil {
+		return domain.PhotoGallery{}, fmt.Errorf("context error: %w", err)
+	}
+
+	field, err := s.fieldRepo.FindByID(ctx, fieldID)
+	if err != nil {
+		return domain.PhotoGallery{}, domain.ErrFieldNotFound
+	}
+
+	return domain.PhotoGallery{VenueID: field.Venue.ID, FieldID: &field.ID}, nil
+}
+
+func (s *photoService) GetPhotos(ctx context.Context, gallery domain.PhotoGallery) ([]domain.Photo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	photos, err := s.photoRepo.FindByGallery(ctx, gallery)
+	if err != nil {
+		logger.Error("failed to find photos", err, "venue_id", gallery.VenueID)
+		return nil, err
+	}
+
+	for i := range photos {
+		s.withURLs(&photos[i])
+	}
+
+	return photos, nil
+}
+
+func (s *photoService) UploadPhoto(ctx context.Context, gallery domain.PhotoGallery, r io.Reader, userID uint) (*domain.Photo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	if err := s.authorizer.AuthorizeVenue(ctx, userID, gallery.VenueID, domain.VenueRoleManager); err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r, s.config.MaxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read photo: %w", err)
+	}
+	if int64(len(data)) > s.config.MaxBytes {
+		return nil, domain.ErrPhotoTooLarge
+	}
+
+	img, contentType, err := decodePhoto(data)
+	if err != nil {
+		return nil, err
+	}
+
+	thumbnail, err := thumbnailJPEG(img, domain.PhotoThumbnailSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create thumbnail: %w", err)
+	}
+
+	name, err := photoName()
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := photoKeyPrefix(gallery)
+	photo := &domain.Photo{
+		VenueID:      gallery.VenueID,
+		FieldID:      gallery.FieldID,
+		StorageKey:   prefix + name + domain.PhotoExtensions[contentType],
+		ThumbnailKey: prefix + name + "_thumb.jpg",
+		ContentType:  contentType,
+		Width:        img.Bounds().Dx(),
+		Height:       img.Bounds().Dy(),
+		SizeBytes:    int64(len(data)),
+		UploadedBy:   userID,
+		CreatedAt:    time.Now(),
+	}
+
+	if err := s.storage.Put(ctx, photo.StorageKey, data, contentType); err != nil {
+		logger.Error("failed to store photo", err, "venue_id", gallery.VenueID)
+		return nil, err
+	}
+	if err := s.storage.Put(ctx, photo.ThumbnailKey, thumbnail, "image/jpeg"); err != nil {
+		logger.Error("failed to store photo thumbnail", err, "venue_id", gallery.VenueID)
+		s.removeFiles(ctx, photo.StorageKey)
+		return nil, err
+	}
+
+	if err := s.photoRepo.Create(ctx, photo); err != nil {
+		logger.Error("failed to create photo", err, "venue_id", gallery.VenueID)
+		s.removeFiles(ctx, photo.StorageKey, photo.ThumbnailKey)
+		return nil, err
+	}
+
+	logger.Info("photo uploaded", "photo_id", photo.ID, "venue_id", photo.VenueID, "user_id", userID)
+
+	return s.withURLs(photo), nil
+}
+
+func (s *photoService) ReorderPhotos(ctx context.Context, gallery domain.PhotoGallery, photoIDs []uint, userID uint) ([]domain.Photo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	if err := s.authorizer.AuthorizeVenue(ctx, userID, gallery.VenueID, domain.VenueRoleManager); err != nil {
+		return nil, err
+	}
+
+	if err := s.photoRepo.Reorder(ctx, gallery, photoIDs); err != nil {
+		return nil, err
+	}
+
+	return s.GetPhotos(ctx, gallery)
+}
+
+func (s *photoService) SetCoverPhoto(ctx context.Context, photoID, userID uint) (*domain.Photo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	photo, err := s.photoRepo.FindByID(ctx, photoID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizer.AuthorizeVenue(ctx, userID, photo.VenueID, domain.VenueRoleManager); err != nil {
+		return nil, err
+	}
+
+	if err := s.photoRepo.SetCover(ctx, photoID); err != nil {
+		return nil, err
+	}
+	photo.IsCover = true
+
+	return s.withURLs(&photo), nil
+}
+
+func (s *photoService) DeletePhoto(ctx context.Context, photoID, userID uint) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("context error: %w", err)
+	}
+
+	photo, err := s.photoRepo.FindByID(ctx, photoID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.authorizer.AuthorizeVenue(ctx, userID, photo.VenueID, domain.VenueRoleManager); err != nil {
+		return err
+	}
+
+	if err := s.photoRepo.Delete(ctx, photoID); err != nil {
+		return err
+	}
+
+	// The row is gone, so a file left behind is only wasted space.
+	s.removeFiles(ctx, photo.StorageKey, photo.ThumbnailKey)
+
+	logger.Info("photo deleted", "photo_id", photoID, "venue_id", photo.VenueID, "user_id", userID)
+
+	return nil
+}
+
+func (s *photoService) withURLs(photo *domain.Photo) *domain.Photo {
+	photo.URL = s.storage.URL(photo.StorageKey)
+	photo.ThumbnailURL = s.storage.URL(photo.ThumbnailKey)
+
+	return photo
+}
+
+func (s *photoService) removeFiles(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if err := s.storage.Delete(ctx, key); err != nil {
+			logger.Warn("failed to delete photo file", "key", key, "error", err)
+		}
+	}
+}
+
+func photoKeyPrefix(gallery domain.PhotoGallery) string {
+	if gallery.FieldID != nil {
+		return fmt.Sprintf("venues/%d/fields/%d/photos/", gallery.VenueID, *gallery.FieldID)
+	}
+
+	return fmt.Sprintf("venues/%d/photos/", gallery.VenueID)
+}
+
+// photoName is random so that URLs of deleted photos are never reused and
+// cannot be guessed from other photos.
+func photoName() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate photo name: %w", err)
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/dto/request"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+	"strings"
+	"time"
+)
+
+// ReviewService manages venue reviews. Customers review a venue once per
+// completed booking there, the venue's owner can reply, and moderators can
+// hide or delete reviews.
+type ReviewService interface {
+	CreateReview(ctx context.Context, venueID uint, req *request.CreateReviewRequest, userID uint) (*domain.Review, error)
+	// GetVenueReviews returns a page of the venue's published reviews, newest
+	// first, and how many there are in total.
+	GetVenueReviews(ctx context.Context, venueID uint, filter domain.ReviewFilter) ([]domain.Review, int64, error)
+	// ReplyToReview replaces any earlier reply. Requires OWNER membership of the
+	// venue.
+	ReplyToReview(ctx context.Context, reviewID uint, reply string, userID uint) (*domain.Review, error)
+	ModerateReview(ctx context.Context, reviewID uint, status string) (*domain.Review, error)
+	DeleteReview(ctx context.Context, reviewID uint) error
+}
+
+type reviewService struct {
+	reviewRepo  repository.ReviewRepository
+	venueRepo   repository.VenueRepository
+	bookingRepo repository.BookingRepository
+	authorizer  Authorizer
+	now         func() time.Time
+}
+
+func NewReviewService(reviewRepo repository.ReviewRepository, venueRepo repository.VenueRepository, bookingRepo repository.BookingRepository, authorizer Authorizer) ReviewService {
+	return &reviewService{
+		reviewRepo:  reviewRepo,
+		venueRepo:   venueRepo,
+		bookingRepo: bookingRepo,
+		authorizer:  authorizer,
+		now:         time.Now,
+	}
+}
+
+func (s *reviewService) CreateReview(ctx context.Context, venueID uint, req *request.CreateReviewRequest, userID uint) (*domain.Review, error) {
+	if req == nil {
+		return nil, errors.New("invalid review request")
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	if req.Rating < domain.ReviewMinRating || req.Rating > domain.ReviewMaxRating {
+		return nil, domain.ErrInvalidRating
+	}
+
+	if _, err := s.venueRepo.FindByID(ctx, venueID); err != nil {
+		return nil, domain.ErrVenueNotFound
+	}
+
+	booking, err := s.bookingRepo.FindByID(ctx, req.BookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	if booking.User.ID != userID || booking.Status != domain.BookingStatusCompleted || booking.Schedule.Field.Venue.ID != venueID {
+		return nil, domain.ErrReviewNotAllowed
+	}
+
+	review := &domain.Review{
+		VenueID:   venueID,
+		BookingID: booking.ID,
+		User:      booking.User,
+		Rating:    req.Rating,
+		Comment:   strings.TrimSpace(req.Comment),
+		Status:    domain.ReviewStatusPublished,
+	}
+
+	if err := s.reviewRepo.Create(ctx, review); err != nil {
+		if !errors.Is(err, domain.ErrReviewExists) {
+			logger.Error("failed to create review", err, "booking_id", booking.ID)
+		}
+		return nil, err
+	}
+
+	logger.Info("review created", "review_id", review.ID, "venue_id", venueID, "rating", review.Rating)
+
+	return review, nil
+}
+
+func (s *reviewService) GetVenueReviews(ctx context.Context, venueID uint, filter domain.ReviewFilter) ([]domain.Review, int64, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, 0, fmt.Errorf("context error: %w", err)
+	}
+
+	if _, err := s.venueRepo.FindByID(ctx, venueID); err != nil {
+		return nil, 0, domain.ErrVenueNotFound
+	}
+
+	reviews, total, err := s.reviewRepo.FindByVenueID(ctx, venueID, filter)
+	if err != nil {
+		logger.Error("failed to find reviews", err, "venue_id", venueID)
+		return nil, 0, err
+	}
+
+	return reviews, total, nil
+}
+
+func (s *reviewService) ReplyToReview(ctx context.Context, reviewID uint, reply string, userID uint) (*domain.Review, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	review, err := s.reviewRepo.FindByID(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizer.AuthorizeVenue(ctx, userID, review.VenueID, domain.VenueRoleOwner); err != nil {
+		return nil, err
+	}
+
+	reply = strings.TrimSpace(reply)
+	if reply == "" {
+		return nil, domain.ErrEmptyReviewReply
+	}
+
+	now := s.now()
+	if err := s.reviewRepo.SaveReply(ctx, reviewID, reply, userID, now); err != nil {
+		logger.Error("failed to save review reply", err, "review_id", reviewID)
+		return nil, err
+	}
+
+	review.Reply = reply
+	review.RepliedBy = &userID
+	review.RepliedAt = &now
+
+	return &review, nil
+}
+
+func (s *reviewService) ModerateReview(ctx context.Context, reviewID uint, status string) (*domain.Review, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	if status != domain.ReviewStatusPublished && status != domain.ReviewStatusHidden {
+		return nil, domain.ErrInvalidReviewStatus
+	}
+
+	if err := s.reviewRepo.UpdateStatus(ctx, reviewID, status); err != nil {
+		if !errors.Is(err, domain.ErrReviewNotFound) {
+			logger.Error("failed to update review status", err, "review_id", reviewID)
+		}
+		return nil, err
+	}
+
+	logger.Info("review moderated", "review_id", reviewID, "status", status)
+
+	review, err := s.reviewRepo.FindByID(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &review, nil
+}
+
+func (s *reviewService) DeleteReview(ctx context.Context, reviewID uint) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("context error: %w", err)
+	}
+
+	if err := s.reviewRepo.Delete(ctx, reviewID); err != nil {
+		if !errors.Is(err, domain.ErrReviewNotFound) {
+			logger.Error("failed to delete review", err, "review_id", reviewID)
+		}
+		return err
+	}
+
+	logger.Info("review deleted", "review_id", reviewID)
+
+	return nil
+}
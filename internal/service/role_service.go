@@ -0,0 +1,324 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+	"slices"
+	"strings"
+)
+
+type RoleService interface {
+	GetAllRoles(ctx context.Context) ([]domain.Role, error)
+	GetRoleByID(ctx context.Context, id uint) (*domain.Role, error)
+	CreateRole(ctx context.Context, name string, permissions []string) (*domain.Role, error)
+	UpdateRole(ctx context.Context, id uint, name string) (*domain.Role, error)
+	SetRolePermissions(ctx context.Context, id uint, permissions []string) (*domain.Role, error)
+	DeleteRole(ctx context.Context, id uint) error
+	GetAllPermissions(ctx context.Context) ([]domain.Permission, error)
+	HasPermission(ctx context.Context, roleID uint, permission string) (bool, error)
+	// AssignUserRole gives a user another role. It returns
+	// domain.ErrLastRoleManager when that would leave no user with the
+	// role:manage permission.
+	AssignUserRole(ctx context.Context, userID uint, roleID uint) (*domain.Role, error)
+}
+
+type roleService struct {
+	roleRepo       repository.RoleRepository
+	permissionRepo repository.PermissionRepository
+	userRepo       repository.UserRepository
+	txManager      repository.TransactionManager
+}
+
+func NewRoleService(roleRepo repository.RoleRepository, permissionRepo repository.PermissionRepository, userRepo repository.UserRepository, txManager repository.TransactionManager) RoleService {
+	return &roleService{
+		roleRepo:       roleRepo,
+		permissionRepo: permissionRepo,
+		userRepo:       userRepo,
+		txManager:      txManager,
+	}
+}
+
+func normalizeRoleName(name string) string {
+	return strings.ToUpper(strings.TrimSpace(name))
+}
+
+func (s *roleService) GetAllRoles(ctx context.Context) ([]domain.Role, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	roles, err := s.roleRepo.FindAll(ctx)
+	if err != nil {
+		logger.Error("failed to find all roles", err)
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+func (s *roleService) GetRoleByID(ctx context.Context, id uint) (*domain.Role, error) {
+	if id == 0 {
+		return nil, errors.New("invalid role id")
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	role, err := s.roleRepo.FindByID(ctx, id)
+	if err != nil {
+		logger.Error("failed to find role by id", err)
+		return nil, err
+	}
+
+	return &role, nil
+}
+
+func (s *roleService) CreateRole(ctx context.Context, name string, permissions []string) (*domain.Role, error) {
+	name = normalizeRoleName(name)
+	if name == "" {
+		return nil, errors.New("invalid role name")
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	if _, err := s.roleRepo.FindByName(ctx, name); err == nil {
+		return nil, domain.ErrRoleAlreadyExists
+	} else if !errors.Is(err, domain.ErrRoleNotFound) {
+		logger.Error("failed to check role name", err)
+		return nil, err
+	}
+
+	permissionIDs, err := s.resolvePermissions(ctx, permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	// A role is never left behind without the permissions it was created with.
+	newRole := &domain.Role{RoleName: name}
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.roleRepo.Create(ctx, newRole); err != nil {
+			logger.Error("failed to create role", err)
+			return err
+		}
+
+		if err := s.roleRepo.ReplacePermissions(ctx, newRole.ID, permissionIDs); err != nil {
+			logger.Error("failed to assign role permissions", err)
+			return fmt.Errorf("failed to assign role permissions: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("role created successfully", "role", name)
+
+	return s.GetRoleByID(ctx, newRole.ID)
+}
+
+func (s *roleService) UpdateRole(ctx context.Context, id uint, name string) (*domain.Role, error) {
+	name = normalizeRoleName(name)
+	if id == 0 || name == "" {
+		return nil, errors.New("invalid role data")
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	role, err := s.roleRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if isBuiltInRole(role.RoleName) && role.RoleName != name {
+		return nil, domain.ErrBuiltInRole
+	}
+
+	if existing, err := s.roleRepo.FindByName(ctx, name); err == nil && existing.ID != id {
+		return nil, domain.ErrRoleAlreadyExists
+	}
+
+	role.RoleName = name
+	if err := s.roleRepo.Update(ctx, &role); err != nil {
+		logger.Error("failed to update role", err)
+		return nil, err
+	}
+
+	return &role, nil
+}
+
+func (s *roleService) SetRolePermissions(ctx context.Context, id uint, permissions []string) (*domain.Role, error) {
+	if id == 0 {
+		return nil, errors.New("invalid role id")
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	role, err := s.roleRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Stripping role:manage from ADMIN would leave nobody able to undo it.
+	if role.RoleName == domain.RoleAdmin && !slices.Contains(permissions, domain.PermRoleManage) {
+		return nil, domain.ErrAdminRoleLockout
+	}
+
+	permissionIDs, err := s.resolvePermissions(ctx, permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.roleRepo.ReplacePermissions(ctx, id, permissionIDs); err != nil {
+		logger.Error("failed to replace role permissions", err)
+		return nil, fmt.Errorf("failed to replace role permissions: %w", err)
+	}
+
+	logger.Info("role permissions updated", "role_id", id, "permissions", permissions)
+
+	return s.GetRoleByID(ctx, id)
+}
+
+func (s *roleService) DeleteRole(ctx context.Context, id uint) error {
+	if id == 0 {
+		return errors.New("invalid role id")
+	}
+
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("context error: %w", err)
+	}
+
+	role, err := s.roleRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if isBuiltInRole(role.RoleName) {
+		return domain.ErrBuiltInRole
+	}
+
+	count, err := s.roleRepo.CountUsers(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return domain.ErrRoleInUse
+	}
+
+	if err := s.roleRepo.Delete(ctx, id); err != nil {
+		logger.Error("failed to delete role", err)
+		return fmt.Errorf("failed to delete role: %w", err)
+	}
+
+	return nil
+}
+
+func (s *roleService) GetAllPermissions(ctx context.Context) ([]domain.Permission, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	return s.permissionRepo.FindAll(ctx)
+}
+
+func (s *roleService) HasPermission(ctx context.Context, roleID uint, permission string) (bool, error) {
+	if roleID == 0 || permission == "" {
+		return false, nil
+	}
+
+	return s.roleRepo.HasPermission(ctx, roleID, permission)
+}
+
+func (s *roleService) AssignUserRole(ctx context.Context, userID uint, roleID uint) (*domain.Role, error) {
+	if userID == 0 || roleID == 0 {
+		return nil, errors.New("invalid user role data")
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	// Deleted roles are not found.
+	role, err := s.roleRepo.FindByID(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Role.ID == roleID {
+		return &role, nil
+	}
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if !role.HasPermission(domain.PermRoleManage) {
+			holders, err := s.roleRepo.LockUsersWithPermission(ctx, domain.PermRoleManage)
+			if err != nil {
+				return err
+			}
+
+			if len(holders) == 1 && holders[0] == userID {
+				return domain.ErrLastRoleManager
+			}
+		}
+
+		return s.userRepo.UpdateRole(ctx, userID, roleID)
+	})
+	if err != nil {
+		if !errors.Is(err, domain.ErrLastRoleManager) {
+			logger.Error("failed to assign user role", err, "user_id", userID, "role_id", roleID)
+		}
+		return nil, err
+	}
+
+	logger.Info("user role assigned", "user_id", userID, "role_id", roleID, "previous_role_id", user.Role.ID)
+
+	return &role, nil
+}
+
+// resolvePermissions maps permission names to their IDs and rejects unknown names.
+func (s *roleService) resolvePermissions(ctx context.Context, names []string) ([]uint, error) {
+	permissions, err := s.permissionRepo.FindByNames(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]uint, len(permissions))
+	for _, p := range permissions {
+		known[p.Name] = p.ID
+	}
+
+	ids := make([]uint, 0, len(names))
+	seen := make(map[uint]bool, len(names))
+	for _, name := range names {
+		id, ok := known[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", domain.ErrPermissionNotFound, name)
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
+}
+
+func isBuiltInRole(name string) bool {
+	return name == domain.RoleAdmin || name == domain.RoleCustomer
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+	"time"
+)
+
+type SavedSearchAlertConfig struct {
+	PollInterval time.Duration
+	// Lookahead is how far ahead free slots are looked for.
+	Lookahead time.Duration
+	BatchSize int
+}
+
+// SavedSearchAlertJob tells users about free slots matching their saved
+// searches. Each slot is claimed in saved_search_alerts in the same
+// transaction that queues the notification, so a slot is alerted once per
+// search however many instances run.
+type SavedSearchAlertJob struct {
+	savedSearchRepo repository.SavedSearchRepository
+	txManager       repository.TransactionManager
+	router          NotificationRouter
+	config          SavedSearchAlertConfig
+	now             func() time.Time
+}
+
+func NewSavedSearchAlertJob(savedSearchRepo repository.SavedSearchRepository, txManager repository.TransactionManager, router NotificationRouter, config SavedSearchAlertConfig) *SavedSearchAlertJob {
+	return &SavedSearchAlertJob{
+		savedSearchRepo: savedSearchRepo,
+		txManager:       txManager,
+		router:          router,
+		config:          config,
+		now:             time.Now,
+	}
+}
+
+// Run sends saved search alerts until ctx is cancelled.
+func (j *SavedSearchAlertJob) Run(ctx context.Context) {
+	logger.Info("saved search alerts started", "poll_interval", j.config.PollInterval.String())
+
+	ticker := time.NewTicker(j.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := j.SendAlerts(ctx); err != nil {
+			logger.Error("saved search alerts failed", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info("saved search alerts stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendAlerts queues one alert for each notifying search with new free slots
+// and returns how many were queued.
+func (j *SavedSearchAlertJob) SendAlerts(ctx context.Context) (int, error) {
+	sent := 0
+	days := int(j.config.Lookahead / (24 * time.Hour))
+	var afterID uint
+
+	for ctx.Err() == nil {
+		searches, err := j.savedSearchRepo.FindNotifying(ctx, afterID, j.config.BatchSize)
+		if err != nil {
+			return sent, err
+		}
+
+		for _, search := range searches {
+			afterID = search.ID
+
+			// One broken search must not hold back the alerts of the rest.
+			ok, err := j.sendAlert(ctx, search, days)
+			if err != nil {
+				logger.Error("failed to send saved search alert", err, "saved_search_id", search.ID)
+				continue
+			}
+			if ok {
+				sent++
+			}
+		}
+
+		if len(searches) < j.config.BatchSize {
+			break
+		}
+	}
+
+	return sent, nil
+}
+
+// sendAlert returns false when there was nothing new to alert.
+func (j *SavedSearchAlertJob) sendAlert(ctx context.Context, search domain.SavedSearch, days int) (bool, error) {
+	now := j.now()
+
+	slots, err := j.savedSearchRepo.FindNewSlots(ctx, search, now, days, domain.SavedSearchAlertSlots)
+	if err != nil || len(slots) == 0 {
+		return false, err
+	}
+
+	claimed := 0
+
+	err = j.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		data := SavedSearchMatchEmailData{
+			FullName:   search.User.FullName,
+			SearchName: search.Name,
+		}
+
+		for _, slot := range slots {
+			ok, err := j.savedSearchRepo.ClaimSlot(ctx, search.ID, slot, now)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+
+			start, end := domain.ScheduleSlotAt(slot.Schedule, slot.Date)
+			data.Slots = append(data.Slots, AvailableSlotEmailData{
+				VenueName: slot.Schedule.Field.Venue.Name,
+				FieldName: slot.Schedule.Field.Name,
+				StartTime: start,
+				EndTime:   end,
+				Price:     slot.Schedule.Price,
+			})
+		}
+
+		claimed = len(data.Slots)
+		if claimed == 0 {
+			return nil
+		}
+
+		return j.router.Notify(ctx, search.User, domain.EmailTemplateSavedSearchMatch, data)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if claimed > 0 {
+		logger.Info("saved search alert queued", "saved_search_id", search.ID, "slots", claimed)
+	}
+
+	return claimed > 0, nil
+}
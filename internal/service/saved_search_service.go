@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/dto/request"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+	"strings"
+)
+
+// SavedSearchService manages a user's saved slot searches. Another user's
+// searches are reported as not found.
+type SavedSearchService interface {
+	GetSavedSearches(ctx context.Context, userID uint) ([]domain.SavedSearch, error)
+	CreateSavedSearch(ctx context.Context, req *request.SavedSearchRequest, userID uint) (*domain.SavedSearch, error)
+	UpdateSavedSearch(ctx context.Context, id uint, req *request.SavedSearchRequest, userID uint) (*domain.SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, id uint, userID uint) error
+}
+
+type savedSearchService struct {
+	savedSearchRepo repository.SavedSearchRepository
+	fieldTypeRepo   repository.FieldTypeRepository
+}
+
+func NewSavedSearchService(savedSearchRepo repository.SavedSearchRepository, fieldTypeRepo repository.FieldTypeRepository) SavedSearchService {
+	return &savedSearchService{
+		savedSearchRepo: savedSearchRepo,
+		fieldTypeRepo:   fieldTypeRepo,
+	}
+}
+
+func (s *savedSearchService) GetSavedSearches(ctx context.Context, userID uint) ([]domain.SavedSearch, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	searches, err := s.savedSearchRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("failed to find saved searches", err, "user_id", userID)
+		return nil, err
+	}
+
+	return searches, nil
+}
+
+func (s *savedSearchService) CreateSavedSearch(ctx context.Context, req *request.SavedSearchRequest, userID uint) (*domain.SavedSearch, error) {
+	if req == nil {
+		return nil, errors.New("invalid saved search request")
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	search, err := s.buildSavedSearch(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	search.UserID = userID
+
+	count, err := s.savedSearchRepo.CountByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("failed to count saved searches", err, "user_id", userID)
+		return nil, err
+	}
+	if count >= domain.SavedSearchMaxPerUser {
+		return nil, domain.ErrTooManySavedSearches
+	}
+
+	if err := s.savedSearchRepo.Create(ctx, &search); err != nil {
+		logger.Error("failed to create saved search", err, "user_id", userID)
+		return nil, err
+	}
+
+	logger.Info("saved search created", "saved_search_id", search.ID, "user_id", userID, "notify", search.Notify)
+
+	return &search, nil
+}
+
+func (s *savedSearchService) UpdateSavedSearch(ctx context.Context, id uint, req *request.SavedSearchRequest, userID uint) (*domain.SavedSearch, error) {
+	if req == nil {
+		return nil, errors.New("invalid saved search request")
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	existing, err := s.findOwned(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	search, err := s.buildSavedSearch(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	search.ID = existing.ID
+	search.UserID = existing.UserID
+	search.CreatedAt = existing.CreatedAt
+
+	if err := s.savedSearchRepo.Update(ctx, &search); err != nil {
+		if !errors.Is(err, domain.ErrSavedSearchNotFound) {
+			logger.Error("failed to update saved search", err, "saved_search_id", id)
+		}
+		return nil, err
+	}
+
+	return &search, nil
+}
+
+func (s *savedSearchService) DeleteSavedSearch(ctx context.Context, id uint, userID uint) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("context error: %w", err)
+	}
+
+	if _, err := s.findOwned(ctx, id, userID); err != nil {
+		return err
+	}
+
+	if err := s.savedSearchRepo.Delete(ctx, id); err != nil {
+		if !errors.Is(err, domain.ErrSavedSearchNotFound) {
+			logger.Error("failed to delete saved search", err, "saved_search_id", id)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *savedSearchService) findOwned(ctx context.Context, id uint, userID uint) (domain.SavedSearch, error) {
+	search, err := s.savedSearchRepo.FindByID(ctx, id)
+	if err != nil {
+		return domain.SavedSearch{}, err
+	}
+
+	if search.UserID != userID {
+		return domain.SavedSearch{}, domain.ErrSavedSearchNotFound
+	}
+
+	return search, nil
+}
+
+// buildSavedSearch validates req. Field types are checked against the known
+// codes.
+func (s *savedSearchService) buildSavedSearch(ctx context.Context, req *request.SavedSearchRequest) (domain.SavedSearch, error) {
+	search := domain.SavedSearch{
+		Name:      strings.TrimSpace(req.Name),
+		City:      strings.TrimSpace(req.City),
+		FieldType: strings.ToUpper(strings.TrimSpace(req.FieldType)),
+		DayOfWeek: req.DayOfWeek,
+		Notify:    req.Notify,
+	}
+
+	if search.Name == "" {
+		return domain.SavedSearch{}, domain.ErrInvalidSavedSearch
+	}
+
+	if req.StartTime != "" {
+		start, err := parseTime(req.StartTime)
+		if err != nil {
+			return domain.SavedSearch{}, domain.ErrInvalidSavedSearch
+		}
+		search.StartTime = &start
+	}
+
+	if req.EndTime != "" {
+		end, err := parseTime(req.EndTime)
+		if err != nil {
+			return domain.SavedSearch{}, domain.ErrInvalidSavedSearch
+		}
+		search.EndTime = &end
+	}
+
+	if err := search.Validate(); err != nil {
+		return domain.SavedSearch{}, err
+	}
+
+	if search.FieldType != "" {
+		if _, err := s.fieldTypeRepo.FindByCode(ctx, search.FieldType); err != nil {
+			return domain.SavedSearch{}, err
+		}
+	}
+
+	return search, nil
+}
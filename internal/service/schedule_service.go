@@ -13,16 +13,29 @@ import (
 
 type ScheduleService interface {
 	GetScheduleByID(ctx context.Context, id uint) (*domain.Schedule, error)
-	GetScheduleByField(ctx context.Context, fieldID uint) ([]*domain.Schedule, error)
-	CreateSchedule(ctx context.Context, req *request.CreateScheduleRequest) (*domain.Schedule, error)
-	UpdateSchedule(ctx context.Context, id uint, dayOfWeek int, startTime, endTime string, price float64) (*domain.Schedule, error)
-	DeleteSchedule(ctx context.Context, id uint) error
+	// GetScheduleByField returns the weekly schedules of a field. With a date,
+	// only the schedules of that day outside maintenance windows are returned.
+	GetScheduleByField(ctx context.Context, fieldID uint, date *time.Time) ([]*domain.Schedule, error)
+	CreateSchedule(ctx context.Context, req *request.CreateScheduleRequest, userID uint) (*domain.Schedule, error)
+	UpdateSchedule(ctx context.Context, id uint, dayOfWeek int, startTime, endTime string, price float64, userID uint) (*domain.Schedule, error)
+	// GetScheduleDeletionPreview counts the upcoming bookings DeleteSchedule
+	// would affect. Requires MANAGER membership of the venue.
+	GetScheduleDeletionPreview(ctx context.Context, id uint, userID uint) (*domain.DeletionImpact, error)
+	// DeleteSchedule soft-deletes the schedule. Upcoming bookings block it
+	// unless force is set, which cancels them.
+	DeleteSchedule(ctx context.Context, id uint, userID uint, force bool) (*domain.DeletionImpact, error)
+	// RestoreSchedule brings back a schedule deleted within
+	// domain.RestoreWindow. Its field and venue must not be deleted.
+	RestoreSchedule(ctx context.Context, id uint) (*domain.Schedule, error)
 }
 
 type scheduleService struct {
 	scheduleRepo repository.ScheduleRepository
 	fieldRepo    repository.FieldRepository
 	bookingRepo  repository.BookingRepository
+	windowRepo   repository.MaintenanceWindowRepository
+	authorizer   Authorizer
+	guard        DeletionGuard
 }
 
 // type CreateScheduleRequest struct {
@@ -33,8 +46,8 @@ type scheduleService struct {
 // 	Price     float64
 // }
 
-func NewScheduleService(scheduleRepo repository.ScheduleRepository, fieldRepo repository.FieldRepository, bookingRepo repository.BookingRepository) ScheduleService {
-	return &scheduleService{scheduleRepo: scheduleRepo, fieldRepo: fieldRepo, bookingRepo: bookingRepo}
+func NewScheduleService(scheduleRepo repository.ScheduleRepository, fieldRepo repository.FieldRepository, bookingRepo repository.BookingRepository, windowRepo repository.MaintenanceWindowRepository, authorizer Authorizer, guard DeletionGuard) ScheduleService {
+	return &scheduleService{scheduleRepo: scheduleRepo, fieldRepo: fieldRepo, bookingRepo: bookingRepo, windowRepo: windowRepo, authorizer: authorizer, guard: guard}
 }
 
 func parseTime(timeStr string) (time.Time, error) {
@@ -66,7 +79,7 @@ func (s *scheduleService) GetScheduleByID(ctx context.Context, id uint) (*domain
 	return &schedule, nil
 }
 
-func (s *scheduleService) GetScheduleByField(ctx context.Context, fieldID uint) ([]*domain.Schedule, error) {
+func (s *scheduleService) GetScheduleByField(ctx context.Context, fieldID uint, date *time.Time) ([]*domain.Schedule, error) {
 	if fieldID == 0 {
 		logger.Error("field id not found when get schedule by field")
 		return nil, errors.New("invalid field id")
@@ -87,15 +100,39 @@ func (s *scheduleService) GetScheduleByField(ctx context.Context, fieldID uint)
 		return nil, err
 	}
 
-	result := make([]*domain.Schedule, len(schedules))
+	if date == nil {
+		result := make([]*domain.Schedule, len(schedules))
+		for i := range schedules {
+			result[i] = &schedules[i]
+		}
+
+		return result, nil
+	}
+
+	// A day of margin on both sides covers the venue's timezone offset.
+	windows, err := s.windowRepo.FindOverlapping(ctx, []uint{fieldID}, date.AddDate(0, 0, -1), date.AddDate(0, 0, 2))
+	if err != nil {
+		logger.Error("failed to get maintenance windows of field", err.Error())
+		return nil, err
+	}
+
+	result := make([]*domain.Schedule, 0)
 	for i := range schedules {
-		result[i] = &schedules[i]
+		if schedules[i].DayOfWeek != domain.ScheduleDay(*date) {
+			continue
+		}
+
+		start, end := domain.ScheduleSlotAt(schedules[i], *date)
+		if inMaintenance(windows, fieldID, start, end) {
+			continue
+		}
+		result = append(result, &schedules[i])
 	}
 
 	return result, nil
 }
 
-func (s *scheduleService) CreateSchedule(ctx context.Context, req *request.CreateScheduleRequest) (*domain.Schedule, error) {
+func (s *scheduleService) CreateSchedule(ctx context.Context, req *request.CreateScheduleRequest, userID uint) (*domain.Schedule, error) {
 	if req == nil || req.FieldID == 0 {
 		logger.Error("missing request value to create schedule")
 		return nil, errors.New("invalid schedule request")
@@ -134,6 +171,10 @@ func (s *scheduleService) CreateSchedule(ctx context.Context, req *request.Creat
 		return nil, errors.New("field not found")
 	}
 
+	if err := s.authorizer.AuthorizeVenue(ctx, userID, field.Venue.ID, domain.VenueRoleManager); err != nil {
+		return nil, err
+	}
+
 	newSchedule := domain.Schedule{
 		Field:     field,
 		DayOfWeek: req.DayOfWeek,
@@ -155,7 +196,7 @@ func (s *scheduleService) CreateSchedule(ctx context.Context, req *request.Creat
 	return &newSchedule, nil
 }
 
-func (s *scheduleService) UpdateSchedule(ctx context.Context, id uint, dayOfWeek int, startTime, endTime string, price float64) (*domain.Schedule, error) {
+func (s *scheduleService) UpdateSchedule(ctx context.Context, id uint, dayOfWeek int, startTime, endTime string, price float64, userID uint) (*domain.Schedule, error) {
 	if id == 0 {
 		return nil, errors.New("invalid schedule id")
 	}
@@ -186,6 +227,10 @@ func (s *scheduleService) UpdateSchedule(ctx context.Context, id uint, dayOfWeek
 		return nil, domain.ErrScheduleNotFound
 	}
 
+	if err := s.authorizer.AuthorizeVenue(ctx, userID, scheduleUpdate.Field.Venue.ID, domain.VenueRoleManager); err != nil {
+		return nil, err
+	}
+
 	if dayOfWeek != 0 {
 		if dayOfWeek < 1 || dayOfWeek > 7 {
 			return nil, domain.ErrInvalidDayOfWeek
@@ -229,27 +274,90 @@ func (s *scheduleService) UpdateSchedule(ctx context.Context, id uint, dayOfWeek
 	return &scheduleUpdate, nil
 }
 
-func (s *scheduleService) DeleteSchedule(ctx context.Context, id uint) error {
+func (s *scheduleService) GetScheduleDeletionPreview(ctx context.Context, id uint, userID uint) (*domain.DeletionImpact, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	schedule, err := s.scheduleRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, domain.ErrScheduleNotFound
+	}
+
+	if err := s.authorizer.AuthorizeVenue(ctx, userID, schedule.Field.Venue.ID, domain.VenueRoleManager); err != nil {
+		return nil, err
+	}
+
+	impact, err := s.guard.Preview(ctx, domain.DeletionScope{ScheduleID: id}, domain.DeletionImpact{Schedules: 1})
+	if err != nil {
+		logger.Error("failed to find upcoming schedule bookings", err, "schedule_id", id)
+		return nil, err
+	}
+
+	return &impact, nil
+}
+
+func (s *scheduleService) DeleteSchedule(ctx context.Context, id uint, userID uint, force bool) (*domain.DeletionImpact, error) {
 	if id == 0 {
-		return errors.New("invalid schedule id")
+		return nil, errors.New("invalid schedule id")
 	}
 
 	if err := ctx.Err(); err != nil {
-		return fmt.Errorf("context error: %w", err)
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	schedule, err := s.scheduleRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, domain.ErrScheduleNotFound
 	}
 
-	if _, err := s.scheduleRepo.FindByID(ctx, id); err != nil {
-		return domain.ErrScheduleNotFound
+	if err := s.authorizer.AuthorizeVenue(ctx, userID, schedule.Field.Venue.ID, domain.VenueRoleManager); err != nil {
+		return nil, err
 	}
 
-	err := s.scheduleRepo.Delete(ctx, id)
+	impact, err := s.guard.Delete(ctx, domain.DeletionScope{ScheduleID: id}, domain.DeletionImpact{Schedules: 1}, force, "This time slot has been removed", func(ctx context.Context, at time.Time) error {
+		return s.scheduleRepo.Delete(ctx, id, at)
+	})
 	if err != nil {
+		if errors.Is(err, domain.ErrHasFutureBookings) {
+			return &impact, err
+		}
 		logger.Error("failed to delete schedule", map[string]any{
 			"schedule_id": id,
 			"error":       err.Error(),
 		})
-		return fmt.Errorf("failed to delete schedule: %w", err)
+		return nil, fmt.Errorf("failed to delete schedule: %w", err)
+	}
+
+	return &impact, nil
+}
+
+func (s *scheduleService) RestoreSchedule(ctx context.Context, id uint) (*domain.Schedule, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	schedule, err := s.scheduleRepo.FindDeletedByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.guard.CheckRestorable(schedule.DeletedAt); err != nil {
+		return nil, err
+	}
+
+	if schedule.Field.DeletedAt != nil || schedule.Field.Venue.DeletedAt != nil {
+		return nil, domain.ErrParentDeleted
+	}
+
+	if err := s.scheduleRepo.Restore(ctx, id); err != nil {
+		if !errors.Is(err, domain.ErrNotDeleted) {
+			logger.Error("failed to restore schedule", err, "schedule_id", id)
+		}
+		return nil, err
 	}
 
-	return nil
+	logger.Info("schedule restored", "schedule_id", id)
+
+	return s.GetScheduleByID(ctx, id)
 }
@@ -0,0 +1,68 @@
+package service_test
+
+import (
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/service"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAvailabilityHub(t *testing.T) {
+	t.Run("Success - Updates only reach the field's subscribers", func(t *testing.T) {
+		hub := service.NewAvailabilityHub(4)
+		fieldOne, err := hub.Subscribe(1)
+		assert.NoError(t, err)
+		fieldTwo, err := hub.Subscribe(2)
+		assert.NoError(t, err)
+
+		hub.Publish(domain.SlotUpdate{Type: domain.SlotUpdateBooked, FieldID: 1, ScheduleID: 7})
+
+		assert.Equal(t, uint(7), (<-fieldOne.Updates).ScheduleID)
+		assert.Len(t, fieldTwo.Updates, 0)
+	})
+
+	t.Run("Success - Closed subscription is removed", func(t *testing.T) {
+		hub := service.NewAvailabilityHub(4)
+		sub, err := hub.Subscribe(1)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, hub.Subscribers(1))
+
+		sub.Close()
+		sub.Close()
+
+		_, open := <-sub.Updates
+		assert.False(t, open)
+		assert.Equal(t, 0, hub.Subscribers(1))
+		hub.Publish(domain.SlotUpdate{FieldID: 1})
+	})
+
+	t.Run("Success - Slow subscriber is disconnected", func(t *testing.T) {
+		hub := service.NewAvailabilityHub(1)
+		sub, err := hub.Subscribe(1)
+		assert.NoError(t, err)
+
+		hub.Publish(domain.SlotUpdate{FieldID: 1, ScheduleID: 1})
+		hub.Publish(domain.SlotUpdate{FieldID: 1, ScheduleID: 2})
+
+		assert.Equal(t, uint(1), (<-sub.Updates).ScheduleID)
+		_, open := <-sub.Updates
+		assert.False(t, open)
+		assert.Equal(t, 0, hub.Subscribers(1))
+	})
+
+	t.Run("Success - Close ends streams and rejects new ones", func(t *testing.T) {
+		hub := service.NewAvailabilityHub(4)
+		sub, err := hub.Subscribe(1)
+		assert.NoError(t, err)
+
+		hub.Close()
+
+		_, open := <-sub.Updates
+		assert.False(t, open)
+		sub.Close()
+
+		_, err = hub.Subscribe(1)
+		assert.ErrorIs(t, err, domain.ErrStreamClosed)
+	})
+}
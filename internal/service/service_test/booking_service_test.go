@@ -13,6 +13,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func init() {
@@ -25,9 +26,21 @@ func TestBookingService_CreateBooking(t *testing.T) {
 
 	mockBookingRepo := mock.NewMockBookingRepository(ctrl)
 	mockScheduleRepo := mock.NewMockScheduleRepository(ctrl)
+	mockWindowRepo := mock.NewMockMaintenanceWindowRepository(ctrl)
 	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockVenueMemberRepo := mock.NewMockVenueMemberRepository(ctrl)
 
-	bookingService := service.NewBookingService(mockBookingRepo, mockScheduleRepo, mockUserRepo)
+	authorizer := service.NewAuthorizer(mockUserRepo, mockRoleRepo, mockVenueMemberRepo)
+	mockPaymentRepo := mock.NewMockPaymentRepository(ctrl)
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	mockTxManager := mock.NewMockTransactionManager(ctrl)
+	expectTransactions(mockTxManager)
+
+	mockPreferenceRepo := mock.NewMockNotificationPreferenceRepository(ctrl)
+	bookingNotifier := service.NewBookingNotifier(service.NewNotificationRouter(service.NewOutboxService(mockOutboxRepo, testOutboxConfig), newTestEmailTemplates(t), mockPreferenceRepo, noInbox(t, ctrl)), noWebhooks(ctrl), service.NewCalendarService(nil, nil, testCalendarConfig))
+	availabilityHub := service.NewAvailabilityHub(8)
+	bookingService := service.NewBookingService(mockBookingRepo, mockScheduleRepo, mockWindowRepo, mockUserRepo, mockPaymentRepo, authorizer, mockTxManager, bookingNotifier, availabilityHub)
 
 	t.Run("Success - Create booking", func(t *testing.T) {
 		ctx := context.Background()
@@ -69,6 +82,7 @@ func TestBookingService_CreateBooking(t *testing.T) {
 			ID:       1,
 			FullName: "John Doe",
 			Email:    "john@example.com",
+			Language: domain.LanguageEnglish,
 			Role: domain.Role{
 				ID:       2,
 				RoleName: "customer",
@@ -79,6 +93,10 @@ func TestBookingService_CreateBooking(t *testing.T) {
 			FindByID(ctx, req.ScheduleID).
 			Return(schedule, nil)
 
+		mockWindowRepo.EXPECT().
+			FindOverlapping(ctx, []uint{schedule.Field.ID}, gomock.Any(), gomock.Any()).
+			Return(nil, nil)
+
 		mockUserRepo.EXPECT().
 			FindByID(ctx, userID).
 			Return(user, nil)
@@ -91,6 +109,21 @@ func TestBookingService_CreateBooking(t *testing.T) {
 				return nil
 			})
 
+		mockOutboxRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, message *domain.OutboxMessage) error {
+				email := decodeOutboxEmail(t, message)
+				assert.Equal(t, "john@example.com", email.To[0].Email)
+				assert.Equal(t, "Booking #1 Received", email.Subject)
+				assert.Contains(t, email.TextBody, "Venue 1")
+				assert.Empty(t, email.Attachments, "the calendar file comes with the confirmation")
+				return nil
+			})
+
+		sub, err := availabilityHub.Subscribe(1)
+		assert.NoError(t, err)
+		defer sub.Close()
+
 		result, err := bookingService.CreateBooking(ctx, req, userID)
 
 		assert.NoError(t, err)
@@ -98,6 +131,13 @@ func TestBookingService_CreateBooking(t *testing.T) {
 		assert.Equal(t, uint(1), result.ID)
 		assert.Equal(t, "PENDING", result.Status)
 		assert.Equal(t, schedule.Price, result.TotalPrice)
+
+		update := <-sub.Updates
+		assert.Equal(t, domain.SlotUpdateBooked, update.Type)
+		assert.Equal(t, uint(1), update.ScheduleID)
+		assert.Equal(t, bookingDate, update.Date)
+		assert.Equal(t, "10:00", update.StartTime)
+		assert.False(t, update.Available)
 	})
 
 	t.Run("Fail - Invalid booking request (nil)", func(t *testing.T) {
@@ -180,6 +220,62 @@ func TestBookingService_CreateBooking(t *testing.T) {
 		assert.Equal(t, domain.ErrScheduleNotFound, err)
 	})
 
+	t.Run("Fail - Field is inactive", func(t *testing.T) {
+		ctx := context.Background()
+
+		req := &request.CreateBookingRequest{
+			ScheduleID:  3,
+			BookingDate: time.Now().Add(24 * time.Hour).Format("2006-01-02"),
+		}
+
+		mockScheduleRepo.EXPECT().
+			FindByID(ctx, req.ScheduleID).
+			Return(domain.Schedule{ID: 3, Field: domain.Field{ID: 1, Status: domain.FieldStatusInactive}}, nil)
+
+		result, err := bookingService.CreateBooking(ctx, req, 1)
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrFieldInactive, err)
+	})
+
+	t.Run("Fail - Field is under maintenance", func(t *testing.T) {
+		ctx := context.Background()
+
+		tomorrow := time.Now().Add(24 * time.Hour)
+		req := &request.CreateBookingRequest{
+			ScheduleID:  4,
+			BookingDate: tomorrow.Format("2006-01-02"),
+		}
+
+		schedule := domain.Schedule{
+			ID:        4,
+			StartTime: time.Date(0, 1, 1, 19, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(0, 1, 1, 20, 0, 0, 0, time.UTC),
+			Field:     domain.Field{ID: 2, Venue: domain.Venue{ID: 1, Timezone: "Asia/Jakarta"}},
+		}
+
+		mockScheduleRepo.EXPECT().
+			FindByID(ctx, req.ScheduleID).
+			Return(schedule, nil)
+
+		jakarta, err := time.LoadLocation("Asia/Jakarta")
+		require.NoError(t, err)
+		slotStart := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 19, 0, 0, 0, jakarta)
+
+		mockWindowRepo.EXPECT().
+			FindOverlapping(ctx, []uint{2}, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fieldIDs []uint, from, to time.Time) ([]domain.MaintenanceWindow, error) {
+				assert.True(t, slotStart.Equal(from), "slot starts at 19:00 venue time, got %s", from)
+				assert.True(t, slotStart.Add(time.Hour).Equal(to), "slot ends at 20:00 venue time, got %s", to)
+				return []domain.MaintenanceWindow{{ID: 1, FieldID: 2, StartsAt: slotStart.Add(-time.Hour), EndsAt: slotStart.Add(24 * time.Hour)}}, nil
+			})
+
+		result, err := bookingService.CreateBooking(ctx, req, 1)
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrFieldInMaintenance, err)
+	})
+
 	t.Run("Fail - Day mismatch", func(t *testing.T) {
 		ctx := context.Background()
 
@@ -211,6 +307,10 @@ func TestBookingService_CreateBooking(t *testing.T) {
 			FindByID(ctx, req.ScheduleID).
 			Return(schedule, nil)
 
+		mockWindowRepo.EXPECT().
+			FindOverlapping(ctx, []uint{schedule.Field.ID}, gomock.Any(), gomock.Any()).
+			Return(nil, nil)
+
 		result, err := bookingService.CreateBooking(ctx, req, 1)
 
 		assert.Error(t, err)
@@ -244,6 +344,10 @@ func TestBookingService_CreateBooking(t *testing.T) {
 			FindByID(ctx, req.ScheduleID).
 			Return(schedule, nil)
 
+		mockWindowRepo.EXPECT().
+			FindOverlapping(ctx, []uint{schedule.Field.ID}, gomock.Any(), gomock.Any()).
+			Return(nil, nil)
+
 		mockUserRepo.EXPECT().
 			FindByID(ctx, userID).
 			Return(domain.User{}, errors.New("user not found"))
@@ -286,6 +390,10 @@ func TestBookingService_CreateBooking(t *testing.T) {
 			FindByID(ctx, req.ScheduleID).
 			Return(schedule, nil)
 
+		mockWindowRepo.EXPECT().
+			FindOverlapping(ctx, []uint{schedule.Field.ID}, gomock.Any(), gomock.Any()).
+			Return(nil, nil)
+
 		mockUserRepo.EXPECT().
 			FindByID(ctx, userID).
 			Return(user, nil)
@@ -308,9 +416,21 @@ func TestBookingService_GetMyBookings(t *testing.T) {
 
 	mockBookingRepo := mock.NewMockBookingRepository(ctrl)
 	mockScheduleRepo := mock.NewMockScheduleRepository(ctrl)
+	mockWindowRepo := mock.NewMockMaintenanceWindowRepository(ctrl)
 	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockVenueMemberRepo := mock.NewMockVenueMemberRepository(ctrl)
 
-	bookingService := service.NewBookingService(mockBookingRepo, mockScheduleRepo, mockUserRepo)
+	authorizer := service.NewAuthorizer(mockUserRepo, mockRoleRepo, mockVenueMemberRepo)
+	mockPaymentRepo := mock.NewMockPaymentRepository(ctrl)
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	mockTxManager := mock.NewMockTransactionManager(ctrl)
+	expectTransactions(mockTxManager)
+
+	mockPreferenceRepo := mock.NewMockNotificationPreferenceRepository(ctrl)
+	bookingNotifier := service.NewBookingNotifier(service.NewNotificationRouter(service.NewOutboxService(mockOutboxRepo, testOutboxConfig), newTestEmailTemplates(t), mockPreferenceRepo, noInbox(t, ctrl)), noWebhooks(ctrl), service.NewCalendarService(nil, nil, testCalendarConfig))
+	availabilityHub := service.NewAvailabilityHub(8)
+	bookingService := service.NewBookingService(mockBookingRepo, mockScheduleRepo, mockWindowRepo, mockUserRepo, mockPaymentRepo, authorizer, mockTxManager, bookingNotifier, availabilityHub)
 
 	t.Run("Success - Get user bookings", func(t *testing.T) {
 		ctx := context.Background()
@@ -391,9 +511,21 @@ func TestBookingService_GetBookingByID(t *testing.T) {
 
 	mockBookingRepo := mock.NewMockBookingRepository(ctrl)
 	mockScheduleRepo := mock.NewMockScheduleRepository(ctrl)
+	mockWindowRepo := mock.NewMockMaintenanceWindowRepository(ctrl)
 	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockVenueMemberRepo := mock.NewMockVenueMemberRepository(ctrl)
+
+	authorizer := service.NewAuthorizer(mockUserRepo, mockRoleRepo, mockVenueMemberRepo)
+	mockPaymentRepo := mock.NewMockPaymentRepository(ctrl)
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	mockTxManager := mock.NewMockTransactionManager(ctrl)
+	expectTransactions(mockTxManager)
 
-	bookingService := service.NewBookingService(mockBookingRepo, mockScheduleRepo, mockUserRepo)
+	mockPreferenceRepo := mock.NewMockNotificationPreferenceRepository(ctrl)
+	bookingNotifier := service.NewBookingNotifier(service.NewNotificationRouter(service.NewOutboxService(mockOutboxRepo, testOutboxConfig), newTestEmailTemplates(t), mockPreferenceRepo, noInbox(t, ctrl)), noWebhooks(ctrl), service.NewCalendarService(nil, nil, testCalendarConfig))
+	availabilityHub := service.NewAvailabilityHub(8)
+	bookingService := service.NewBookingService(mockBookingRepo, mockScheduleRepo, mockWindowRepo, mockUserRepo, mockPaymentRepo, authorizer, mockTxManager, bookingNotifier, availabilityHub)
 
 	t.Run("Success - Get booking by ID", func(t *testing.T) {
 		ctx := context.Background()
@@ -418,7 +550,7 @@ func TestBookingService_GetBookingByID(t *testing.T) {
 			FindByID(ctx, bookingID).
 			Return(booking, nil)
 
-		result, err := bookingService.GetBookingByID(ctx, bookingID)
+		result, err := bookingService.GetBookingByID(ctx, bookingID, 1)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -428,7 +560,7 @@ func TestBookingService_GetBookingByID(t *testing.T) {
 	t.Run("Fail - Invalid booking ID", func(t *testing.T) {
 		ctx := context.Background()
 
-		result, err := bookingService.GetBookingByID(ctx, 0)
+		result, err := bookingService.GetBookingByID(ctx, 0, 1)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -443,12 +575,89 @@ func TestBookingService_GetBookingByID(t *testing.T) {
 			FindByID(ctx, bookingID).
 			Return(domain.Booking{}, domain.ErrBookingNotFound)
 
-		result, err := bookingService.GetBookingByID(ctx, bookingID)
+		result, err := bookingService.GetBookingByID(ctx, bookingID, 1)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
 		assert.Equal(t, domain.ErrBookingNotFound, err)
 	})
+
+	t.Run("Success - Venue staff reads booking", func(t *testing.T) {
+		ctx := context.Background()
+		bookingID := uint(2)
+		staffID := uint(7)
+
+		booking := domain.Booking{
+			ID:   bookingID,
+			User: domain.User{ID: 1},
+			Schedule: domain.Schedule{
+				ID:    1,
+				Field: domain.Field{ID: 1, Venue: domain.Venue{ID: 3}},
+			},
+		}
+
+		mockBookingRepo.EXPECT().
+			FindByID(ctx, bookingID).
+			Return(booking, nil)
+
+		mockUserRepo.EXPECT().
+			FindByID(ctx, staffID).
+			Return(domain.User{ID: staffID, Role: domain.Role{ID: 2, RoleName: domain.RoleCustomer}}, nil)
+
+		mockRoleRepo.EXPECT().
+			HasPermission(ctx, uint(2), domain.PermBookingReadAny).
+			Return(false, nil)
+
+		mockVenueMemberRepo.EXPECT().
+			FindByVenueAndUser(ctx, uint(3), staffID).
+			Return(domain.VenueMember{Role: domain.VenueRoleStaff}, nil)
+
+		result, err := bookingService.GetBookingByID(ctx, bookingID, staffID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, bookingID, result.ID)
+	})
+
+	t.Run("Fail - Not owner nor venue member", func(t *testing.T) {
+		ctx := context.Background()
+		bookingID := uint(2)
+		strangerID := uint(8)
+
+		booking := domain.Booking{
+			ID:   bookingID,
+			User: domain.User{ID: 1},
+			Schedule: domain.Schedule{
+				ID:    1,
+				Field: domain.Field{ID: 1, Venue: domain.Venue{ID: 3}},
+			},
+		}
+
+		mockBookingRepo.EXPECT().
+			FindByID(ctx, bookingID).
+			Return(booking, nil)
+
+		mockUserRepo.EXPECT().
+			FindByID(ctx, strangerID).
+			Return(domain.User{ID: strangerID, Role: domain.Role{ID: 2, RoleName: domain.RoleCustomer}}, nil).
+			Times(2)
+
+		mockRoleRepo.EXPECT().
+			HasPermission(ctx, uint(2), domain.PermBookingReadAny).
+			Return(false, nil)
+
+		mockVenueMemberRepo.EXPECT().
+			FindByVenueAndUser(ctx, uint(3), strangerID).
+			Return(domain.VenueMember{}, domain.ErrVenueMemberNotFound)
+
+		mockRoleRepo.EXPECT().
+			HasPermission(ctx, uint(2), domain.PermVenueManageAny).
+			Return(false, nil)
+
+		result, err := bookingService.GetBookingByID(ctx, bookingID, strangerID)
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrForbidden, err)
+	})
 }
 
 func TestBookingService_CancelBooking(t *testing.T) {
@@ -457,9 +666,21 @@ func TestBookingService_CancelBooking(t *testing.T) {
 
 	mockBookingRepo := mock.NewMockBookingRepository(ctrl)
 	mockScheduleRepo := mock.NewMockScheduleRepository(ctrl)
+	mockWindowRepo := mock.NewMockMaintenanceWindowRepository(ctrl)
 	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockVenueMemberRepo := mock.NewMockVenueMemberRepository(ctrl)
 
-	bookingService := service.NewBookingService(mockBookingRepo, mockScheduleRepo, mockUserRepo)
+	authorizer := service.NewAuthorizer(mockUserRepo, mockRoleRepo, mockVenueMemberRepo)
+	mockPaymentRepo := mock.NewMockPaymentRepository(ctrl)
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	mockTxManager := mock.NewMockTransactionManager(ctrl)
+	expectTransactions(mockTxManager)
+
+	mockPreferenceRepo := mock.NewMockNotificationPreferenceRepository(ctrl)
+	bookingNotifier := service.NewBookingNotifier(service.NewNotificationRouter(service.NewOutboxService(mockOutboxRepo, testOutboxConfig), newTestEmailTemplates(t), mockPreferenceRepo, noInbox(t, ctrl)), noWebhooks(ctrl), service.NewCalendarService(nil, nil, testCalendarConfig))
+	availabilityHub := service.NewAvailabilityHub(8)
+	bookingService := service.NewBookingService(mockBookingRepo, mockScheduleRepo, mockWindowRepo, mockUserRepo, mockPaymentRepo, authorizer, mockTxManager, bookingNotifier, availabilityHub)
 
 	t.Run("Success - Cancel booking", func(t *testing.T) {
 		ctx := context.Background()
@@ -474,6 +695,8 @@ func TestBookingService_CancelBooking(t *testing.T) {
 			User: domain.User{
 				ID:       userID,
 				FullName: "John Doe",
+				Email:    "john@example.com",
+				Language: domain.LanguageEnglish,
 			},
 		}
 
@@ -485,15 +708,32 @@ func TestBookingService_CancelBooking(t *testing.T) {
 			CancelBooking(ctx, bookingID).
 			Return(nil)
 
-		err := bookingService.CancelBooking(ctx, bookingID, userID)
+		mockOutboxRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, message *domain.OutboxMessage) error {
+				email := decodeOutboxEmail(t, message)
+				assert.Equal(t, "Booking #1 Cancelled", email.Subject)
+				assert.Contains(t, email.TextBody, "Field closed for repairs")
+				return nil
+			})
 
+		sub, err := availabilityHub.Subscribe(booking.Schedule.Field.ID)
 		assert.NoError(t, err)
+		defer sub.Close()
+
+		err = bookingService.CancelBooking(ctx, bookingID, userID, "Field closed for repairs")
+
+		assert.NoError(t, err)
+
+		update := <-sub.Updates
+		assert.Equal(t, domain.SlotUpdateReleased, update.Type)
+		assert.True(t, update.Available)
 	})
 
 	t.Run("Fail - Invalid booking ID", func(t *testing.T) {
 		ctx := context.Background()
 
-		err := bookingService.CancelBooking(ctx, 0, 1)
+		err := bookingService.CancelBooking(ctx, 0, 1, "")
 
 		assert.Error(t, err)
 		assert.Equal(t, "invalid booking or user id", err.Error())
@@ -502,7 +742,7 @@ func TestBookingService_CancelBooking(t *testing.T) {
 	t.Run("Fail - Invalid user ID", func(t *testing.T) {
 		ctx := context.Background()
 
-		err := bookingService.CancelBooking(ctx, 1, 0)
+		err := bookingService.CancelBooking(ctx, 1, 0, "")
 
 		assert.Error(t, err)
 		assert.Equal(t, "invalid booking or user id", err.Error())
@@ -517,7 +757,7 @@ func TestBookingService_CancelBooking(t *testing.T) {
 			FindByID(ctx, bookingID).
 			Return(domain.Booking{}, domain.ErrBookingNotFound)
 
-		err := bookingService.CancelBooking(ctx, bookingID, userID)
+		err := bookingService.CancelBooking(ctx, bookingID, userID, "")
 
 		assert.Error(t, err)
 		assert.Equal(t, domain.ErrBookingNotFound, err)
@@ -540,7 +780,7 @@ func TestBookingService_CancelBooking(t *testing.T) {
 			FindByID(ctx, bookingID).
 			Return(booking, nil)
 
-		err := bookingService.CancelBooking(ctx, bookingID, userID)
+		err := bookingService.CancelBooking(ctx, bookingID, userID, "")
 
 		assert.Error(t, err)
 		assert.Equal(t, domain.ErrForbidden, err)
@@ -563,7 +803,7 @@ func TestBookingService_CancelBooking(t *testing.T) {
 			FindByID(ctx, bookingID).
 			Return(booking, nil)
 
-		err := bookingService.CancelBooking(ctx, bookingID, userID)
+		err := bookingService.CancelBooking(ctx, bookingID, userID, "")
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "cannot cancel booking with status")
@@ -576,7 +816,7 @@ func TestBookingService_CancelBooking(t *testing.T) {
 
 		booking := domain.Booking{
 			ID:     bookingID,
-			Status: "COMPLETED",
+			Status: domain.BookingStatusCompleted,
 			User: domain.User{
 				ID: userID,
 			},
@@ -586,7 +826,7 @@ func TestBookingService_CancelBooking(t *testing.T) {
 			FindByID(ctx, bookingID).
 			Return(booking, nil)
 
-		err := bookingService.CancelBooking(ctx, bookingID, userID)
+		err := bookingService.CancelBooking(ctx, bookingID, userID, "")
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "cannot cancel booking with status")
@@ -613,9 +853,219 @@ func TestBookingService_CancelBooking(t *testing.T) {
 			CancelBooking(ctx, bookingID).
 			Return(errors.New("database error"))
 
-		err := bookingService.CancelBooking(ctx, bookingID, userID)
+		err := bookingService.CancelBooking(ctx, bookingID, userID, "")
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to cancel booking")
 	})
 }
+
+func TestBookingService_RecordPayment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBookingRepo := mock.NewMockBookingRepository(ctrl)
+	mockScheduleRepo := mock.NewMockScheduleRepository(ctrl)
+	mockWindowRepo := mock.NewMockMaintenanceWindowRepository(ctrl)
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockVenueMemberRepo := mock.NewMockVenueMemberRepository(ctrl)
+
+	authorizer := service.NewAuthorizer(mockUserRepo, mockRoleRepo, mockVenueMemberRepo)
+	mockPaymentRepo := mock.NewMockPaymentRepository(ctrl)
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	mockTxManager := mock.NewMockTransactionManager(ctrl)
+	expectTransactions(mockTxManager)
+
+	mockPreferenceRepo := mock.NewMockNotificationPreferenceRepository(ctrl)
+	bookingNotifier := service.NewBookingNotifier(service.NewNotificationRouter(service.NewOutboxService(mockOutboxRepo, testOutboxConfig), newTestEmailTemplates(t), mockPreferenceRepo, noInbox(t, ctrl)), noWebhooks(ctrl), service.NewCalendarService(nil, nil, testCalendarConfig))
+	availabilityHub := service.NewAvailabilityHub(8)
+	bookingService := service.NewBookingService(mockBookingRepo, mockScheduleRepo, mockWindowRepo, mockUserRepo, mockPaymentRepo, authorizer, mockTxManager, bookingNotifier, availabilityHub)
+
+	staffID := uint(5)
+	newBooking := func(status string) domain.Booking {
+		return domain.Booking{
+			ID:          7,
+			Status:      status,
+			TotalPrice:  150000,
+			BookingDate: time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC),
+			User:        domain.User{ID: 1, FullName: "John Doe", Email: "john@example.com", Language: domain.LanguageEnglish},
+			Schedule: domain.Schedule{
+				StartTime: time.Date(0, 1, 1, 19, 0, 0, 0, time.UTC),
+				EndTime:   time.Date(0, 1, 1, 20, 0, 0, 0, time.UTC),
+				Field:     domain.Field{Name: "Field A", Venue: domain.Venue{ID: 3, Name: "Venue 1"}},
+			},
+		}
+	}
+
+	t.Run("Success - Staff records payment", func(t *testing.T) {
+		ctx := context.Background()
+		paidAt := time.Date(2026, 3, 10, 9, 30, 0, 0, time.UTC)
+
+		mockBookingRepo.EXPECT().FindByID(ctx, uint(7)).Return(newBooking(domain.BookingStatusPending), nil)
+		mockVenueMemberRepo.EXPECT().
+			FindByVenueAndUser(ctx, uint(3), staffID).
+			Return(domain.VenueMember{Role: domain.VenueRoleStaff}, nil)
+		mockPaymentRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, payment *domain.Payment) error {
+				assert.Equal(t, float64(150000), payment.Amount)
+				assert.Equal(t, domain.PaymentStatusSuccess, payment.Status)
+				payment.ID = 42
+				payment.CreatedAt = paidAt
+				return nil
+			})
+		mockBookingRepo.EXPECT().Confirm(ctx, uint(7), gomock.Any()).Return(true, nil)
+		gomock.InOrder(
+			mockOutboxRepo.EXPECT().
+				Create(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, message *domain.OutboxMessage) error {
+					email := decodeOutboxEmail(t, message)
+					assert.Equal(t, "Booking #7 Confirmed", email.Subject)
+					require.Len(t, email.Attachments, 1)
+					assert.Equal(t, "booking-7.ics", email.Attachments[0].Filename)
+					assert.Contains(t, string(email.Attachments[0].Content), "UID:booking-7@api.futsal.test")
+					return nil
+				}),
+			mockOutboxRepo.EXPECT().
+				Create(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, message *domain.OutboxMessage) error {
+					email := decodeOutboxEmail(t, message)
+					assert.Equal(t, "Payment Receipt INV-20260310-000042", email.Subject)
+					return nil
+				}),
+		)
+
+		payment, err := bookingService.RecordPayment(ctx, 7, staffID, domain.PaymentMethodCash)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(42), payment.ID)
+		assert.Equal(t, "INV-20260310-000042", payment.ReceiptNumber())
+	})
+
+	t.Run("Fail - Booking is not pending", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockBookingRepo.EXPECT().FindByID(ctx, uint(7)).Return(newBooking(domain.BookingStatusConfirmed), nil)
+		mockVenueMemberRepo.EXPECT().
+			FindByVenueAndUser(ctx, uint(3), staffID).
+			Return(domain.VenueMember{Role: domain.VenueRoleStaff}, nil)
+
+		payment, err := bookingService.RecordPayment(ctx, 7, staffID, domain.PaymentMethodCash)
+
+		assert.ErrorIs(t, err, domain.ErrBookingNotPayable)
+		assert.Nil(t, payment)
+	})
+
+	t.Run("Fail - Booking paid at the same time", func(t *testing.T) {
+		ctx := context.Background()
+
+		// Both desks read the booking as pending; the other one confirmed it first.
+		mockBookingRepo.EXPECT().FindByID(ctx, uint(7)).Return(newBooking(domain.BookingStatusPending), nil)
+		mockVenueMemberRepo.EXPECT().
+			FindByVenueAndUser(ctx, uint(3), staffID).
+			Return(domain.VenueMember{Role: domain.VenueRoleStaff}, nil)
+		mockBookingRepo.EXPECT().Confirm(ctx, uint(7), gomock.Any()).Return(false, nil)
+
+		payment, err := bookingService.RecordPayment(ctx, 7, staffID, domain.PaymentMethodCash)
+
+		assert.ErrorIs(t, err, domain.ErrBookingNotPayable)
+		assert.Nil(t, payment)
+	})
+
+	t.Run("Fail - Not venue staff", func(t *testing.T) {
+		ctx := context.Background()
+		strangerID := uint(9)
+
+		mockBookingRepo.EXPECT().FindByID(ctx, uint(7)).Return(newBooking(domain.BookingStatusPending), nil)
+		mockVenueMemberRepo.EXPECT().
+			FindByVenueAndUser(ctx, uint(3), strangerID).
+			Return(domain.VenueMember{}, domain.ErrVenueMemberNotFound)
+		mockUserRepo.EXPECT().
+			FindByID(ctx, strangerID).
+			Return(domain.User{ID: strangerID, Role: domain.Role{ID: 2, RoleName: domain.RoleCustomer}}, nil)
+		mockRoleRepo.EXPECT().
+			HasPermission(ctx, uint(2), domain.PermVenueManageAny).
+			Return(false, nil)
+
+		payment, err := bookingService.RecordPayment(ctx, 7, strangerID, domain.PaymentMethodCash)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		assert.Nil(t, payment)
+	})
+}
+
+func TestBookingReminderScheduler_SendDueReminders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBookingRepo := mock.NewMockBookingRepository(ctrl)
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	mockTxManager := mock.NewMockTransactionManager(ctrl)
+	expectTransactions(mockTxManager)
+
+	mockPreferenceRepo := mock.NewMockNotificationPreferenceRepository(ctrl)
+	bookingNotifier := service.NewBookingNotifier(service.NewNotificationRouter(service.NewOutboxService(mockOutboxRepo, testOutboxConfig), newTestEmailTemplates(t), mockPreferenceRepo, noInbox(t, ctrl)), noWebhooks(ctrl), service.NewCalendarService(nil, nil, testCalendarConfig))
+	scheduler := service.NewBookingReminderScheduler(mockBookingRepo, mockTxManager, bookingNotifier, service.ReminderConfig{
+		Offsets:      []time.Duration{24 * time.Hour, 2 * time.Hour},
+		PollInterval: time.Minute,
+		BatchSize:    10,
+	})
+
+	booking := &domain.Booking{
+		ID:          11,
+		Status:      domain.BookingStatusConfirmed,
+		BookingDate: time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC),
+		User:        domain.User{ID: 1, FullName: "John Doe", Email: "john@example.com", Language: domain.LanguageEnglish},
+		Schedule: domain.Schedule{
+			StartTime: time.Date(0, 1, 1, 19, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(0, 1, 1, 20, 0, 0, 0, time.UTC),
+			Field:     domain.Field{Name: "Field A", Venue: domain.Venue{ID: 3, Name: "Venue 1"}},
+		},
+	}
+
+	t.Run("Success - Queues each claimed reminder once", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockBookingRepo.EXPECT().
+			FindDueForReminder(ctx, gomock.Any(), 24*time.Hour, 10).
+			Return([]*domain.Booking{booking}, nil)
+		mockBookingRepo.EXPECT().
+			MarkReminderSent(ctx, uint(11), 24*time.Hour, gomock.Any()).
+			Return(true, nil)
+		mockOutboxRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, message *domain.OutboxMessage) error {
+				email := decodeOutboxEmail(t, message)
+				assert.Equal(t, "john@example.com", email.To[0].Email)
+				assert.Contains(t, email.Subject, "Reminder")
+				return nil
+			})
+
+		// Another replica already sent the 2 hour reminder.
+		mockBookingRepo.EXPECT().
+			FindDueForReminder(ctx, gomock.Any(), 2*time.Hour, 10).
+			Return([]*domain.Booking{booking}, nil)
+		mockBookingRepo.EXPECT().
+			MarkReminderSent(ctx, uint(11), 2*time.Hour, gomock.Any()).
+			Return(false, nil)
+
+		sent, err := scheduler.SendDueReminders(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, sent)
+	})
+
+	t.Run("Fail - Repository error stops the run", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockBookingRepo.EXPECT().
+			FindDueForReminder(ctx, gomock.Any(), 24*time.Hour, 10).
+			Return(nil, errors.New("database error"))
+
+		sent, err := scheduler.SendDueReminders(ctx)
+
+		assert.Error(t, err)
+		assert.Equal(t, 0, sent)
+	})
+}
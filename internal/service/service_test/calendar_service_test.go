@@ -0,0 +1,188 @@
+package service_test
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository/mock"
+	"go-futsal-booking-api/internal/service"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testCalendarConfig = service.CalendarConfig{
+	ProductID:    "Futsal Booking API",
+	BaseURL:      "https://api.futsal.test",
+	FeedLookback: 30 * 24 * time.Hour,
+}
+
+func testCalendarBooking(id uint, date time.Time, status string) *domain.Booking {
+	return &domain.Booking{
+		ID:          id,
+		BookingDate: date,
+		Status:      status,
+		Schedule: domain.Schedule{
+			StartTime: time.Date(0, 1, 1, 19, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(0, 1, 1, 20, 0, 0, 0, time.UTC),
+			Field: domain.Field{
+				Name: "Field A",
+				Venue: domain.Venue{
+					Name:     "Arena Senayan",
+					Address:  "Jl. Pintu Satu Senayan",
+					City:     "Jakarta",
+					Timezone: "Asia/Makassar",
+				},
+			},
+		},
+	}
+}
+
+func TestEncodeCalendar(t *testing.T) {
+	makassar, err := time.LoadLocation("Asia/Makassar")
+	require.NoError(t, err)
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	now := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+
+	t.Run("Success - Fixed-offset zone is written with TZID and VTIMEZONE", func(t *testing.T) {
+		start := time.Date(2026, 3, 14, 19, 0, 0, 0, makassar)
+		ics := string(service.EncodeCalendar("Futsal Booking API", "", []domain.CalendarEvent{{
+			UID:      "booking-7@api.futsal.test",
+			Summary:  "Futsal: Field A, Arena",
+			Location: "Jl. Sudirman No. 1, Makassar",
+			Status:   domain.CalendarStatusConfirmed,
+			Start:    start,
+			End:      start.Add(time.Hour),
+		}}, now))
+
+		assert.True(t, strings.HasPrefix(ics, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+		assert.True(t, strings.HasSuffix(ics, "END:VCALENDAR\r\n"))
+		assert.Contains(t, ics, "TZID:Asia/Makassar\r\n")
+		assert.Contains(t, ics, "TZOFFSETTO:+0800\r\n")
+		assert.Contains(t, ics, "DTSTART;TZID=Asia/Makassar:20260314T190000\r\n")
+		assert.Contains(t, ics, "DTEND;TZID=Asia/Makassar:20260314T200000\r\n")
+		assert.Contains(t, ics, "DTSTAMP:20260301T080000Z\r\n")
+		assert.Contains(t, ics, `LOCATION:Jl. Sudirman No. 1\, Makassar`+"\r\n")
+		assert.Contains(t, ics, "STATUS:CONFIRMED\r\n")
+	})
+
+	t.Run("Success - Zone with daylight saving is written in UTC", func(t *testing.T) {
+		start := time.Date(2026, 7, 4, 19, 0, 0, 0, newYork)
+		ics := string(service.EncodeCalendar("Futsal Booking API", "", []domain.CalendarEvent{{
+			UID:   "booking-8@api.futsal.test",
+			Start: start,
+			End:   start.Add(time.Hour),
+		}}, now))
+
+		assert.NotContains(t, ics, "VTIMEZONE")
+		assert.Contains(t, ics, "DTSTART:20260704T230000Z\r\n")
+	})
+
+	t.Run("Success - Long lines are folded without splitting characters", func(t *testing.T) {
+		start := time.Date(2026, 3, 14, 19, 0, 0, 0, makassar)
+		ics := string(service.EncodeCalendar("Futsal Booking API", "", []domain.CalendarEvent{{
+			UID:         "booking-9@api.futsal.test",
+			Description: strings.Repeat("Lapangan ⚽ ", 20),
+			Start:       start,
+			End:         start.Add(time.Hour),
+		}}, now))
+
+		for _, line := range strings.Split(strings.TrimSuffix(ics, "\r\n"), "\r\n") {
+			assert.LessOrEqual(t, len(line), 75, line)
+		}
+
+		unfolded := strings.ReplaceAll(ics, "\r\n ", "")
+		assert.Contains(t, unfolded, "DESCRIPTION:"+strings.Repeat("Lapangan ⚽ ", 20)+"\r\n")
+	})
+}
+
+func TestCalendarService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCalendarRepo := mock.NewMockCalendarRepository(ctrl)
+	mockBookingRepo := mock.NewMockBookingRepository(ctrl)
+	calendarService := service.NewCalendarService(mockCalendarRepo, mockBookingRepo, testCalendarConfig)
+
+	t.Run("Success - Booking is in the venue's timezone with its address", func(t *testing.T) {
+		booking := testCalendarBooking(7, time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC), domain.BookingStatusConfirmed)
+
+		attachment := calendarService.BookingAttachment(*booking)
+		ics := string(attachment.Content)
+
+		assert.Equal(t, "booking-7.ics", attachment.Filename)
+		assert.True(t, strings.HasPrefix(attachment.ContentType, "text/calendar"))
+		assert.Contains(t, ics, "UID:booking-7@api.futsal.test\r\n")
+		assert.Contains(t, ics, "DTSTART;TZID=Asia/Makassar:20260314T190000\r\n")
+		assert.Contains(t, ics, `LOCATION:Jl. Pintu Satu Senayan\, Jakarta`+"\r\n")
+		assert.Contains(t, ics, `SUMMARY:Futsal: Field A\, Arena Senayan`+"\r\n")
+	})
+
+	t.Run("Success - Feed URL token opens the feed", func(t *testing.T) {
+		ctx := context.Background()
+		var savedHash string
+
+		mockCalendarRepo.EXPECT().
+			SaveFeed(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, feed *domain.CalendarFeed) error {
+				assert.Equal(t, uint(1), feed.UserID)
+				savedHash = feed.TokenHash
+				return nil
+			})
+
+		feedURL, err := calendarService.CreateFeed(ctx, 1)
+		require.NoError(t, err)
+
+		parsed, err := url.Parse(feedURL)
+		require.NoError(t, err)
+		assert.Equal(t, "/api/v1/users/me/calendar.ics", parsed.Path)
+		token := parsed.Query().Get("token")
+		assert.True(t, strings.HasPrefix(token, "cal_"))
+		assert.NotContains(t, savedHash, token)
+
+		recent := testCalendarBooking(1, time.Now().AddDate(0, 0, 3), domain.BookingStatusConfirmed)
+		cancelled := testCalendarBooking(2, time.Now().AddDate(0, 0, 1), domain.BookingStatusCancelled)
+		old := testCalendarBooking(3, time.Now().AddDate(0, -6, 0), domain.BookingStatusConfirmed)
+
+		mockCalendarRepo.EXPECT().
+			FindFeedByTokenHash(ctx, savedHash).
+			Return(domain.CalendarFeed{UserID: 1, TokenHash: savedHash}, nil)
+		mockBookingRepo.EXPECT().
+			FindByUserID(ctx, uint(1)).
+			Return([]*domain.Booking{recent, cancelled, old}, nil)
+
+		feed, err := calendarService.GetFeed(ctx, token)
+
+		require.NoError(t, err)
+		ics := string(feed)
+		assert.Equal(t, 2, strings.Count(ics, "BEGIN:VEVENT"))
+		assert.Equal(t, 1, strings.Count(ics, "BEGIN:VTIMEZONE"))
+		assert.Less(t, strings.Index(ics, "UID:booking-2@"), strings.Index(ics, "UID:booking-1@"))
+		assert.Contains(t, ics, "STATUS:CANCELLED\r\n")
+		assert.NotContains(t, ics, "UID:booking-3@")
+	})
+
+	t.Run("Fail - Unknown token", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockCalendarRepo.EXPECT().
+			FindFeedByTokenHash(ctx, gomock.Any()).
+			Return(domain.CalendarFeed{}, domain.ErrInvalidCalendarToken)
+
+		_, err := calendarService.GetFeed(ctx, "cal_unknown")
+
+		assert.Equal(t, domain.ErrInvalidCalendarToken, err)
+	})
+
+	t.Run("Fail - Missing token", func(t *testing.T) {
+		_, err := calendarService.GetFeed(context.Background(), "")
+
+		assert.Equal(t, domain.ErrInvalidCalendarToken, err)
+	})
+}
@@ -0,0 +1,266 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository/mock"
+	"go-futsal-booking-api/internal/service"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+const catalogHeader = "venue_name,venue_address,venue_city,venue_timezone,field_name,field_type,field_indoor,field_lighting,field_max_players,day_of_week,start_time,end_time,price\n"
+
+func TestCatalogService_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCatalogRepo := mock.NewMockCatalogRepository(ctrl)
+	mockFieldTypeRepo := mock.NewMockFieldTypeRepository(ctrl)
+	mockTxManager := mock.NewMockTransactionManager(ctrl)
+	expectTransactions(mockTxManager)
+
+	catalogService := service.NewCatalogService(mockCatalogRepo, mockFieldTypeRepo, mockTxManager)
+
+	mockFieldTypeRepo.EXPECT().FindAll(gomock.Any()).Return([]domain.FieldType{{Code: "SINTETIS"}, {Code: "VINYL"}}, nil).AnyTimes()
+
+	t.Run("Success - Import rows", func(t *testing.T) {
+		file := catalogHeader +
+			"Arena,Jl. Sudirman 1,Jakarta,,Court 1,vinyl,true,yes,,1,08:00,09:00,150000\n" +
+			"Arena,Jl. Sudirman 1,Jakarta,,Court 1,VINYL,true,true,10,2,08:00,09:00,150000\n" +
+			"Arena,Jl. Sudirman 1,Jakarta,,Court 2,SINTETIS,false,false,12,,,,\n" +
+			"\n" +
+			"Hall,Jl. Thamrin 2,Bandung,Asia/Makassar,,,,,,,,,\n"
+
+		mockCatalogRepo.EXPECT().
+			Import(gomock.Any(), gomock.Any(), uint(3)).
+			DoAndReturn(func(ctx context.Context, rows []domain.CatalogRow, ownerID uint) (domain.CatalogImport, error) {
+				require.Len(t, rows, 4)
+
+				assert.Equal(t, 2, rows[0].Row)
+				assert.Equal(t, domain.DefaultVenueTimezone, rows[0].Venue.Timezone)
+				assert.Equal(t, "VINYL", rows[0].Field.Type)
+				assert.True(t, rows[0].Field.HasLighting)
+				assert.Equal(t, domain.DefaultFieldMaxPlayers, rows[0].Field.MaxPlayers)
+				assert.Equal(t, 1, rows[0].Schedule.DayOfWeek)
+				assert.Equal(t, 150000.0, rows[0].Schedule.Price)
+
+				assert.Equal(t, 12, rows[2].Field.MaxPlayers)
+				assert.Nil(t, rows[2].Schedule)
+
+				assert.Equal(t, 6, rows[3].Row)
+				assert.Equal(t, "Asia/Makassar", rows[3].Venue.Timezone)
+				assert.Nil(t, rows[3].Field)
+
+				return domain.CatalogImport{VenuesCreated: 2, FieldsCreated: 2, SchedulesCreated: 2}, nil
+			})
+
+		result, err := catalogService.Import(context.Background(), "catalog.CSV", strings.NewReader(file), 3, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.VenuesCreated)
+		assert.Equal(t, 2, result.SchedulesCreated)
+		assert.False(t, result.DryRun)
+	})
+
+	t.Run("Fail - Every invalid row is reported", func(t *testing.T) {
+		file := catalogHeader +
+			"Arena,Jl. Sudirman 1,Jakarta,,Court 1,CLAY,true,true,10,8,09:00,08:00,0\n" +
+			"Arena,Jl. Sudirman 1,Bandung,,,,,,,,,,\n" +
+			",Jl. Thamrin 2,Jakarta,Mars/Olympus,,,,,,1,,,\n" +
+			"Hall,Jl. Thamrin 2,Jakarta,,Court 1,VINYL,maybe,,60,1,08:00,09:00,100000\n"
+
+		_, err := catalogService.Import(context.Background(), "catalog.csv", strings.NewReader(file), 3, false)
+
+		var importErr *domain.CatalogImportError
+		require.True(t, errors.As(err, &importErr))
+		assert.ErrorIs(t, err, domain.ErrInvalidCatalog)
+		assert.Equal(t, []domain.CatalogRowError{
+			{Row: 2, Column: "field_type", Message: domain.ErrFieldTypeNotFound.Error()},
+			{Row: 2, Column: "end_time", Message: "must be after start_time"},
+			{Row: 2, Column: "day_of_week", Message: domain.ErrInvalidDayOfWeek.Error()},
+			{Row: 2, Column: "price", Message: domain.ErrInvalidPrice.Error()},
+			{Row: 4, Column: "venue_name", Message: "is required"},
+			{Row: 4, Column: "venue_timezone", Message: domain.ErrInvalidTimezone.Error()},
+			{Row: 4, Column: "day_of_week", Message: "must be empty without a field_name"},
+			{Row: 5, Column: "field_indoor", Message: "must be true or false"},
+			{Row: 5, Column: "field_max_players", Message: "must be between 2 and 50"},
+		}, importErr.Rows)
+	})
+
+	t.Run("Fail - Rows disagree about the same venue", func(t *testing.T) {
+		file := catalogHeader +
+			"Arena,Jl. Sudirman 1,Jakarta,,Court 1,VINYL,,,,1,08:00,09:00,150000\n" +
+			"Arena,Jl. Sudirman 1,Bandung,,,,,,,,,,\n" +
+			"Arena,Jl. Sudirman 1,Jakarta,,Court 1,VINYL,,,,1,08:00,10:00,150000\n"
+
+		_, err := catalogService.Import(context.Background(), "catalog.csv", strings.NewReader(file), 3, false)
+
+		var importErr *domain.CatalogImportError
+		require.True(t, errors.As(err, &importErr))
+		assert.Equal(t, []domain.CatalogRowError{
+			{Row: 3, Message: "venue details differ from row 2"},
+			{Row: 4, Column: "start_time", Message: "same schedule as row 2"},
+		}, importErr.Rows)
+	})
+
+	t.Run("Fail - IDs must name one venue, field and schedule", func(t *testing.T) {
+		header := "venue_id,venue_name,venue_address,venue_city,venue_timezone,field_id,field_name,field_type,field_indoor,field_lighting,field_max_players,schedule_id,day_of_week,start_time,end_time,price\n"
+		file := header +
+			"4,Arena,Jl. Sudirman 1,Jakarta,,9,Court 1,VINYL,,,,31,1,08:00,09:00,150000\n" +
+			",Arena,Jl. Sudirman 1,Jakarta,,9,Court 1,VINYL,,,,,1,09:00,10:00,150000\n" +
+			"4,Arena Baru,Jl. Sudirman 1,Jakarta,,,,,,,,,,,,\n" +
+			"5,Hall,Jl. Thamrin 2,Jakarta,,9,Court 1,VINYL,,,,31,1,10:00,11:00,150000\n" +
+			"x,Dome,Jl. Thamrin 3,Jakarta,,,,,,,,,,,,\n"
+
+		_, err := catalogService.Import(context.Background(), "catalog.csv", strings.NewReader(file), 3, false)
+
+		var importErr *domain.CatalogImportError
+		require.True(t, errors.As(err, &importErr))
+		assert.Equal(t, []domain.CatalogRowError{
+			{Row: 3, Message: "venue details differ from row 2"},
+			{Row: 4, Column: "venue_id", Message: "same venue as row 2 under another name"},
+			{Row: 5, Column: "field_id", Message: "same field as row 2 under another name"},
+			{Row: 6, Column: "venue_id", Message: "must be a positive whole number"},
+		}, importErr.Rows)
+	})
+
+	t.Run("Fail - Missing and unknown columns", func(t *testing.T) {
+		file := strings.Replace(catalogHeader, "price", "cost", 1)
+
+		_, err := catalogService.Import(context.Background(), "catalog.csv", strings.NewReader(file), 3, false)
+
+		var importErr *domain.CatalogImportError
+		require.True(t, errors.As(err, &importErr))
+		assert.Equal(t, []domain.CatalogRowError{
+			{Row: 1, Column: "cost", Message: "unknown column"},
+			{Row: 1, Column: "price", Message: "missing column"},
+		}, importErr.Rows)
+	})
+
+	t.Run("Success - Dry run keeps nothing", func(t *testing.T) {
+		file := catalogHeader + "Arena,Jl. Sudirman 1,Jakarta,,,,,,,,,,\n"
+
+		var committed bool
+		dryRunTx := mock.NewMockTransactionManager(ctrl)
+		dryRunTx.EXPECT().
+			WithinTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				err := fn(ctx)
+				committed = err == nil
+				return err
+			})
+		dryRunService := service.NewCatalogService(mockCatalogRepo, mockFieldTypeRepo, dryRunTx)
+
+		mockCatalogRepo.EXPECT().Import(gomock.Any(), gomock.Any(), uint(3)).Return(domain.CatalogImport{VenuesUpdated: 1}, nil)
+
+		result, err := dryRunService.Import(context.Background(), "catalog.csv", strings.NewReader(file), 3, true)
+
+		require.NoError(t, err)
+		assert.False(t, committed)
+		assert.True(t, result.DryRun)
+		assert.Equal(t, 1, result.VenuesUpdated)
+	})
+
+	t.Run("Fail - Unsupported file", func(t *testing.T) {
+		_, err := catalogService.Import(context.Background(), "catalog.xls", strings.NewReader(catalogHeader), 3, false)
+
+		assert.Equal(t, domain.ErrUnsupportedCatalog, err)
+	})
+}
+
+func TestCatalogService_ExportRoundTrip(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCatalogRepo := mock.NewMockCatalogRepository(ctrl)
+	mockFieldTypeRepo := mock.NewMockFieldTypeRepository(ctrl)
+	mockTxManager := mock.NewMockTransactionManager(ctrl)
+	expectTransactions(mockTxManager)
+
+	catalogService := service.NewCatalogService(mockCatalogRepo, mockFieldTypeRepo, mockTxManager)
+
+	venue := domain.Venue{ID: 4, Name: "Arena, Senayan", Address: "Jl. Sudirman 1", City: "Jakarta", Timezone: "Asia/Jakarta"}
+	field := domain.Field{ID: 9, Name: "Court 1", Type: "VINYL", Indoor: true, MaxPlayers: 10, Status: domain.FieldStatusActive}
+	schedule := domain.Schedule{
+		ID:        31,
+		DayOfWeek: 6,
+		StartTime: time.Date(0, 1, 1, 18, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(0, 1, 1, 19, 30, 0, 0, time.UTC),
+		Price:     175000.5,
+	}
+	exported := []domain.CatalogRow{
+		{Venue: venue, Field: &field, Schedule: &schedule},
+		{Venue: venue, Field: &domain.Field{ID: 10, Name: "Court 2", Type: "SINTETIS", MaxPlayers: 12, Status: domain.FieldStatusActive}},
+		{Venue: domain.Venue{ID: 5, Name: "=HYPERLINK(\"https://evil.example\")", Address: "@SUM(A1)", City: "Bandung", Timezone: "Asia/Jakarta"}},
+	}
+
+	mockCatalogRepo.EXPECT().Export(gomock.Any()).Return(exported, nil).Times(2)
+	mockFieldTypeRepo.EXPECT().FindAll(gomock.Any()).Return([]domain.FieldType{{Code: "SINTETIS"}, {Code: "VINYL"}}, nil).Times(2)
+
+	for _, format := range []string{domain.CatalogFormatCSV, domain.CatalogFormatXLSX} {
+		t.Run("Success - "+format, func(t *testing.T) {
+			file, err := catalogService.Export(context.Background(), format)
+			require.NoError(t, err)
+
+			mockCatalogRepo.EXPECT().
+				Import(gomock.Any(), gomock.Any(), uint(1)).
+				DoAndReturn(func(ctx context.Context, rows []domain.CatalogRow, ownerID uint) (domain.CatalogImport, error) {
+					require.Len(t, rows, len(exported))
+					for i, row := range rows {
+						assert.Equal(t, exported[i].Venue, row.Venue)
+						assert.Equal(t, exported[i].Field, row.Field)
+					}
+					assert.Equal(t, uint(31), rows[0].Schedule.ID)
+					assert.Equal(t, 6, rows[0].Schedule.DayOfWeek)
+					assert.Equal(t, "18:00", rows[0].Schedule.StartTime.Format("15:04"))
+					assert.Equal(t, "19:30", rows[0].Schedule.EndTime.Format("15:04"))
+					assert.Equal(t, 175000.5, rows[0].Schedule.Price)
+					return domain.CatalogImport{}, nil
+				})
+
+			_, err = catalogService.Import(context.Background(), "catalog."+format, strings.NewReader(string(file)), 1, false)
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("Success - Formulas are quoted", func(t *testing.T) {
+		mockCatalogRepo.EXPECT().Export(gomock.Any()).Return(exported, nil)
+
+		file, err := catalogService.Export(context.Background(), domain.CatalogFormatCSV)
+
+		require.NoError(t, err)
+		assert.Contains(t, string(file), "\n5,\"'=HYPERLINK(\"\"https://evil.example\"\")\",'@SUM(A1),Bandung,")
+	})
+
+	t.Run("Success - XLSX cells are not quoted", func(t *testing.T) {
+		mockCatalogRepo.EXPECT().Export(gomock.Any()).Return(exported, nil)
+
+		file, err := catalogService.Export(context.Background(), domain.CatalogFormatXLSX)
+		require.NoError(t, err)
+
+		book, err := excelize.OpenReader(bytes.NewReader(file))
+		require.NoError(t, err)
+		defer book.Close()
+
+		rows, err := book.GetRows(book.GetSheetName(0))
+		require.NoError(t, err)
+		require.Len(t, rows, len(exported)+1)
+		assert.Contains(t, rows[len(exported)], "=HYPERLINK(\"https://evil.example\")")
+		assert.Contains(t, rows[len(exported)], "@SUM(A1)")
+	})
+
+	t.Run("Fail - Unsupported format", func(t *testing.T) {
+		_, err := catalogService.Export(context.Background(), "pdf")
+
+		assert.Equal(t, domain.ErrUnsupportedCatalog, err)
+	})
+}
@@ -0,0 +1,209 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository/mock"
+	"go-futsal-booking-api/internal/service"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCheckInKey = "check-in-secret"
+
+// checkInToken builds the token that the booking's QR code encodes.
+func checkInToken(key string, bookingID uint, date time.Time) string {
+	id := fmt.Sprint(bookingID)
+	day := date.Format("20060102")
+
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte("check-in:" + id + ":" + day))
+
+	return id + "." + day + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func testCheckInBooking(id uint, date time.Time, status string) domain.Booking {
+	return domain.Booking{
+		ID:          id,
+		User:        domain.User{ID: 1},
+		BookingDate: time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC),
+		Status:      status,
+		Schedule: domain.Schedule{
+			Field: domain.Field{
+				Venue: domain.Venue{ID: 3, Timezone: "Asia/Makassar"},
+			},
+		},
+	}
+}
+
+func TestCheckInService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBookingRepo := mock.NewMockBookingRepository(ctrl)
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockVenueMemberRepo := mock.NewMockVenueMemberRepository(ctrl)
+	authorizer := service.NewAuthorizer(mockUserRepo, mockRoleRepo, mockVenueMemberRepo)
+	checkInService := service.NewCheckInService(mockBookingRepo, authorizer, testCheckInKey)
+
+	makassar, err := time.LoadLocation("Asia/Makassar")
+	require.NoError(t, err)
+	today := time.Now().In(makassar)
+	staffID := uint(9)
+
+	expectStaff := func(ctx context.Context) {
+		mockVenueMemberRepo.EXPECT().
+			FindByVenueAndUser(ctx, uint(3), staffID).
+			Return(domain.VenueMember{Venue: domain.Venue{ID: 3}, User: domain.User{ID: staffID}, Role: domain.VenueRoleStaff}, nil)
+	}
+
+	t.Run("Success - Owner gets a PNG QR code", func(t *testing.T) {
+		ctx := context.Background()
+		booking := testCheckInBooking(7, today, domain.BookingStatusConfirmed)
+
+		mockBookingRepo.EXPECT().FindByID(ctx, uint(7)).Return(booking, nil)
+
+		png, err := checkInService.QRCode(ctx, 7, 1)
+
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(png, []byte("\x89PNG\r\n\x1a\n")))
+	})
+
+	t.Run("Fail - Another user cannot get the QR code", func(t *testing.T) {
+		ctx := context.Background()
+		booking := testCheckInBooking(7, today, domain.BookingStatusConfirmed)
+
+		mockBookingRepo.EXPECT().FindByID(ctx, uint(7)).Return(booking, nil)
+
+		_, err := checkInService.QRCode(ctx, 7, 2)
+
+		assert.Equal(t, domain.ErrForbidden, err)
+	})
+
+	t.Run("Fail - Pending booking has no QR code", func(t *testing.T) {
+		ctx := context.Background()
+		booking := testCheckInBooking(7, today, domain.BookingStatusPending)
+
+		mockBookingRepo.EXPECT().FindByID(ctx, uint(7)).Return(booking, nil)
+
+		_, err := checkInService.QRCode(ctx, 7, 1)
+
+		assert.Equal(t, domain.ErrBookingNotConfirmed, err)
+	})
+
+	t.Run("Success - Staff checks in today's booking", func(t *testing.T) {
+		ctx := context.Background()
+		booking := testCheckInBooking(7, today, domain.BookingStatusConfirmed)
+
+		expectStaff(ctx)
+		mockBookingRepo.EXPECT().FindByID(ctx, uint(7)).Return(booking, nil)
+		mockBookingRepo.EXPECT().CheckIn(ctx, uint(7), gomock.Any()).Return(true, nil)
+
+		checkedIn, err := checkInService.CheckIn(ctx, checkInToken(testCheckInKey, 7, today), 3, staffID)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.BookingStatusCheckedIn, checkedIn.Status)
+		require.NotNil(t, checkedIn.CheckedInAt)
+	})
+
+	t.Run("Fail - Code cannot be used twice", func(t *testing.T) {
+		ctx := context.Background()
+		booking := testCheckInBooking(7, today, domain.BookingStatusCheckedIn)
+
+		expectStaff(ctx)
+		mockBookingRepo.EXPECT().FindByID(ctx, uint(7)).Return(booking, nil)
+
+		_, err := checkInService.CheckIn(ctx, checkInToken(testCheckInKey, 7, today), 3, staffID)
+
+		assert.Equal(t, domain.ErrAlreadyCheckedIn, err)
+	})
+
+	t.Run("Fail - Concurrent scan loses the race", func(t *testing.T) {
+		ctx := context.Background()
+		booking := testCheckInBooking(7, today, domain.BookingStatusConfirmed)
+
+		expectStaff(ctx)
+		mockBookingRepo.EXPECT().FindByID(ctx, uint(7)).Return(booking, nil)
+		mockBookingRepo.EXPECT().CheckIn(ctx, uint(7), gomock.Any()).Return(false, nil)
+
+		_, err := checkInService.CheckIn(ctx, checkInToken(testCheckInKey, 7, today), 3, staffID)
+
+		assert.Equal(t, domain.ErrAlreadyCheckedIn, err)
+	})
+
+	t.Run("Fail - Booking is for another day", func(t *testing.T) {
+		ctx := context.Background()
+		tomorrow := today.AddDate(0, 0, 1)
+		booking := testCheckInBooking(7, tomorrow, domain.BookingStatusConfirmed)
+
+		expectStaff(ctx)
+		mockBookingRepo.EXPECT().FindByID(ctx, uint(7)).Return(booking, nil)
+
+		_, err := checkInService.CheckIn(ctx, checkInToken(testCheckInKey, 7, tomorrow), 3, staffID)
+
+		assert.Equal(t, domain.ErrCheckInWrongDate, err)
+	})
+
+	t.Run("Fail - Booking is at another venue", func(t *testing.T) {
+		ctx := context.Background()
+		booking := testCheckInBooking(7, today, domain.BookingStatusConfirmed)
+		booking.Schedule.Field.Venue.ID = 4
+
+		expectStaff(ctx)
+		mockBookingRepo.EXPECT().FindByID(ctx, uint(7)).Return(booking, nil)
+
+		_, err := checkInService.CheckIn(ctx, checkInToken(testCheckInKey, 7, today), 3, staffID)
+
+		assert.Equal(t, domain.ErrCheckInWrongVenue, err)
+	})
+
+	t.Run("Fail - Tampered code", func(t *testing.T) {
+		ctx := context.Background()
+
+		expectStaff(ctx)
+
+		_, err := checkInService.CheckIn(ctx, checkInToken("guessed-key", 7, today), 3, staffID)
+
+		assert.Equal(t, domain.ErrInvalidCheckInToken, err)
+	})
+
+	t.Run("Fail - Code for a booking that was moved", func(t *testing.T) {
+		ctx := context.Background()
+		booking := testCheckInBooking(7, today.AddDate(0, 0, 2), domain.BookingStatusConfirmed)
+
+		expectStaff(ctx)
+		mockBookingRepo.EXPECT().FindByID(ctx, uint(7)).Return(booking, nil)
+
+		_, err := checkInService.CheckIn(ctx, checkInToken(testCheckInKey, 7, today), 3, staffID)
+
+		assert.Equal(t, domain.ErrInvalidCheckInToken, err)
+	})
+
+	t.Run("Fail - Customer cannot check themselves in", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockVenueMemberRepo.EXPECT().
+			FindByVenueAndUser(ctx, uint(3), uint(1)).
+			Return(domain.VenueMember{}, domain.ErrVenueMemberNotFound)
+		mockUserRepo.EXPECT().
+			FindByID(ctx, uint(1)).
+			Return(domain.User{ID: 1, Role: domain.Role{ID: 2, RoleName: domain.RoleCustomer}}, nil)
+		mockRoleRepo.EXPECT().
+			HasPermission(ctx, uint(2), domain.PermVenueManageAny).
+			Return(false, nil)
+
+		_, err := checkInService.CheckIn(ctx, checkInToken(testCheckInKey, 7, today), 3, 1)
+
+		assert.Equal(t, domain.ErrForbidden, err)
+	})
+}
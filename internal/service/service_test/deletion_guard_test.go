@@ -0,0 +1,179 @@
+package service_test
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository/mock"
+	"go-futsal-booking-api/internal/service"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeletionGuard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVenueRepo := mock.NewMockVenueRepository(ctrl)
+	mockFieldRepo := mock.NewMockFieldRepository(ctrl)
+	mockScheduleRepo := mock.NewMockScheduleRepository(ctrl)
+	mockBookingRepo := mock.NewMockBookingRepository(ctrl)
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockVenueMemberRepo := mock.NewMockVenueMemberRepository(ctrl)
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	mockPreferenceRepo := mock.NewMockNotificationPreferenceRepository(ctrl)
+	mockTxManager := mock.NewMockTransactionManager(ctrl)
+	expectTransactions(mockTxManager)
+
+	authorizer := service.NewAuthorizer(mockUserRepo, mockRoleRepo, mockVenueMemberRepo)
+	bookingNotifier := service.NewBookingNotifier(service.NewNotificationRouter(service.NewOutboxService(mockOutboxRepo, testOutboxConfig), newTestEmailTemplates(t), mockPreferenceRepo, noInbox(t, ctrl)), noWebhooks(ctrl), service.NewCalendarService(nil, nil, testCalendarConfig))
+	availabilityHub := service.NewAvailabilityHub(8)
+	guard := service.NewDeletionGuard(mockBookingRepo, mockTxManager, bookingNotifier, availabilityHub)
+	venueService := service.NewVenueService(mockVenueRepo, mockVenueMemberRepo, mockUserRepo, nil, authorizer, guard, mockTxManager)
+	fieldService := service.NewFieldService(mockFieldRepo, mockVenueRepo, mockScheduleRepo, nil, authorizer, guard)
+	scheduleService := service.NewScheduleService(mockScheduleRepo, mockFieldRepo, mockBookingRepo, nil, authorizer, guard)
+
+	venue := domain.Venue{ID: 1, Name: "Arena", Timezone: "Asia/Jakarta"}
+	ownerID := uint(10)
+	booking := &domain.Booking{
+		ID:          21,
+		Status:      domain.BookingStatusConfirmed,
+		BookingDate: time.Now().AddDate(0, 0, 3),
+		User:        domain.User{ID: 7, FullName: "Budi", Email: "budi@example.com", Language: domain.LanguageEnglish},
+		Schedule: domain.Schedule{
+			ID:        3,
+			StartTime: time.Date(0, 1, 1, 19, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(0, 1, 1, 20, 0, 0, 0, time.UTC),
+			Field:     domain.Field{ID: 2, Name: "Field A", Venue: venue},
+		},
+	}
+	scope := domain.DeletionScope{VenueID: 1}
+
+	expectOwner := func(ctx context.Context) {
+		mockVenueMemberRepo.EXPECT().
+			FindByVenueAndUser(ctx, uint(1), ownerID).
+			Return(domain.VenueMember{Role: domain.VenueRoleOwner}, nil)
+	}
+
+	t.Run("Success - Preview counts what a venue deletion affects", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockVenueRepo.EXPECT().FindByID(ctx, uint(1)).Return(venue, nil)
+		expectOwner(ctx)
+		mockVenueRepo.EXPECT().DeletionImpact(ctx, uint(1)).Return(domain.DeletionImpact{Fields: 2, Schedules: 14}, nil)
+		mockBookingRepo.EXPECT().FindUpcoming(ctx, scope, gomock.Any()).Return([]*domain.Booking{booking}, nil)
+
+		impact, err := venueService.GetVenueDeletionPreview(ctx, 1, ownerID)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.DeletionImpact{Fields: 2, Schedules: 14, FutureBookings: 1}, *impact)
+	})
+
+	t.Run("Fail - Upcoming bookings block deletion", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockVenueRepo.EXPECT().FindByID(ctx, uint(1)).Return(venue, nil)
+		expectOwner(ctx)
+		mockVenueRepo.EXPECT().DeletionImpact(ctx, uint(1)).Return(domain.DeletionImpact{Fields: 2, Schedules: 14}, nil)
+		mockBookingRepo.EXPECT().FindUpcoming(ctx, scope, gomock.Any()).Return([]*domain.Booking{booking}, nil)
+
+		impact, err := venueService.DeleteVenue(ctx, 1, ownerID, false)
+
+		assert.ErrorIs(t, err, domain.ErrHasFutureBookings)
+		require.NotNil(t, impact)
+		assert.Equal(t, int64(1), impact.FutureBookings)
+	})
+
+	t.Run("Success - Forced deletion cancels upcoming bookings", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockVenueRepo.EXPECT().FindByID(ctx, uint(1)).Return(venue, nil)
+		expectOwner(ctx)
+		mockVenueRepo.EXPECT().DeletionImpact(ctx, uint(1)).Return(domain.DeletionImpact{Fields: 2, Schedules: 14}, nil)
+		mockBookingRepo.EXPECT().FindUpcoming(ctx, scope, gomock.Any()).Return([]*domain.Booking{booking}, nil)
+		mockBookingRepo.EXPECT().CancelBooking(ctx, uint(21)).Return(nil)
+		mockOutboxRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, message *domain.OutboxMessage) error {
+				email := decodeOutboxEmail(t, message)
+				assert.Equal(t, "budi@example.com", email.To[0].Email)
+				assert.Equal(t, "Booking #21 Cancelled", email.Subject)
+				assert.Contains(t, email.TextBody, "Reason: The venue has closed")
+				return nil
+			})
+		mockVenueRepo.EXPECT().Delete(ctx, uint(1), gomock.Any()).Return(nil)
+
+		sub, err := availabilityHub.Subscribe(2)
+		require.NoError(t, err)
+		defer sub.Close()
+
+		impact, err := venueService.DeleteVenue(ctx, 1, ownerID, true)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.DeletionImpact{Fields: 2, Schedules: 14, FutureBookings: 1}, *impact)
+
+		released := <-sub.Updates
+		assert.Equal(t, domain.SlotUpdateReleased, released.Type)
+		assert.Equal(t, uint(3), released.ScheduleID)
+		closed := <-sub.Updates
+		assert.Equal(t, domain.SlotUpdateClosed, closed.Type)
+		assert.Equal(t, uint(3), closed.ScheduleID)
+		assert.False(t, closed.Available)
+	})
+
+	t.Run("Success - Restore a recently deleted field", func(t *testing.T) {
+		ctx := context.Background()
+		deletedAt := time.Now().Add(-time.Hour)
+
+		mockFieldRepo.EXPECT().
+			FindDeletedByID(ctx, uint(2)).
+			Return(domain.Field{ID: 2, Venue: venue, DeletedAt: &deletedAt}, nil)
+		mockFieldRepo.EXPECT().Restore(ctx, uint(2)).Return(nil)
+		mockFieldRepo.EXPECT().FindByID(ctx, uint(2)).Return(domain.Field{ID: 2, Venue: venue}, nil)
+
+		field, err := fieldService.RestoreField(ctx, 2)
+
+		require.NoError(t, err)
+		assert.Nil(t, field.DeletedAt)
+	})
+
+	t.Run("Fail - Restore window has passed", func(t *testing.T) {
+		ctx := context.Background()
+		deletedAt := time.Now().Add(-domain.RestoreWindow - time.Hour)
+
+		mockFieldRepo.EXPECT().
+			FindDeletedByID(ctx, uint(2)).
+			Return(domain.Field{ID: 2, Venue: venue, DeletedAt: &deletedAt}, nil)
+
+		_, err := fieldService.RestoreField(ctx, 2)
+
+		assert.Equal(t, domain.ErrRestoreExpired, err)
+	})
+
+	t.Run("Fail - Restore a schedule of a deleted field", func(t *testing.T) {
+		ctx := context.Background()
+		deletedAt := time.Now().Add(-time.Hour)
+
+		mockScheduleRepo.EXPECT().
+			FindDeletedByID(ctx, uint(3)).
+			Return(domain.Schedule{ID: 3, Field: domain.Field{ID: 2, Venue: venue, DeletedAt: &deletedAt}, DeletedAt: &deletedAt}, nil)
+
+		_, err := scheduleService.RestoreSchedule(ctx, 3)
+
+		assert.Equal(t, domain.ErrParentDeleted, err)
+	})
+
+	t.Run("Fail - Restore a venue that is not deleted", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockVenueRepo.EXPECT().FindDeletedByID(ctx, uint(1)).Return(domain.Venue{}, domain.ErrNotDeleted)
+
+		_, err := venueService.RestoreVenue(ctx, 1)
+
+		assert.Equal(t, domain.ErrNotDeleted, err)
+	})
+}
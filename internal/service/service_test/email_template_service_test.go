@@ -0,0 +1,85 @@
+package service_test
+
+import (
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/service"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailTemplateService_Render(t *testing.T) {
+	templates := newTestEmailTemplates(t)
+
+	start := time.Date(2025, time.March, 14, 19, 0, 0, 0, time.FixedZone("WIB", 7*60*60))
+	data := service.BookingEmailData{
+		FullName:   "Budi <script>",
+		BookingID:  7,
+		VenueName:  "Arena",
+		FieldName:  "A",
+		StartTime:  start,
+		EndTime:    start.Add(time.Hour),
+		TotalPrice: 1250000,
+	}
+
+	t.Run("Success - Indonesian booking confirmation", func(t *testing.T) {
+		email, err := templates.Render(domain.EmailTemplateBookingConfirmation, domain.LanguageIndonesian, data)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Booking #7 Dikonfirmasi", email.Subject)
+		assert.Contains(t, email.TextBody, "Jumat, 14 Maret 2025")
+		assert.Contains(t, email.TextBody, "Rp1.250.000")
+		assert.Contains(t, email.TextBody, "Budi <script>")
+		assert.Contains(t, email.HTMLBody, `<html lang="id">`)
+		assert.Contains(t, email.HTMLBody, "Budi &lt;script&gt;")
+		assert.NotContains(t, email.HTMLBody, "<script>")
+	})
+
+	t.Run("Success - English booking confirmation", func(t *testing.T) {
+		email, err := templates.Render(domain.EmailTemplateBookingConfirmation, domain.LanguageEnglish, data)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Booking #7 Confirmed", email.Subject)
+		assert.Contains(t, email.TextBody, "Friday, 14 March 2025")
+		assert.Contains(t, email.TextBody, "Rp1,250,000")
+		assert.Contains(t, email.HTMLBody, "19:00 - 20:00")
+	})
+
+	t.Run("Success - Unknown language falls back to Indonesian", func(t *testing.T) {
+		email, err := templates.Render(domain.EmailTemplateBookingConfirmation, "fr", data)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Booking #7 Dikonfirmasi", email.Subject)
+	})
+
+	t.Run("Fail - Unknown template", func(t *testing.T) {
+		_, err := templates.Render("welcome_pack", domain.LanguageEnglish, data)
+
+		assert.Equal(t, domain.ErrEmailTemplateNotFound, err)
+	})
+}
+
+func TestEmailTemplateService_Preview(t *testing.T) {
+	templates := newTestEmailTemplates(t)
+
+	t.Run("Success - Every template renders in every language", func(t *testing.T) {
+		for _, name := range templates.Templates() {
+			for _, language := range domain.SupportedLanguages {
+				email, err := templates.Preview(name, language)
+
+				require.NoError(t, err, "%s/%s", language, name)
+				assert.NotEmpty(t, email.Subject, "%s/%s", language, name)
+				assert.NotEmpty(t, email.TextBody, "%s/%s", language, name)
+				assert.Contains(t, email.HTMLBody, "Futsal Booking API", "%s/%s", language, name)
+			}
+		}
+	})
+
+	t.Run("Fail - Unsupported language", func(t *testing.T) {
+		_, err := templates.Preview(domain.EmailTemplatePaymentReceipt, "de")
+
+		assert.Equal(t, domain.ErrUnsupportedLanguage, err)
+	})
+}
@@ -0,0 +1,92 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository/mock"
+	"go-futsal-booking-api/internal/service"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFavouriteService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFavouriteRepo := mock.NewMockFavouriteRepository(ctrl)
+	mockVenueRepo := mock.NewMockVenueRepository(ctrl)
+	mockFieldRepo := mock.NewMockFieldRepository(ctrl)
+
+	favouriteService := service.NewFavouriteService(mockFavouriteRepo, mockVenueRepo, mockFieldRepo)
+
+	venue := domain.Venue{ID: 1, Name: "Arena"}
+	field := domain.Field{ID: 2, Name: "Field A", Venue: venue}
+
+	t.Run("Success - Get favourites", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockFavouriteRepo.EXPECT().
+			FindByUserID(ctx, uint(7)).
+			Return(domain.Favourites{Venues: []domain.Venue{venue}, Fields: []domain.Field{field}}, nil)
+
+		favourites, err := favouriteService.GetFavourites(ctx, 7)
+
+		require.NoError(t, err)
+		assert.Len(t, favourites.Venues, 1)
+		assert.Len(t, favourites.Fields, 1)
+	})
+
+	t.Run("Success - Add venue", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockVenueRepo.EXPECT().FindByID(ctx, uint(1)).Return(venue, nil)
+		mockFavouriteRepo.EXPECT().AddVenue(ctx, uint(7), uint(1)).Return(nil)
+
+		assert.NoError(t, favouriteService.AddVenue(ctx, 7, 1))
+	})
+
+	t.Run("Fail - Add unknown venue", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockVenueRepo.EXPECT().FindByID(ctx, uint(99)).Return(domain.Venue{}, errors.New("venue not found"))
+
+		assert.Equal(t, domain.ErrVenueNotFound, favouriteService.AddVenue(ctx, 7, 99))
+	})
+
+	t.Run("Fail - Remove venue not in favourites", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockFavouriteRepo.EXPECT().RemoveVenue(ctx, uint(7), uint(1)).Return(domain.ErrFavouriteNotFound)
+
+		assert.Equal(t, domain.ErrFavouriteNotFound, favouriteService.RemoveVenue(ctx, 7, 1))
+	})
+
+	t.Run("Success - Add field", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockFieldRepo.EXPECT().FindByID(ctx, uint(2)).Return(field, nil)
+		mockFavouriteRepo.EXPECT().AddField(ctx, uint(7), uint(2)).Return(nil)
+
+		assert.NoError(t, favouriteService.AddField(ctx, 7, 2))
+	})
+
+	t.Run("Fail - Add unknown field", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockFieldRepo.EXPECT().FindByID(ctx, uint(99)).Return(domain.Field{}, domain.ErrFieldNotFound)
+
+		assert.Equal(t, domain.ErrFieldNotFound, favouriteService.AddField(ctx, 7, 99))
+	})
+
+	t.Run("Success - Remove field", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockFavouriteRepo.EXPECT().RemoveField(ctx, uint(7), uint(2)).Return(nil)
+
+		assert.NoError(t, favouriteService.RemoveField(ctx, 7, 2))
+	})
+}
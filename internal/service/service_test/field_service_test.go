@@ -0,0 +1,143 @@
+package service_test
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/dto/request"
+	"go-futsal-booking-api/internal/repository/mock"
+	"go-futsal-booking-api/internal/service"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFieldRepo := mock.NewMockFieldRepository(ctrl)
+	mockVenueRepo := mock.NewMockVenueRepository(ctrl)
+	mockScheduleRepo := mock.NewMockScheduleRepository(ctrl)
+	mockFieldTypeRepo := mock.NewMockFieldTypeRepository(ctrl)
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockVenueMemberRepo := mock.NewMockVenueMemberRepository(ctrl)
+
+	authorizer := service.NewAuthorizer(mockUserRepo, mockRoleRepo, mockVenueMemberRepo)
+	fieldService := service.NewFieldService(mockFieldRepo, mockVenueRepo, mockScheduleRepo, mockFieldTypeRepo, authorizer, nil)
+
+	venue := domain.Venue{ID: 1, Name: "Arena"}
+	expectManager := func(ctx context.Context) {
+		mockVenueMemberRepo.EXPECT().
+			FindByVenueAndUser(ctx, uint(1), uint(10)).
+			Return(domain.VenueMember{Role: domain.VenueRoleManager}, nil)
+	}
+	sintetis := domain.FieldType{Code: "SINTETIS", Name: "Synthetic grass"}
+
+	t.Run("Success - Create field with defaults", func(t *testing.T) {
+		ctx := context.Background()
+		length := 40.0
+
+		mockVenueRepo.EXPECT().FindByID(ctx, uint(1)).Return(venue, nil)
+		expectManager(ctx)
+		mockFieldTypeRepo.EXPECT().FindByCode(ctx, "SINTETIS").Return(sintetis, nil)
+		mockFieldRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		field, err := fieldService.CreateField(ctx, &request.CreateFieldRequest{
+			VenueID:             1,
+			Name:                "Field A",
+			FieldType:           "sintetis",
+			FieldDetailsRequest: request.FieldDetailsRequest{LengthMeters: &length},
+		}, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, "SINTETIS", field.Type)
+		assert.Equal(t, &length, field.LengthMeters)
+		assert.Nil(t, field.WidthMeters)
+		assert.Equal(t, domain.DefaultFieldMaxPlayers, field.MaxPlayers)
+		assert.Equal(t, domain.FieldStatusActive, field.Status)
+	})
+
+	t.Run("Fail - Unknown field type", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockVenueRepo.EXPECT().FindByID(ctx, uint(1)).Return(venue, nil)
+		expectManager(ctx)
+		mockFieldTypeRepo.EXPECT().FindByCode(ctx, "RUMPUT").Return(domain.FieldType{}, domain.ErrFieldTypeNotFound)
+
+		_, err := fieldService.CreateField(ctx, &request.CreateFieldRequest{
+			VenueID:   1,
+			Name:      "Field A",
+			FieldType: "rumput",
+		}, 10)
+
+		assert.Equal(t, domain.ErrFieldTypeNotFound, err)
+	})
+
+	t.Run("Fail - Too many players", func(t *testing.T) {
+		ctx := context.Background()
+		players := 60
+
+		mockVenueRepo.EXPECT().FindByID(ctx, uint(1)).Return(venue, nil)
+		expectManager(ctx)
+		mockFieldTypeRepo.EXPECT().FindByCode(ctx, "SINTETIS").Return(sintetis, nil)
+
+		_, err := fieldService.CreateField(ctx, &request.CreateFieldRequest{
+			VenueID:             1,
+			Name:                "Field A",
+			FieldType:           "SINTETIS",
+			FieldDetailsRequest: request.FieldDetailsRequest{MaxPlayers: &players},
+		}, 10)
+
+		assert.Equal(t, domain.ErrInvalidFieldDetails, err)
+	})
+
+	t.Run("Success - Update keeps details that are not sent", func(t *testing.T) {
+		ctx := context.Background()
+		width := 20.0
+		inactive := domain.FieldStatusInactive
+		existing := domain.Field{
+			ID:          3,
+			Name:        "Field A",
+			Type:        "SINTETIS",
+			Venue:       venue,
+			WidthMeters: &width,
+			Indoor:      true,
+			MaxPlayers:  12,
+			Status:      domain.FieldStatusActive,
+		}
+
+		mockFieldRepo.EXPECT().FindByID(ctx, uint(3)).Return(existing, nil)
+		expectManager(ctx)
+		mockFieldTypeRepo.EXPECT().FindByCode(ctx, "VINYL").Return(domain.FieldType{Code: "VINYL"}, nil)
+		mockFieldRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+
+		field, err := fieldService.UpdateField(ctx, 3, &request.UpdateFieldRequest{
+			Name:                "Field B",
+			FieldType:           "VINYL",
+			FieldDetailsRequest: request.FieldDetailsRequest{Status: &inactive},
+		}, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Field B", field.Name)
+		assert.Equal(t, "VINYL", field.Type)
+		assert.Equal(t, &width, field.WidthMeters)
+		assert.True(t, field.Indoor)
+		assert.Equal(t, 12, field.MaxPlayers)
+		assert.False(t, field.IsActive())
+	})
+
+	t.Run("Success - List field types", func(t *testing.T) {
+		ctx := context.Background()
+		types := []domain.FieldType{sintetis, {Code: "VINYL", Name: "Vinyl"}}
+
+		mockFieldTypeRepo.EXPECT().FindAll(ctx).Return(types, nil)
+
+		result, err := fieldService.GetFieldTypes(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, types, result)
+	})
+}
@@ -0,0 +1,241 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository/mock"
+	"go-futsal-booking-api/internal/service"
+	"go-futsal-booking-api/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// noInbox returns an inbox that accepts every notification, for tests that are
+// not about the inbox.
+func noInbox(t *testing.T, ctrl *gomock.Controller) service.InboxService {
+	inboxRepo := mock.NewMockInboxRepository(ctrl)
+	inboxRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Return(nil).
+		AnyTimes()
+
+	return service.NewInboxService(inboxRepo, newTestEmailTemplates(t))
+}
+
+func TestEmailTemplateService_RenderInbox(t *testing.T) {
+	templates := newTestEmailTemplates(t)
+
+	t.Run("Success - Every inbox template renders in every language", func(t *testing.T) {
+		start := time.Date(2025, time.March, 14, 19, 0, 0, 0, time.UTC)
+		booking := service.BookingEmailData{FullName: "Budi", BookingID: 7, VenueName: "Arena", FieldName: "A", StartTime: start, EndTime: start.Add(time.Hour)}
+		data := map[string]any{
+			domain.EmailTemplateAccountLocked:       service.AccountLockedEmailData{FullName: "Budi", LockedUntil: start},
+			domain.EmailTemplateBookingReceived:     booking,
+			domain.EmailTemplateBookingConfirmation: booking,
+			domain.EmailTemplateBookingCancellation: booking,
+			domain.EmailTemplateBookingReminder:     booking,
+			domain.EmailTemplateBookingMaintenance: service.BookingMaintenanceEmailData{
+				BookingEmailData: booking,
+				Alternatives:     []service.AlternativeSlotEmailData{{FieldName: "B", StartTime: start, EndTime: start.Add(time.Hour)}},
+			},
+			domain.EmailTemplatePaymentReceipt: service.PaymentReceiptEmailData{FullName: "Budi", Amount: 150000, PaidAt: start, Booking: booking},
+			domain.EmailTemplateSavedSearchMatch: service.SavedSearchMatchEmailData{
+				FullName:   "Budi",
+				SearchName: "Friday nights",
+				Slots:      []service.AvailableSlotEmailData{{VenueName: "Arena", FieldName: "A", StartTime: start, EndTime: start.Add(time.Hour)}},
+			},
+			domain.InboxEventAccountActivated:  service.AccountInboxData{FullName: "Budi"},
+			domain.InboxEventPhoneVerified:     service.AccountInboxData{FullName: "Budi", PhoneNumber: "+6281234567890"},
+			domain.InboxEventTwoFactorEnabled:  service.AccountInboxData{FullName: "Budi"},
+			domain.InboxEventTwoFactorDisabled: service.AccountInboxData{FullName: "Budi"},
+		}
+
+		for _, name := range domain.InboxTemplates {
+			for _, language := range []string{domain.LanguageIndonesian, domain.LanguageEnglish} {
+				title, body, err := templates.RenderInbox(name, language, data[name])
+
+				require.NoError(t, err, "%s/%s", language, name)
+				assert.NotEmpty(t, title, "%s/%s", language, name)
+				assert.NotEmpty(t, body, "%s/%s", language, name)
+			}
+		}
+	})
+
+	t.Run("Fail - Unknown template", func(t *testing.T) {
+		_, _, err := templates.RenderInbox("welcome_pack", domain.LanguageEnglish, nil)
+
+		assert.Equal(t, domain.ErrEmailTemplateNotFound, err)
+	})
+}
+
+func TestInboxService(t *testing.T) {
+	logger.Init("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockInboxRepo := mock.NewMockInboxRepository(ctrl)
+	inboxService := service.NewInboxService(mockInboxRepo, newTestEmailTemplates(t))
+
+	t.Run("Success - Notification is rendered in the user's language", func(t *testing.T) {
+		ctx := context.Background()
+		user := domain.User{ID: 1, FullName: "Budi", Language: domain.LanguageIndonesian}
+
+		mockInboxRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, notification *domain.InboxNotification) error {
+				assert.Equal(t, uint(1), notification.UserID)
+				assert.Equal(t, domain.InboxEventAccountActivated, notification.Event)
+				assert.Equal(t, "Selamat datang, Budi!", notification.Title)
+				assert.Nil(t, notification.ReadAt)
+				assert.False(t, notification.CreatedAt.IsZero())
+				return nil
+			})
+
+		err := inboxService.Notify(ctx, user, domain.InboxEventAccountActivated, service.AccountInboxData{FullName: "Budi"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail - Unknown event is not stored", func(t *testing.T) {
+		err := inboxService.Notify(context.Background(), domain.User{ID: 1}, "welcome_pack", nil)
+
+		assert.Equal(t, domain.ErrEmailTemplateNotFound, err)
+	})
+
+	t.Run("Success - Mark one as read", func(t *testing.T) {
+		ctx := context.Background()
+		readAt := time.Now()
+
+		mockInboxRepo.EXPECT().
+			MarkRead(ctx, uint(1), uint(5), gomock.Any()).
+			Return(domain.InboxNotification{ID: 5, UserID: 1, ReadAt: &readAt}, nil)
+
+		notification, err := inboxService.MarkRead(ctx, 1, 5)
+
+		require.NoError(t, err)
+		assert.True(t, notification.IsRead())
+	})
+
+	t.Run("Fail - Mark read on another user's notification", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockInboxRepo.EXPECT().
+			MarkRead(ctx, uint(2), uint(5), gomock.Any()).
+			Return(domain.InboxNotification{}, domain.ErrInboxNotFound)
+
+		_, err := inboxService.MarkRead(ctx, 2, 5)
+
+		assert.Equal(t, domain.ErrInboxNotFound, err)
+	})
+
+	t.Run("Success - Mark all as read", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockInboxRepo.EXPECT().
+			MarkAllRead(ctx, uint(1), gomock.Any()).
+			Return(int64(3), nil)
+
+		marked, err := inboxService.MarkAllRead(ctx, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), marked)
+	})
+}
+
+func TestNotificationRouter_WritesInbox(t *testing.T) {
+	logger.Init("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	mockPreferenceRepo := mock.NewMockNotificationPreferenceRepository(ctrl)
+	mockInboxRepo := mock.NewMockInboxRepository(ctrl)
+	templates := newTestEmailTemplates(t)
+	router := service.NewNotificationRouter(service.NewOutboxService(mockOutboxRepo, testOutboxConfig), templates, mockPreferenceRepo, service.NewInboxService(mockInboxRepo, templates))
+
+	user := domain.User{ID: 1, FullName: "Budi", Email: "budi@example.com", Language: domain.LanguageEnglish}
+	start := time.Date(2026, 3, 14, 19, 0, 0, 0, time.UTC)
+	data := service.BookingEmailData{FullName: "Budi", BookingID: 7, VenueName: "Arena Senayan", FieldName: "Field A", StartTime: start, EndTime: start.Add(time.Hour), TotalPrice: 150000}
+
+	t.Run("Success - Booking notification lands in the inbox", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockInboxRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, notification *domain.InboxNotification) error {
+				assert.Equal(t, domain.EmailTemplateBookingConfirmation, notification.Event)
+				assert.Equal(t, "Booking #7 confirmed", notification.Title)
+				assert.Equal(t, "Arena Senayan, Field A on Saturday, 14 March 2026 at 19:00-20:00. Total Rp150,000.", notification.Body)
+				return nil
+			})
+
+		err := router.Notify(ctx, user, domain.EmailTemplateBookingConfirmation, data)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail - Inbox error is returned so the transaction rolls back", func(t *testing.T) {
+		ctx := context.Background()
+		dbErr := errors.New("db down")
+
+		mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockInboxRepo.EXPECT().Create(ctx, gomock.Any()).Return(dbErr)
+
+		err := router.Notify(ctx, user, domain.EmailTemplateBookingConfirmation, data)
+
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
+
+func TestInboxRetentionJob_DeleteExpired(t *testing.T) {
+	logger.Init("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockInboxRepo := mock.NewMockInboxRepository(ctrl)
+	job := service.NewInboxRetentionJob(mockInboxRepo, service.InboxRetentionConfig{
+		RetainRead:   30 * 24 * time.Hour,
+		PollInterval: time.Hour,
+		BatchSize:    2,
+	})
+
+	t.Run("Success - Deletes in batches until a short batch", func(t *testing.T) {
+		ctx := context.Background()
+		before := time.Now().Add(-30 * 24 * time.Hour)
+
+		gomock.InOrder(
+			mockInboxRepo.EXPECT().
+				DeleteReadBefore(ctx, gomock.Any(), 2).
+				DoAndReturn(func(ctx context.Context, cutoff time.Time, limit int) (int64, error) {
+					assert.WithinDuration(t, before, cutoff, time.Minute)
+					return 2, nil
+				}),
+			mockInboxRepo.EXPECT().DeleteReadBefore(ctx, gomock.Any(), 2).Return(int64(1), nil),
+		)
+
+		deleted, err := job.DeleteExpired(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), deleted)
+	})
+
+	t.Run("Fail - Repository error stops the run", func(t *testing.T) {
+		ctx := context.Background()
+		dbErr := errors.New("db down")
+
+		mockInboxRepo.EXPECT().DeleteReadBefore(ctx, gomock.Any(), 2).Return(int64(0), dbErr)
+
+		_, err := job.DeleteExpired(ctx)
+
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
@@ -0,0 +1,235 @@
+package service_test
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/dto/request"
+	"go-futsal-booking-api/internal/repository/mock"
+	"go-futsal-booking-api/internal/service"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenanceService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockWindowRepo := mock.NewMockMaintenanceWindowRepository(ctrl)
+	mockFieldRepo := mock.NewMockFieldRepository(ctrl)
+	mockScheduleRepo := mock.NewMockScheduleRepository(ctrl)
+	mockBookingRepo := mock.NewMockBookingRepository(ctrl)
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockVenueMemberRepo := mock.NewMockVenueMemberRepository(ctrl)
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	mockPreferenceRepo := mock.NewMockNotificationPreferenceRepository(ctrl)
+	mockTxManager := mock.NewMockTransactionManager(ctrl)
+	expectTransactions(mockTxManager)
+
+	authorizer := service.NewAuthorizer(mockUserRepo, mockRoleRepo, mockVenueMemberRepo)
+	bookingNotifier := service.NewBookingNotifier(service.NewNotificationRouter(service.NewOutboxService(mockOutboxRepo, testOutboxConfig), newTestEmailTemplates(t), mockPreferenceRepo, noInbox(t, ctrl)), noWebhooks(ctrl), service.NewCalendarService(nil, nil, testCalendarConfig))
+	availabilityHub := service.NewAvailabilityHub(8)
+	maintenanceService := service.NewMaintenanceService(mockWindowRepo, mockFieldRepo, mockScheduleRepo, mockBookingRepo, authorizer, mockTxManager, bookingNotifier, availabilityHub)
+
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	require.NoError(t, err)
+
+	venue := domain.Venue{ID: 1, Name: "Arena", Timezone: "Asia/Jakarta"}
+	fieldA := domain.Field{ID: 1, Name: "Field A", Type: "VINYL", Venue: venue}
+	fieldB := domain.Field{ID: 2, Name: "Field B", Type: "VINYL", Venue: venue}
+	closed := domain.Field{ID: 3, Name: "Field C", Type: "VINYL", Venue: venue, Status: domain.FieldStatusInactive}
+
+	base := time.Now().AddDate(0, 0, 10)
+	date := time.Date(base.Year(), base.Month(), base.Day(), 0, 0, 0, 0, time.UTC)
+	at := func(hour int) time.Time { return time.Date(0, 1, 1, hour, 0, 0, 0, time.UTC) }
+
+	// Every field plays 19:00-20:00 each day; field B also plays 21:00-22:00
+	// on the booked day.
+	var schedules []domain.Schedule
+	for day := 1; day <= 7; day++ {
+		for _, field := range []domain.Field{fieldA, fieldB, closed} {
+			schedules = append(schedules, domain.Schedule{ID: field.ID*100 + uint(day), Field: field, DayOfWeek: day, StartTime: at(19), EndTime: at(20), Price: 100000})
+		}
+	}
+	lateB := domain.Schedule{ID: 299, Field: fieldB, DayOfWeek: domain.ScheduleDay(date), StartTime: at(21), EndTime: at(22), Price: 120000}
+	schedules = append(schedules, lateB)
+
+	scheduleOf := func(field domain.Field, date time.Time) domain.Schedule {
+		return domain.Schedule{ID: field.ID*100 + uint(domain.ScheduleDay(date)), Field: field, DayOfWeek: domain.ScheduleDay(date), StartTime: at(19), EndTime: at(20), Price: 100000}
+	}
+
+	customer := domain.User{ID: 7, FullName: "Budi", Email: "budi@example.com", Language: domain.LanguageEnglish}
+	hit := &domain.Booking{ID: 1, User: customer, Schedule: scheduleOf(fieldA, date), BookingDate: date, Status: domain.BookingStatusConfirmed, TotalPrice: 100000}
+	other := &domain.Booking{ID: 2, User: domain.User{ID: 8}, Schedule: scheduleOf(fieldB, date), BookingDate: date, Status: domain.BookingStatusPending}
+
+	// The window closes field A for the booked day and the day after.
+	startsAt := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, jakarta)
+	req := &request.CreateMaintenanceWindowRequest{StartsAt: startsAt, EndsAt: startsAt.AddDate(0, 0, 2), Reason: " Resurfacing "}
+
+	expectManager := func(ctx context.Context) {
+		mockVenueMemberRepo.EXPECT().
+			FindByVenueAndUser(ctx, uint(1), uint(10)).
+			Return(domain.VenueMember{Role: domain.VenueRoleManager}, nil)
+	}
+
+	t.Run("Success - Create window and suggest alternatives", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockFieldRepo.EXPECT().FindByID(ctx, uint(1)).Return(fieldA, nil)
+		expectManager(ctx)
+		mockWindowRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, window *domain.MaintenanceWindow) error {
+				assert.Equal(t, "Resurfacing", window.Reason)
+				assert.Equal(t, uint(10), window.CreatedBy)
+				window.ID = 5
+				return nil
+			})
+		mockBookingRepo.EXPECT().
+			FindActiveByVenueBetween(ctx, uint(1), gomock.Any(), gomock.Any()).
+			Return([]*domain.Booking{hit, other}, nil)
+		mockScheduleRepo.EXPECT().FindByVenueID(ctx, uint(1)).Return(schedules, nil)
+		mockWindowRepo.EXPECT().
+			FindOverlapping(ctx, []uint{1, 2, 3}, gomock.Any(), gomock.Any()).
+			Return(nil, nil)
+		mockOutboxRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, message *domain.OutboxMessage) error {
+				email := decodeOutboxEmail(t, message)
+				assert.Equal(t, "budi@example.com", email.To[0].Email)
+				assert.Equal(t, "Booking #1: Field Closed for Maintenance", email.Subject)
+				assert.Contains(t, email.TextBody, "Reason: Resurfacing")
+				assert.Contains(t, email.TextBody, "- Field B,")
+				assert.Contains(t, email.TextBody, "21:00 - 22:00")
+				return nil
+			})
+		var schedulesA []domain.Schedule
+		for _, schedule := range schedules {
+			if schedule.Field.ID == fieldA.ID {
+				schedulesA = append(schedulesA, schedule)
+			}
+		}
+		mockScheduleRepo.EXPECT().FindByFieldID(ctx, uint(1)).Return(schedulesA, nil)
+
+		sub, err := availabilityHub.Subscribe(1)
+		require.NoError(t, err)
+		defer sub.Close()
+
+		window, impacts, err := maintenanceService.CreateMaintenanceWindow(ctx, 1, req, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, uint(5), window.ID)
+		require.Len(t, impacts, 1)
+		assert.Equal(t, uint(1), impacts[0].Booking.ID)
+
+		// Field B is booked at 19:00 on the day, field A is closed until the
+		// day after and field C is inactive, so the closest free slots are
+		// field B later that evening and both open fields the day before.
+		alternatives := impacts[0].Alternatives
+		require.Len(t, alternatives, domain.MaintenanceSuggestionLimit)
+		assert.Equal(t, uint(299), alternatives[0].Schedule.ID)
+		assert.Equal(t, date, alternatives[0].Date)
+		assert.Equal(t, 21, alternatives[0].StartsAt().Hour())
+		assert.Equal(t, jakarta.String(), alternatives[0].StartsAt().Location().String())
+		for _, slot := range alternatives[1:] {
+			assert.Equal(t, date.AddDate(0, 0, -1), slot.Date)
+			assert.NotEqual(t, closed.ID, slot.Schedule.Field.ID)
+		}
+
+		// Field A's evening slots on both days of the window are closed.
+		for _, day := range []time.Time{date, date.AddDate(0, 0, 1)} {
+			update := <-sub.Updates
+			assert.Equal(t, domain.SlotUpdateClosed, update.Type)
+			assert.Equal(t, scheduleOf(fieldA, day).ID, update.ScheduleID)
+			assert.Equal(t, day.Format("2006-01-02"), update.Date)
+			assert.False(t, update.Available)
+		}
+		assert.Empty(t, sub.Updates)
+	})
+
+	t.Run("Fail - Window ends before it starts", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockFieldRepo.EXPECT().FindByID(ctx, uint(1)).Return(fieldA, nil)
+		expectManager(ctx)
+
+		_, _, err := maintenanceService.CreateMaintenanceWindow(ctx, 1, &request.CreateMaintenanceWindowRequest{
+			StartsAt: req.EndsAt,
+			EndsAt:   req.StartsAt,
+			Reason:   "Resurfacing",
+		}, 10)
+
+		assert.Equal(t, domain.ErrInvalidMaintenance, err)
+	})
+
+	t.Run("Fail - Overlaps another window", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockFieldRepo.EXPECT().FindByID(ctx, uint(1)).Return(fieldA, nil)
+		expectManager(ctx)
+		mockWindowRepo.EXPECT().Create(ctx, gomock.Any()).Return(domain.ErrMaintenanceOverlap)
+
+		_, _, err := maintenanceService.CreateMaintenanceWindow(ctx, 1, req, 10)
+
+		assert.ErrorIs(t, err, domain.ErrMaintenanceOverlap)
+	})
+
+	t.Run("Fail - Not a venue manager", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockFieldRepo.EXPECT().FindByID(ctx, uint(1)).Return(fieldA, nil)
+		mockVenueMemberRepo.EXPECT().
+			FindByVenueAndUser(ctx, uint(1), uint(11)).
+			Return(domain.VenueMember{Role: domain.VenueRoleStaff}, nil)
+		mockUserRepo.EXPECT().
+			FindByID(ctx, uint(11)).
+			Return(domain.User{ID: 11, Role: domain.Role{ID: 5, RoleName: "STAFF"}}, nil)
+		mockRoleRepo.EXPECT().
+			HasPermission(ctx, uint(5), domain.PermVenueManageAny).
+			Return(false, nil)
+
+		_, _, err := maintenanceService.CreateMaintenanceWindow(ctx, 1, req, 11)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("Success - No bookings in the window", func(t *testing.T) {
+		ctx := context.Background()
+		window := domain.MaintenanceWindow{ID: 5, FieldID: 1, StartsAt: req.StartsAt, EndsAt: req.EndsAt}
+
+		mockWindowRepo.EXPECT().FindByID(ctx, uint(5)).Return(window, nil)
+		mockFieldRepo.EXPECT().FindByID(ctx, uint(1)).Return(fieldA, nil)
+		expectManager(ctx)
+		mockBookingRepo.EXPECT().
+			FindActiveByVenueBetween(ctx, uint(1), gomock.Any(), gomock.Any()).
+			Return([]*domain.Booking{other}, nil)
+
+		impacts, err := maintenanceService.GetAffectedBookings(ctx, 5, 10)
+
+		require.NoError(t, err)
+		assert.Empty(t, impacts)
+	})
+
+	t.Run("Success - Delete window", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockWindowRepo.EXPECT().FindByID(ctx, uint(5)).Return(domain.MaintenanceWindow{ID: 5, FieldID: 1}, nil)
+		mockFieldRepo.EXPECT().FindByID(ctx, uint(1)).Return(fieldA, nil)
+		expectManager(ctx)
+		mockWindowRepo.EXPECT().Delete(ctx, uint(5)).Return(nil)
+
+		assert.NoError(t, maintenanceService.DeleteMaintenanceWindow(ctx, 5, 10))
+	})
+
+	t.Run("Fail - Delete unknown window", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockWindowRepo.EXPECT().FindByID(ctx, uint(6)).Return(domain.MaintenanceWindow{}, domain.ErrMaintenanceNotFound)
+
+		assert.Equal(t, domain.ErrMaintenanceNotFound, maintenanceService.DeleteMaintenanceWindow(ctx, 6, 10))
+	})
+}
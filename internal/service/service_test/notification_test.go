@@ -0,0 +1,158 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSender = domain.EmailAddress{Name: "Futsal Booking", Email: "noreply@futsal.test"}
+
+func testEmail() domain.Email {
+	return domain.Email{
+		To:       []domain.EmailAddress{{Name: "Budi", Email: "budi@example.com"}},
+		Cc:       []domain.EmailAddress{{Email: "owner@example.com"}},
+		Bcc:      []domain.EmailAddress{{Email: "audit@example.com"}},
+		Subject:  "Booking confirmed",
+		TextBody: "Your booking is confirmed.",
+		HTMLBody: "<p>Your booking is <b>confirmed</b>.</p>",
+		Attachments: []domain.EmailAttachment{
+			{Filename: "booking.ics", ContentType: "text/calendar", Content: []byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n")},
+		},
+	}
+}
+
+func TestMailjetRepository_Send(t *testing.T) {
+	t.Run("Success - Sends separate parts, cc, bcc and attachments", func(t *testing.T) {
+		var payload struct {
+			Messages []repository.Messages
+		}
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v3.1/send", r.URL.Path)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		repo := repository.NewMailjetRepository(repository.MailjetConfig{MailjetBaseURL: server.URL}, testSender)
+
+		err := repo.Send(context.Background(), testEmail())
+
+		require.NoError(t, err)
+		require.Len(t, payload.Messages, 1)
+		message := payload.Messages[0]
+		assert.Equal(t, testSender.Email, message.From.Email)
+		assert.Equal(t, "Your booking is confirmed.", message.TextPart)
+		assert.Equal(t, "<p>Your booking is <b>confirmed</b>.</p>", message.HTMLPart)
+		assert.Equal(t, "owner@example.com", message.Cc[0].Email)
+		assert.Equal(t, "audit@example.com", message.Bcc[0].Email)
+		require.Len(t, message.Attachments, 1)
+		assert.Equal(t, "booking.ics", message.Attachments[0].Filename)
+	})
+
+	for _, tc := range []struct {
+		name      string
+		status    int
+		retryable bool
+	}{
+		{"Fail - Server error is retryable", http.StatusServiceUnavailable, true},
+		{"Fail - Rate limit is retryable", http.StatusTooManyRequests, true},
+		{"Fail - Bad request is permanent", http.StatusBadRequest, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+			}))
+			defer server.Close()
+
+			repo := repository.NewMailjetRepository(repository.MailjetConfig{MailjetBaseURL: server.URL}, testSender)
+
+			err := repo.Send(context.Background(), testEmail())
+
+			var notificationErr *domain.NotificationError
+			require.ErrorAs(t, err, &notificationErr)
+			assert.Equal(t, tc.retryable, notificationErr.Retryable)
+			assert.Equal(t, tc.retryable, domain.IsRetryableNotification(err))
+		})
+	}
+
+	t.Run("Fail - Missing recipient is permanent", func(t *testing.T) {
+		repo := repository.NewMailjetRepository(repository.MailjetConfig{MailjetBaseURL: "http://127.0.0.1:0"}, testSender)
+
+		err := repo.Send(context.Background(), domain.Email{Subject: "Hi", TextBody: "Hi"})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidEmail)
+		assert.False(t, domain.IsRetryableNotification(err))
+	})
+}
+
+func TestFileNotificationRepository_Send(t *testing.T) {
+	t.Run("Success - Writes a multipart message to the directory", func(t *testing.T) {
+		dir := t.TempDir()
+		repo := repository.NewFileNotificationRepository(nil, dir, testSender)
+
+		require.NoError(t, repo.Send(context.Background(), testEmail()))
+
+		files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+
+		raw, err := os.ReadFile(files[0])
+		require.NoError(t, err)
+
+		message, err := mail.ReadMessage(bytes.NewReader(raw))
+		require.NoError(t, err)
+		assert.Equal(t, "Booking confirmed", message.Header.Get("Subject"))
+		assert.Contains(t, message.Header.Get("Cc"), "owner@example.com")
+		assert.NotContains(t, string(raw), "audit@example.com")
+
+		mediaType, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+		require.NoError(t, err)
+		assert.Equal(t, "multipart/mixed", mediaType)
+
+		reader := multipart.NewReader(message.Body, params["boundary"])
+
+		body, err := reader.NextPart()
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(body.Header.Get("Content-Type"), "multipart/alternative"))
+
+		attachment, err := reader.NextPart()
+		require.NoError(t, err)
+		assert.Equal(t, "booking.ics", attachment.FileName())
+		assert.Equal(t, "text/calendar", attachment.Header.Get("Content-Type"))
+
+		_, err = reader.NextPart()
+		assert.Equal(t, io.EOF, err)
+	})
+
+	t.Run("Success - Writes to the writer when no directory is set", func(t *testing.T) {
+		var out bytes.Buffer
+		repo := repository.NewFileNotificationRepository(&out, "", testSender)
+
+		email := domain.Email{
+			To:       []domain.EmailAddress{{Email: "budi@example.com"}},
+			Subject:  "Halo",
+			TextBody: "Plain only",
+		}
+
+		require.NoError(t, repo.Send(context.Background(), email))
+		assert.Contains(t, out.String(), "Content-Type: text/plain; charset=utf-8")
+		assert.Contains(t, out.String(), "Plain only")
+	})
+}
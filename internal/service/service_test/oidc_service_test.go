@@ -0,0 +1,271 @@
+package service_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/internal/repository/mock"
+	"go-futsal-booking-api/internal/service"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockOIDCIssuer is a minimal OpenID Connect issuer that signs ID tokens with a
+// throwaway RSA key and enforces PKCE on the token endpoint.
+type mockOIDCIssuer struct {
+	server        *httptest.Server
+	key           *rsa.PrivateKey
+	codeChallenge string
+	nonce         string
+	emailVerified bool
+}
+
+func newMockOIDCIssuer(t *testing.T) *mockOIDCIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	issuer := &mockOIDCIssuer{key: key, emailVerified: true}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 issuer.server.URL,
+			"authorization_endpoint": issuer.server.URL + "/authorize",
+			"token_endpoint":         issuer.server.URL + "/token",
+			"jwks_uri":               issuer.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{{
+				"kid": "test-key",
+				"kty": "RSA",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if r.PostForm.Get("code") != "good-code" || base64.RawURLEncoding.EncodeToString(sum[:]) != issuer.codeChallenge {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":            issuer.server.URL,
+			"aud":            "futsal-client",
+			"sub":            "google-sub-42",
+			"email":          "player@example.com",
+			"email_verified": issuer.emailVerified,
+			"name":           "Budi Player",
+			"nonce":          issuer.nonce,
+			"exp":            time.Now().Add(time.Hour).Unix(),
+			"iat":            time.Now().Unix(),
+		})
+		token.Header["kid"] = "test-key"
+		idToken, _ := token.SignedString(key)
+
+		json.NewEncoder(w).Encode(map[string]string{"id_token": idToken, "token_type": "Bearer"})
+	})
+
+	issuer.server = httptest.NewServer(mux)
+	t.Cleanup(issuer.server.Close)
+
+	return issuer
+}
+
+// authorize plays the user agent: it reads the PKCE challenge and nonce from the
+// authorization URL, as the real provider would.
+func (m *mockOIDCIssuer) authorize(t *testing.T, authURL string) {
+	parsed, err := url.Parse(authURL)
+	assert.NoError(t, err)
+	assert.Equal(t, "S256", parsed.Query().Get("code_challenge_method"))
+
+	m.codeChallenge = parsed.Query().Get("code_challenge")
+	m.nonce = parsed.Query().Get("nonce")
+}
+
+func TestOIDCService_Login(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	issuer := newMockOIDCIssuer(t)
+
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	mockTxManager := mock.NewMockTransactionManager(ctrl)
+	mockLoginAttemptRepo := mock.NewMockLoginAttemptRepository(ctrl)
+	mockTwoFactorRepo := mock.NewMockTwoFactorRepository(ctrl)
+	mockStateRepo := mock.NewMockOIDCStateRepository(ctrl)
+	mockIdentityRepo := mock.NewMockUserIdentityRepository(ctrl)
+
+	loginGuard := service.NewLoginGuard(mockLoginAttemptRepo, testLoginGuardConfig)
+	twoFactorService := service.NewTwoFactorService(mockUserRepo, mockTwoFactorRepo, loginGuard, noInbox(t, ctrl), testTOTPEncryptionKey, "Futsal Booking API")
+	userService := service.NewUserService(mockUserRepo, mockRoleRepo, loginGuard, twoFactorService, validator.New(), mockTxManager, service.NewOutboxService(mockOutboxRepo, testOutboxConfig), newTestEmailTemplates(t), noInbox(t, ctrl), testTOTPEncryptionKey, "http://localhost:8080")
+
+	provider := repository.NewOIDCProvider(repository.OIDCConfig{
+		ProviderName: "google",
+		IssuerURL:    issuer.server.URL,
+		ClientID:     "futsal-client",
+		RedirectURL:  "http://localhost:8080/api/v1/users/oidc/callback",
+		Scopes:       []string{"openid", "email", "profile"},
+	})
+	oidcService := service.NewOIDCService(provider, mockStateRepo, mockIdentityRepo, mockUserRepo, mockRoleRepo, userService)
+
+	ctx := context.Background()
+
+	// beginLogin runs the first leg and returns the stored state.
+	beginLogin := func(t *testing.T) domain.OIDCState {
+		var stored domain.OIDCState
+
+		mockStateRepo.EXPECT().DeleteExpired(ctx, gomock.Any()).Return(nil)
+		mockStateRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, state domain.OIDCState) error {
+				stored = state
+				return nil
+			})
+
+		authURL, err := oidcService.BeginLogin(ctx)
+		assert.NoError(t, err)
+		issuer.authorize(t, authURL)
+
+		return stored
+	}
+
+	t.Run("Success - First login creates verified user", func(t *testing.T) {
+		stored := beginLogin(t)
+
+		mockStateRepo.EXPECT().Consume(ctx, stored.State).Return(stored, nil)
+
+		mockIdentityRepo.EXPECT().
+			FindByProviderSubject(ctx, "google", "google-sub-42").
+			Return(domain.UserIdentity{}, domain.ErrIdentityNotFound)
+
+		mockUserRepo.EXPECT().
+			FindByEmail(ctx, "player@example.com").
+			Return(domain.User{}, errors.New("user not found"))
+
+		mockRoleRepo.EXPECT().
+			FindByName(ctx, domain.RoleCustomer).
+			Return(domain.Role{ID: 2, RoleName: domain.RoleCustomer}, nil)
+
+		mockUserRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, user *domain.User) error {
+				assert.True(t, user.IsVerified)
+				assert.Equal(t, "Budi Player", user.FullName)
+				user.ID = 11
+				return nil
+			})
+
+		mockIdentityRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, identity *domain.UserIdentity) error {
+				assert.Equal(t, uint(11), identity.UserID)
+				assert.Equal(t, "google-sub-42", identity.Subject)
+				return nil
+			})
+
+		mockTwoFactorRepo.EXPECT().
+			FindByUserID(ctx, uint(11)).
+			Return(domain.TwoFactor{}, domain.ErrTwoFactorNotFound)
+
+		mockLoginAttemptRepo.EXPECT().
+			Reset(ctx, domain.LoginScopeAccount, "player@example.com").
+			Return(nil)
+
+		result, err := oidcService.CompleteLogin(ctx, stored.State, "good-code")
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, result.Token)
+		assert.Equal(t, uint(11), result.User.ID)
+	})
+
+	t.Run("Fail - Unverified email is not linked", func(t *testing.T) {
+		issuer.emailVerified = false
+		defer func() { issuer.emailVerified = true }()
+
+		stored := beginLogin(t)
+
+		mockStateRepo.EXPECT().Consume(ctx, stored.State).Return(stored, nil)
+
+		mockIdentityRepo.EXPECT().
+			FindByProviderSubject(ctx, "google", "google-sub-42").
+			Return(domain.UserIdentity{}, domain.ErrIdentityNotFound)
+
+		result, err := oidcService.CompleteLogin(ctx, stored.State, "good-code")
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrOIDCEmailNotVerified, err)
+	})
+
+	t.Run("Fail - Unverified password account is not linked", func(t *testing.T) {
+		stored := beginLogin(t)
+
+		mockStateRepo.EXPECT().Consume(ctx, stored.State).Return(stored, nil)
+
+		mockIdentityRepo.EXPECT().
+			FindByProviderSubject(ctx, "google", "google-sub-42").
+			Return(domain.UserIdentity{}, domain.ErrIdentityNotFound)
+
+		// Someone else registered the address with a password of their choosing.
+		mockUserRepo.EXPECT().
+			FindByEmail(ctx, "player@example.com").
+			Return(domain.User{ID: 12, Email: "player@example.com", Password: "$2a$10$attacker", IsVerified: false}, nil)
+
+		result, err := oidcService.CompleteLogin(ctx, stored.State, "good-code")
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrOIDCAccountUnverified, err)
+	})
+
+	t.Run("Fail - Wrong PKCE verifier", func(t *testing.T) {
+		stored := beginLogin(t)
+		stored.CodeVerifier = "not-the-verifier"
+
+		mockStateRepo.EXPECT().Consume(ctx, stored.State).Return(stored, nil)
+
+		result, err := oidcService.CompleteLogin(ctx, stored.State, "good-code")
+
+		assert.Nil(t, result)
+		assert.Error(t, err)
+	})
+
+	t.Run("Fail - Unknown state", func(t *testing.T) {
+		mockStateRepo.EXPECT().
+			Consume(ctx, "forged").
+			Return(domain.OIDCState{}, domain.ErrInvalidOIDCState)
+
+		result, err := oidcService.CompleteLogin(ctx, "forged", "good-code")
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrInvalidOIDCState, err)
+	})
+}
+
+func TestOIDCService_NotConfigured(t *testing.T) {
+	oidcService := service.NewOIDCService(nil, nil, nil, nil, nil, nil)
+
+	_, err := oidcService.BeginLogin(context.Background())
+
+	assert.Equal(t, domain.ErrOIDCNotConfigured, err)
+}
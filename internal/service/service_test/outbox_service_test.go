@@ -0,0 +1,185 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository/mock"
+	"go-futsal-booking-api/internal/service"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutboxDispatcher_DispatchBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	mockNotifRepo := mock.NewMockNotificationRepository(ctrl)
+
+	dispatcher := service.NewOutboxDispatcher(mockOutboxRepo, testOutboxConfig)
+	dispatcher.Handle(domain.OutboxTopicEmail, service.NewEmailOutboxHandler(mockNotifRepo))
+
+	payload, _ := json.Marshal(domain.Email{
+		To:       []domain.EmailAddress{{Email: "budi@example.com"}},
+		Subject:  "Halo",
+		TextBody: "Halo",
+	})
+
+	emailMessage := func(id uint, attempts int) domain.OutboxMessage {
+		return domain.OutboxMessage{
+			ID:          id,
+			Topic:       domain.OutboxTopicEmail,
+			Payload:     payload,
+			Status:      domain.OutboxStatusPending,
+			Attempts:    attempts,
+			MaxAttempts: testOutboxConfig.MaxAttempts,
+		}
+	}
+
+	expectClaim := func(ctx context.Context, messages ...domain.OutboxMessage) {
+		mockOutboxRepo.EXPECT().
+			Claim(ctx, gomock.Any(), testOutboxConfig.BatchSize, testOutboxConfig.Lease).
+			Return(messages, nil)
+	}
+
+	t.Run("Success - Delivered message is marked sent", func(t *testing.T) {
+		ctx := context.Background()
+		expectClaim(ctx, emailMessage(1, 1))
+
+		mockNotifRepo.EXPECT().
+			Send(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, email domain.Email) error {
+				assert.Equal(t, "budi@example.com", email.To[0].Email)
+				return nil
+			})
+
+		mockOutboxRepo.EXPECT().MarkSent(gomock.Any(), uint(1), gomock.Any()).Return(nil)
+
+		n, err := dispatcher.DispatchBatch(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("Success - Retryable failure is rescheduled with backoff", func(t *testing.T) {
+		ctx := context.Background()
+		expectClaim(ctx, emailMessage(2, 2))
+
+		mockNotifRepo.EXPECT().
+			Send(gomock.Any(), gomock.Any()).
+			Return(&domain.NotificationError{Provider: "mailjet", Retryable: true, Err: errors.New("503")})
+
+		before := time.Now()
+		mockOutboxRepo.EXPECT().
+			MarkRetry(gomock.Any(), uint(2), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id uint, nextAttemptAt time.Time, lastError string) error {
+				// Second attempt waits twice the base delay.
+				assert.WithinDuration(t, before.Add(2*time.Second), nextAttemptAt, time.Second)
+				assert.Contains(t, lastError, "503")
+				return nil
+			})
+
+		_, err := dispatcher.DispatchBatch(ctx)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Success - Backoff is capped at the max delay", func(t *testing.T) {
+		ctx := context.Background()
+		message := emailMessage(3, 6)
+		message.MaxAttempts = 10
+		expectClaim(ctx, message)
+
+		mockNotifRepo.EXPECT().Send(gomock.Any(), gomock.Any()).Return(errors.New("connection reset"))
+
+		before := time.Now()
+		mockOutboxRepo.EXPECT().
+			MarkRetry(gomock.Any(), uint(3), gomock.Any(), "connection reset").
+			DoAndReturn(func(ctx context.Context, id uint, nextAttemptAt time.Time, lastError string) error {
+				assert.WithinDuration(t, before.Add(testOutboxConfig.MaxDelay), nextAttemptAt, time.Second)
+				return nil
+			})
+
+		_, err := dispatcher.DispatchBatch(ctx)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail - Permanent failure goes straight to dead letter", func(t *testing.T) {
+		ctx := context.Background()
+		expectClaim(ctx, emailMessage(4, 1))
+
+		mockNotifRepo.EXPECT().
+			Send(gomock.Any(), gomock.Any()).
+			Return(&domain.NotificationError{Provider: "mailjet", Err: errors.New("400 invalid recipient")})
+
+		mockOutboxRepo.EXPECT().MarkDead(gomock.Any(), uint(4), gomock.Any()).Return(nil)
+
+		_, err := dispatcher.DispatchBatch(ctx)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail - Exhausted attempts go to dead letter", func(t *testing.T) {
+		ctx := context.Background()
+		expectClaim(ctx, emailMessage(5, testOutboxConfig.MaxAttempts))
+
+		mockNotifRepo.EXPECT().Send(gomock.Any(), gomock.Any()).Return(errors.New("timeout"))
+		mockOutboxRepo.EXPECT().MarkDead(gomock.Any(), uint(5), "timeout").Return(nil)
+
+		_, err := dispatcher.DispatchBatch(ctx)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail - Unknown topic goes to dead letter", func(t *testing.T) {
+		ctx := context.Background()
+		message := emailMessage(6, 1)
+		message.Topic = "sms.send"
+		expectClaim(ctx, message)
+
+		mockOutboxRepo.EXPECT().MarkDead(gomock.Any(), uint(6), domain.ErrUnknownOutboxTopic.Error()).Return(nil)
+
+		_, err := dispatcher.DispatchBatch(ctx)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestOutboxService_Replay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	outboxService := service.NewOutboxService(mockOutboxRepo, testOutboxConfig)
+
+	t.Run("Success - Dead message is queued again", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockOutboxRepo.EXPECT().Replay(ctx, uint(9), gomock.Any()).Return(nil)
+		mockOutboxRepo.EXPECT().
+			FindByID(ctx, uint(9)).
+			Return(domain.OutboxMessage{ID: 9, Status: domain.OutboxStatusPending}, nil)
+
+		message, err := outboxService.Replay(ctx, 9)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.OutboxStatusPending, message.Status)
+	})
+
+	t.Run("Fail - Message is not dead", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockOutboxRepo.EXPECT().Replay(ctx, uint(10), gomock.Any()).Return(domain.ErrOutboxNotReplayable)
+
+		message, err := outboxService.Replay(ctx, 10)
+
+		assert.Nil(t, message)
+		assert.Equal(t, domain.ErrOutboxNotReplayable, err)
+	})
+}
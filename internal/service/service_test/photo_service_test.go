@@ -0,0 +1,238 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/internal/repository/mock"
+	"go-futsal-booking-api/internal/service"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+
+	return buf.Bytes()
+}
+
+// storedFiles lists the files under dir as slash-separated keys.
+func storedFiles(t *testing.T, dir string) []string {
+	t.Helper()
+
+	var keys []string
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, _ := filepath.Rel(dir, path)
+		keys = append(keys, filepath.ToSlash(rel))
+		return nil
+	})
+	require.NoError(t, err)
+
+	return keys
+}
+
+func TestPhotoService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPhotoRepo := mock.NewMockPhotoRepository(ctrl)
+	mockVenueRepo := mock.NewMockVenueRepository(ctrl)
+	mockFieldRepo := mock.NewMockFieldRepository(ctrl)
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockVenueMemberRepo := mock.NewMockVenueMemberRepository(ctrl)
+	authorizer := service.NewAuthorizer(mockUserRepo, mockRoleRepo, mockVenueMemberRepo)
+
+	newService := func(t *testing.T) (service.PhotoService, string) {
+		dir := t.TempDir()
+		storage, err := repository.NewLocalBlobStorage(dir, "http://localhost:8080/uploads")
+		require.NoError(t, err)
+
+		return service.NewPhotoService(mockPhotoRepo, mockVenueRepo, mockFieldRepo, storage, authorizer, service.PhotoConfig{MaxBytes: 1 << 20}), dir
+	}
+
+	expectManager := func(ctx context.Context, venueID, userID uint) {
+		mockVenueMemberRepo.EXPECT().
+			FindByVenueAndUser(ctx, venueID, userID).
+			Return(domain.VenueMember{Role: domain.VenueRoleManager}, nil)
+	}
+
+	fieldID := uint(5)
+	fieldGallery := domain.PhotoGallery{VenueID: 1, FieldID: &fieldID}
+
+	t.Run("Success - Upload stores the photo and a thumbnail", func(t *testing.T) {
+		ctx := context.Background()
+		photoService, dir := newService(t)
+
+		expectManager(ctx, 1, 10)
+		mockPhotoRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, photo *domain.Photo) error {
+				photo.ID = 7
+				photo.Position = 1
+				photo.IsCover = true
+				return nil
+			})
+
+		photo, err := photoService.UploadPhoto(ctx, fieldGallery, bytes.NewReader(testPNG(t, 800, 600)), 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, "image/png", photo.ContentType)
+		assert.Equal(t, 800, photo.Width)
+		assert.Equal(t, 600, photo.Height)
+		assert.True(t, strings.HasPrefix(photo.StorageKey, "venues/1/fields/5/photos/"))
+		assert.True(t, strings.HasSuffix(photo.StorageKey, ".png"))
+		assert.Equal(t, "http://localhost:8080/uploads/"+photo.StorageKey, photo.URL)
+		assert.Equal(t, "http://localhost:8080/uploads/"+photo.ThumbnailKey, photo.ThumbnailURL)
+		assert.ElementsMatch(t, []string{photo.StorageKey, photo.ThumbnailKey}, storedFiles(t, dir))
+
+		thumbnail, err := os.Open(filepath.Join(dir, filepath.FromSlash(photo.ThumbnailKey)))
+		require.NoError(t, err)
+		defer thumbnail.Close()
+		config, err := jpeg.DecodeConfig(thumbnail)
+		require.NoError(t, err)
+		assert.Equal(t, domain.PhotoThumbnailSize, config.Width)
+		assert.Equal(t, 300, config.Height)
+	})
+
+	t.Run("Fail - Photo over the size limit", func(t *testing.T) {
+		ctx := context.Background()
+		photoService, dir := newService(t)
+
+		expectManager(ctx, 1, 10)
+
+		_, err := photoService.UploadPhoto(ctx, fieldGallery, bytes.NewReader(make([]byte, 1<<20+1)), 10)
+
+		assert.Equal(t, domain.ErrPhotoTooLarge, err)
+		assert.Empty(t, storedFiles(t, dir))
+	})
+
+	t.Run("Fail - Content is not an image whatever the file is called", func(t *testing.T) {
+		ctx := context.Background()
+		photoService, _ := newService(t)
+
+		expectManager(ctx, 1, 10)
+
+		_, err := photoService.UploadPhoto(ctx, fieldGallery, strings.NewReader("<svg onload=alert(1)>"), 10)
+
+		assert.Equal(t, domain.ErrUnsupportedPhoto, err)
+	})
+
+	t.Run("Fail - Truncated image", func(t *testing.T) {
+		ctx := context.Background()
+		photoService, _ := newService(t)
+		data := testPNG(t, 64, 64)
+
+		expectManager(ctx, 1, 10)
+
+		_, err := photoService.UploadPhoto(ctx, fieldGallery, bytes.NewReader(data[:len(data)/2]), 10)
+
+		assert.Equal(t, domain.ErrUnsupportedPhoto, err)
+	})
+
+	t.Run("Fail - Staff cannot upload photos", func(t *testing.T) {
+		ctx := context.Background()
+		photoService, _ := newService(t)
+
+		mockVenueMemberRepo.EXPECT().
+			FindByVenueAndUser(ctx, uint(1), uint(11)).
+			Return(domain.VenueMember{Role: domain.VenueRoleStaff}, nil)
+		mockUserRepo.EXPECT().
+			FindByID(ctx, uint(11)).
+			Return(domain.User{ID: 11, Role: domain.Role{ID: 2, RoleName: domain.RoleCustomer}}, nil)
+		mockRoleRepo.EXPECT().
+			HasPermission(ctx, uint(2), domain.PermVenueManageAny).
+			Return(false, nil)
+
+		_, err := photoService.UploadPhoto(ctx, fieldGallery, bytes.NewReader(testPNG(t, 8, 8)), 11)
+
+		assert.Equal(t, domain.ErrForbidden, err)
+	})
+
+	t.Run("Fail - Files are removed when saving the photo fails", func(t *testing.T) {
+		ctx := context.Background()
+		photoService, dir := newService(t)
+
+		expectManager(ctx, 1, 10)
+		mockPhotoRepo.EXPECT().Create(ctx, gomock.Any()).Return(errors.New("db down"))
+
+		_, err := photoService.UploadPhoto(ctx, domain.PhotoGallery{VenueID: 1}, bytes.NewReader(testPNG(t, 8, 8)), 10)
+
+		assert.Error(t, err)
+		assert.Empty(t, storedFiles(t, dir))
+	})
+
+	t.Run("Success - Field gallery belongs to the field's venue", func(t *testing.T) {
+		ctx := context.Background()
+		photoService, _ := newService(t)
+
+		mockFieldRepo.EXPECT().
+			FindByID(ctx, uint(5)).
+			Return(domain.Field{ID: 5, Venue: domain.Venue{ID: 1}}, nil)
+
+		gallery, err := photoService.FieldGallery(ctx, 5)
+
+		require.NoError(t, err)
+		assert.Equal(t, fieldGallery, gallery)
+	})
+
+	t.Run("Fail - Reorder with a photo of another gallery", func(t *testing.T) {
+		ctx := context.Background()
+		photoService, _ := newService(t)
+
+		expectManager(ctx, 1, 10)
+		mockPhotoRepo.EXPECT().
+			Reorder(ctx, fieldGallery, []uint{3, 99}).
+			Return(domain.ErrInvalidPhotoOrder)
+
+		_, err := photoService.ReorderPhotos(ctx, fieldGallery, []uint{3, 99}, 10)
+
+		assert.Equal(t, domain.ErrInvalidPhotoOrder, err)
+	})
+
+	t.Run("Success - Delete removes the files", func(t *testing.T) {
+		ctx := context.Background()
+		photoService, dir := newService(t)
+		photo := domain.Photo{ID: 7, VenueID: 1, StorageKey: "venues/1/photos/a.png", ThumbnailKey: "venues/1/photos/a_thumb.jpg"}
+
+		for _, key := range []string{photo.StorageKey, photo.ThumbnailKey} {
+			path := filepath.Join(dir, filepath.FromSlash(key))
+			require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+			require.NoError(t, os.WriteFile(path, []byte("x"), 0o644))
+		}
+
+		mockPhotoRepo.EXPECT().FindByID(ctx, uint(7)).Return(photo, nil)
+		expectManager(ctx, 1, 10)
+		mockPhotoRepo.EXPECT().Delete(ctx, uint(7)).Return(nil)
+
+		err := photoService.DeletePhoto(ctx, 7, 10)
+
+		require.NoError(t, err)
+		assert.Empty(t, storedFiles(t, dir))
+	})
+}
@@ -0,0 +1,184 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/dto/request"
+	"go-futsal-booking-api/internal/repository/mock"
+	"go-futsal-booking-api/internal/service"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReviewService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReviewRepo := mock.NewMockReviewRepository(ctrl)
+	mockVenueRepo := mock.NewMockVenueRepository(ctrl)
+	mockBookingRepo := mock.NewMockBookingRepository(ctrl)
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockVenueMemberRepo := mock.NewMockVenueMemberRepository(ctrl)
+
+	authorizer := service.NewAuthorizer(mockUserRepo, mockRoleRepo, mockVenueMemberRepo)
+	reviewService := service.NewReviewService(mockReviewRepo, mockVenueRepo, mockBookingRepo, authorizer)
+
+	venue := domain.Venue{ID: 1, Name: "Arena", Timezone: "Asia/Jakarta"}
+	customer := domain.User{ID: 7, FullName: "Budi"}
+	completed := domain.Booking{
+		ID:       3,
+		User:     customer,
+		Schedule: domain.Schedule{ID: 1, Field: domain.Field{ID: 1, Venue: venue}},
+		Status:   domain.BookingStatusCompleted,
+	}
+	req := &request.CreateReviewRequest{BookingID: 3, Rating: 5, Comment: " Great pitch "}
+
+	t.Run("Success - Review a completed booking", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockVenueRepo.EXPECT().FindByID(ctx, uint(1)).Return(venue, nil)
+		mockBookingRepo.EXPECT().FindByID(ctx, uint(3)).Return(completed, nil)
+		mockReviewRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, review *domain.Review) error {
+				assert.Equal(t, uint(1), review.VenueID)
+				assert.Equal(t, uint(3), review.BookingID)
+				assert.Equal(t, uint(7), review.User.ID)
+				assert.Equal(t, "Great pitch", review.Comment)
+				assert.Equal(t, domain.ReviewStatusPublished, review.Status)
+				review.ID = 9
+				return nil
+			})
+
+		review, err := reviewService.CreateReview(ctx, 1, req, 7)
+
+		require.NoError(t, err)
+		assert.Equal(t, uint(9), review.ID)
+		assert.Equal(t, 5, review.Rating)
+	})
+
+	t.Run("Fail - Booking not completed", func(t *testing.T) {
+		ctx := context.Background()
+		confirmed := completed
+		confirmed.Status = domain.BookingStatusConfirmed
+
+		mockVenueRepo.EXPECT().FindByID(ctx, uint(1)).Return(venue, nil)
+		mockBookingRepo.EXPECT().FindByID(ctx, uint(3)).Return(confirmed, nil)
+
+		_, err := reviewService.CreateReview(ctx, 1, req, 7)
+
+		assert.Equal(t, domain.ErrReviewNotAllowed, err)
+	})
+
+	t.Run("Fail - Someone else's booking", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockVenueRepo.EXPECT().FindByID(ctx, uint(1)).Return(venue, nil)
+		mockBookingRepo.EXPECT().FindByID(ctx, uint(3)).Return(completed, nil)
+
+		_, err := reviewService.CreateReview(ctx, 1, req, 8)
+
+		assert.Equal(t, domain.ErrReviewNotAllowed, err)
+	})
+
+	t.Run("Fail - Booking at another venue", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockVenueRepo.EXPECT().FindByID(ctx, uint(2)).Return(domain.Venue{ID: 2}, nil)
+		mockBookingRepo.EXPECT().FindByID(ctx, uint(3)).Return(completed, nil)
+
+		_, err := reviewService.CreateReview(ctx, 2, req, 7)
+
+		assert.Equal(t, domain.ErrReviewNotAllowed, err)
+	})
+
+	t.Run("Fail - Booking already reviewed", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockVenueRepo.EXPECT().FindByID(ctx, uint(1)).Return(venue, nil)
+		mockBookingRepo.EXPECT().FindByID(ctx, uint(3)).Return(completed, nil)
+		mockReviewRepo.EXPECT().Create(ctx, gomock.Any()).Return(domain.ErrReviewExists)
+
+		_, err := reviewService.CreateReview(ctx, 1, req, 7)
+
+		assert.Equal(t, domain.ErrReviewExists, err)
+	})
+
+	t.Run("Fail - Unknown venue", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockVenueRepo.EXPECT().FindByID(ctx, uint(5)).Return(domain.Venue{}, errors.New("venue not found"))
+
+		_, _, err := reviewService.GetVenueReviews(ctx, 5, domain.ReviewFilter{Limit: 20})
+
+		assert.Equal(t, domain.ErrVenueNotFound, err)
+	})
+
+	t.Run("Success - Owner replies", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockReviewRepo.EXPECT().FindByID(ctx, uint(9)).Return(domain.Review{ID: 9, VenueID: 1, User: customer, Rating: 5}, nil)
+		mockVenueMemberRepo.EXPECT().
+			FindByVenueAndUser(ctx, uint(1), uint(10)).
+			Return(domain.VenueMember{Role: domain.VenueRoleOwner}, nil)
+		mockReviewRepo.EXPECT().SaveReply(ctx, uint(9), "Thank you!", uint(10), gomock.Any()).Return(nil)
+
+		review, err := reviewService.ReplyToReview(ctx, 9, " Thank you! ", 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Thank you!", review.Reply)
+		require.NotNil(t, review.RepliedBy)
+		assert.Equal(t, uint(10), *review.RepliedBy)
+		assert.NotNil(t, review.RepliedAt)
+	})
+
+	t.Run("Fail - Manager cannot reply", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockReviewRepo.EXPECT().FindByID(ctx, uint(9)).Return(domain.Review{ID: 9, VenueID: 1}, nil)
+		mockVenueMemberRepo.EXPECT().
+			FindByVenueAndUser(ctx, uint(1), uint(11)).
+			Return(domain.VenueMember{Role: domain.VenueRoleManager}, nil)
+		mockUserRepo.EXPECT().
+			FindByID(ctx, uint(11)).
+			Return(domain.User{ID: 11, Role: domain.Role{ID: 4, RoleName: "USER"}}, nil)
+		mockRoleRepo.EXPECT().
+			HasPermission(ctx, uint(4), domain.PermVenueManageAny).
+			Return(false, nil)
+
+		_, err := reviewService.ReplyToReview(ctx, 9, "Thank you!", 11)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("Success - Hide review", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockReviewRepo.EXPECT().UpdateStatus(ctx, uint(9), domain.ReviewStatusHidden).Return(nil)
+		mockReviewRepo.EXPECT().FindByID(ctx, uint(9)).Return(domain.Review{ID: 9, Status: domain.ReviewStatusHidden}, nil)
+
+		review, err := reviewService.ModerateReview(ctx, 9, domain.ReviewStatusHidden)
+
+		require.NoError(t, err)
+		assert.False(t, review.IsPublished())
+	})
+
+	t.Run("Fail - Unknown moderation status", func(t *testing.T) {
+		_, err := reviewService.ModerateReview(context.Background(), 9, "DELETED")
+
+		assert.Equal(t, domain.ErrInvalidReviewStatus, err)
+	})
+
+	t.Run("Fail - Delete unknown review", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockReviewRepo.EXPECT().Delete(ctx, uint(6)).Return(domain.ErrReviewNotFound)
+
+		assert.Equal(t, domain.ErrReviewNotFound, reviewService.DeleteReview(ctx, 6))
+	})
+}
@@ -0,0 +1,278 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository/mock"
+	"go-futsal-booking-api/internal/service"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoleService_CreateRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockPermissionRepo := mock.NewMockPermissionRepository(ctrl)
+	mockTxManager := mock.NewMockTransactionManager(ctrl)
+	expectTransactions(mockTxManager)
+
+	roleService := service.NewRoleService(mockRoleRepo, mockPermissionRepo, mock.NewMockUserRepository(ctrl), mockTxManager)
+
+	t.Run("Success - Create role with permissions", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRoleRepo.EXPECT().
+			FindByName(ctx, "CASHIER").
+			Return(domain.Role{}, domain.ErrRoleNotFound)
+
+		mockPermissionRepo.EXPECT().
+			FindByNames(ctx, []string{domain.PermBookingReadAny, domain.PermReviewModerate}).
+			Return([]domain.Permission{
+				{ID: 4, Name: domain.PermBookingReadAny},
+				{ID: 5, Name: domain.PermReviewModerate},
+			}, nil)
+
+		mockRoleRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, role *domain.Role) error {
+				role.ID = 3
+				return nil
+			})
+
+		mockRoleRepo.EXPECT().
+			ReplacePermissions(ctx, uint(3), []uint{4, 5}).
+			Return(nil)
+
+		mockRoleRepo.EXPECT().
+			FindByID(ctx, uint(3)).
+			Return(domain.Role{
+				ID:       3,
+				RoleName: "CASHIER",
+				Permissions: []domain.Permission{
+					{ID: 4, Name: domain.PermBookingReadAny},
+					{ID: 5, Name: domain.PermReviewModerate},
+				},
+			}, nil)
+
+		result, err := roleService.CreateRole(ctx, " cashier ", []string{domain.PermBookingReadAny, domain.PermReviewModerate})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "CASHIER", result.RoleName)
+		assert.True(t, result.HasPermission(domain.PermReviewModerate))
+	})
+
+	t.Run("Fail - Role is not kept without its permissions", func(t *testing.T) {
+		ctx := context.Background()
+		failure := errors.New("connection reset")
+
+		var committed bool
+		tx := mock.NewMockTransactionManager(ctrl)
+		tx.EXPECT().
+			WithinTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				err := fn(ctx)
+				committed = err == nil
+				return err
+			})
+		txRoleService := service.NewRoleService(mockRoleRepo, mockPermissionRepo, mock.NewMockUserRepository(ctrl), tx)
+
+		mockRoleRepo.EXPECT().
+			FindByName(ctx, "CASHIER").
+			Return(domain.Role{}, domain.ErrRoleNotFound)
+		mockPermissionRepo.EXPECT().
+			FindByNames(ctx, []string{domain.PermBookingReadAny}).
+			Return([]domain.Permission{{ID: 4, Name: domain.PermBookingReadAny}}, nil)
+		mockRoleRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, role *domain.Role) error {
+				role.ID = 3
+				return nil
+			})
+		mockRoleRepo.EXPECT().
+			ReplacePermissions(ctx, uint(3), []uint{4}).
+			Return(failure)
+
+		result, err := txRoleService.CreateRole(ctx, "cashier", []string{domain.PermBookingReadAny})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, failure)
+		assert.False(t, committed)
+	})
+
+	t.Run("Fail - Unknown permission", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRoleRepo.EXPECT().
+			FindByName(ctx, "FRONT_DESK").
+			Return(domain.Role{}, domain.ErrRoleNotFound)
+
+		mockPermissionRepo.EXPECT().
+			FindByNames(ctx, []string{"booking:teleport"}).
+			Return([]domain.Permission{}, nil)
+
+		result, err := roleService.CreateRole(ctx, "front_desk", []string{"booking:teleport"})
+
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, domain.ErrPermissionNotFound))
+	})
+
+	t.Run("Fail - Role already exists", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRoleRepo.EXPECT().
+			FindByName(ctx, domain.RoleAdmin).
+			Return(domain.Role{ID: 1, RoleName: domain.RoleAdmin}, nil)
+
+		result, err := roleService.CreateRole(ctx, "admin", nil)
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrRoleAlreadyExists, err)
+	})
+}
+
+func TestRoleService_SetRolePermissions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockPermissionRepo := mock.NewMockPermissionRepository(ctrl)
+	mockTxManager := mock.NewMockTransactionManager(ctrl)
+	expectTransactions(mockTxManager)
+
+	roleService := service.NewRoleService(mockRoleRepo, mockPermissionRepo, mock.NewMockUserRepository(ctrl), mockTxManager)
+
+	t.Run("Fail - ADMIN cannot lose role:manage", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRoleRepo.EXPECT().
+			FindByID(ctx, uint(1)).
+			Return(domain.Role{ID: 1, RoleName: domain.RoleAdmin}, nil)
+
+		result, err := roleService.SetRolePermissions(ctx, 1, []string{domain.PermVenueWrite})
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrAdminRoleLockout, err)
+	})
+}
+
+func TestRoleService_DeleteRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockPermissionRepo := mock.NewMockPermissionRepository(ctrl)
+	mockTxManager := mock.NewMockTransactionManager(ctrl)
+	expectTransactions(mockTxManager)
+
+	roleService := service.NewRoleService(mockRoleRepo, mockPermissionRepo, mock.NewMockUserRepository(ctrl), mockTxManager)
+
+	t.Run("Fail - Built-in role", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRoleRepo.EXPECT().
+			FindByID(ctx, uint(2)).
+			Return(domain.Role{ID: 2, RoleName: domain.RoleCustomer}, nil)
+
+		err := roleService.DeleteRole(ctx, 2)
+
+		assert.Equal(t, domain.ErrBuiltInRole, err)
+	})
+
+	t.Run("Fail - Role still in use", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRoleRepo.EXPECT().
+			FindByID(ctx, uint(3)).
+			Return(domain.Role{ID: 3, RoleName: "CASHIER"}, nil)
+
+		mockRoleRepo.EXPECT().
+			CountUsers(ctx, uint(3)).
+			Return(int64(2), nil)
+
+		err := roleService.DeleteRole(ctx, 3)
+
+		assert.Equal(t, domain.ErrRoleInUse, err)
+	})
+}
+
+func TestRoleService_AssignUserRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockPermissionRepo := mock.NewMockPermissionRepository(ctrl)
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockTxManager := mock.NewMockTransactionManager(ctrl)
+	expectTransactions(mockTxManager)
+
+	roleService := service.NewRoleService(mockRoleRepo, mockPermissionRepo, mockUserRepo, mockTxManager)
+
+	admin := domain.Role{ID: 1, RoleName: domain.RoleAdmin, Permissions: []domain.Permission{{Name: domain.PermRoleManage}}}
+	cashier := domain.Role{ID: 3, RoleName: "CASHIER", Permissions: []domain.Permission{{Name: domain.PermBookingReadAny}}}
+
+	t.Run("Success - Customer becomes cashier", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRoleRepo.EXPECT().FindByID(ctx, uint(3)).Return(cashier, nil)
+		mockUserRepo.EXPECT().FindByID(ctx, uint(7)).Return(domain.User{ID: 7, Role: domain.Role{ID: 2}}, nil)
+		mockRoleRepo.EXPECT().LockUsersWithPermission(ctx, domain.PermRoleManage).Return([]uint{1}, nil)
+		mockUserRepo.EXPECT().UpdateRole(ctx, uint(7), uint(3)).Return(nil)
+
+		role, err := roleService.AssignUserRole(ctx, 7, 3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "CASHIER", role.RoleName)
+	})
+
+	t.Run("Success - Another admin can step down", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRoleRepo.EXPECT().FindByID(ctx, uint(3)).Return(cashier, nil)
+		mockUserRepo.EXPECT().FindByID(ctx, uint(1)).Return(domain.User{ID: 1, Role: admin}, nil)
+		mockRoleRepo.EXPECT().LockUsersWithPermission(ctx, domain.PermRoleManage).Return([]uint{1, 4}, nil)
+		mockUserRepo.EXPECT().UpdateRole(ctx, uint(1), uint(3)).Return(nil)
+
+		_, err := roleService.AssignUserRole(ctx, 1, 3)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail - Last role:manage holder keeps it", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRoleRepo.EXPECT().FindByID(ctx, uint(3)).Return(cashier, nil)
+		mockUserRepo.EXPECT().FindByID(ctx, uint(1)).Return(domain.User{ID: 1, Role: admin}, nil)
+		mockRoleRepo.EXPECT().LockUsersWithPermission(ctx, domain.PermRoleManage).Return([]uint{1}, nil)
+
+		role, err := roleService.AssignUserRole(ctx, 1, 3)
+
+		assert.Nil(t, role)
+		assert.ErrorIs(t, err, domain.ErrLastRoleManager)
+	})
+
+	t.Run("Fail - Unknown or deleted role", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRoleRepo.EXPECT().FindByID(ctx, uint(9)).Return(domain.Role{}, domain.ErrRoleNotFound)
+
+		_, err := roleService.AssignUserRole(ctx, 7, 9)
+
+		assert.ErrorIs(t, err, domain.ErrRoleNotFound)
+	})
+
+	t.Run("Fail - Unknown user", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRoleRepo.EXPECT().FindByID(ctx, uint(3)).Return(cashier, nil)
+		mockUserRepo.EXPECT().FindByID(ctx, uint(99)).Return(domain.User{}, domain.ErrUserNotFound)
+
+		_, err := roleService.AssignUserRole(ctx, 99, 3)
+
+		assert.ErrorIs(t, err, domain.ErrUserNotFound)
+	})
+}
@@ -0,0 +1,232 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/dto/request"
+	"go-futsal-booking-api/internal/repository/mock"
+	"go-futsal-booking-api/internal/service"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSavedSearchService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSavedSearchRepo := mock.NewMockSavedSearchRepository(ctrl)
+	mockFieldTypeRepo := mock.NewMockFieldTypeRepository(ctrl)
+
+	savedSearchService := service.NewSavedSearchService(mockSavedSearchRepo, mockFieldTypeRepo)
+
+	req := &request.SavedSearchRequest{
+		Name:      " Friday evenings ",
+		City:      "Jakarta",
+		FieldType: "vinyl",
+		DayOfWeek: 5,
+		StartTime: "18:00",
+		EndTime:   "22:00",
+		Notify:    true,
+	}
+
+	t.Run("Success - Create saved search", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockFieldTypeRepo.EXPECT().FindByCode(ctx, "VINYL").Return(domain.FieldType{Code: "VINYL"}, nil)
+		mockSavedSearchRepo.EXPECT().CountByUserID(ctx, uint(7)).Return(int64(2), nil)
+		mockSavedSearchRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, search *domain.SavedSearch) error {
+				assert.Equal(t, uint(7), search.UserID)
+				assert.Equal(t, "Friday evenings", search.Name)
+				assert.Equal(t, "VINYL", search.FieldType)
+				assert.Equal(t, 18, search.StartTime.Hour())
+				assert.Equal(t, 22, search.EndTime.Hour())
+				search.ID = 4
+				return nil
+			})
+
+		search, err := savedSearchService.CreateSavedSearch(ctx, req, 7)
+
+		require.NoError(t, err)
+		assert.Equal(t, uint(4), search.ID)
+		assert.True(t, search.Notify)
+	})
+
+	t.Run("Fail - Empty time range", func(t *testing.T) {
+		ctx := context.Background()
+		empty := *req
+		empty.StartTime = "22:00"
+
+		_, err := savedSearchService.CreateSavedSearch(ctx, &empty, 7)
+
+		assert.Equal(t, domain.ErrInvalidSavedSearch, err)
+	})
+
+	t.Run("Fail - Unknown field type", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockFieldTypeRepo.EXPECT().FindByCode(ctx, "VINYL").Return(domain.FieldType{}, domain.ErrFieldTypeNotFound)
+
+		_, err := savedSearchService.CreateSavedSearch(ctx, req, 7)
+
+		assert.Equal(t, domain.ErrFieldTypeNotFound, err)
+	})
+
+	t.Run("Fail - Limit reached", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockFieldTypeRepo.EXPECT().FindByCode(ctx, "VINYL").Return(domain.FieldType{Code: "VINYL"}, nil)
+		mockSavedSearchRepo.EXPECT().CountByUserID(ctx, uint(7)).Return(int64(domain.SavedSearchMaxPerUser), nil)
+
+		_, err := savedSearchService.CreateSavedSearch(ctx, req, 7)
+
+		assert.Equal(t, domain.ErrTooManySavedSearches, err)
+	})
+
+	t.Run("Success - Update saved search", func(t *testing.T) {
+		ctx := context.Background()
+		anyDay := request.SavedSearchRequest{Name: "Any time in Bandung", City: "Bandung"}
+
+		mockSavedSearchRepo.EXPECT().FindByID(ctx, uint(4)).Return(domain.SavedSearch{ID: 4, UserID: 7, Notify: true}, nil)
+		mockSavedSearchRepo.EXPECT().
+			Update(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, search *domain.SavedSearch) error {
+				assert.Equal(t, uint(4), search.ID)
+				assert.Equal(t, "Bandung", search.City)
+				assert.Zero(t, search.DayOfWeek)
+				assert.Nil(t, search.StartTime)
+				assert.False(t, search.Notify)
+				return nil
+			})
+
+		search, err := savedSearchService.UpdateSavedSearch(ctx, 4, &anyDay, 7)
+
+		require.NoError(t, err)
+		assert.Equal(t, uint(7), search.UserID)
+	})
+
+	t.Run("Fail - Someone else's saved search", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockSavedSearchRepo.EXPECT().FindByID(ctx, uint(4)).Return(domain.SavedSearch{ID: 4, UserID: 8}, nil)
+
+		err := savedSearchService.DeleteSavedSearch(ctx, 4, 7)
+
+		assert.Equal(t, domain.ErrSavedSearchNotFound, err)
+	})
+
+	t.Run("Success - Delete saved search", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockSavedSearchRepo.EXPECT().FindByID(ctx, uint(4)).Return(domain.SavedSearch{ID: 4, UserID: 7}, nil)
+		mockSavedSearchRepo.EXPECT().Delete(ctx, uint(4)).Return(nil)
+
+		assert.NoError(t, savedSearchService.DeleteSavedSearch(ctx, 4, 7))
+	})
+}
+
+func TestSavedSearchAlertJob_SendAlerts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSavedSearchRepo := mock.NewMockSavedSearchRepository(ctrl)
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	mockPreferenceRepo := mock.NewMockNotificationPreferenceRepository(ctrl)
+	mockTxManager := mock.NewMockTransactionManager(ctrl)
+	expectTransactions(mockTxManager)
+
+	router := service.NewNotificationRouter(service.NewOutboxService(mockOutboxRepo, testOutboxConfig), newTestEmailTemplates(t), mockPreferenceRepo, noInbox(t, ctrl))
+	job := service.NewSavedSearchAlertJob(mockSavedSearchRepo, mockTxManager, router, service.SavedSearchAlertConfig{
+		PollInterval: time.Minute,
+		Lookahead:    7 * 24 * time.Hour,
+		BatchSize:    2,
+	})
+
+	user := domain.User{ID: 7, FullName: "Budi", Email: "budi@example.com", Language: domain.LanguageEnglish}
+	search := domain.SavedSearch{ID: 4, UserID: 7, User: user, Name: "Friday evenings", Notify: true}
+	quiet := domain.SavedSearch{ID: 5, UserID: 8, User: domain.User{ID: 8}, Name: "Mornings", Notify: true}
+
+	venue := domain.Venue{ID: 1, Name: "Arena", Timezone: "Asia/Jakarta"}
+	schedule := domain.Schedule{
+		ID:        3,
+		Field:     domain.Field{ID: 2, Name: "Field A", Venue: venue},
+		DayOfWeek: 5,
+		StartTime: time.Date(0, 1, 1, 19, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(0, 1, 1, 20, 0, 0, 0, time.UTC),
+		Price:     150000,
+	}
+	first := domain.SavedSearchSlot{Schedule: schedule, Date: time.Date(2026, 3, 13, 0, 0, 0, 0, time.UTC)}
+	second := domain.SavedSearchSlot{Schedule: schedule, Date: time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)}
+
+	t.Run("Success - Alerts newly claimed slots once per search", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockSavedSearchRepo.EXPECT().FindNotifying(ctx, uint(0), 2).Return([]domain.SavedSearch{search, quiet}, nil)
+		mockSavedSearchRepo.EXPECT().FindNotifying(ctx, uint(5), 2).Return(nil, nil)
+
+		// Another replica already alerted the first slot.
+		mockSavedSearchRepo.EXPECT().
+			FindNewSlots(ctx, search, gomock.Any(), 7, domain.SavedSearchAlertSlots).
+			Return([]domain.SavedSearchSlot{first, second}, nil)
+		mockSavedSearchRepo.EXPECT().ClaimSlot(ctx, uint(4), first, gomock.Any()).Return(false, nil)
+		mockSavedSearchRepo.EXPECT().ClaimSlot(ctx, uint(4), second, gomock.Any()).Return(true, nil)
+		mockOutboxRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, message *domain.OutboxMessage) error {
+				email := decodeOutboxEmail(t, message)
+				assert.Equal(t, "budi@example.com", email.To[0].Email)
+				assert.Equal(t, `New Slots for "Friday evenings"`, email.Subject)
+				assert.Contains(t, email.TextBody, "- Arena, Field A,")
+				assert.Contains(t, email.TextBody, "19:00 - 20:00")
+				return nil
+			})
+
+		mockSavedSearchRepo.EXPECT().
+			FindNewSlots(ctx, quiet, gomock.Any(), 7, domain.SavedSearchAlertSlots).
+			Return(nil, nil)
+
+		sent, err := job.SendAlerts(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, sent)
+	})
+
+	t.Run("Success - A failing search does not stop the others", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockSavedSearchRepo.EXPECT().FindNotifying(ctx, uint(0), 2).Return([]domain.SavedSearch{quiet, search}, nil)
+		mockSavedSearchRepo.EXPECT().FindNotifying(ctx, uint(4), 2).Return(nil, nil)
+
+		mockSavedSearchRepo.EXPECT().
+			FindNewSlots(ctx, quiet, gomock.Any(), 7, domain.SavedSearchAlertSlots).
+			Return(nil, errors.New("database error"))
+
+		mockSavedSearchRepo.EXPECT().
+			FindNewSlots(ctx, search, gomock.Any(), 7, domain.SavedSearchAlertSlots).
+			Return([]domain.SavedSearchSlot{first}, nil)
+		mockSavedSearchRepo.EXPECT().ClaimSlot(ctx, uint(4), first, gomock.Any()).Return(true, nil)
+		mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		sent, err := job.SendAlerts(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, sent)
+	})
+
+	t.Run("Fail - Repository error stops the run", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockSavedSearchRepo.EXPECT().FindNotifying(ctx, uint(0), 2).Return(nil, errors.New("database error"))
+
+		sent, err := job.SendAlerts(ctx)
+
+		assert.Error(t, err)
+		assert.Equal(t, 0, sent)
+	})
+}
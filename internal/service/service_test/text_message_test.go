@@ -0,0 +1,379 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/internal/repository/mock"
+	"go-futsal-booking-api/internal/service"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeOutboxTextMessage(t *testing.T, message *domain.OutboxMessage) domain.TextMessage {
+	assert.Equal(t, domain.OutboxTopicTextMessage, message.Topic)
+
+	var textMessage domain.TextMessage
+	if err := json.Unmarshal(message.Payload, &textMessage); err != nil {
+		t.Fatalf("failed to decode outbox text message: %v", err)
+	}
+	return textMessage
+}
+
+func TestNormalizePhoneNumber(t *testing.T) {
+	for _, tc := range []struct {
+		input    string
+		expected string
+		err      error
+	}{
+		{"0812-3456-7890", "+6281234567890", nil},
+		{"+62 812 3456 7890", "+6281234567890", nil},
+		{"6281234567890", "+6281234567890", nil},
+		{"+44 20 7946 0958", "+442079460958", nil},
+		{"0812", "", domain.ErrInvalidPhoneNumber},
+		{"0812-abc-7890", "", domain.ErrInvalidPhoneNumber},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			phone, err := domain.NormalizePhoneNumber(tc.input)
+
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.expected, phone)
+		})
+	}
+}
+
+func TestHTTPTextMessageRepository_Send(t *testing.T) {
+	t.Run("Success - Posts message to the gateway", func(t *testing.T) {
+		var payload map[string]string
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/messages", r.URL.Path)
+			assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		repo := repository.NewHTTPTextMessageRepository(repository.TextMessageHTTPConfig{
+			BaseURL: server.URL,
+			APIKey:  "secret",
+			Sender:  "FUTSAL",
+			Timeout: time.Second,
+		})
+
+		err := repo.Send(context.Background(), domain.TextMessage{
+			Channel: domain.NotificationChannelWhatsApp,
+			To:      "+6281234567890",
+			Body:    "Booking #7 confirmed",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "whatsapp", payload["channel"])
+		assert.Equal(t, "FUTSAL", payload["from"])
+		assert.Equal(t, "+6281234567890", payload["to"])
+		assert.Equal(t, "Booking #7 confirmed", payload["body"])
+	})
+
+	for _, tc := range []struct {
+		name      string
+		status    int
+		retryable bool
+	}{
+		{"Fail - Server error is retryable", http.StatusBadGateway, true},
+		{"Fail - Rate limit is retryable", http.StatusTooManyRequests, true},
+		{"Fail - Rejected number is permanent", http.StatusUnprocessableEntity, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+			}))
+			defer server.Close()
+
+			repo := repository.NewHTTPTextMessageRepository(repository.TextMessageHTTPConfig{BaseURL: server.URL, Timeout: time.Second})
+
+			err := repo.Send(context.Background(), domain.TextMessage{Channel: domain.NotificationChannelSMS, To: "+6281234567890", Body: "hi"})
+
+			require.Error(t, err)
+			assert.Equal(t, tc.retryable, domain.IsRetryableNotification(err))
+		})
+	}
+
+	t.Run("Fail - Email channel is rejected", func(t *testing.T) {
+		var out bytes.Buffer
+		repo := repository.NewFakeTextMessageRepository(&out)
+
+		err := repo.Send(context.Background(), domain.TextMessage{Channel: domain.NotificationChannelEmail, To: "+6281234567890", Body: "hi"})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidTextMessage)
+		assert.False(t, domain.IsRetryableNotification(err))
+		assert.Empty(t, out.String())
+	})
+}
+
+func TestNotificationRouter_Notify(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	mockPreferenceRepo := mock.NewMockNotificationPreferenceRepository(ctrl)
+	router := service.NewNotificationRouter(service.NewOutboxService(mockOutboxRepo, testOutboxConfig), newTestEmailTemplates(t), mockPreferenceRepo, noInbox(t, ctrl))
+
+	verifiedAt := time.Now()
+	data := service.BookingEmailData{
+		FullName:   "Budi",
+		BookingID:  7,
+		VenueName:  "Arena Senayan",
+		FieldName:  "Field A",
+		StartTime:  time.Date(2026, 3, 14, 19, 0, 0, 0, time.UTC),
+		EndTime:    time.Date(2026, 3, 14, 20, 0, 0, 0, time.UTC),
+		TotalPrice: 150000,
+	}
+
+	t.Run("Success - WhatsApp when phone is verified", func(t *testing.T) {
+		ctx := context.Background()
+		user := domain.User{ID: 1, Email: "budi@example.com", Language: domain.LanguageEnglish, PhoneNumber: "+6281234567890", PhoneVerifiedAt: &verifiedAt}
+
+		mockPreferenceRepo.EXPECT().
+			FindChannel(ctx, uint(1), domain.EmailTemplateBookingConfirmation).
+			Return(domain.NotificationChannelWhatsApp, nil)
+		mockOutboxRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, message *domain.OutboxMessage) error {
+				textMessage := decodeOutboxTextMessage(t, message)
+				assert.Equal(t, domain.NotificationChannelWhatsApp, textMessage.Channel)
+				assert.Equal(t, "+6281234567890", textMessage.To)
+				assert.Equal(t, "Booking #7 confirmed: Arena Senayan, Field A, Saturday, 14 March 2026 at 19:00-20:00. Total Rp150,000.", textMessage.Body)
+				return nil
+			})
+
+		err := router.Notify(ctx, user, domain.EmailTemplateBookingConfirmation, data)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Success - Falls back to email without a verified phone", func(t *testing.T) {
+		ctx := context.Background()
+		user := domain.User{ID: 2, FullName: "Budi", Email: "budi@example.com", Language: domain.LanguageEnglish}
+
+		mockOutboxRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, message *domain.OutboxMessage) error {
+				email := decodeOutboxEmail(t, message)
+				assert.Equal(t, "budi@example.com", email.To[0].Email)
+				assert.Equal(t, "Booking #7 Confirmed", email.Subject)
+				return nil
+			})
+
+		err := router.Notify(ctx, user, domain.EmailTemplateBookingConfirmation, data)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Success - Email when no preference is set", func(t *testing.T) {
+		ctx := context.Background()
+		user := domain.User{ID: 3, Email: "budi@example.com", PhoneNumber: "+6281234567890", PhoneVerifiedAt: &verifiedAt}
+
+		mockPreferenceRepo.EXPECT().
+			FindChannel(ctx, uint(3), domain.EmailTemplateBookingReminder).
+			Return("", nil)
+		mockOutboxRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, message *domain.OutboxMessage) error {
+				assert.Equal(t, domain.OutboxTopicEmail, message.Topic)
+				return nil
+			})
+
+		err := router.Notify(ctx, user, domain.EmailTemplateBookingReminder, data)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestPhoneVerificationService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockVerificationRepo := mock.NewMockPhoneVerificationRepository(ctrl)
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	mockTxManager := mock.NewMockTransactionManager(ctrl)
+	expectTransactions(mockTxManager)
+
+	phoneService := service.NewPhoneVerificationService(
+		mockUserRepo,
+		mockVerificationRepo,
+		mockTxManager,
+		service.NewOutboxService(mockOutboxRepo, testOutboxConfig),
+		newTestEmailTemplates(t),
+		noInbox(t, ctrl),
+		"Futsal Booking API",
+		service.PhoneVerificationConfig{
+			Channel:        domain.NotificationChannelWhatsApp,
+			CodeTTL:        5 * time.Minute,
+			ResendCooldown: time.Minute,
+			MaxAttempts:    3,
+		},
+	)
+
+	user := domain.User{ID: 1, FullName: "Budi", Language: domain.LanguageEnglish}
+	codePattern := regexp.MustCompile(`code is (\d{6})`)
+
+	t.Run("Success - Code is sent and accepted", func(t *testing.T) {
+		ctx := context.Background()
+		var saved domain.PhoneVerification
+		var code string
+
+		mockUserRepo.EXPECT().FindByID(ctx, uint(1)).Return(user, nil)
+		mockUserRepo.EXPECT().FindByPhoneNumber(ctx, "+6281234567890").Return(domain.User{}, domain.ErrUserNotFound).Times(2)
+		mockVerificationRepo.EXPECT().FindByUserID(ctx, uint(1)).Return(domain.PhoneVerification{}, domain.ErrInvalidPhoneCode)
+		mockVerificationRepo.EXPECT().
+			Save(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, verification *domain.PhoneVerification) error {
+				saved = *verification
+				return nil
+			})
+		mockOutboxRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, message *domain.OutboxMessage) error {
+				textMessage := decodeOutboxTextMessage(t, message)
+				assert.Equal(t, domain.NotificationChannelWhatsApp, textMessage.Channel)
+				assert.Equal(t, "+6281234567890", textMessage.To)
+				match := codePattern.FindStringSubmatch(textMessage.Body)
+				require.Len(t, match, 2)
+				code = match[1]
+				return nil
+			})
+
+		verification, err := phoneService.RequestCode(ctx, 1, "0812-3456-7890")
+
+		require.NoError(t, err)
+		assert.Equal(t, "+6281234567890", verification.PhoneNumber)
+		assert.NotContains(t, saved.CodeHash, code)
+
+		mockVerificationRepo.EXPECT().FindByUserID(ctx, uint(1)).Return(saved, nil)
+		mockUserRepo.EXPECT().UpdatePhone(ctx, uint(1), "+6281234567890", gomock.Any()).Return(nil)
+		mockVerificationRepo.EXPECT().Delete(ctx, uint(1)).Return(nil)
+		verified := user
+		verified.PhoneNumber = "+6281234567890"
+		verified.PhoneVerifiedAt = &saved.CreatedAt
+		mockUserRepo.EXPECT().FindByID(ctx, uint(1)).Return(verified, nil)
+
+		result, err := phoneService.VerifyCode(ctx, 1, code)
+
+		require.NoError(t, err)
+		assert.True(t, result.HasVerifiedPhone())
+	})
+
+	t.Run("Fail - Wrong code counts an attempt", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockVerificationRepo.EXPECT().FindByUserID(ctx, uint(1)).Return(domain.PhoneVerification{
+			UserID:      1,
+			PhoneNumber: "+6281234567890",
+			CodeHash:    "not-the-hash",
+			ExpiresAt:   time.Now().Add(time.Minute),
+		}, nil)
+		mockVerificationRepo.EXPECT().IncrementAttempts(ctx, uint(1)).Return(nil)
+
+		_, err := phoneService.VerifyCode(ctx, 1, "000000")
+
+		assert.ErrorIs(t, err, domain.ErrInvalidPhoneCode)
+	})
+
+	t.Run("Fail - Too many attempts", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockVerificationRepo.EXPECT().FindByUserID(ctx, uint(1)).Return(domain.PhoneVerification{
+			UserID:    1,
+			Attempts:  3,
+			ExpiresAt: time.Now().Add(time.Minute),
+		}, nil)
+
+		_, err := phoneService.VerifyCode(ctx, 1, "000000")
+
+		assert.ErrorIs(t, err, domain.ErrInvalidPhoneCode)
+	})
+
+	t.Run("Fail - Resend within cooldown", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockUserRepo.EXPECT().FindByID(ctx, uint(1)).Return(user, nil)
+		mockUserRepo.EXPECT().FindByPhoneNumber(ctx, "+6281234567890").Return(domain.User{}, domain.ErrUserNotFound)
+		mockVerificationRepo.EXPECT().FindByUserID(ctx, uint(1)).Return(domain.PhoneVerification{CreatedAt: time.Now()}, nil)
+
+		_, err := phoneService.RequestCode(ctx, 1, "081234567890")
+
+		assert.ErrorIs(t, err, domain.ErrPhoneCodeCooldown)
+	})
+
+	t.Run("Fail - Number verified by another user", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockUserRepo.EXPECT().FindByID(ctx, uint(1)).Return(user, nil)
+		mockUserRepo.EXPECT().FindByPhoneNumber(ctx, "+6281234567890").Return(domain.User{ID: 2}, nil)
+
+		_, err := phoneService.RequestCode(ctx, 1, "081234567890")
+
+		assert.ErrorIs(t, err, domain.ErrPhoneNumberTaken)
+	})
+
+	t.Run("Fail - Invalid number", func(t *testing.T) {
+		_, err := phoneService.RequestCode(context.Background(), 1, "12")
+
+		assert.ErrorIs(t, err, domain.ErrInvalidPhoneNumber)
+	})
+}
+
+func TestNotificationPreferenceService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPreferenceRepo := mock.NewMockNotificationPreferenceRepository(ctrl)
+	preferenceService := service.NewNotificationPreferenceService(mockPreferenceRepo)
+
+	t.Run("Success - Unset events default to email", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockPreferenceRepo.EXPECT().FindByUserID(ctx, uint(1)).Return([]domain.NotificationPreference{
+			{UserID: 1, Event: domain.EmailTemplateBookingReminder, Channel: domain.NotificationChannelWhatsApp},
+		}, nil)
+
+		preferences, err := preferenceService.GetPreferences(ctx, 1)
+
+		require.NoError(t, err)
+		require.Len(t, preferences, len(domain.NotificationEvents))
+		for _, p := range preferences {
+			if p.Event == domain.EmailTemplateBookingReminder {
+				assert.Equal(t, domain.NotificationChannelWhatsApp, p.Channel)
+			} else {
+				assert.Equal(t, domain.NotificationChannelEmail, p.Channel)
+			}
+		}
+	})
+
+	t.Run("Fail - Account emails cannot be rerouted", func(t *testing.T) {
+		_, err := preferenceService.UpdatePreferences(context.Background(), 1, []domain.NotificationPreference{
+			{Event: domain.EmailTemplateAccountLocked, Channel: domain.NotificationChannelSMS},
+		})
+
+		assert.True(t, errors.Is(err, domain.ErrUnknownEvent))
+	})
+
+	t.Run("Fail - Unknown channel", func(t *testing.T) {
+		_, err := preferenceService.UpdatePreferences(context.Background(), 1, []domain.NotificationPreference{
+			{Event: domain.EmailTemplateBookingReminder, Channel: "PIGEON"},
+		})
+
+		assert.ErrorIs(t, err, domain.ErrUnknownChannel)
+	})
+}
@@ -0,0 +1,314 @@
+package service_test
+
+import (
+	"context"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository/mock"
+	"go-futsal-booking-api/internal/service"
+	"go-futsal-booking-api/pkg/utils"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTOTPCode_RFC6238(t *testing.T) {
+	// RFC 6238 appendix B, SHA1 secret "12345678901234567890", truncated to 6 digits.
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+	vectors := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+
+	for unix, want := range vectors {
+		code, err := utils.TOTPCode(secret, utils.TOTPStep(time.Unix(unix, 0)))
+		assert.NoError(t, err)
+		assert.Equal(t, want, code, "unix time %d", unix)
+	}
+}
+
+func TestTwoFactorService_Enrolment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockTwoFactorRepo := mock.NewMockTwoFactorRepository(ctrl)
+	mockLoginAttemptRepo := mock.NewMockLoginAttemptRepository(ctrl)
+
+	loginGuard := service.NewLoginGuard(mockLoginAttemptRepo, testLoginGuardConfig)
+	twoFactorService := service.NewTwoFactorService(mockUserRepo, mockTwoFactorRepo, loginGuard, noInbox(t, ctrl), testTOTPEncryptionKey, "Futsal Booking API")
+
+	ctx := context.Background()
+	admin := domain.User{
+		ID:    1,
+		Email: "admin@example.com",
+		Role:  domain.Role{ID: 1, RoleName: domain.RoleAdmin},
+	}
+
+	var stored domain.TwoFactor
+	var setup *domain.TwoFactorSetup
+
+	t.Run("Success - Setup returns otpauth URI", func(t *testing.T) {
+		mockUserRepo.EXPECT().
+			FindByID(ctx, admin.ID).
+			Return(admin, nil)
+
+		mockTwoFactorRepo.EXPECT().
+			FindByUserID(ctx, admin.ID).
+			Return(domain.TwoFactor{}, domain.ErrTwoFactorNotFound)
+
+		mockTwoFactorRepo.EXPECT().
+			Save(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, twoFactor *domain.TwoFactor) error {
+				stored = *twoFactor
+				return nil
+			})
+
+		var err error
+		setup, err = twoFactorService.BeginSetup(ctx, admin.ID)
+
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(setup.OTPAuthURI, "otpauth://totp/"))
+		assert.Contains(t, setup.OTPAuthURI, "secret="+setup.Secret)
+		assert.NotEqual(t, setup.Secret, stored.Secret, "secret must be stored encrypted")
+		assert.False(t, stored.Enabled)
+	})
+
+	t.Run("Fail - Enable with wrong code", func(t *testing.T) {
+		mockUserRepo.EXPECT().
+			FindByID(ctx, admin.ID).
+			Return(admin, nil)
+
+		mockTwoFactorRepo.EXPECT().
+			FindByUserID(ctx, admin.ID).
+			Return(stored, nil)
+
+		mockLoginAttemptRepo.EXPECT().
+			Reserve(ctx, domain.LoginScopeAccount, admin.Email, gomock.Any(), gomock.Any()).
+			Return(domain.LoginAttempt{FailedCount: 1}, true, nil)
+
+		mockLoginAttemptRepo.EXPECT().
+			Lock(ctx, domain.LoginScopeAccount, admin.Email, 10, gomock.Any()).
+			Return(false, nil)
+
+		code, _ := utils.TOTPCode(setup.Secret, utils.TOTPStep(time.Now())+5)
+		recoveryCodes, err := twoFactorService.Enable(ctx, admin.ID, code)
+
+		assert.Equal(t, domain.ErrInvalidTwoFactorCode, err)
+		assert.Nil(t, recoveryCodes)
+	})
+
+	t.Run("Success - Enable returns recovery codes", func(t *testing.T) {
+		step := utils.TOTPStep(time.Now())
+		code, _ := utils.TOTPCode(setup.Secret, step)
+
+		mockUserRepo.EXPECT().
+			FindByID(ctx, admin.ID).
+			Return(admin, nil)
+
+		mockTwoFactorRepo.EXPECT().
+			FindByUserID(ctx, admin.ID).
+			Return(stored, nil)
+
+		mockLoginAttemptRepo.EXPECT().
+			Reserve(ctx, domain.LoginScopeAccount, admin.Email, gomock.Any(), gomock.Any()).
+			Return(domain.LoginAttempt{FailedCount: 1}, true, nil)
+
+		mockLoginAttemptRepo.EXPECT().
+			Release(ctx, domain.LoginScopeAccount, admin.Email).
+			Return(nil)
+
+		mockTwoFactorRepo.EXPECT().
+			AdvanceStep(ctx, admin.ID, step).
+			Return(nil)
+
+		mockTwoFactorRepo.EXPECT().
+			Save(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, twoFactor *domain.TwoFactor) error {
+				assert.True(t, twoFactor.Enabled)
+				return nil
+			})
+
+		var storedHashes []string
+		mockTwoFactorRepo.EXPECT().
+			ReplaceRecoveryCodes(ctx, admin.ID, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, userID uint, hashes []string) error {
+				storedHashes = hashes
+				return nil
+			})
+
+		recoveryCodes, err := twoFactorService.Enable(ctx, admin.ID, code)
+
+		assert.NoError(t, err)
+		assert.Len(t, recoveryCodes, 10)
+		assert.Len(t, storedHashes, 10)
+		assert.NotContains(t, storedHashes, recoveryCodes[0], "recovery codes must be stored hashed")
+	})
+
+	t.Run("Fail - Admin cannot disable", func(t *testing.T) {
+		mockUserRepo.EXPECT().
+			FindByID(ctx, admin.ID).
+			Return(admin, nil)
+
+		err := twoFactorService.Disable(ctx, admin.ID, "123456")
+
+		assert.Equal(t, domain.ErrTwoFactorMandatory, err)
+	})
+}
+
+func TestTwoFactorService_EnableCodeReplay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockTwoFactorRepo := mock.NewMockTwoFactorRepository(ctrl)
+	mockLoginAttemptRepo := mock.NewMockLoginAttemptRepository(ctrl)
+
+	loginGuard := service.NewLoginGuard(mockLoginAttemptRepo, testLoginGuardConfig)
+	twoFactorService := service.NewTwoFactorService(mockUserRepo, mockTwoFactorRepo, loginGuard, noInbox(t, ctrl), testTOTPEncryptionKey, "Futsal Booking API")
+
+	ctx := context.Background()
+	user := domain.User{ID: 4, Email: "jane@example.com", Role: domain.Role{ID: 2, RoleName: "customer"}}
+
+	// The stored settings, updated the way the table is: AdvanceStep only moves
+	// the step forward and Save writes whatever step it is given.
+	var stored domain.TwoFactor
+	mockTwoFactorRepo.EXPECT().
+		Save(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, twoFactor *domain.TwoFactor) error {
+			stored = *twoFactor
+			return nil
+		}).
+		Times(2)
+	mockTwoFactorRepo.EXPECT().
+		AdvanceStep(ctx, user.ID, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, userID uint, step int64) error {
+			if step <= stored.LastUsedStep {
+				return domain.ErrInvalidTwoFactorCode
+			}
+			stored.LastUsedStep = step
+			return nil
+		}).
+		Times(2)
+	mockTwoFactorRepo.EXPECT().
+		FindByUserID(ctx, user.ID).
+		DoAndReturn(func(ctx context.Context, userID uint) (domain.TwoFactor, error) {
+			if stored.UserID == 0 {
+				return domain.TwoFactor{}, domain.ErrTwoFactorNotFound
+			}
+			return stored, nil
+		}).
+		Times(3)
+	mockTwoFactorRepo.EXPECT().
+		ReplaceRecoveryCodes(ctx, user.ID, gomock.Any()).
+		Return(nil)
+	mockUserRepo.EXPECT().
+		FindByID(ctx, user.ID).
+		Return(user, nil).
+		Times(3)
+	mockLoginAttemptRepo.EXPECT().
+		Reserve(ctx, domain.LoginScopeAccount, user.Email, gomock.Any(), gomock.Any()).
+		Return(domain.LoginAttempt{FailedCount: 1}, true, nil).
+		Times(2)
+	mockLoginAttemptRepo.EXPECT().
+		Release(ctx, domain.LoginScopeAccount, user.Email).
+		Return(nil)
+	mockLoginAttemptRepo.EXPECT().
+		Lock(ctx, domain.LoginScopeAccount, user.Email, 10, gomock.Any()).
+		Return(false, nil)
+
+	setup, err := twoFactorService.BeginSetup(ctx, user.ID)
+	assert.NoError(t, err)
+
+	code, _ := utils.TOTPCode(setup.Secret, utils.TOTPStep(time.Now()))
+
+	_, err = twoFactorService.Enable(ctx, user.ID, code)
+	assert.NoError(t, err)
+
+	challenge, err := twoFactorService.IssueChallenge(user, domain.ChallengeTwoFactorLogin)
+	assert.NoError(t, err)
+
+	result, err := twoFactorService.CompleteLogin(ctx, challenge, code)
+
+	assert.Equal(t, domain.ErrInvalidTwoFactorCode, err, "the code that enabled two-factor must not log in again")
+	assert.Nil(t, result)
+}
+
+func TestTwoFactorService_CompleteLogin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockTwoFactorRepo := mock.NewMockTwoFactorRepository(ctrl)
+	mockLoginAttemptRepo := mock.NewMockLoginAttemptRepository(ctrl)
+
+	loginGuard := service.NewLoginGuard(mockLoginAttemptRepo, testLoginGuardConfig)
+	twoFactorService := service.NewTwoFactorService(mockUserRepo, mockTwoFactorRepo, loginGuard, noInbox(t, ctrl), testTOTPEncryptionKey, "Futsal Booking API")
+
+	ctx := context.Background()
+	user := domain.User{
+		ID:    5,
+		Email: "jane@example.com",
+		Role:  domain.Role{ID: 2, RoleName: domain.RoleCustomer},
+	}
+
+	t.Run("Success - Recovery code", func(t *testing.T) {
+		challenge, err := twoFactorService.IssueChallenge(user, domain.ChallengeTwoFactorLogin)
+		assert.NoError(t, err)
+
+		mockUserRepo.EXPECT().
+			FindByID(ctx, user.ID).
+			Return(user, nil)
+
+		mockTwoFactorRepo.EXPECT().
+			FindByUserID(ctx, user.ID).
+			Return(domain.TwoFactor{UserID: user.ID, Enabled: true}, nil)
+
+		mockLoginAttemptRepo.EXPECT().
+			Reserve(ctx, domain.LoginScopeAccount, user.Email, gomock.Any(), gomock.Any()).
+			Return(domain.LoginAttempt{FailedCount: 1}, true, nil)
+
+		mockLoginAttemptRepo.EXPECT().
+			Release(ctx, domain.LoginScopeAccount, user.Email).
+			Return(nil)
+
+		// Dashes and case are ignored, so "k7qm2-xw9rd" matches "K7QM2XW9RD".
+		mockTwoFactorRepo.EXPECT().
+			UseRecoveryCode(ctx, user.ID, gomock.Any()).
+			Return(nil)
+
+		mockLoginAttemptRepo.EXPECT().
+			Reset(ctx, domain.LoginScopeAccount, user.Email).
+			Return(nil)
+
+		result, err := twoFactorService.CompleteLogin(ctx, challenge, "k7qm2-xw9rd")
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, result.Token)
+		assert.Equal(t, user.ID, result.User.ID)
+	})
+
+	t.Run("Fail - Setup challenge cannot complete login", func(t *testing.T) {
+		challenge, err := twoFactorService.IssueChallenge(user, domain.ChallengeTwoFactorSetup)
+		assert.NoError(t, err)
+
+		result, err := twoFactorService.CompleteLogin(ctx, challenge, "123456")
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrInvalidChallenge, err)
+	})
+
+	t.Run("Fail - Challenge token is not an access token", func(t *testing.T) {
+		challenge, err := twoFactorService.IssueChallenge(user, domain.ChallengeTwoFactorLogin)
+		assert.NoError(t, err)
+
+		claims, err := utils.ParseJWT(challenge)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, claims.Purpose)
+	})
+}
@@ -2,6 +2,7 @@ package service_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"go-futsal-booking-api/internal/domain"
 	"go-futsal-booking-api/internal/repository/mock"
@@ -19,18 +20,107 @@ func init() {
 	logger.Init("test")
 }
 
+const testTOTPEncryptionKey = "0123456789abcdef0123456789abcdef"
+
+func newTestEmailTemplates(t *testing.T) service.EmailTemplateService {
+	templates, err := service.NewEmailTemplateService("Futsal Booking API")
+	if err != nil {
+		t.Fatalf("failed to load email templates: %v", err)
+	}
+	return templates
+}
+
+var testOutboxConfig = service.OutboxConfig{
+	MaxAttempts:  3,
+	BaseDelay:    time.Second,
+	MaxDelay:     10 * time.Second,
+	PollInterval: time.Second,
+	BatchSize:    10,
+	Lease:        time.Minute,
+}
+
+// expectTransactions makes the mock run every transaction body inline.
+func expectTransactions(txManager *mock.MockTransactionManager) {
+	txManager.EXPECT().
+		WithinTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).
+		AnyTimes()
+}
+
+func decodeOutboxEmail(t *testing.T, message *domain.OutboxMessage) domain.Email {
+	assert.Equal(t, domain.OutboxTopicEmail, message.Topic)
+
+	var email domain.Email
+	if err := json.Unmarshal(message.Payload, &email); err != nil {
+		t.Fatalf("failed to decode outbox email: %v", err)
+	}
+	return email
+}
+
+var testLoginGuardConfig = service.LoginGuardConfig{
+	AccountFreeAttempts:  3,
+	AccountLockThreshold: 10,
+	IPFreeAttempts:       10,
+	IPLockThreshold:      50,
+	BaseDelay:            time.Second,
+	MaxDelay:             time.Minute,
+	LockDuration:         15 * time.Minute,
+	Window:               time.Hour,
+}
+
+// expectLoginAllowed lets a login through the throttle for the given email and IP.
+func expectLoginAllowed(repo *mock.MockLoginAttemptRepository, ctx context.Context, email, ip string) {
+	repo.EXPECT().
+		Reserve(ctx, domain.LoginScopeAccount, email, gomock.Any(), gomock.Any()).
+		Return(domain.LoginAttempt{Scope: domain.LoginScopeAccount, Key: email, FailedCount: 1}, true, nil)
+	repo.EXPECT().
+		Reserve(ctx, domain.LoginScopeIP, ip, gomock.Any(), gomock.Any()).
+		Return(domain.LoginAttempt{Scope: domain.LoginScopeIP, Key: ip, FailedCount: 1}, true, nil)
+}
+
+// expectLoginReleased gives back the attempts counted for a correct password.
+func expectLoginReleased(repo *mock.MockLoginAttemptRepository, ctx context.Context, email, ip string) {
+	repo.EXPECT().Release(ctx, domain.LoginScopeAccount, email).Return(nil)
+	repo.EXPECT().Release(ctx, domain.LoginScopeIP, ip).Return(nil)
+}
+
+// expectLoginFailure records one failed attempt below every threshold.
+func expectLoginFailure(repo *mock.MockLoginAttemptRepository, ctx context.Context, email, ip string) {
+	repo.EXPECT().
+		Lock(ctx, domain.LoginScopeAccount, email, 10, gomock.Any()).
+		Return(false, nil)
+	repo.EXPECT().
+		Lock(ctx, domain.LoginScopeIP, ip, 50, gomock.Any()).
+		Return(false, nil)
+}
+
 func TestUserService_Register(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockUserRepo := mock.NewMockUserRepository(ctrl)
-	mockNotifRepo := mock.NewMockNotificationRepository(ctrl)
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	mockTxManager := mock.NewMockTransactionManager(ctrl)
+	expectTransactions(mockTxManager)
+	mockLoginAttemptRepo := mock.NewMockLoginAttemptRepository(ctrl)
+	mockTwoFactorRepo := mock.NewMockTwoFactorRepository(ctrl)
 	validate := validator.New()
 
+	loginGuard := service.NewLoginGuard(mockLoginAttemptRepo, testLoginGuardConfig)
+	twoFactorService := service.NewTwoFactorService(mockUserRepo, mockTwoFactorRepo, loginGuard, noInbox(t, ctrl), testTOTPEncryptionKey, "Futsal Booking API")
 	userService := service.NewUserService(
 		mockUserRepo,
+		mockRoleRepo,
+		loginGuard,
+		twoFactorService,
 		validate,
-		mockNotifRepo,
+		mockTxManager,
+		service.NewOutboxService(mockOutboxRepo, testOutboxConfig),
+		newTestEmailTemplates(t),
+		noInbox(t, ctrl),
 		"test-encryption-key-32-characters",
 		"http://localhost:8080",
 	)
@@ -47,19 +137,34 @@ func TestUserService_Register(t *testing.T) {
 			FindByEmail(ctx, email).
 			Return(domain.User{}, errors.New("user not found"))
 
+		mockRoleRepo.EXPECT().
+			FindByName(ctx, domain.RoleCustomer).
+			Return(domain.Role{ID: 2, RoleName: domain.RoleCustomer}, nil)
+
 		mockUserRepo.EXPECT().
 			Create(ctx, gomock.Any()).
 			DoAndReturn(func(ctx context.Context, user *domain.User) error {
+				assert.Equal(t, uint(2), user.Role.ID)
 				user.ID = 1
 				user.CreatedAt = time.Now()
 				return nil
 			})
 
-		mockNotifRepo.EXPECT().
-			SendEmail(gomock.Any(), email, gomock.Any(), gomock.Any()).
-			Return(nil)
+		mockOutboxRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, outboxMessage *domain.OutboxMessage) error {
+				assert.Equal(t, domain.OutboxStatusPending, outboxMessage.Status)
+				assert.Equal(t, testOutboxConfig.MaxAttempts, outboxMessage.MaxAttempts)
+
+				message := decodeOutboxEmail(t, outboxMessage)
+				assert.Equal(t, email, message.To[0].Email)
+				assert.Equal(t, "Activate Your Account", message.Subject)
+				assert.Contains(t, message.TextBody, "http://localhost:8080/users/email-verification/")
+				assert.Contains(t, message.HTMLBody, "Activate Account")
+				return nil
+			})
 
-		result, err := userService.Register(ctx, fullName, email, password, age, address)
+		result, err := userService.Register(ctx, fullName, email, password, age, address, domain.LanguageEnglish)
 
 		assert.NoError(t, err)
 		assert.Equal(t, uint(1), result.ID)
@@ -77,18 +182,27 @@ func TestUserService_Register(t *testing.T) {
 			FindByEmail(ctx, email).
 			Return(domain.User{ID: 1, Email: email}, nil)
 
-		result, err := userService.Register(ctx, "John Doe", email, "password123", 25, "Address")
+		result, err := userService.Register(ctx, "John Doe", email, "password123", 25, "Address", "")
 
 		assert.Error(t, err)
 		assert.Equal(t, "email already exists", err.Error())
 		assert.Equal(t, uint(0), result.ID)
 	})
 
+	t.Run("Fail - Unsupported language", func(t *testing.T) {
+		ctx := context.Background()
+
+		result, err := userService.Register(ctx, "John Doe", "john.doe@example.com", "password123", 25, "Address", "fr")
+
+		assert.Equal(t, domain.ErrUnsupportedLanguage, err)
+		assert.Equal(t, uint(0), result.ID)
+	})
+
 	t.Run("Fail - Invalid email format", func(t *testing.T) {
 		ctx := context.Background()
 		invalidEmail := "invalid-email"
 
-		result, err := userService.Register(ctx, "John Doe", invalidEmail, "password123", 25, "Address")
+		result, err := userService.Register(ctx, "John Doe", invalidEmail, "password123", 25, "Address", "")
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "invalid email format")
@@ -100,7 +214,7 @@ func TestUserService_Register(t *testing.T) {
 		email := "test@example.com"
 		shortPassword := "123"
 
-		result, err := userService.Register(ctx, "John Doe", email, shortPassword, 25, "Address")
+		result, err := userService.Register(ctx, "John Doe", email, shortPassword, 25, "Address", "")
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "password must be at least 6 characters")
@@ -111,13 +225,32 @@ func TestUserService_Register(t *testing.T) {
 		ctx := context.Background()
 		email := "test@example.com"
 
-		result, err := userService.Register(ctx, "John Doe", email, "password123", 10, "Address")
+		result, err := userService.Register(ctx, "John Doe", email, "password123", 10, "Address", "")
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "age must be at least 15 years old")
 		assert.Equal(t, uint(0), result.ID)
 	})
 
+	t.Run("Fail - Default role missing", func(t *testing.T) {
+		ctx := context.Background()
+		email := "norole@example.com"
+
+		mockUserRepo.EXPECT().
+			FindByEmail(ctx, email).
+			Return(domain.User{}, errors.New("user not found"))
+
+		mockRoleRepo.EXPECT().
+			FindByName(ctx, domain.RoleCustomer).
+			Return(domain.Role{}, domain.ErrRoleNotFound)
+
+		result, err := userService.Register(ctx, "John Doe", email, "password123", 25, "Address", "")
+
+		assert.Error(t, err)
+		assert.Equal(t, "failed to assign default role", err.Error())
+		assert.Equal(t, uint(0), result.ID)
+	})
+
 	t.Run("Fail - Database error on create", func(t *testing.T) {
 		ctx := context.Background()
 		email := "test@example.com"
@@ -126,16 +259,46 @@ func TestUserService_Register(t *testing.T) {
 			FindByEmail(ctx, email).
 			Return(domain.User{}, errors.New("user not found"))
 
+		mockRoleRepo.EXPECT().
+			FindByName(ctx, domain.RoleCustomer).
+			Return(domain.Role{ID: 2, RoleName: domain.RoleCustomer}, nil)
+
 		mockUserRepo.EXPECT().
 			Create(ctx, gomock.Any()).
 			Return(errors.New("database error"))
 
-		result, err := userService.Register(ctx, "John Doe", email, "password123", 25, "Address")
+		result, err := userService.Register(ctx, "John Doe", email, "password123", 25, "Address", "")
 
 		assert.Error(t, err)
 		assert.Equal(t, "database error", err.Error())
 		assert.Equal(t, uint(0), result.ID)
 	})
+
+	t.Run("Fail - Outbox write rolls back registration", func(t *testing.T) {
+		ctx := context.Background()
+		email := "outbox@example.com"
+
+		mockUserRepo.EXPECT().
+			FindByEmail(ctx, email).
+			Return(domain.User{}, errors.New("user not found"))
+
+		mockRoleRepo.EXPECT().
+			FindByName(ctx, domain.RoleCustomer).
+			Return(domain.Role{ID: 2, RoleName: domain.RoleCustomer}, nil)
+
+		mockUserRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			Return(nil)
+
+		mockOutboxRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			Return(errors.New("outbox unavailable"))
+
+		result, err := userService.Register(ctx, "John Doe", email, "password123", 25, "Address", "")
+
+		assert.EqualError(t, err, "outbox unavailable")
+		assert.Equal(t, uint(0), result.ID)
+	})
 }
 
 func TestUserService_Login(t *testing.T) {
@@ -143,19 +306,33 @@ func TestUserService_Login(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUserRepo := mock.NewMockUserRepository(ctrl)
-	mockNotifRepo := mock.NewMockNotificationRepository(ctrl)
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	mockTxManager := mock.NewMockTransactionManager(ctrl)
+	expectTransactions(mockTxManager)
+	mockLoginAttemptRepo := mock.NewMockLoginAttemptRepository(ctrl)
+	mockTwoFactorRepo := mock.NewMockTwoFactorRepository(ctrl)
 	validate := validator.New()
 
+	loginGuard := service.NewLoginGuard(mockLoginAttemptRepo, testLoginGuardConfig)
+	twoFactorService := service.NewTwoFactorService(mockUserRepo, mockTwoFactorRepo, loginGuard, noInbox(t, ctrl), testTOTPEncryptionKey, "Futsal Booking API")
 	userService := service.NewUserService(
 		mockUserRepo,
+		mockRoleRepo,
+		loginGuard,
+		twoFactorService,
 		validate,
-		mockNotifRepo,
+		mockTxManager,
+		service.NewOutboxService(mockOutboxRepo, testOutboxConfig),
+		newTestEmailTemplates(t),
+		noInbox(t, ctrl),
 		"test-encryption-key-32-characters",
 		"http://localhost:8080",
 	)
 
 	t.Run("Success - Login with valid credentials", func(t *testing.T) {
 		ctx := context.Background()
+		ip := "203.0.113.7"
 		email := "john.doe@example.com"
 		password := "password123"
 
@@ -173,37 +350,54 @@ func TestUserService_Login(t *testing.T) {
 			},
 		}
 
+		expectLoginAllowed(mockLoginAttemptRepo, ctx, email, ip)
+		expectLoginReleased(mockLoginAttemptRepo, ctx, email, ip)
+
 		mockUserRepo.EXPECT().
 			FindByEmail(ctx, email).
 			Return(user, nil)
 
-		token, result, err := userService.Login(ctx, email, password)
+		mockTwoFactorRepo.EXPECT().
+			FindByUserID(ctx, user.ID).
+			Return(domain.TwoFactor{}, domain.ErrTwoFactorNotFound)
+
+		mockLoginAttemptRepo.EXPECT().
+			Reset(ctx, domain.LoginScopeAccount, email).
+			Return(nil)
+
+		result, err := userService.Login(ctx, email, password, ip)
 
 		assert.NoError(t, err)
-		assert.NotEmpty(t, token)
-		assert.Equal(t, user.ID, result.ID)
-		assert.Equal(t, user.Email, result.Email)
-		assert.Equal(t, "", result.Password)
+		assert.NotEmpty(t, result.Token)
+		assert.Empty(t, result.ChallengeToken)
+		assert.Equal(t, user.ID, result.User.ID)
+		assert.Equal(t, user.Email, result.User.Email)
+		assert.Equal(t, "", result.User.Password)
 	})
 
 	t.Run("Fail - User not found", func(t *testing.T) {
 		ctx := context.Background()
+		ip := "203.0.113.7"
 		email := "nonexistent@example.com"
 		password := "password123"
 
+		expectLoginAllowed(mockLoginAttemptRepo, ctx, email, ip)
+
 		mockUserRepo.EXPECT().
 			FindByEmail(ctx, email).
 			Return(domain.User{}, errors.New("user not found"))
 
-		token, result, err := userService.Login(ctx, email, password)
+		expectLoginFailure(mockLoginAttemptRepo, ctx, email, ip)
 
-		assert.Error(t, err)
-		assert.Empty(t, token)
-		assert.Equal(t, uint(0), result.ID)
+		result, err := userService.Login(ctx, email, password, ip)
+
+		assert.Equal(t, domain.ErrInvalidCredentials, err)
+		assert.Nil(t, result)
 	})
 
 	t.Run("Fail - Incorrect password", func(t *testing.T) {
 		ctx := context.Background()
+		ip := "203.0.113.7"
 		email := "john.doe@example.com"
 		wrongPassword := "wrongpassword"
 
@@ -220,20 +414,23 @@ func TestUserService_Login(t *testing.T) {
 			},
 		}
 
+		expectLoginAllowed(mockLoginAttemptRepo, ctx, email, ip)
+
 		mockUserRepo.EXPECT().
 			FindByEmail(ctx, email).
 			Return(user, nil)
 
-		token, result, err := userService.Login(ctx, email, wrongPassword)
+		expectLoginFailure(mockLoginAttemptRepo, ctx, email, ip)
 
-		assert.Error(t, err)
-		assert.Equal(t, "incorrect password", err.Error())
-		assert.Empty(t, token)
-		assert.Equal(t, uint(0), result.ID)
+		result, err := userService.Login(ctx, email, wrongPassword, ip)
+
+		assert.Equal(t, domain.ErrInvalidCredentials, err)
+		assert.Nil(t, result)
 	})
 
 	t.Run("Fail - Email not verified", func(t *testing.T) {
 		ctx := context.Background()
+		ip := "203.0.113.7"
 		email := "john.doe@example.com"
 		password := "password123"
 
@@ -250,15 +447,152 @@ func TestUserService_Login(t *testing.T) {
 			},
 		}
 
+		expectLoginAllowed(mockLoginAttemptRepo, ctx, email, ip)
+		expectLoginReleased(mockLoginAttemptRepo, ctx, email, ip)
+
 		mockUserRepo.EXPECT().
 			FindByEmail(ctx, email).
 			Return(user, nil)
 
-		token, result, err := userService.Login(ctx, email, password)
+		result, err := userService.Login(ctx, email, password, ip)
 
 		assert.Error(t, err)
 		assert.Equal(t, "email address has not been verified", err.Error())
-		assert.Empty(t, token)
-		assert.Equal(t, uint(0), result.ID)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Fail - Account backing off", func(t *testing.T) {
+		ctx := context.Background()
+		email := "john.doe@example.com"
+		lastFailedAt := time.Now()
+
+		mockLoginAttemptRepo.EXPECT().
+			Reserve(ctx, domain.LoginScopeAccount, email, gomock.Any(), domain.LoginBackoff{
+				FreeAttempts: 3,
+				BaseDelay:    time.Second,
+				MaxDelay:     time.Minute,
+				Window:       time.Hour,
+			}).
+			Return(domain.LoginAttempt{FailedCount: 5, LastFailedAt: &lastFailedAt}, false, nil)
+
+		result, err := userService.Login(ctx, email, "password123", "203.0.113.7")
+
+		var throttleErr *domain.LoginThrottleError
+		assert.True(t, errors.As(err, &throttleErr))
+		assert.True(t, errors.Is(err, domain.ErrTooManyLoginAttempts))
+		assert.Greater(t, throttleErr.RetryAfter, time.Duration(0))
+		assert.LessOrEqual(t, throttleErr.RetryAfter, 4*time.Second)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Fail - Account locked", func(t *testing.T) {
+		ctx := context.Background()
+		email := "john.doe@example.com"
+		lockedUntil := time.Now().Add(10 * time.Minute)
+
+		mockLoginAttemptRepo.EXPECT().
+			Reserve(ctx, domain.LoginScopeAccount, email, gomock.Any(), gomock.Any()).
+			Return(domain.LoginAttempt{LockedUntil: &lockedUntil}, false, nil)
+
+		_, err := userService.Login(ctx, email, "password123", "203.0.113.7")
+
+		assert.True(t, errors.Is(err, domain.ErrAccountLocked))
+	})
+
+	t.Run("Fail - IP backing off gives the account attempt back", func(t *testing.T) {
+		ctx := context.Background()
+		ip := "203.0.113.7"
+		email := "john.doe@example.com"
+		lastFailedAt := time.Now()
+
+		mockLoginAttemptRepo.EXPECT().
+			Reserve(ctx, domain.LoginScopeAccount, email, gomock.Any(), gomock.Any()).
+			Return(domain.LoginAttempt{FailedCount: 1}, true, nil)
+
+		mockLoginAttemptRepo.EXPECT().
+			Reserve(ctx, domain.LoginScopeIP, ip, gomock.Any(), gomock.Any()).
+			Return(domain.LoginAttempt{FailedCount: 12, LastFailedAt: &lastFailedAt}, false, nil)
+
+		mockLoginAttemptRepo.EXPECT().
+			Release(ctx, domain.LoginScopeAccount, email).
+			Return(nil)
+
+		result, err := userService.Login(ctx, email, "password123", ip)
+
+		assert.True(t, errors.Is(err, domain.ErrTooManyLoginAttempts))
+		assert.Nil(t, result)
+	})
+
+	t.Run("Fail - Lockout sends notification", func(t *testing.T) {
+		ctx := context.Background()
+		ip := "203.0.113.7"
+		email := "john.doe@example.com"
+
+		user := domain.User{
+			ID:         1,
+			FullName:   "John Doe",
+			Email:      email,
+			Password:   "$2a$10$RZRAkKRSKe/DR8AaCo8N6e0pJW.eDwsOUMbHkrDoa1OWAkTQ9Y4Oy",
+			IsVerified: true,
+		}
+
+		expectLoginAllowed(mockLoginAttemptRepo, ctx, email, ip)
+
+		mockUserRepo.EXPECT().
+			FindByEmail(ctx, email).
+			Return(user, nil)
+
+		mockLoginAttemptRepo.EXPECT().
+			Lock(ctx, domain.LoginScopeAccount, email, 10, gomock.Any()).
+			Return(true, nil)
+
+		mockLoginAttemptRepo.EXPECT().
+			Lock(ctx, domain.LoginScopeIP, ip, 50, gomock.Any()).
+			Return(false, nil)
+
+		mockOutboxRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, outboxMessage *domain.OutboxMessage) error {
+				message := decodeOutboxEmail(t, outboxMessage)
+				assert.Equal(t, []domain.EmailAddress{{Name: user.FullName, Email: email}}, message.To)
+				assert.Equal(t, "Akun Anda Dikunci Sementara", message.Subject)
+				return nil
+			})
+
+		_, err := userService.Login(ctx, email, "wrongpassword", ip)
+
+		assert.Equal(t, domain.ErrInvalidCredentials, err)
+	})
+
+	t.Run("Success - Admin without 2FA must enrol", func(t *testing.T) {
+		ctx := context.Background()
+		ip := "203.0.113.7"
+		email := "admin@example.com"
+
+		admin := domain.User{
+			ID:         9,
+			Email:      email,
+			Password:   "$2a$10$RZRAkKRSKe/DR8AaCo8N6e0pJW.eDwsOUMbHkrDoa1OWAkTQ9Y4Oy",
+			IsVerified: true,
+			Role:       domain.Role{ID: 1, RoleName: domain.RoleAdmin},
+		}
+
+		expectLoginAllowed(mockLoginAttemptRepo, ctx, email, ip)
+		expectLoginReleased(mockLoginAttemptRepo, ctx, email, ip)
+
+		mockUserRepo.EXPECT().
+			FindByEmail(ctx, email).
+			Return(admin, nil)
+
+		mockTwoFactorRepo.EXPECT().
+			FindByUserID(ctx, admin.ID).
+			Return(domain.TwoFactor{}, domain.ErrTwoFactorNotFound)
+
+		result, err := userService.Login(ctx, email, "password123", ip)
+
+		assert.NoError(t, err)
+		assert.Empty(t, result.Token)
+		assert.NotEmpty(t, result.ChallengeToken)
+		assert.Equal(t, domain.ChallengeTwoFactorSetup, result.ChallengePurpose)
 	})
 }
@@ -0,0 +1,474 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository/mock"
+	"go-futsal-booking-api/internal/service"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVenueService_RemoveVenueMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVenueRepo := mock.NewMockVenueRepository(ctrl)
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockVenueMemberRepo := mock.NewMockVenueMemberRepository(ctrl)
+	mockAmenityRepo := mock.NewMockAmenityRepository(ctrl)
+
+	mockTxManager := mock.NewMockTransactionManager(ctrl)
+	expectTransactions(mockTxManager)
+
+	authorizer := service.NewAuthorizer(mockUserRepo, mockRoleRepo, mockVenueMemberRepo)
+	venueService := service.NewVenueService(mockVenueRepo, mockVenueMemberRepo, mockUserRepo, mockAmenityRepo, authorizer, nil, mockTxManager)
+
+	t.Run("Success - Owner removes staff", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockVenueMemberRepo.EXPECT().
+			FindByVenueAndUser(ctx, uint(1), uint(10)).
+			Return(domain.VenueMember{Role: domain.VenueRoleOwner}, nil)
+
+		mockVenueMemberRepo.EXPECT().
+			FindByVenueAndUser(ctx, uint(1), uint(20)).
+			Return(domain.VenueMember{Role: domain.VenueRoleStaff}, nil)
+
+		mockVenueMemberRepo.EXPECT().
+			Delete(ctx, uint(1), uint(20)).
+			Return(nil)
+
+		err := venueService.RemoveVenueMember(ctx, 1, 20, 10)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Success - Owner leaves while another owner remains", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockVenueMemberRepo.EXPECT().
+			FindByVenueAndUser(ctx, uint(1), uint(10)).
+			Return(domain.VenueMember{Role: domain.VenueRoleOwner}, nil).
+			Times(2)
+
+		mockVenueMemberRepo.EXPECT().
+			LockUserIDsByRole(ctx, uint(1), domain.VenueRoleOwner).
+			Return([]uint{10, 11}, nil)
+
+		mockVenueMemberRepo.EXPECT().
+			Delete(ctx, uint(1), uint(10)).
+			Return(nil)
+
+		err := venueService.RemoveVenueMember(ctx, 1, 10, 10)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail - Last owner", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockVenueMemberRepo.EXPECT().
+			FindByVenueAndUser(ctx, uint(1), uint(10)).
+			Return(domain.VenueMember{Role: domain.VenueRoleOwner}, nil).
+			Times(2)
+
+		mockVenueMemberRepo.EXPECT().
+			LockUserIDsByRole(ctx, uint(1), domain.VenueRoleOwner).
+			Return([]uint{10}, nil)
+
+		err := venueService.RemoveVenueMember(ctx, 1, 10, 10)
+
+		assert.Equal(t, domain.ErrLastVenueOwner, err)
+	})
+
+	t.Run("Fail - Manager cannot remove members", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockVenueMemberRepo.EXPECT().
+			FindByVenueAndUser(ctx, uint(1), uint(30)).
+			Return(domain.VenueMember{Role: domain.VenueRoleManager}, nil)
+
+		mockUserRepo.EXPECT().
+			FindByID(ctx, uint(30)).
+			Return(domain.User{ID: 30, Role: domain.Role{ID: 2, RoleName: domain.RoleCustomer}}, nil)
+
+		mockRoleRepo.EXPECT().
+			HasPermission(ctx, uint(2), domain.PermVenueManageAny).
+			Return(false, nil)
+
+		err := venueService.RemoveVenueMember(ctx, 1, 20, 30)
+
+		assert.Equal(t, domain.ErrForbidden, err)
+	})
+}
+
+func TestVenueService_SearchVenues(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVenueRepo := mock.NewMockVenueRepository(ctrl)
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockVenueMemberRepo := mock.NewMockVenueMemberRepository(ctrl)
+	mockAmenityRepo := mock.NewMockAmenityRepository(ctrl)
+
+	mockTxManager := mock.NewMockTransactionManager(ctrl)
+	expectTransactions(mockTxManager)
+
+	authorizer := service.NewAuthorizer(mockUserRepo, mockRoleRepo, mockVenueMemberRepo)
+	venueService := service.NewVenueService(mockVenueRepo, mockVenueMemberRepo, mockUserRepo, mockAmenityRepo, authorizer, nil, mockTxManager)
+
+	price := func(p float64) *float64 { return &p }
+	listings := []domain.VenueListing{
+		{Venue: domain.Venue{ID: 4, Name: "Arena"}, MinPrice: price(100000)},
+		{Venue: domain.Venue{ID: 2, Name: "Bola"}, MinPrice: price(120000)},
+		{Venue: domain.Venue{ID: 9, Name: "Champion"}, MinPrice: price(150000)},
+	}
+
+	t.Run("Success - Last page has no cursor", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockVenueRepo.EXPECT().
+			Search(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, filter domain.VenueFilter) ([]domain.VenueListing, int64, error) {
+				assert.Equal(t, domain.VenueSortName, filter.Sort)
+				assert.Equal(t, 51, filter.Limit)
+				return listings, 3, nil
+			})
+
+		page, err := venueService.SearchVenues(ctx, domain.VenueFilter{Limit: 50}, "")
+
+		require.NoError(t, err)
+		assert.Len(t, page.Venues, 3)
+		assert.Equal(t, int64(3), page.Total)
+		assert.False(t, page.HasMore)
+		assert.Empty(t, page.NextCursor)
+	})
+
+	t.Run("Success - Cursor continues after the last venue", func(t *testing.T) {
+		ctx := context.Background()
+		filter := domain.VenueFilter{City: "Jakarta", Sort: domain.VenueSortPrice, Limit: 2, Offset: 10}
+
+		mockVenueRepo.EXPECT().
+			Search(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, filter domain.VenueFilter) ([]domain.VenueListing, int64, error) {
+				assert.Nil(t, filter.After)
+				assert.Equal(t, 3, filter.Limit)
+				return listings, 3, nil
+			})
+
+		page, err := venueService.SearchVenues(ctx, filter, "")
+
+		require.NoError(t, err)
+		assert.Len(t, page.Venues, 2)
+		assert.True(t, page.HasMore)
+		require.NotEmpty(t, page.NextCursor)
+
+		mockVenueRepo.EXPECT().
+			Search(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, filter domain.VenueFilter) ([]domain.VenueListing, int64, error) {
+				require.NotNil(t, filter.After)
+				assert.Equal(t, uint(2), filter.After.ID)
+				assert.Equal(t, 120000.0, *filter.After.MinPrice)
+				assert.Equal(t, "Jakarta", filter.City)
+				assert.Zero(t, filter.Offset)
+				return listings[2:], 3, nil
+			})
+
+		next, err := venueService.SearchVenues(ctx, filter, page.NextCursor)
+
+		require.NoError(t, err)
+		assert.Len(t, next.Venues, 1)
+		assert.False(t, next.HasMore)
+	})
+
+	t.Run("Fail - Cursor from another sort", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockVenueRepo.EXPECT().
+			Search(ctx, gomock.Any()).
+			Return(listings, int64(3), nil)
+
+		page, err := venueService.SearchVenues(ctx, domain.VenueFilter{Sort: domain.VenueSortNewest, Limit: 1}, "")
+		require.NoError(t, err)
+
+		_, err = venueService.SearchVenues(ctx, domain.VenueFilter{Sort: domain.VenueSortName, Limit: 1}, page.NextCursor)
+
+		assert.Equal(t, domain.ErrInvalidCursor, err)
+	})
+
+	t.Run("Fail - Malformed cursor", func(t *testing.T) {
+		_, err := venueService.SearchVenues(context.Background(), domain.VenueFilter{Limit: 1}, "not-a-cursor")
+
+		assert.Equal(t, domain.ErrInvalidCursor, err)
+	})
+
+	t.Run("Fail - Unknown sort", func(t *testing.T) {
+		_, err := venueService.SearchVenues(context.Background(), domain.VenueFilter{Sort: "distance"}, "")
+
+		assert.Equal(t, domain.ErrInvalidVenueSort, err)
+	})
+
+	t.Run("Fail - Min price above max price", func(t *testing.T) {
+		_, err := venueService.SearchVenues(context.Background(), domain.VenueFilter{MinPrice: price(200000), MaxPrice: price(100000)}, "")
+
+		assert.Equal(t, domain.ErrInvalidPriceRange, err)
+	})
+}
+
+func TestVenueService_NearbyVenues(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVenueRepo := mock.NewMockVenueRepository(ctrl)
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockVenueMemberRepo := mock.NewMockVenueMemberRepository(ctrl)
+	mockAmenityRepo := mock.NewMockAmenityRepository(ctrl)
+
+	mockTxManager := mock.NewMockTransactionManager(ctrl)
+	expectTransactions(mockTxManager)
+
+	authorizer := service.NewAuthorizer(mockUserRepo, mockRoleRepo, mockVenueMemberRepo)
+	venueService := service.NewVenueService(mockVenueRepo, mockVenueMemberRepo, mockUserRepo, mockAmenityRepo, authorizer, nil, mockTxManager)
+
+	monas := domain.GeoPoint{Latitude: -6.1754, Longitude: 106.8272}
+
+	t.Run("Success - Venues come back nearest first", func(t *testing.T) {
+		ctx := context.Background()
+		nearby := []domain.NearbyVenue{
+			{Venue: domain.Venue{ID: 2}, DistanceKm: 1.2},
+			{Venue: domain.Venue{ID: 5}, DistanceKm: 4.8},
+		}
+
+		mockVenueRepo.EXPECT().
+			FindNearby(ctx, monas, 5.0, 50).
+			Return(nearby, nil)
+
+		venues, err := venueService.NearbyVenues(ctx, monas, 5, 50)
+
+		require.NoError(t, err)
+		assert.Equal(t, nearby, venues)
+	})
+
+	t.Run("Fail - Radius out of range", func(t *testing.T) {
+		for _, radius := range []float64{0, -1, 101} {
+			_, err := venueService.NearbyVenues(context.Background(), monas, radius, 50)
+
+			assert.Equal(t, domain.ErrInvalidRadius, err, radius)
+		}
+	})
+
+	t.Run("Fail - Point off the map", func(t *testing.T) {
+		_, err := venueService.NearbyVenues(context.Background(), domain.GeoPoint{Latitude: 91, Longitude: 106}, 5, 50)
+
+		assert.Equal(t, domain.ErrInvalidCoordinates, err)
+	})
+
+	t.Run("Fail - Create venue with invalid coordinates", func(t *testing.T) {
+		_, err := venueService.CreateVenue(context.Background(), service.VenueInput{
+			Name:        "Arena",
+			Address:     "Jl. Sudirman",
+			City:        "Jakarta",
+			Coordinates: &domain.GeoPoint{Latitude: -6.2, Longitude: 200},
+		}, 1)
+
+		assert.Equal(t, domain.ErrInvalidCoordinates, err)
+	})
+}
+
+func TestVenueService_VenueDetails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVenueRepo := mock.NewMockVenueRepository(ctrl)
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockVenueMemberRepo := mock.NewMockVenueMemberRepository(ctrl)
+	mockAmenityRepo := mock.NewMockAmenityRepository(ctrl)
+
+	mockTxManager := mock.NewMockTransactionManager(ctrl)
+	expectTransactions(mockTxManager)
+
+	authorizer := service.NewAuthorizer(mockUserRepo, mockRoleRepo, mockVenueMemberRepo)
+	venueService := service.NewVenueService(mockVenueRepo, mockVenueMemberRepo, mockUserRepo, mockAmenityRepo, authorizer, nil, mockTxManager)
+
+	at := func(hour int) time.Time {
+		return time.Date(0, 1, 1, hour, 0, 0, 0, time.UTC)
+	}
+	phone := "0812-3456-7890"
+	parking := domain.Amenity{Code: "PARKING", Name: "Parking"}
+	shower := domain.Amenity{Code: "SHOWER", Name: "Shower"}
+
+	t.Run("Success - Create venue with contacts, hours and amenities", func(t *testing.T) {
+		ctx := context.Background()
+		hours := []domain.OpeningHours{
+			{DayOfWeek: 1, OpensAt: at(8), ClosesAt: at(22)},
+			{DayOfWeek: 6, OpensAt: at(16), ClosesAt: at(2)},
+		}
+
+		mockAmenityRepo.EXPECT().
+			FindByCodes(ctx, []string{"PARKING", "SHOWER"}).
+			Return([]domain.Amenity{parking, shower}, nil)
+		mockVenueRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockVenueMemberRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		venue, err := venueService.CreateVenue(ctx, service.VenueInput{
+			Name:         "Arena",
+			Address:      "Jl. Sudirman",
+			City:         "Jakarta",
+			PhoneNumber:  &phone,
+			OpeningHours: hours,
+			Amenities:    []string{"parking", " SHOWER", "PARKING"},
+		}, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, "+6281234567890", venue.PhoneNumber)
+		assert.Empty(t, venue.WhatsAppNumber)
+		assert.Equal(t, hours, venue.OpeningHours)
+		assert.Equal(t, []domain.Amenity{parking, shower}, venue.Amenities)
+	})
+
+	t.Run("Fail - Venue is not kept without its owner", func(t *testing.T) {
+		ctx := context.Background()
+		failure := errors.New("connection reset")
+
+		var committed bool
+		tx := mock.NewMockTransactionManager(ctrl)
+		tx.EXPECT().
+			WithinTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				err := fn(ctx)
+				committed = err == nil
+				return err
+			})
+		txVenueService := service.NewVenueService(mockVenueRepo, mockVenueMemberRepo, mockUserRepo, mockAmenityRepo, authorizer, nil, tx)
+
+		mockVenueRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockVenueMemberRepo.EXPECT().Create(ctx, gomock.Any()).Return(failure)
+
+		venue, err := txVenueService.CreateVenue(ctx, service.VenueInput{
+			Name:    "Arena",
+			Address: "Jl. Sudirman",
+			City:    "Jakarta",
+		}, 1)
+
+		assert.Nil(t, venue)
+		assert.ErrorIs(t, err, failure)
+		assert.False(t, committed)
+	})
+
+	t.Run("Fail - Unknown amenity", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockAmenityRepo.EXPECT().
+			FindByCodes(ctx, []string{"PARKING", "SAUNA"}).
+			Return([]domain.Amenity{parking}, nil)
+
+		_, err := venueService.CreateVenue(ctx, service.VenueInput{
+			Name:      "Arena",
+			Address:   "Jl. Sudirman",
+			City:      "Jakarta",
+			Amenities: []string{"PARKING", "SAUNA"},
+		}, 1)
+
+		assert.Equal(t, domain.ErrAmenityNotFound, err)
+	})
+
+	t.Run("Fail - Day listed twice", func(t *testing.T) {
+		_, err := venueService.CreateVenue(context.Background(), service.VenueInput{
+			Name:    "Arena",
+			Address: "Jl. Sudirman",
+			City:    "Jakarta",
+			OpeningHours: []domain.OpeningHours{
+				{DayOfWeek: 1, OpensAt: at(8), ClosesAt: at(12)},
+				{DayOfWeek: 1, OpensAt: at(14), ClosesAt: at(22)},
+			},
+		}, 1)
+
+		assert.Equal(t, domain.ErrInvalidOpeningHours, err)
+	})
+
+	t.Run("Fail - Invalid phone number", func(t *testing.T) {
+		invalid := "12ab"
+
+		_, err := venueService.CreateVenue(context.Background(), service.VenueInput{
+			Name:           "Arena",
+			Address:        "Jl. Sudirman",
+			City:           "Jakarta",
+			WhatsAppNumber: &invalid,
+		}, 1)
+
+		assert.Equal(t, domain.ErrInvalidPhoneNumber, err)
+	})
+
+	t.Run("Success - Update keeps details that are not sent", func(t *testing.T) {
+		ctx := context.Background()
+		existing := domain.Venue{
+			ID:           1,
+			Name:         "Arena",
+			Timezone:     domain.DefaultVenueTimezone,
+			PhoneNumber:  "+6281234567890",
+			OpeningHours: []domain.OpeningHours{{DayOfWeek: 1, OpensAt: at(8), ClosesAt: at(22)}},
+			Amenities:    []domain.Amenity{parking},
+		}
+		cleared := ""
+
+		mockVenueRepo.EXPECT().FindByID(ctx, uint(1)).Return(existing, nil)
+		mockVenueMemberRepo.EXPECT().
+			FindByVenueAndUser(ctx, uint(1), uint(10)).
+			Return(domain.VenueMember{Role: domain.VenueRoleOwner}, nil)
+		mockVenueRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+
+		venue, err := venueService.UpdateVenue(ctx, 1, service.VenueInput{
+			Name:        "Arena Baru",
+			Address:     "Jl. Thamrin",
+			City:        "Jakarta",
+			PhoneNumber: &cleared,
+			Amenities:   []string{},
+		}, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Arena Baru", venue.Name)
+		assert.Empty(t, venue.PhoneNumber)
+		assert.Equal(t, existing.OpeningHours, venue.OpeningHours)
+		assert.Empty(t, venue.Amenities)
+	})
+}
+
+func TestGeoPoint(t *testing.T) {
+	monas := domain.GeoPoint{Latitude: -6.1754, Longitude: 106.8272}
+	gedungSate := domain.GeoPoint{Latitude: -6.9025, Longitude: 107.6187}
+
+	t.Run("Success - Haversine distance", func(t *testing.T) {
+		assert.InDelta(t, 119.1, monas.DistanceKm(gedungSate), 0.5)
+		assert.InDelta(t, 0, monas.DistanceKm(monas), 1e-9)
+	})
+
+	t.Run("Success - Bounding box holds the whole radius", func(t *testing.T) {
+		box := monas.BoundingBox(10)
+
+		for _, edge := range []domain.GeoPoint{
+			{Latitude: box.MinLatitude, Longitude: monas.Longitude},
+			{Latitude: box.MaxLatitude, Longitude: monas.Longitude},
+			{Latitude: monas.Latitude, Longitude: box.MinLongitude},
+			{Latitude: monas.Latitude, Longitude: box.MaxLongitude},
+		} {
+			assert.GreaterOrEqual(t, monas.DistanceKm(edge), 10-1e-6)
+		}
+	})
+
+	t.Run("Success - Box across the antimeridian spans all longitudes", func(t *testing.T) {
+		box := domain.GeoPoint{Latitude: -17.7, Longitude: 179.95}.BoundingBox(20)
+
+		assert.Equal(t, -180.0, box.MinLongitude)
+		assert.Equal(t, 180.0, box.MaxLongitude)
+	})
+}
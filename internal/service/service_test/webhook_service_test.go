@@ -0,0 +1,455 @@
+package service_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository/mock"
+	"go-futsal-booking-api/internal/service"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pobyzaarif/goshortcute"
+	"github.com/stretchr/testify/assert"
+)
+
+var testWebhookConfig = service.WebhookConfig{
+	EncryptionKey: testTOTPEncryptionKey,
+	Timeout:       5 * time.Second,
+	MaxAttempts:   3,
+	UserAgent:     "Futsal-Booking-Webhooks/1.0",
+}
+
+// noWebhooks returns a publisher with no subscriptions, for tests of services
+// that publish webhook events as a side effect.
+func noWebhooks(ctrl *gomock.Controller) service.WebhookPublisher {
+	webhookRepo := mock.NewMockWebhookRepository(ctrl)
+	webhookRepo.EXPECT().
+		FindActiveSubscriptions(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, nil).
+		AnyTimes()
+
+	return service.NewWebhookService(webhookRepo, nil, nil, nil, testWebhookConfig)
+}
+
+func encryptWebhookSecret(t *testing.T, secret string) string {
+	encrypted, err := goshortcute.AESCBCEncrypt([]byte(secret), []byte(testWebhookConfig.EncryptionKey))
+	if err != nil {
+		t.Fatalf("failed to encrypt webhook secret: %v", err)
+	}
+	return encrypted
+}
+
+func TestSignWebhookPayload(t *testing.T) {
+	body := []byte(`{"id":"evt_1","type":"booking.created"}`)
+	timestamp := time.Unix(1767225600, 0)
+
+	mac := hmac.New(sha256.New, []byte("whsec_test"))
+	mac.Write([]byte("1767225600." + string(body)))
+	expected := "t=1767225600,v1=" + hex.EncodeToString(mac.Sum(nil))
+
+	assert.Equal(t, expected, service.SignWebhookPayload("whsec_test", timestamp, body))
+	assert.NotEqual(t, expected, service.SignWebhookPayload("whsec_other", timestamp, body))
+}
+
+func TestWebhookService_Publish(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockWebhookRepo := mock.NewMockWebhookRepository(ctrl)
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	webhookService := service.NewWebhookService(mockWebhookRepo, service.NewOutboxService(mockOutboxRepo, testOutboxConfig), nil, nil, testWebhookConfig)
+
+	t.Run("Success - One delivery per subscription", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockWebhookRepo.EXPECT().
+			FindActiveSubscriptions(ctx, domain.WebhookEventBookingCreated, uint(3)).
+			Return([]domain.WebhookSubscription{{ID: 1}, {ID: 2}}, nil)
+
+		var eventIDs []string
+		nextID := uint(10)
+		mockWebhookRepo.EXPECT().
+			CreateDelivery(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, delivery *domain.WebhookDelivery) error {
+				var event map[string]any
+				assert.NoError(t, json.Unmarshal(delivery.Payload, &event))
+				assert.Equal(t, domain.WebhookEventBookingCreated, event["type"])
+				assert.Equal(t, map[string]any{"booking_id": float64(7)}, event["data"])
+				assert.Equal(t, domain.WebhookDeliveryPending, delivery.Status)
+
+				eventIDs = append(eventIDs, delivery.EventID)
+				delivery.ID = nextID
+				nextID++
+				return nil
+			}).
+			Times(2)
+		mockOutboxRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, message *domain.OutboxMessage) error {
+				assert.Equal(t, domain.OutboxTopicWebhook, message.Topic)
+				assert.Contains(t, []string{`{"delivery_id":10}`, `{"delivery_id":11}`}, string(message.Payload))
+				return nil
+			}).
+			Times(2)
+
+		err := webhookService.Publish(ctx, domain.WebhookEventBookingCreated, 3, map[string]any{"booking_id": 7})
+
+		assert.NoError(t, err)
+		assert.Len(t, eventIDs, 2)
+		assert.Equal(t, eventIDs[0], eventIDs[1])
+		assert.True(t, strings.HasPrefix(eventIDs[0], "evt_"))
+	})
+
+	t.Run("Success - Nothing queued without subscriptions", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockWebhookRepo.EXPECT().
+			FindActiveSubscriptions(ctx, domain.WebhookEventBookingCancelled, uint(3)).
+			Return(nil, nil)
+
+		err := webhookService.Publish(ctx, domain.WebhookEventBookingCancelled, 3, nil)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestWebhookService_CreateSubscription(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockWebhookRepo := mock.NewMockWebhookRepository(ctrl)
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockVenueMemberRepo := mock.NewMockVenueMemberRepository(ctrl)
+	authorizer := service.NewAuthorizer(mockUserRepo, mockRoleRepo, mockVenueMemberRepo)
+	webhookService := service.NewWebhookService(mockWebhookRepo, nil, authorizer, nil, testWebhookConfig)
+
+	admin := domain.User{ID: 1, Role: domain.Role{ID: 1, RoleName: "ADMIN"}}
+	subscription := domain.WebhookSubscription{
+		URL:        "https://partner.example.com/hooks",
+		EventTypes: []string{domain.WebhookEventBookingCreated, domain.WebhookEventPaymentSucceeded},
+		Active:     true,
+	}
+
+	t.Run("Success - Secret returned once and stored encrypted", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockUserRepo.EXPECT().FindByID(ctx, uint(1)).Return(admin, nil)
+		mockRoleRepo.EXPECT().HasPermission(ctx, uint(1), domain.PermWebhookManage).Return(true, nil)
+
+		var stored string
+		mockWebhookRepo.EXPECT().
+			CreateSubscription(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, s *domain.WebhookSubscription) error {
+				assert.Equal(t, uint(1), s.CreatedBy)
+				stored = s.Secret
+				s.ID = 5
+				return nil
+			})
+
+		created, err := webhookService.CreateSubscription(ctx, 1, subscription)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(5), created.ID)
+		assert.True(t, strings.HasPrefix(created.Secret, "whsec_"))
+		assert.NotEqual(t, created.Secret, stored)
+
+		decrypted, err := goshortcute.AESCBCDecrypt([]byte(stored), []byte(testWebhookConfig.EncryptionKey))
+		assert.NoError(t, err)
+		assert.Equal(t, created.Secret, decrypted)
+	})
+
+	t.Run("Fail - Invalid URL", func(t *testing.T) {
+		invalid := subscription
+		invalid.URL = "ftp://partner.example.com"
+
+		_, err := webhookService.CreateSubscription(context.Background(), 1, invalid)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidWebhookURL)
+	})
+
+	t.Run("Fail - Plain http URL", func(t *testing.T) {
+		invalid := subscription
+		invalid.URL = "http://partner.example.com/hooks"
+
+		_, err := webhookService.CreateSubscription(context.Background(), 1, invalid)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidWebhookURL)
+	})
+
+	t.Run("Fail - Internal address", func(t *testing.T) {
+		for _, target := range []string{"https://127.0.0.1/hooks", "https://169.254.169.254/latest/meta-data", "https://10.0.0.5/hooks", "https://[::1]/hooks", "https://localhost/hooks"} {
+			invalid := subscription
+			invalid.URL = target
+
+			_, err := webhookService.CreateSubscription(context.Background(), 1, invalid)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidWebhookURL, target)
+		}
+	})
+
+	t.Run("Fail - Unknown event type", func(t *testing.T) {
+		invalid := subscription
+		invalid.EventTypes = []string{"booking.deleted"}
+
+		_, err := webhookService.CreateSubscription(context.Background(), 1, invalid)
+
+		assert.ErrorIs(t, err, domain.ErrUnknownWebhookEvent)
+	})
+
+	t.Run("Fail - Global subscription without permission", func(t *testing.T) {
+		ctx := context.Background()
+		customer := domain.User{ID: 2, Role: domain.Role{ID: 2, RoleName: "CUSTOMER"}}
+
+		mockUserRepo.EXPECT().FindByID(ctx, uint(2)).Return(customer, nil)
+		mockRoleRepo.EXPECT().HasPermission(ctx, uint(2), domain.PermWebhookManage).Return(false, nil)
+
+		_, err := webhookService.CreateSubscription(ctx, 2, subscription)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestWebhookService_ReplayDelivery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockWebhookRepo := mock.NewMockWebhookRepository(ctrl)
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockVenueMemberRepo := mock.NewMockVenueMemberRepository(ctrl)
+	mockTxManager := mock.NewMockTransactionManager(ctrl)
+	expectTransactions(mockTxManager)
+
+	authorizer := service.NewAuthorizer(mockUserRepo, mockRoleRepo, mockVenueMemberRepo)
+	webhookService := service.NewWebhookService(mockWebhookRepo, service.NewOutboxService(mockOutboxRepo, testOutboxConfig), authorizer, mockTxManager, testWebhookConfig)
+
+	venueID := uint(3)
+	original := domain.WebhookDelivery{
+		ID:             20,
+		SubscriptionID: 5,
+		EventID:        "evt_abc",
+		EventType:      domain.WebhookEventBookingCancelled,
+		Payload:        json.RawMessage(`{"id":"evt_abc"}`),
+		Status:         domain.WebhookDeliveryFailed,
+		Attempts:       3,
+	}
+
+	t.Run("Success - Venue manager replays a failed delivery", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockWebhookRepo.EXPECT().FindDeliveryByID(ctx, uint(20)).Return(original, nil)
+		mockWebhookRepo.EXPECT().FindSubscriptionByID(ctx, uint(5)).Return(domain.WebhookSubscription{ID: 5, VenueID: &venueID}, nil)
+		mockVenueMemberRepo.EXPECT().
+			FindByVenueAndUser(ctx, venueID, uint(9)).
+			Return(domain.VenueMember{Role: domain.VenueRoleManager}, nil)
+		mockWebhookRepo.EXPECT().
+			CreateDelivery(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, delivery *domain.WebhookDelivery) error {
+				assert.Equal(t, "evt_abc", delivery.EventID)
+				assert.Equal(t, original.Payload, delivery.Payload)
+				assert.Equal(t, domain.WebhookDeliveryPending, delivery.Status)
+				assert.Equal(t, uint(20), *delivery.ReplayOf)
+				delivery.ID = 21
+				return nil
+			})
+		mockOutboxRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, message *domain.OutboxMessage) error {
+				assert.JSONEq(t, `{"delivery_id":21}`, string(message.Payload))
+				return nil
+			})
+
+		replay, err := webhookService.ReplayDelivery(ctx, 9, 20)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(21), replay.ID)
+	})
+
+	t.Run("Fail - Delivery not found", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockWebhookRepo.EXPECT().FindDeliveryByID(ctx, uint(99)).Return(domain.WebhookDelivery{}, domain.ErrDeliveryNotFound)
+
+		_, err := webhookService.ReplayDelivery(ctx, 9, 99)
+
+		assert.ErrorIs(t, err, domain.ErrDeliveryNotFound)
+	})
+}
+
+func TestWebhookOutboxHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// The test server listens on loopback over plain http.
+	localConfig := testWebhookConfig
+	localConfig.AllowInsecureTargets = true
+
+	mockWebhookRepo := mock.NewMockWebhookRepository(ctrl)
+	handler := service.NewWebhookOutboxHandler(mockWebhookRepo, localConfig)
+
+	payload := json.RawMessage(`{"id":"evt_abc","type":"booking.created","data":{"booking_id":7}}`)
+	message := json.RawMessage(`{"delivery_id":20}`)
+
+	var status int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, string(payload), string(body))
+		assert.Equal(t, "booking.created", r.Header.Get("X-Webhook-Event"))
+		assert.Equal(t, "evt_abc", r.Header.Get("X-Webhook-ID"))
+		assert.Equal(t, "20", r.Header.Get("X-Webhook-Delivery"))
+
+		signature := r.Header.Get("X-Webhook-Signature")
+		ts, err := strconv.ParseInt(strings.TrimPrefix(strings.Split(signature, ",")[0], "t="), 10, 64)
+		assert.NoError(t, err)
+		assert.Equal(t, service.SignWebhookPayload("whsec_test", time.Unix(ts, 0), body), r.Header.Get("X-Webhook-Signature"))
+
+		if status == http.StatusFound {
+			w.Header().Set("Location", "/moved")
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	subscription := domain.WebhookSubscription{ID: 5, URL: server.URL, Secret: encryptWebhookSecret(t, "whsec_test"), Active: true}
+	delivery := domain.WebhookDelivery{ID: 20, SubscriptionID: 5, EventID: "evt_abc", EventType: domain.WebhookEventBookingCreated, Payload: payload}
+
+	expectDelivery := func(ctx context.Context, attempts int) {
+		d := delivery
+		d.Attempts = attempts
+		mockWebhookRepo.EXPECT().FindDeliveryByID(ctx, uint(20)).Return(d, nil)
+		mockWebhookRepo.EXPECT().FindSubscriptionByID(ctx, uint(5)).Return(subscription, nil)
+	}
+
+	t.Run("Success - Signed and recorded", func(t *testing.T) {
+		ctx := context.Background()
+		status = http.StatusNoContent
+
+		expectDelivery(ctx, 0)
+		mockWebhookRepo.EXPECT().
+			RecordAttempt(ctx, uint(20), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id uint, attempt domain.WebhookAttempt) error {
+				assert.Equal(t, domain.WebhookDeliverySucceeded, attempt.Status)
+				assert.Equal(t, http.StatusNoContent, attempt.ResponseStatus)
+				return nil
+			})
+
+		assert.NoError(t, handler(ctx, message))
+	})
+
+	t.Run("Fail - Server error is retried", func(t *testing.T) {
+		ctx := context.Background()
+		status = http.StatusBadGateway
+
+		expectDelivery(ctx, 0)
+		mockWebhookRepo.EXPECT().
+			RecordAttempt(ctx, uint(20), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id uint, attempt domain.WebhookAttempt) error {
+				assert.Equal(t, domain.WebhookDeliveryPending, attempt.Status)
+				assert.Equal(t, http.StatusBadGateway, attempt.ResponseStatus)
+				return nil
+			})
+
+		err := handler(ctx, message)
+
+		assert.Error(t, err)
+		assert.True(t, domain.IsRetryableNotification(err))
+	})
+
+	t.Run("Fail - Last attempt marks the delivery failed", func(t *testing.T) {
+		ctx := context.Background()
+		status = http.StatusServiceUnavailable
+
+		expectDelivery(ctx, testWebhookConfig.MaxAttempts-1)
+		mockWebhookRepo.EXPECT().
+			RecordAttempt(ctx, uint(20), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id uint, attempt domain.WebhookAttempt) error {
+				assert.Equal(t, domain.WebhookDeliveryFailed, attempt.Status)
+				return nil
+			})
+
+		assert.Error(t, handler(ctx, message))
+	})
+
+	t.Run("Fail - Client error is not retried", func(t *testing.T) {
+		ctx := context.Background()
+		status = http.StatusGone
+
+		expectDelivery(ctx, 0)
+		mockWebhookRepo.EXPECT().
+			RecordAttempt(ctx, uint(20), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id uint, attempt domain.WebhookAttempt) error {
+				assert.Equal(t, domain.WebhookDeliveryFailed, attempt.Status)
+				return nil
+			})
+
+		err := handler(ctx, message)
+
+		var notifErr *domain.NotificationError
+		assert.True(t, errors.As(err, &notifErr))
+		assert.False(t, domain.IsRetryableNotification(err))
+	})
+
+	t.Run("Fail - Redirect is not followed", func(t *testing.T) {
+		ctx := context.Background()
+		status = http.StatusFound
+
+		expectDelivery(ctx, 0)
+		mockWebhookRepo.EXPECT().
+			RecordAttempt(ctx, uint(20), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id uint, attempt domain.WebhookAttempt) error {
+				assert.Equal(t, domain.WebhookDeliveryFailed, attempt.Status)
+				assert.Equal(t, http.StatusFound, attempt.ResponseStatus)
+				return nil
+			})
+
+		err := handler(ctx, message)
+
+		assert.Error(t, err)
+		assert.False(t, domain.IsRetryableNotification(err))
+	})
+
+	t.Run("Fail - Loopback target is refused outside development", func(t *testing.T) {
+		ctx := context.Background()
+		status = http.StatusNoContent
+		secureHandler := service.NewWebhookOutboxHandler(mockWebhookRepo, testWebhookConfig)
+
+		expectDelivery(ctx, 0)
+		mockWebhookRepo.EXPECT().
+			RecordAttempt(ctx, uint(20), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id uint, attempt domain.WebhookAttempt) error {
+				assert.Equal(t, domain.WebhookDeliveryFailed, attempt.Status)
+				assert.Zero(t, attempt.ResponseStatus)
+				return nil
+			})
+
+		err := secureHandler(ctx, message)
+
+		assert.Error(t, err)
+		assert.False(t, domain.IsRetryableNotification(err))
+	})
+
+	t.Run("Fail - Deleted subscription stops retries", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockWebhookRepo.EXPECT().FindDeliveryByID(ctx, uint(20)).Return(domain.WebhookDelivery{}, domain.ErrDeliveryNotFound)
+
+		err := handler(ctx, message)
+
+		assert.ErrorIs(t, err, domain.ErrDeliveryNotFound)
+		assert.False(t, domain.IsRetryableNotification(err))
+	})
+}
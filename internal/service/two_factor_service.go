@@ -0,0 +1,439 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+	"go-futsal-booking-api/pkg/utils"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pobyzaarif/goshortcute"
+)
+
+type TwoFactorService interface {
+	IsEnabled(ctx context.Context, userID uint) (bool, error)
+	IssueChallenge(user domain.User, purpose string) (string, error)
+	BeginSetup(ctx context.Context, userID uint) (*domain.TwoFactorSetup, error)
+	BeginSetupWithChallenge(ctx context.Context, challengeToken string) (*domain.TwoFactorSetup, error)
+	Enable(ctx context.Context, userID uint, code string) ([]string, error)
+	EnableWithChallenge(ctx context.Context, challengeToken, code string) (*LoginResult, []string, error)
+	Disable(ctx context.Context, userID uint, code string) error
+	RegenerateRecoveryCodes(ctx context.Context, userID uint, code string) ([]string, error)
+	CompleteLogin(ctx context.Context, challengeToken, code string) (*LoginResult, error)
+}
+
+const (
+	twoFactorLoginChallengeTTL = 5 * time.Minute
+	twoFactorSetupChallengeTTL = 15 * time.Minute
+	totpSkewSteps              = 1
+	recoveryCodeCount          = 10
+	recoveryCodeAlphabet       = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+)
+
+type twoFactorService struct {
+	userRepo      repository.UserRepository
+	twoFactorRepo repository.TwoFactorRepository
+	loginGuard    LoginGuard
+	inbox         InboxService
+	encryptionKey string
+	issuer        string
+}
+
+func NewTwoFactorService(
+	userRepo repository.UserRepository,
+	twoFactorRepo repository.TwoFactorRepository,
+	loginGuard LoginGuard,
+	inbox InboxService,
+	encryptionKey string,
+	issuer string,
+) TwoFactorService {
+	return &twoFactorService{
+		userRepo:      userRepo,
+		twoFactorRepo: twoFactorRepo,
+		loginGuard:    loginGuard,
+		inbox:         inbox,
+		encryptionKey: encryptionKey,
+		issuer:        issuer,
+	}
+}
+
+func (s *twoFactorService) IsEnabled(ctx context.Context, userID uint) (bool, error) {
+	twoFactor, err := s.twoFactorRepo.FindByUserID(ctx, userID)
+	if errors.Is(err, domain.ErrTwoFactorNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return twoFactor.Enabled, nil
+}
+
+func (s *twoFactorService) IssueChallenge(user domain.User, purpose string) (string, error) {
+	ttl := twoFactorLoginChallengeTTL
+	if purpose == domain.ChallengeTwoFactorSetup {
+		ttl = twoFactorSetupChallengeTTL
+	}
+
+	return utils.GenerateChallengeJWT(strconv.FormatUint(uint64(user.ID), 10), purpose, ttl)
+}
+
+func (s *twoFactorService) BeginSetup(ctx context.Context, userID uint) (*domain.TwoFactorSetup, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	return s.beginSetup(ctx, user)
+}
+
+func (s *twoFactorService) BeginSetupWithChallenge(ctx context.Context, challengeToken string) (*domain.TwoFactorSetup, error) {
+	user, err := s.userFromChallenge(ctx, challengeToken, domain.ChallengeTwoFactorSetup)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.beginSetup(ctx, user)
+}
+
+func (s *twoFactorService) beginSetup(ctx context.Context, user domain.User) (*domain.TwoFactorSetup, error) {
+	existing, err := s.twoFactorRepo.FindByUserID(ctx, user.ID)
+	if err == nil && existing.Enabled {
+		return nil, domain.ErrTwoFactorEnabled
+	}
+	if err != nil && !errors.Is(err, domain.ErrTwoFactorNotFound) {
+		logger.Error("failed to find two-factor settings", err)
+		return nil, err
+	}
+
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		logger.Error("failed to generate totp secret", err)
+		return nil, errors.New("failed to generate totp secret")
+	}
+
+	encrypted, err := goshortcute.AESCBCEncrypt([]byte(secret), []byte(s.encryptionKey))
+	if err != nil {
+		logger.Error("failed to encrypt totp secret", err)
+		return nil, errors.New("failed to generate totp secret")
+	}
+
+	// A pending secret is replaced until the user proves they can produce codes.
+	twoFactor := domain.TwoFactor{UserID: user.ID, Secret: encrypted}
+	if err := s.twoFactorRepo.Save(ctx, &twoFactor); err != nil {
+		logger.Error("failed to save two-factor settings", err)
+		return nil, err
+	}
+
+	return &domain.TwoFactorSetup{
+		Secret:     secret,
+		OTPAuthURI: utils.TOTPURI(s.issuer, user.Email, secret),
+	}, nil
+}
+
+func (s *twoFactorService) Enable(ctx context.Context, userID uint, code string) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	return s.enable(ctx, user, code)
+}
+
+func (s *twoFactorService) EnableWithChallenge(ctx context.Context, challengeToken, code string) (*LoginResult, []string, error) {
+	user, err := s.userFromChallenge(ctx, challengeToken, domain.ChallengeTwoFactorSetup)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	recoveryCodes, err := s.enable(ctx, user, code)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, err := s.finishLogin(ctx, user)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, recoveryCodes, nil
+}
+
+func (s *twoFactorService) enable(ctx context.Context, user domain.User, code string) ([]string, error) {
+	twoFactor, err := s.twoFactorRepo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if twoFactor.Enabled {
+		return nil, domain.ErrTwoFactorEnabled
+	}
+
+	if err := s.verifyCode(ctx, user, &twoFactor, code, false); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	twoFactor.Enabled = true
+	twoFactor.EnabledAt = &now
+	if err := s.twoFactorRepo.Save(ctx, &twoFactor); err != nil {
+		logger.Error("failed to enable two-factor", err)
+		return nil, err
+	}
+
+	recoveryCodes, err := s.replaceRecoveryCodes(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("two-factor authentication enabled", "user_id", user.ID)
+	s.notify(ctx, user, domain.InboxEventTwoFactorEnabled)
+
+	return recoveryCodes, nil
+}
+
+func (s *twoFactorService) Disable(ctx context.Context, userID uint, code string) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("context error: %w", err)
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return domain.ErrUserNotFound
+	}
+
+	if domain.RoleRequiresTwoFactor(user.Role.RoleName) {
+		return domain.ErrTwoFactorMandatory
+	}
+
+	twoFactor, err := s.enabledTwoFactor(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.verifyCode(ctx, user, &twoFactor, code, true); err != nil {
+		return err
+	}
+
+	if err := s.twoFactorRepo.Delete(ctx, userID); err != nil {
+		logger.Error("failed to disable two-factor", err)
+		return err
+	}
+
+	logger.Info("two-factor authentication disabled", "user_id", userID)
+	s.notify(ctx, user, domain.InboxEventTwoFactorDisabled)
+
+	return nil
+}
+
+// notify stores a security notice in the user's inbox. Two-factor changes have
+// already been saved by then, so a failure here is only logged.
+func (s *twoFactorService) notify(ctx context.Context, user domain.User, event string) {
+	if err := s.inbox.Notify(ctx, user, event, AccountInboxData{FullName: user.FullName}); err != nil {
+		logger.Warn("failed to store two-factor notification", "user_id", user.ID, "event", event, "error", err)
+	}
+}
+
+func (s *twoFactorService) RegenerateRecoveryCodes(ctx context.Context, userID uint, code string) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	twoFactor, err := s.enabledTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyCode(ctx, user, &twoFactor, code, false); err != nil {
+		return nil, err
+	}
+
+	return s.replaceRecoveryCodes(ctx, userID)
+}
+
+func (s *twoFactorService) CompleteLogin(ctx context.Context, challengeToken, code string) (*LoginResult, error) {
+	user, err := s.userFromChallenge(ctx, challengeToken, domain.ChallengeTwoFactorLogin)
+	if err != nil {
+		return nil, err
+	}
+
+	twoFactor, err := s.enabledTwoFactor(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyCode(ctx, user, &twoFactor, code, true); err != nil {
+		return nil, err
+	}
+
+	return s.finishLogin(ctx, user)
+}
+
+func (s *twoFactorService) finishLogin(ctx context.Context, user domain.User) (*LoginResult, error) {
+	if err := s.loginGuard.RecordSuccess(ctx, user.Email); err != nil {
+		logger.Warn("Failed to reset login attempts", err)
+	}
+
+	token, err := utils.GenerateJWT(strconv.FormatUint(uint64(user.ID), 10), user.Role.ID, user.Role.RoleName)
+	if err != nil {
+		logger.Error("Failed to generated token", err)
+		return nil, errors.New("failed to generate token")
+	}
+
+	user.Password = ""
+	return &LoginResult{Token: token, User: user}, nil
+}
+
+func (s *twoFactorService) userFromChallenge(ctx context.Context, challengeToken, purpose string) (domain.User, error) {
+	claims, err := utils.ParseChallengeJWT(challengeToken, purpose)
+	if err != nil {
+		return domain.User{}, domain.ErrInvalidChallenge
+	}
+
+	userID, err := strconv.ParseUint(claims.UserID, 10, 64)
+	if err != nil {
+		return domain.User{}, domain.ErrInvalidChallenge
+	}
+
+	user, err := s.userRepo.FindByID(ctx, uint(userID))
+	if err != nil {
+		return domain.User{}, domain.ErrInvalidChallenge
+	}
+
+	return user, nil
+}
+
+func (s *twoFactorService) enabledTwoFactor(ctx context.Context, userID uint) (domain.TwoFactor, error) {
+	twoFactor, err := s.twoFactorRepo.FindByUserID(ctx, userID)
+	if errors.Is(err, domain.ErrTwoFactorNotFound) {
+		return domain.TwoFactor{}, domain.ErrTwoFactorNotEnabled
+	}
+	if err != nil {
+		return domain.TwoFactor{}, err
+	}
+
+	if !twoFactor.Enabled {
+		return domain.TwoFactor{}, domain.ErrTwoFactorNotEnabled
+	}
+
+	return twoFactor, nil
+}
+
+// verifyCode accepts a current TOTP code, or an unused recovery code when
+// allowRecovery is set. Failures count towards the account's login throttle.
+func (s *twoFactorService) verifyCode(ctx context.Context, user domain.User, twoFactor *domain.TwoFactor, code string, allowRecovery bool) error {
+	if err := s.loginGuard.Check(ctx, user.Email, ""); err != nil {
+		return err
+	}
+
+	err := s.checkCode(ctx, twoFactor, code, allowRecovery)
+	if errors.Is(err, domain.ErrInvalidTwoFactorCode) {
+		if _, recordErr := s.loginGuard.RecordFailure(ctx, user.Email, ""); recordErr != nil {
+			logger.Error("Failed to record two-factor failure", recordErr)
+		}
+	} else if releaseErr := s.loginGuard.Release(ctx, user.Email, ""); releaseErr != nil {
+		logger.Warn("Failed to release two-factor attempt", releaseErr)
+	}
+
+	return err
+}
+
+func (s *twoFactorService) checkCode(ctx context.Context, twoFactor *domain.TwoFactor, code string, allowRecovery bool) error {
+	code = strings.TrimSpace(code)
+
+	if len(code) == utils.TOTPDigits {
+		secret, err := goshortcute.AESCBCDecrypt([]byte(twoFactor.Secret), []byte(s.encryptionKey))
+		if err != nil {
+			logger.Error("failed to decrypt totp secret", err)
+			return errors.New("failed to verify two-factor code")
+		}
+
+		step, ok := utils.ValidateTOTP(secret, code, time.Now(), totpSkewSteps)
+		if !ok {
+			return domain.ErrInvalidTwoFactorCode
+		}
+
+		// Refuse a code whose time step has already been used, so an intercepted
+		// code cannot be replayed within its validity window.
+		if err := s.twoFactorRepo.AdvanceStep(ctx, twoFactor.UserID, step); err != nil {
+			return err
+		}
+
+		// Keep the caller's copy in step so saving it cannot move the step back.
+		twoFactor.LastUsedStep = step
+		return nil
+	}
+
+	if !allowRecovery {
+		return domain.ErrInvalidTwoFactorCode
+	}
+
+	return s.twoFactorRepo.UseRecoveryCode(ctx, twoFactor.UserID, hashRecoveryCode(code))
+}
+
+func (s *twoFactorService) replaceRecoveryCodes(ctx context.Context, userID uint) ([]string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			logger.Error("failed to generate recovery code", err)
+			return nil, errors.New("failed to generate recovery codes")
+		}
+		codes[i] = code
+		hashes[i] = hashRecoveryCode(code)
+	}
+
+	if err := s.twoFactorRepo.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		logger.Error("failed to store recovery codes", err)
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// generateRecoveryCode returns a code such as "K7QM2-XW9RD" from an alphabet
+// without easily confused characters.
+func generateRecoveryCode() (string, error) {
+	buf := make([]byte, 10)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	code := make([]byte, len(buf))
+	for i, b := range buf {
+		code[i] = recoveryCodeAlphabet[int(b)%len(recoveryCodeAlphabet)]
+	}
+
+	return string(code[:5]) + "-" + string(code[5:]), nil
+}
+
+// hashRecoveryCode normalises a recovery code and hashes it. Codes carry 50 bits of
+// randomness, so a fast hash is enough and lets them be looked up directly.
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -11,6 +11,7 @@ import (
 	"log/slog"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-playground/validator/v10"
@@ -18,42 +19,84 @@ import (
 )
 
 type UserService interface {
-	Register(ctx context.Context, fullName, email, password string, age int, address string) (domain.User, error)
-	Login(ctx context.Context, email, password string) (string, domain.User, error)
+	Register(ctx context.Context, fullName, email, password string, age int, address, language string) (domain.User, error)
+	Login(ctx context.Context, email, password, ip string) (*LoginResult, error)
 	VerifyEmail(ctx context.Context, verificationCodeEncrypt string) (err error)
+	UnlockUser(ctx context.Context, userID uint) error
+	UpdateLanguage(ctx context.Context, userID uint, language string) (domain.User, error)
+	// IssueLogin finishes a login for a user whose first factor has already been
+	// verified, returning either an access token or a two-factor challenge.
+	IssueLogin(ctx context.Context, user domain.User) (*LoginResult, error)
+}
+
+// LoginResult carries either an access token or, when a second factor is still
+// needed, a challenge token whose purpose tells the client which step comes next.
+type LoginResult struct {
+	Token            string
+	User             domain.User
+	ChallengeToken   string
+	ChallengePurpose string
 }
 
 type userService struct {
 	userRepo                repository.UserRepository
+	roleRepo                repository.RoleRepository
+	loginGuard              LoginGuard
+	twoFactorService        TwoFactorService
 	validate                *validator.Validate
-	notifRepo               repository.NotificationRepository
+	txManager               repository.TransactionManager
+	outbox                  OutboxService
+	emailTemplates          EmailTemplateService
+	inbox                   InboxService
 	appEmailVerificationKey string
 	appDeploymentUrl        string
 }
 
-const (
-	verificationCodeTTL      = 5
-	SubjectRegisterAccount   = "Activate Your Account!"
-	EmailBodyRegisterAccount = `Halo, %v, aktivasi akun anda dengan membuka tautan dibawah</br></br>%v</br>catatan: link hanya berlaku %v menit`
+const verificationCodeTTL = 5
+
+var (
+	dummyPasswordHashOnce sync.Once
+	dummyPasswordHash     string
 )
 
+// compareDummyPassword spends the same bcrypt time as a real check so that unknown
+// emails cannot be told apart from wrong passwords by response time.
+func compareDummyPassword(password string) {
+	dummyPasswordHashOnce.Do(func() {
+		dummyPasswordHash, _ = utils.HashPassword("dummy-password-for-timing")
+	})
+	utils.CheckPassword(password, dummyPasswordHash)
+}
+
 func NewUserService(
 	userRepo repository.UserRepository,
+	roleRepo repository.RoleRepository,
+	loginGuard LoginGuard,
+	twoFactorService TwoFactorService,
 	validate *validator.Validate,
-	notifRepo repository.NotificationRepository,
+	txManager repository.TransactionManager,
+	outbox OutboxService,
+	emailTemplates EmailTemplateService,
+	inbox InboxService,
 	appEmailVerificationKey string,
 	appDeploymentUrl string,
 ) UserService {
 	return &userService{
 		userRepo:                userRepo,
+		roleRepo:                roleRepo,
+		loginGuard:              loginGuard,
+		twoFactorService:        twoFactorService,
 		validate:                validate,
-		notifRepo:               notifRepo,
+		txManager:               txManager,
+		outbox:                  outbox,
+		emailTemplates:          emailTemplates,
+		inbox:                   inbox,
 		appEmailVerificationKey: appEmailVerificationKey,
 		appDeploymentUrl:        appDeploymentUrl,
 	}
 }
 
-func (s *userService) Register(ctx context.Context, fullName, email, password string, age int, address string) (domain.User, error) {
+func (s *userService) Register(ctx context.Context, fullName, email, password string, age int, address, language string) (domain.User, error) {
 	if err := s.validate.Var(email, "required,email"); err != nil {
 		logger.Error("Invalid email format", err)
 		return domain.User{}, errors.New("invalid email format")
@@ -69,12 +112,24 @@ func (s *userService) Register(ctx context.Context, fullName, email, password st
 		return domain.User{}, errors.New("age must be at least 15 years old")
 	}
 
+	if language == "" {
+		language = domain.DefaultLanguage
+	} else if !domain.IsSupportedLanguage(language) {
+		return domain.User{}, domain.ErrUnsupportedLanguage
+	}
+
 	_, err := s.userRepo.FindByEmail(ctx, email)
 	if err == nil {
 		logger.Error("Email already exists", err)
 		return domain.User{}, errors.New("email already exists")
 	}
 
+	customerRole, err := s.roleRepo.FindByName(ctx, domain.RoleCustomer)
+	if err != nil {
+		logger.Error("Failed to find default customer role", err)
+		return domain.User{}, errors.New("failed to assign default role")
+	}
+
 	passwordHash, err := utils.HashPassword(password)
 	if err != nil {
 		logger.Error("Failed to hash password", err)
@@ -87,15 +142,9 @@ func (s *userService) Register(ctx context.Context, fullName, email, password st
 		Password:   string(passwordHash),
 		Age:        age,
 		Address:    address,
+		Language:   language,
 		IsVerified: false,
-		Role: domain.Role{
-			ID: 2,
-		},
-	}
-
-	if err := s.userRepo.Create(ctx, &newUser); err != nil {
-		logger.Error("Failed to create new user")
-		return domain.User{}, err
+		Role:       customerRole,
 	}
 
 	timeNow := time.Now()
@@ -105,42 +154,137 @@ func (s *userService) Register(ctx context.Context, fullName, email, password st
 	verificationCodeEncrypt, _ := goshortcute.AESCBCEncrypt([]byte(verificationCode), []byte(s.appEmailVerificationKey))
 	activationLink := s.appDeploymentUrl + "/users/email-verification/" + verificationCodeEncrypt
 
-	err = s.notifRepo.SendEmail(newUser.FullName, newUser.Email, SubjectRegisterAccount, fmt.Sprintf(EmailBodyRegisterAccount, newUser.FullName, activationLink, verificationCodeTTL))
+	// The activation email is queued in the same transaction, so a user is never
+	// created without one and a failed send is retried by the outbox dispatcher.
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.userRepo.Create(ctx, &newUser); err != nil {
+			logger.Error("Failed to create new user")
+			return err
+		}
+
+		return s.queueTemplatedEmail(ctx, newUser, domain.EmailTemplateAccountActivation, AccountActivationEmailData{
+			FullName:         newUser.FullName,
+			ActivationLink:   activationLink,
+			ExpiresInMinutes: verificationCodeTTL,
+		})
+	})
 	if err != nil {
-		logger.Warn("Failed to send verification email", err)
+		return domain.User{}, err
 	}
 
 	newUser.Password = ""
 	return newUser, nil
 }
 
-func (s *userService) Login(ctx context.Context, email, password string) (string, domain.User, error) {
+func (s *userService) Login(ctx context.Context, email, password, ip string) (*LoginResult, error) {
+	if err := s.loginGuard.Check(ctx, email, ip); err != nil {
+		logger.Warn("Login throttled", "email", email, "ip", ip, "error", err)
+		return nil, err
+	}
+
 	user, err := s.userRepo.FindByEmail(ctx, email)
 	if err != nil {
 		logger.Error("Invalid user credentials", err)
-		return "", domain.User{}, err
+		compareDummyPassword(password)
+		s.recordLoginFailure(ctx, nil, email, ip)
+		return nil, domain.ErrInvalidCredentials
 	}
 
 	ok := utils.CheckPassword(password, user.Password)
 	if !ok {
-		logger.Error("User password incorrect", err)
-		return "", domain.User{}, errors.New("incorrect password")
+		logger.Error("User password incorrect", "user_id", user.ID)
+		s.recordLoginFailure(ctx, &user, email, ip)
+		return nil, domain.ErrInvalidCredentials
+	}
+
+	if err := s.loginGuard.Release(ctx, email, ip); err != nil {
+		logger.Warn("Failed to release login attempt", err)
 	}
 
 	if !user.IsVerified {
 		logger.Error("Email address has not been verified", err)
-		return "", domain.User{}, errors.New("email address has not been verified")
+		return nil, errors.New("email address has not been verified")
+	}
+
+	return s.IssueLogin(ctx, user)
+}
+
+func (s *userService) IssueLogin(ctx context.Context, user domain.User) (*LoginResult, error) {
+	twoFactorEnabled, err := s.twoFactorService.IsEnabled(ctx, user.ID)
+	if err != nil {
+		logger.Error("Failed to check two-factor status", err)
+		return nil, errors.New("failed to check two-factor status")
+	}
+
+	// The account counter is only reset once every factor has been verified.
+	if twoFactorEnabled || domain.RoleRequiresTwoFactor(user.Role.RoleName) {
+		purpose := domain.ChallengeTwoFactorLogin
+		if !twoFactorEnabled {
+			purpose = domain.ChallengeTwoFactorSetup
+		}
+
+		challenge, err := s.twoFactorService.IssueChallenge(user, purpose)
+		if err != nil {
+			logger.Error("Failed to issue two-factor challenge", err)
+			return nil, errors.New("failed to generate token")
+		}
+
+		return &LoginResult{ChallengeToken: challenge, ChallengePurpose: purpose}, nil
+	}
+
+	if err := s.loginGuard.RecordSuccess(ctx, user.Email); err != nil {
+		logger.Warn("Failed to reset login attempts", err)
 	}
 
 	userIdStr := strconv.FormatUint(uint64(user.ID), 10)
-	token, err := utils.GenerateJWT(userIdStr, user.Role.RoleName)
+	token, err := utils.GenerateJWT(userIdStr, user.Role.ID, user.Role.RoleName)
 	if err != nil {
 		logger.Error("Failed to generated token", err)
-		return "", domain.User{}, errors.New("failed to generate token")
+		return nil, errors.New("failed to generate token")
 	}
 
 	user.Password = ""
-	return token, user, nil
+	return &LoginResult{Token: token, User: user}, nil
+}
+
+// recordLoginFailure counts a failed login and emails the account holder when it
+// causes a lockout. user is nil when the email does not belong to any account.
+func (s *userService) recordLoginFailure(ctx context.Context, user *domain.User, email, ip string) {
+	lockedUntil, err := s.loginGuard.RecordFailure(ctx, email, ip)
+	if err != nil {
+		logger.Error("Failed to record login failure", err)
+		return
+	}
+
+	if lockedUntil == nil || user == nil {
+		return
+	}
+
+	data := AccountLockedEmailData{
+		FullName:    user.FullName,
+		LockedUntil: *lockedUntil,
+	}
+
+	if err := s.queueTemplatedEmail(ctx, *user, domain.EmailTemplateAccountLocked, data); err != nil {
+		logger.Warn("Failed to queue account locked email", err)
+	}
+
+	if err := s.inbox.Notify(ctx, *user, domain.EmailTemplateAccountLocked, data); err != nil {
+		logger.Warn("Failed to store account locked notification", err)
+	}
+}
+
+// queueTemplatedEmail renders a template in the user's language and queues it
+// for delivery to them.
+func (s *userService) queueTemplatedEmail(ctx context.Context, user domain.User, template string, data any) error {
+	email, err := s.emailTemplates.Render(template, user.Language, data)
+	if err != nil {
+		return err
+	}
+
+	email.To = []domain.EmailAddress{{Name: user.FullName, Email: user.Email}}
+
+	return s.outbox.EnqueueEmail(ctx, email)
 }
 
 func (s *userService) VerifyEmail(ctx context.Context, verificationCodeEncrypt string) error {
@@ -187,5 +331,55 @@ func (s *userService) VerifyEmail(ctx context.Context, verificationCodeEncrypt s
 		return err
 	}
 
+	if err := s.inbox.Notify(ctx, getUser, domain.InboxEventAccountActivated, AccountInboxData{FullName: getUser.FullName}); err != nil {
+		logger.Warn("Failed to store account activated notification", err)
+	}
+
+	return nil
+}
+
+func (s *userService) UnlockUser(ctx context.Context, userID uint) error {
+	if userID == 0 {
+		return errors.New("invalid user id")
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		logger.Error("Failed to find user to unlock", err)
+		return domain.ErrUserNotFound
+	}
+
+	if err := s.loginGuard.Unlock(ctx, user.Email); err != nil {
+		logger.Error("Failed to unlock user", err)
+		return err
+	}
+
+	logger.Info("user login unlocked", "user_id", userID)
+
 	return nil
 }
+
+func (s *userService) UpdateLanguage(ctx context.Context, userID uint, language string) (domain.User, error) {
+	if !domain.IsSupportedLanguage(language) {
+		return domain.User{}, domain.ErrUnsupportedLanguage
+	}
+
+	if err := ctx.Err(); err != nil {
+		return domain.User{}, fmt.Errorf("context error: %w", err)
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		logger.Error("Failed to find user to update language", err)
+		return domain.User{}, domain.ErrUserNotFound
+	}
+
+	user.Language = language
+	if err := s.userRepo.Update(ctx, &user); err != nil {
+		logger.Error("Failed to update user language", err)
+		return domain.User{}, err
+	}
+
+	user.Password = ""
+	return user, nil
+}
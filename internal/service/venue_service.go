@@ -2,28 +2,79 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"go-futsal-booking-api/internal/domain"
 	"go-futsal-booking-api/internal/repository"
 	"go-futsal-booking-api/pkg/logger"
+	"strings"
+	"time"
 )
 
 type VenueService interface {
 	GetVenueByID(ctx context.Context, id uint) (*domain.Venue, error)
-	GetAllVenues(ctx context.Context) ([]domain.Venue, error)
-	CreateVenue(ctx context.Context, name, address, city string) (*domain.Venue, error)
-	UpdateVenue(ctx context.Context, id uint, name, address, city string) (*domain.Venue, error)
-	DeleteVenue(ctx context.Context, id uint) error
+	// SearchVenues returns one page of venues. A non-empty cursor, from the
+	// NextCursor of an earlier page with the same sort, continues after that
+	// page instead of using filter.Offset.
+	SearchVenues(ctx context.Context, filter domain.VenueFilter, cursor string) (domain.VenuePage, error)
+	// NearbyVenues returns up to limit venues within radiusKm of center,
+	// nearest first.
+	NearbyVenues(ctx context.Context, center domain.GeoPoint, radiusKm float64, limit int) ([]domain.NearbyVenue, error)
+	// CreateVenue uses DefaultVenueTimezone when input.Timezone is empty.
+	CreateVenue(ctx context.Context, input VenueInput, userID uint) (*domain.Venue, error)
+	UpdateVenue(ctx context.Context, id uint, input VenueInput, userID uint) (*domain.Venue, error)
+	// GetVenueDeletionPreview counts what DeleteVenue would affect. Requires
+	// OWNER membership of the venue.
+	GetVenueDeletionPreview(ctx context.Context, id uint, userID uint) (*domain.DeletionImpact, error)
+	// DeleteVenue soft-deletes the venue with its fields and schedules. Upcoming
+	// bookings block it unless force is set, which cancels them.
+	DeleteVenue(ctx context.Context, id uint, userID uint, force bool) (*domain.DeletionImpact, error)
+	// RestoreVenue brings back a venue deleted within domain.RestoreWindow,
+	// with the fields and schedules deleted along with it.
+	RestoreVenue(ctx context.Context, id uint) (*domain.Venue, error)
+	GetVenueMembers(ctx context.Context, venueID uint, userID uint) ([]domain.VenueMember, error)
+	AddVenueMember(ctx context.Context, venueID, memberUserID uint, role string, userID uint) (*domain.VenueMember, error)
+	UpdateVenueMember(ctx context.Context, venueID, memberUserID uint, role string, userID uint) (*domain.VenueMember, error)
+	RemoveVenueMember(ctx context.Context, venueID, memberUserID uint, userID uint) error
+}
+
+// VenueInput is what a venue manager can set. Name, Address and City are
+// required. On update an empty Timezone and nil fields keep the current value;
+// an empty contact number or an empty, non-nil slice clears it.
+type VenueInput struct {
+	Name           string
+	Address        string
+	City           string
+	Timezone       string
+	Coordinates    *domain.GeoPoint
+	PhoneNumber    *string
+	WhatsAppNumber *string
+	OpeningHours   []domain.OpeningHours
+	// Amenities are amenity codes.
+	Amenities []string
 }
 
 type venueService struct {
-	venueRepo repository.VenueRepository
+	venueRepo   repository.VenueRepository
+	memberRepo  repository.VenueMemberRepository
+	userRepo    repository.UserRepository
+	amenityRepo repository.AmenityRepository
+	authorizer  Authorizer
+	guard       DeletionGuard
+	txManager   repository.TransactionManager
 }
 
-func NewVenueService(repo repository.VenueRepository) VenueService {
+func NewVenueService(repo repository.VenueRepository, memberRepo repository.VenueMemberRepository, userRepo repository.UserRepository, amenityRepo repository.AmenityRepository, authorizer Authorizer, guard DeletionGuard, txManager repository.TransactionManager) VenueService {
 	return &venueService{
-		venueRepo: repo,
+		venueRepo:   repo,
+		memberRepo:  memberRepo,
+		userRepo:    userRepo,
+		amenityRepo: amenityRepo,
+		authorizer:  authorizer,
+		guard:       guard,
+		txManager:   txManager,
 	}
 }
 
@@ -47,41 +98,152 @@ func (s *venueService) GetVenueByID(ctx context.Context, id uint) (*domain.Venue
 	return &venue, nil
 }
 
-func (s *venueService) GetAllVenues(ctx context.Context) ([]domain.Venue, error) {
+func (s *venueService) SearchVenues(ctx context.Context, filter domain.VenueFilter, cursor string) (domain.VenuePage, error) {
+	if err := ctx.Err(); err != nil {
+		logger.Error("context error when search venues")
+		return domain.VenuePage{}, fmt.Errorf("context error: %w", err)
+	}
+
+	if filter.Sort == "" {
+		filter.Sort = domain.VenueSortName
+	}
+	if !domain.IsVenueSort(filter.Sort) {
+		return domain.VenuePage{}, domain.ErrInvalidVenueSort
+	}
+
+	if filter.MinPrice != nil && filter.MaxPrice != nil && *filter.MinPrice > *filter.MaxPrice {
+		return domain.VenuePage{}, domain.ErrInvalidPriceRange
+	}
+
+	if cursor != "" {
+		after, err := decodeVenueCursor(cursor)
+		if err != nil || after.Sort != filter.Sort {
+			return domain.VenuePage{}, domain.ErrInvalidCursor
+		}
+		filter.After = &after
+		filter.Offset = 0
+	}
+
+	if filter.Limit <= 0 {
+		filter.Limit = 50
+	}
+
+	// One extra row tells whether there is another page.
+	limit := filter.Limit
+	filter.Limit++
+
+	venues, total, err := s.venueRepo.Search(ctx, filter)
+	if err != nil {
+		logger.Error("failed to search venues", err)
+		return domain.VenuePage{}, err
+	}
+
+	page := domain.VenuePage{Venues: venues, Total: total}
+	if len(venues) > limit {
+		page.Venues = venues[:limit]
+		page.HasMore = true
+		page.NextCursor = encodeVenueCursor(page.Venues[limit-1].CursorAfter(filter.Sort))
+	}
+
+	return page, nil
+}
+
+// Cursors are opaque to clients but not secret, so plain base64 JSON is enough.
+func encodeVenueCursor(cursor domain.VenueCursor) string {
+	b, _ := json.Marshal(cursor)
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeVenueCursor(cursor string) (domain.VenueCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return domain.VenueCursor{}, err
+	}
+
+	var decoded domain.VenueCursor
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		return domain.VenueCursor{}, err
+	}
+	if decoded.ID == 0 {
+		return domain.VenueCursor{}, domain.ErrInvalidCursor
+	}
+
+	return decoded, nil
+}
+
+func (s *venueService) NearbyVenues(ctx context.Context, center domain.GeoPoint, radiusKm float64, limit int) ([]domain.NearbyVenue, error) {
 	if err := ctx.Err(); err != nil {
-		logger.Error("context error when get all venues")
 		return nil, fmt.Errorf("context error: %w", err)
 	}
 
-	venues, err := s.venueRepo.FindAll(ctx)
+	if !center.Valid() {
+		return nil, domain.ErrInvalidCoordinates
+	}
+	if !(radiusKm > 0 && radiusKm <= domain.MaxNearbyRadiusKm) {
+		return nil, domain.ErrInvalidRadius
+	}
+
+	venues, err := s.venueRepo.FindNearby(ctx, center, radiusKm, limit)
 	if err != nil {
-		logger.Error("Failed to find all venue", err)
+		logger.Error("failed to find nearby venues", err)
 		return nil, err
 	}
 
 	return venues, nil
 }
 
-func (s *venueService) CreateVenue(ctx context.Context, name, address, city string) (*domain.Venue, error) {
-	if name == "" || address == "" || city == "" {
+func (s *venueService) CreateVenue(ctx context.Context, input VenueInput, userID uint) (*domain.Venue, error) {
+	if input.Name == "" || input.Address == "" || input.City == "" {
 		logger.Error("Invalid venue data")
 		return nil, errors.New("invalid venue data")
 	}
 
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = domain.DefaultVenueTimezone
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return nil, domain.ErrInvalidTimezone
+	}
+
 	if err := ctx.Err(); err != nil {
 		logger.Error("context error when create venue")
 		return nil, fmt.Errorf("context error: %w", err)
 	}
 
 	newVenue := &domain.Venue{
-		Name:    name,
-		Address: address,
-		City:    city,
+		Name:     input.Name,
+		Address:  input.Address,
+		City:     input.City,
+		Timezone: timezone,
 	}
+	if err := s.applyDetails(ctx, newVenue, input); err != nil {
+		return nil, err
+	}
+
+	// The creator owns the venue so they can manage it and invite their staff.
+	// Both are written together so a venue is never left without an owner.
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.venueRepo.Create(ctx, newVenue); err != nil {
+			logger.Error("failed to create new venue", err)
+			return fmt.Errorf("failed to create venue: %w", err)
+		}
+
+		owner := &domain.VenueMember{
+			Venue: *newVenue,
+			User:  domain.User{ID: userID},
+			Role:  domain.VenueRoleOwner,
+		}
+		if err := s.memberRepo.Create(ctx, owner); err != nil {
+			logger.Error("failed to register venue owner", err)
+			return fmt.Errorf("failed to register venue owner: %w", err)
+		}
 
-	if err := s.venueRepo.Create(ctx, newVenue); err != nil {
-		logger.Error("failed to create new venue", err)
-		return nil, fmt.Errorf("failed to create venue: %w", err)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	logger.Info("venue created successfully")
@@ -89,12 +251,18 @@ func (s *venueService) CreateVenue(ctx context.Context, name, address, city stri
 	return newVenue, nil
 }
 
-func (s *venueService) UpdateVenue(ctx context.Context, id uint, name, address, city string) (*domain.Venue, error) {
-	if id == 0 || name == "" || address == "" || city == "" {
+func (s *venueService) UpdateVenue(ctx context.Context, id uint, input VenueInput, userID uint) (*domain.Venue, error) {
+	if id == 0 || input.Name == "" || input.Address == "" || input.City == "" {
 		logger.Error("Invalid venue data")
 		return nil, errors.New("invalid venue data")
 	}
 
+	if input.Timezone != "" {
+		if _, err := time.LoadLocation(input.Timezone); err != nil {
+			return nil, domain.ErrInvalidTimezone
+		}
+	}
+
 	if err := ctx.Err(); err != nil {
 		logger.Error("context error when updating venue")
 		return nil, fmt.Errorf("context error: %w", err)
@@ -103,12 +271,22 @@ func (s *venueService) UpdateVenue(ctx context.Context, id uint, name, address,
 	venueUpdate, err := s.venueRepo.FindByID(ctx, id)
 	if err != nil {
 		logger.Error("venue not found", err)
-		return nil, errors.New("venue not found")
+		return nil, domain.ErrVenueNotFound
 	}
 
-	venueUpdate.Name = name
-	venueUpdate.Address = address
-	venueUpdate.City = city
+	if err := s.authorizer.AuthorizeVenue(ctx, userID, id, domain.VenueRoleManager); err != nil {
+		return nil, err
+	}
+
+	venueUpdate.Name = input.Name
+	venueUpdate.Address = input.Address
+	venueUpdate.City = input.City
+	if input.Timezone != "" {
+		venueUpdate.Timezone = input.Timezone
+	}
+	if err := s.applyDetails(ctx, &venueUpdate, input); err != nil {
+		return nil, err
+	}
 
 	if err := s.venueRepo.Update(ctx, &venueUpdate); err != nil {
 		logger.Error("failed to update venue", err)
@@ -120,29 +298,329 @@ func (s *venueService) UpdateVenue(ctx context.Context, id uint, name, address,
 	return &venueUpdate, nil
 }
 
-func (s *venueService) DeleteVenue(ctx context.Context, id uint) error {
+// applyDetails validates the optional parts of input and sets those that are
+// not nil on venue.
+func (s *venueService) applyDetails(ctx context.Context, venue *domain.Venue, input VenueInput) error {
+	if input.Coordinates != nil {
+		if !input.Coordinates.Valid() {
+			return domain.ErrInvalidCoordinates
+		}
+		venue.Coordinates = input.Coordinates
+	}
+
+	for _, contact := range []struct {
+		value *string
+		field *string
+	}{
+		{input.PhoneNumber, &venue.PhoneNumber},
+		{input.WhatsAppNumber, &venue.WhatsAppNumber},
+	} {
+		if contact.value == nil {
+			continue
+		}
+
+		*contact.field = ""
+		if *contact.value != "" {
+			number, err := domain.NormalizePhoneNumber(*contact.value)
+			if err != nil {
+				return err
+			}
+			*contact.field = number
+		}
+	}
+
+	if input.OpeningHours != nil {
+		if err := domain.ValidateOpeningHours(input.OpeningHours); err != nil {
+			return err
+		}
+		venue.OpeningHours = input.OpeningHours
+	}
+
+	if input.Amenities != nil {
+		codes := make([]string, 0, len(input.Amenities))
+		seen := make(map[string]bool, len(input.Amenities))
+		for _, code := range input.Amenities {
+			code = strings.ToUpper(strings.TrimSpace(code))
+			if !seen[code] {
+				seen[code] = true
+				codes = append(codes, code)
+			}
+		}
+
+		venue.Amenities = []domain.Amenity{}
+		if len(codes) > 0 {
+			amenities, err := s.amenityRepo.FindByCodes(ctx, codes)
+			if err != nil {
+				logger.Error("failed to find amenities", err)
+				return err
+			}
+			if len(amenities) != len(codes) {
+				return domain.ErrAmenityNotFound
+			}
+			venue.Amenities = amenities
+		}
+	}
+
+	return nil
+}
+
+func (s *venueService) GetVenueDeletionPreview(ctx context.Context, id uint, userID uint) (*domain.DeletionImpact, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	if _, err := s.venueRepo.FindByID(ctx, id); err != nil {
+		return nil, domain.ErrVenueNotFound
+	}
+
+	if err := s.authorizer.AuthorizeVenue(ctx, userID, id, domain.VenueRoleOwner); err != nil {
+		return nil, err
+	}
+
+	impact, err := s.venueRepo.DeletionImpact(ctx, id)
+	if err != nil {
+		logger.Error("failed to count venue deletion impact", err, "venue_id", id)
+		return nil, err
+	}
+
+	impact, err = s.guard.Preview(ctx, domain.DeletionScope{VenueID: id}, impact)
+	if err != nil {
+		logger.Error("failed to find upcoming venue bookings", err, "venue_id", id)
+		return nil, err
+	}
+
+	return &impact, nil
+}
+
+func (s *venueService) DeleteVenue(ctx context.Context, id uint, userID uint, force bool) (*domain.DeletionImpact, error) {
 	if id == 0 {
 		logger.Error("Invalid venue id when deleting venue")
-		return errors.New("invalid venue id")
+		return nil, errors.New("invalid venue id")
 	}
 
 	if err := ctx.Err(); err != nil {
 		logger.Error("context error when deleting venue")
-		return fmt.Errorf("context error: %w", err)
+		return nil, fmt.Errorf("context error: %w", err)
 	}
 
 	_, err := s.venueRepo.FindByID(ctx, id)
 	if err != nil {
 		logger.Error("venue not found", err)
-		return errors.New("venue not found")
+		return nil, domain.ErrVenueNotFound
+	}
+
+	if err := s.authorizer.AuthorizeVenue(ctx, userID, id, domain.VenueRoleOwner); err != nil {
+		return nil, err
 	}
 
-	if err := s.venueRepo.Delete(ctx, id); err != nil {
+	impact, err := s.venueRepo.DeletionImpact(ctx, id)
+	if err != nil {
+		logger.Error("failed to count venue deletion impact", err, "venue_id", id)
+		return nil, err
+	}
+
+	impact, err = s.guard.Delete(ctx, domain.DeletionScope{VenueID: id}, impact, force, "The venue has closed", func(ctx context.Context, at time.Time) error {
+		return s.venueRepo.Delete(ctx, id, at)
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrHasFutureBookings) {
+			return &impact, err
+		}
 		logger.Error("failed to delete venue", err)
-		return fmt.Errorf("failed to delete venue: %w", err)
+		return nil, fmt.Errorf("failed to delete venue: %w", err)
+	}
+
+	logger.Info("venue deleted success", "venue_id", id, "fields", impact.Fields, "schedules", impact.Schedules, "cancelled_bookings", impact.FutureBookings)
+
+	return &impact, nil
+}
+
+func (s *venueService) RestoreVenue(ctx context.Context, id uint) (*domain.Venue, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
 	}
 
-	logger.Info("venue deleted success")
+	venue, err := s.venueRepo.FindDeletedByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.guard.CheckRestorable(venue.DeletedAt); err != nil {
+		return nil, err
+	}
+
+	if err := s.venueRepo.Restore(ctx, id); err != nil {
+		if !errors.Is(err, domain.ErrNotDeleted) {
+			logger.Error("failed to restore venue", err, "venue_id", id)
+		}
+		return nil, err
+	}
+
+	logger.Info("venue restored", "venue_id", id)
+
+	return s.GetVenueByID(ctx, id)
+}
+
+func (s *venueService) GetVenueMembers(ctx context.Context, venueID uint, userID uint) ([]domain.VenueMember, error) {
+	if venueID == 0 {
+		return nil, errors.New("invalid venue id")
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	if _, err := s.venueRepo.FindByID(ctx, venueID); err != nil {
+		logger.Error("venue not found", err)
+		return nil, domain.ErrVenueNotFound
+	}
+
+	if err := s.authorizer.AuthorizeVenue(ctx, userID, venueID, domain.VenueRoleManager); err != nil {
+		return nil, err
+	}
+
+	return s.memberRepo.FindByVenueID(ctx, venueID)
+}
+
+func (s *venueService) AddVenueMember(ctx context.Context, venueID, memberUserID uint, role string, userID uint) (*domain.VenueMember, error) {
+	if venueID == 0 || memberUserID == 0 {
+		return nil, errors.New("invalid venue member data")
+	}
+
+	if !domain.IsValidVenueRole(role) {
+		return nil, domain.ErrInvalidVenueRole
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	venue, err := s.venueRepo.FindByID(ctx, venueID)
+	if err != nil {
+		logger.Error("venue not found", err)
+		return nil, domain.ErrVenueNotFound
+	}
+
+	if err := s.authorizer.AuthorizeVenue(ctx, userID, venueID, domain.VenueRoleOwner); err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.FindByID(ctx, memberUserID)
+	if err != nil {
+		logger.Error("user not found when adding venue member", err)
+		return nil, domain.ErrUserNotFound
+	}
+
+	if _, err := s.memberRepo.FindByVenueAndUser(ctx, venueID, memberUserID); err == nil {
+		return nil, domain.ErrVenueMemberExists
+	} else if !errors.Is(err, domain.ErrVenueMemberNotFound) {
+		return nil, err
+	}
+
+	member := &domain.VenueMember{
+		Venue: venue,
+		User:  user,
+		Role:  role,
+	}
+	if err := s.memberRepo.Create(ctx, member); err != nil {
+		logger.Error("failed to add venue member", err)
+		return nil, err
+	}
+
+	logger.Info("venue member added", "venue_id", venueID, "user_id", memberUserID, "role", role)
+
+	return member, nil
+}
+
+func (s *venueService) UpdateVenueMember(ctx context.Context, venueID, memberUserID uint, role string, userID uint) (*domain.VenueMember, error) {
+	if venueID == 0 || memberUserID == 0 {
+		return nil, errors.New("invalid venue member data")
+	}
+
+	if !domain.IsValidVenueRole(role) {
+		return nil, domain.ErrInvalidVenueRole
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	if err := s.authorizer.AuthorizeVenue(ctx, userID, venueID, domain.VenueRoleOwner); err != nil {
+		return nil, err
+	}
+
+	member, err := s.memberRepo.FindByVenueAndUser(ctx, venueID, memberUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if member.Role == domain.VenueRoleOwner && role != domain.VenueRoleOwner {
+			if err := s.ensureAnotherOwner(ctx, venueID); err != nil {
+				return err
+			}
+		}
+
+		return s.memberRepo.UpdateRole(ctx, venueID, memberUserID, role)
+	})
+	if err != nil {
+		logger.Error("failed to update venue member", err)
+		return nil, err
+	}
+
+	member.Role = role
+
+	return &member, nil
+}
+
+func (s *venueService) RemoveVenueMember(ctx context.Context, venueID, memberUserID uint, userID uint) error {
+	if venueID == 0 || memberUserID == 0 {
+		return errors.New("invalid venue member data")
+	}
+
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("context error: %w", err)
+	}
+
+	if err := s.authorizer.AuthorizeVenue(ctx, userID, venueID, domain.VenueRoleOwner); err != nil {
+		return err
+	}
+
+	member, err := s.memberRepo.FindByVenueAndUser(ctx, venueID, memberUserID)
+	if err != nil {
+		return err
+	}
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if member.Role == domain.VenueRoleOwner {
+			if err := s.ensureAnotherOwner(ctx, venueID); err != nil {
+				return err
+			}
+		}
+
+		return s.memberRepo.Delete(ctx, venueID, memberUserID)
+	})
+	if err != nil {
+		logger.Error("failed to remove venue member", err)
+		return err
+	}
+
+	logger.Info("venue member removed", "venue_id", venueID, "user_id", memberUserID)
+
+	return nil
+}
+
+// ensureAnotherOwner must run inside a transaction: it locks the venue's owner
+// rows so a concurrent demotion or removal cannot leave the venue ownerless.
+func (s *venueService) ensureAnotherOwner(ctx context.Context, venueID uint) error {
+	owners, err := s.memberRepo.LockUserIDsByRole(ctx, venueID, domain.VenueRoleOwner)
+	if err != nil {
+		return err
+	}
+
+	if len(owners) <= 1 {
+		return domain.ErrLastVenueOwner
+	}
 
 	return nil
 }
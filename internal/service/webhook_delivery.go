@@ -0,0 +1,209 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/pobyzaarif/goshortcute"
+)
+
+const (
+	WebhookHeaderSignature = "X-Webhook-Signature"
+	WebhookHeaderEvent     = "X-Webhook-Event"
+	WebhookHeaderEventID   = "X-Webhook-ID"
+	WebhookHeaderDelivery  = "X-Webhook-Delivery"
+)
+
+// SignWebhookPayload returns the X-Webhook-Signature value for body sent at
+// timestamp: "t=<unix seconds>,v1=<hex HMAC-SHA256 of "<unix seconds>.<body>">".
+// Receivers recompute v1 with their secret and reject old timestamps to stop
+// replays by third parties.
+func SignWebhookPayload(secret string, timestamp time.Time, body []byte) string {
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewWebhookOutboxHandler delivers domain.OutboxTopicWebhook messages and
+// records every attempt in the delivery log. Network errors, 429 and 5xx
+// responses are retried by the dispatcher; other responses fail the delivery.
+func NewWebhookOutboxHandler(webhookRepo repository.WebhookRepository, config WebhookConfig) OutboxHandler {
+	client := newWebhookClient(config)
+
+	return func(ctx context.Context, payload json.RawMessage) error {
+		var message webhookDeliveryMessage
+		if err := json.Unmarshal(payload, &message); err != nil {
+			return &domain.NotificationError{Provider: "outbox", Err: fmt.Errorf("invalid webhook payload: %w", err)}
+		}
+
+		delivery, err := webhookRepo.FindDeliveryByID(ctx, message.DeliveryID)
+		if err != nil {
+			return webhookLookupError(err)
+		}
+
+		subscription, err := webhookRepo.FindSubscriptionByID(ctx, delivery.SubscriptionID)
+		if err != nil {
+			return webhookLookupError(err)
+		}
+
+		if !subscription.Active {
+			recordWebhookAttempt(ctx, webhookRepo, delivery.ID, domain.WebhookAttempt{
+				Status: domain.WebhookDeliveryFailed,
+				Error:  "subscription is disabled",
+				At:     time.Now(),
+			})
+			return nil
+		}
+
+		secret, err := goshortcute.AESCBCDecrypt([]byte(subscription.Secret), []byte(config.EncryptionKey))
+		if err != nil {
+			return &domain.NotificationError{Provider: "webhook", Err: fmt.Errorf("failed to decrypt webhook secret: %w", err)}
+		}
+
+		attempt, sendErr := sendWebhook(ctx, client, config, subscription, delivery, secret)
+		if sendErr != nil && domain.IsRetryableNotification(sendErr) && delivery.Attempts+1 < config.MaxAttempts {
+			attempt.Status = domain.WebhookDeliveryPending
+		}
+		recordWebhookAttempt(ctx, webhookRepo, delivery.ID, attempt)
+
+		return sendErr
+	}
+}
+
+// newWebhookClient returns a client that only connects to public addresses.
+// The address is checked when dialling, after DNS resolution, so a hostname
+// that resolves to an internal address is refused as well.
+func newWebhookClient(config WebhookConfig) *http.Client {
+	dialer := &net.Dialer{Timeout: config.Timeout}
+	if !config.AllowInsecureTargets {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if !isPublicWebhookIP(net.ParseIP(host)) {
+				return fmt.Errorf("%w: %s", errWebhookTargetForbidden, host)
+			}
+			return nil
+		}
+	}
+
+	return &http.Client{
+		Timeout: config.Timeout,
+		Transport: &http.Transport{
+			// A proxy would connect to the subscriber in our place, past the
+			// address check, so none is used.
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			ForceAttemptHTTP2:   true,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+			TLSHandshakeTimeout: 10 * time.Second,
+		},
+		// A redirect could point anywhere, so it is treated as the response.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+var errWebhookTargetForbidden = errors.New("webhook target is not a public address")
+
+// sharedAddressSpace is the carrier-grade NAT range, which some clouds use for
+// their metadata services.
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+func isPublicWebhookIP(ip net.IP) bool {
+	return ip != nil &&
+		!ip.IsUnspecified() &&
+		!ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsMulticast() &&
+		!sharedAddressSpace.Contains(ip)
+}
+
+func sendWebhook(ctx context.Context, client *http.Client, config WebhookConfig, subscription domain.WebhookSubscription, delivery domain.WebhookDelivery, secret string) (domain.WebhookAttempt, error) {
+	start := time.Now()
+	attempt := domain.WebhookAttempt{Status: domain.WebhookDeliveryFailed}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		attempt.Error = err.Error()
+		attempt.At = start
+		return attempt, &domain.NotificationError{Provider: "webhook", Err: err}
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", config.UserAgent)
+	req.Header.Set(WebhookHeaderEvent, delivery.EventType)
+	req.Header.Set(WebhookHeaderEventID, delivery.EventID)
+	req.Header.Set(WebhookHeaderDelivery, strconv.FormatUint(uint64(delivery.ID), 10))
+	req.Header.Set(WebhookHeaderSignature, SignWebhookPayload(secret, start, delivery.Payload))
+
+	res, err := client.Do(req)
+	attempt.At = time.Now()
+	attempt.DurationMs = attempt.At.Sub(start).Milliseconds()
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt, &domain.NotificationError{
+			Provider:  "webhook",
+			Retryable: !errors.Is(err, context.Canceled) && !errors.Is(err, errWebhookTargetForbidden),
+			Err:       err,
+		}
+	}
+	defer res.Body.Close()
+
+	// The body is not kept: it may be anything the target chose to return.
+	// Reading a little of it lets the connection be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 4096))
+	attempt.ResponseStatus = res.StatusCode
+
+	if res.StatusCode >= 200 && res.StatusCode <= 299 {
+		attempt.Status = domain.WebhookDeliverySucceeded
+		return attempt, nil
+	}
+
+	attempt.Error = fmt.Sprintf("subscriber returned %d", res.StatusCode)
+	return attempt, &domain.NotificationError{
+		Provider:  "webhook",
+		Retryable: res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= 500,
+		Err:       fmt.Errorf("webhook endpoint return negative response %v", res.StatusCode),
+	}
+}
+
+func recordWebhookAttempt(ctx context.Context, webhookRepo repository.WebhookRepository, deliveryID uint, attempt domain.WebhookAttempt) {
+	if err := webhookRepo.RecordAttempt(ctx, deliveryID, attempt); err != nil {
+		logger.Error("failed to record webhook attempt", err, "delivery_id", deliveryID)
+	}
+}
+
+// webhookLookupError stops retries once the delivery or its subscription is
+// gone, which happens when a subscription is deleted with deliveries queued.
+func webhookLookupError(err error) error {
+	if errors.Is(err, domain.ErrDeliveryNotFound) || errors.Is(err, domain.ErrWebhookNotFound) {
+		return &domain.NotificationError{Provider: "webhook", Err: err}
+	}
+
+	return err
+}
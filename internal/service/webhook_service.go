@@ -0,0 +1,418 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-futsal-booking-api/internal/domain"
+	"go-futsal-booking-api/internal/repository"
+	"go-futsal-booking-api/pkg/logger"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pobyzaarif/goshortcute"
+)
+
+type WebhookConfig struct {
+	// EncryptionKey encrypts subscription secrets at rest.
+	EncryptionKey string
+	// Timeout bounds a single POST to a subscriber.
+	Timeout time.Duration
+	// MaxAttempts matches the outbox setting; the delivery is marked FAILED on
+	// the last attempt.
+	MaxAttempts int
+	UserAgent   string
+	// AllowInsecureTargets lets subscribers use plain http and loopback,
+	// private and link-local addresses. It is only meant for development.
+	AllowInsecureTargets bool
+}
+
+// WebhookPublisher turns domain events into webhook deliveries. Publish writes
+// to the outbox, so calling it inside a transaction makes the deliveries part
+// of that transaction.
+type WebhookPublisher interface {
+	Publish(ctx context.Context, eventType string, venueID uint, data any) error
+}
+
+// WebhookService manages webhook subscriptions and their delivery log.
+// Subscriptions scoped to a venue are managed by its managers; global ones
+// need the webhook:manage permission.
+type WebhookService interface {
+	WebhookPublisher
+	// CreateSubscription returns the subscription with its secret in plain text.
+	// The secret is not returned again.
+	CreateSubscription(ctx context.Context, userID uint, subscription domain.WebhookSubscription) (*domain.WebhookSubscription, error)
+	GetSubscriptions(ctx context.Context, userID uint, venueID *uint) ([]domain.WebhookSubscription, error)
+	GetSubscription(ctx context.Context, userID, id uint) (*domain.WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, userID, id uint, changes domain.WebhookSubscription) (*domain.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, userID, id uint) error
+	// RotateSecret replaces the secret and returns the new one in plain text.
+	RotateSecret(ctx context.Context, userID, id uint) (*domain.WebhookSubscription, error)
+	GetDeliveries(ctx context.Context, userID, subscriptionID uint, filter domain.WebhookDeliveryFilter) ([]domain.WebhookDelivery, int64, error)
+	// ReplayDelivery sends the payload of a past delivery again as a new delivery.
+	ReplayDelivery(ctx context.Context, userID, deliveryID uint) (*domain.WebhookDelivery, error)
+}
+
+type webhookService struct {
+	webhookRepo repository.WebhookRepository
+	outbox      OutboxService
+	authorizer  Authorizer
+	txManager   repository.TransactionManager
+	config      WebhookConfig
+	now         func() time.Time
+}
+
+func NewWebhookService(webhookRepo repository.WebhookRepository, outbox OutboxService, authorizer Authorizer, txManager repository.TransactionManager, config WebhookConfig) WebhookService {
+	return &webhookService{
+		webhookRepo: webhookRepo,
+		outbox:      outbox,
+		authorizer:  authorizer,
+		txManager:   txManager,
+		config:      config,
+		now:         time.Now,
+	}
+}
+
+type webhookDeliveryMessage struct {
+	DeliveryID uint `json:"delivery_id"`
+}
+
+func (s *webhookService) Publish(ctx context.Context, eventType string, venueID uint, data any) error {
+	subscriptions, err := s.webhookRepo.FindActiveSubscriptions(ctx, eventType, venueID)
+	if err != nil {
+		logger.Error("failed to find webhook subscriptions", err, "event_type", eventType)
+		return err
+	}
+
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	eventID, err := generateWebhookToken("evt_", 12)
+	if err != nil {
+		return fmt.Errorf("failed to generate webhook event id: %w", err)
+	}
+
+	payload, err := json.Marshal(domain.WebhookEvent{
+		ID:        eventID,
+		Type:      eventType,
+		CreatedAt: s.now().UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook event: %w", err)
+	}
+
+	for _, subscription := range subscriptions {
+		delivery := &domain.WebhookDelivery{
+			SubscriptionID: subscription.ID,
+			EventID:        eventID,
+			EventType:      eventType,
+			Payload:        payload,
+			Status:         domain.WebhookDeliveryPending,
+		}
+		if err := s.enqueue(ctx, delivery); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *webhookService) CreateSubscription(ctx context.Context, userID uint, subscription domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	if err := validateWebhookSubscription(subscription, s.config.AllowInsecureTargets); err != nil {
+		return nil, err
+	}
+
+	if err := s.authorize(ctx, userID, subscription.VenueID); err != nil {
+		return nil, err
+	}
+
+	secret, encrypted, err := s.newSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	subscription.Secret = encrypted
+	subscription.CreatedBy = userID
+	if err := s.webhookRepo.CreateSubscription(ctx, &subscription); err != nil {
+		logger.Error("failed to create webhook subscription", err)
+		return nil, err
+	}
+
+	logger.Info("webhook subscription created", "subscription_id", subscription.ID, "user_id", userID)
+
+	subscription.Secret = secret
+	return &subscription, nil
+}
+
+func (s *webhookService) GetSubscriptions(ctx context.Context, userID uint, venueID *uint) ([]domain.WebhookSubscription, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	if err := s.authorize(ctx, userID, venueID); err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := s.webhookRepo.FindSubscriptions(ctx, venueID)
+	if err != nil {
+		logger.Error("failed to get webhook subscriptions", err)
+		return nil, err
+	}
+
+	for i := range subscriptions {
+		subscriptions[i].Secret = ""
+	}
+
+	return subscriptions, nil
+}
+
+func (s *webhookService) GetSubscription(ctx context.Context, userID, id uint) (*domain.WebhookSubscription, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	subscription, err := s.findSubscription(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription.Secret = ""
+	return &subscription, nil
+}
+
+func (s *webhookService) UpdateSubscription(ctx context.Context, userID, id uint, changes domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	if err := validateWebhookSubscription(changes, s.config.AllowInsecureTargets); err != nil {
+		return nil, err
+	}
+
+	subscription, err := s.findSubscription(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription.URL = changes.URL
+	subscription.Description = changes.Description
+	subscription.EventTypes = changes.EventTypes
+	subscription.Active = changes.Active
+
+	if err := s.webhookRepo.UpdateSubscription(ctx, &subscription); err != nil {
+		logger.Error("failed to update webhook subscription", err, "subscription_id", id)
+		return nil, err
+	}
+
+	subscription.Secret = ""
+	return &subscription, nil
+}
+
+func (s *webhookService) DeleteSubscription(ctx context.Context, userID, id uint) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("context error: %w", err)
+	}
+
+	if _, err := s.findSubscription(ctx, userID, id); err != nil {
+		return err
+	}
+
+	if err := s.webhookRepo.DeleteSubscription(ctx, id); err != nil {
+		logger.Error("failed to delete webhook subscription", err, "subscription_id", id)
+		return err
+	}
+
+	logger.Info("webhook subscription deleted", "subscription_id", id, "user_id", userID)
+
+	return nil
+}
+
+func (s *webhookService) RotateSecret(ctx context.Context, userID, id uint) (*domain.WebhookSubscription, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	subscription, err := s.findSubscription(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, encrypted, err := s.newSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	subscription.Secret = encrypted
+	if err := s.webhookRepo.UpdateSubscription(ctx, &subscription); err != nil {
+		logger.Error("failed to rotate webhook secret", err, "subscription_id", id)
+		return nil, err
+	}
+
+	logger.Info("webhook secret rotated", "subscription_id", id, "user_id", userID)
+
+	subscription.Secret = secret
+	return &subscription, nil
+}
+
+func (s *webhookService) GetDeliveries(ctx context.Context, userID, subscriptionID uint, filter domain.WebhookDeliveryFilter) ([]domain.WebhookDelivery, int64, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, 0, fmt.Errorf("context error: %w", err)
+	}
+
+	if filter.Status != "" && filter.Status != domain.WebhookDeliveryPending &&
+		filter.Status != domain.WebhookDeliverySucceeded && filter.Status != domain.WebhookDeliveryFailed {
+		return nil, 0, errors.New("invalid delivery status")
+	}
+
+	if _, err := s.findSubscription(ctx, userID, subscriptionID); err != nil {
+		return nil, 0, err
+	}
+
+	return s.webhookRepo.FindDeliveries(ctx, subscriptionID, filter)
+}
+
+func (s *webhookService) ReplayDelivery(ctx context.Context, userID, deliveryID uint) (*domain.WebhookDelivery, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context error: %w", err)
+	}
+
+	original, err := s.webhookRepo.FindDeliveryByID(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.findSubscription(ctx, userID, original.SubscriptionID); err != nil {
+		return nil, err
+	}
+
+	delivery := &domain.WebhookDelivery{
+		SubscriptionID: original.SubscriptionID,
+		EventID:        original.EventID,
+		EventType:      original.EventType,
+		Payload:        original.Payload,
+		Status:         domain.WebhookDeliveryPending,
+		ReplayOf:       &original.ID,
+	}
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		return s.enqueue(ctx, delivery)
+	})
+	if err != nil {
+		logger.Error("failed to replay webhook delivery", err, "delivery_id", deliveryID)
+		return nil, err
+	}
+
+	logger.Info("webhook delivery replayed", "delivery_id", deliveryID, "replay_id", delivery.ID, "user_id", userID)
+
+	return delivery, nil
+}
+
+// enqueue records the delivery and queues it for the dispatcher.
+func (s *webhookService) enqueue(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	if err := s.webhookRepo.CreateDelivery(ctx, delivery); err != nil {
+		return err
+	}
+
+	return s.outbox.Enqueue(ctx, domain.OutboxTopicWebhook, webhookDeliveryMessage{DeliveryID: delivery.ID})
+}
+
+// findSubscription loads a subscription the user is allowed to manage.
+func (s *webhookService) findSubscription(ctx context.Context, userID, id uint) (domain.WebhookSubscription, error) {
+	subscription, err := s.webhookRepo.FindSubscriptionByID(ctx, id)
+	if err != nil {
+		return domain.WebhookSubscription{}, err
+	}
+
+	if err := s.authorize(ctx, userID, subscription.VenueID); err != nil {
+		return domain.WebhookSubscription{}, err
+	}
+
+	return subscription, nil
+}
+
+func (s *webhookService) authorize(ctx context.Context, userID uint, venueID *uint) error {
+	if venueID != nil {
+		return s.authorizer.AuthorizeVenue(ctx, userID, *venueID, domain.VenueRoleManager)
+	}
+
+	allowed, err := s.authorizer.HasPermission(ctx, userID, domain.PermWebhookManage)
+	if err != nil {
+		logger.Error("failed to check webhook:manage permission", err)
+		return err
+	}
+
+	if !allowed {
+		logger.Warn("webhook access denied", "user_id", userID)
+		return domain.ErrForbidden
+	}
+
+	return nil
+}
+
+// newSecret returns a signing secret and its encrypted form.
+func (s *webhookService) newSecret() (string, string, error) {
+	secret, err := generateWebhookToken("whsec_", 32)
+	if err != nil {
+		logger.Error("failed to generate webhook secret", err)
+		return "", "", errors.New("failed to generate webhook secret")
+	}
+
+	encrypted, err := goshortcute.AESCBCEncrypt([]byte(secret), []byte(s.config.EncryptionKey))
+	if err != nil {
+		logger.Error("failed to encrypt webhook secret", err)
+		return "", "", errors.New("failed to generate webhook secret")
+	}
+
+	return secret, encrypted, nil
+}
+
+func validateWebhookSubscription(subscription domain.WebhookSubscription, allowInsecure bool) error {
+	target, err := url.Parse(subscription.URL)
+	if err != nil || target.Hostname() == "" {
+		return domain.ErrInvalidWebhookURL
+	}
+
+	if target.Scheme != "https" && !(allowInsecure && target.Scheme == "http") {
+		return domain.ErrInvalidWebhookURL
+	}
+
+	// Hostnames are checked again when delivering, once they are resolved.
+	if !allowInsecure {
+		host := target.Hostname()
+		if ip := net.ParseIP(host); (ip != nil && !isPublicWebhookIP(ip)) || strings.EqualFold(host, "localhost") {
+			return domain.ErrInvalidWebhookURL
+		}
+	}
+
+	if len(subscription.EventTypes) == 0 {
+		return domain.ErrUnknownWebhookEvent
+	}
+
+	for _, eventType := range subscription.EventTypes {
+		if !domain.IsWebhookEventType(eventType) {
+			return fmt.Errorf("%w: %s", domain.ErrUnknownWebhookEvent, eventType)
+		}
+	}
+
+	return nil
+}
+
+func generateWebhookToken(prefix string, size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return prefix + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,36 @@
+-- Permissions Table
+CREATE TABLE IF NOT EXISTS permissions (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) UNIQUE NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Role Permissions Table
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role_id INT NOT NULL,
+    permission_id INT NOT NULL,
+    PRIMARY KEY (role_id, permission_id),
+    FOREIGN KEY (role_id) REFERENCES roles(id) ON DELETE CASCADE,
+    FOREIGN KEY (permission_id) REFERENCES permissions(id) ON DELETE CASCADE
+);
+
+INSERT INTO permissions (name, description)
+VALUES
+    ('venue:write', 'Create, update and delete venues'),
+    ('field:write', 'Create, update and delete fields'),
+    ('schedule:write', 'Create, update and delete schedules'),
+    ('booking:read:any', 'Read bookings owned by other users'),
+    ('payment:refund', 'Refund payments'),
+    ('role:manage', 'Manage roles and their permissions')
+ON CONFLICT (name) DO NOTHING;
+
+-- ADMIN keeps every permission it implicitly had before
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT roles.id, permissions.id
+FROM roles CROSS JOIN permissions
+WHERE roles.role_name = 'ADMIN'
+ON CONFLICT DO NOTHING;
+
+SELECT setval(pg_get_serial_sequence('roles', 'id'), (SELECT MAX(id) FROM roles));
@@ -0,0 +1,3 @@
+DELETE FROM roles WHERE role_name = 'VENUE_OPERATOR';
+DELETE FROM permissions WHERE name = 'venue:manage:any';
+DROP TABLE IF EXISTS venue_members;
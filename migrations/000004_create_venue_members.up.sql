@@ -0,0 +1,34 @@
+-- Venue Members Table
+CREATE TABLE IF NOT EXISTS venue_members (
+    id SERIAL PRIMARY KEY,
+    venue_id INT NOT NULL,
+    user_id INT NOT NULL,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('OWNER', 'MANAGER', 'STAFF')),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (venue_id, user_id),
+    FOREIGN KEY (venue_id) REFERENCES venues(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_venue_members_user_id ON venue_members(user_id);
+
+INSERT INTO permissions (name, description)
+VALUES ('venue:manage:any', 'Manage every venue regardless of membership')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT roles.id, permissions.id
+FROM roles CROSS JOIN permissions
+WHERE roles.role_name = 'ADMIN' AND permissions.name = 'venue:manage:any'
+ON CONFLICT DO NOTHING;
+
+-- Venue operators may create venues and manage the ones they belong to
+INSERT INTO roles (role_name) VALUES ('VENUE_OPERATOR') ON CONFLICT (role_name) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT roles.id, permissions.id
+FROM roles CROSS JOIN permissions
+WHERE roles.role_name = 'VENUE_OPERATOR'
+  AND permissions.name IN ('venue:write', 'field:write', 'schedule:write')
+ON CONFLICT DO NOTHING;
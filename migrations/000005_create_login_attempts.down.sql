@@ -0,0 +1,2 @@
+DELETE FROM permissions WHERE name = 'user:manage';
+DROP TABLE IF EXISTS login_attempts;
@@ -0,0 +1,22 @@
+-- Login Attempts Table
+CREATE TABLE IF NOT EXISTS login_attempts (
+    id SERIAL PRIMARY KEY,
+    scope VARCHAR(20) NOT NULL CHECK (scope IN ('ACCOUNT', 'IP')),
+    key VARCHAR(255) NOT NULL,
+    failed_count INT NOT NULL DEFAULT 0,
+    last_failed_at TIMESTAMP,
+    locked_until TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (scope, key)
+);
+
+INSERT INTO permissions (name, description)
+VALUES ('user:manage', 'Manage user accounts, including unlocking logins')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT roles.id, permissions.id
+FROM roles CROSS JOIN permissions
+WHERE roles.role_name = 'ADMIN' AND permissions.name = 'user:manage'
+ON CONFLICT DO NOTHING;
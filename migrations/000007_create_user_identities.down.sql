@@ -0,0 +1,5 @@
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_age_check;
+ALTER TABLE users ADD CONSTRAINT users_age_check CHECK (age >= 15) NOT VALID;
+
+DROP TABLE IF EXISTS oidc_states;
+DROP TABLE IF EXISTS user_identities;
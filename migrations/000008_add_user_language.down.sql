@@ -0,0 +1,4 @@
+DELETE FROM permissions WHERE name = 'notification:manage';
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_language_check;
+ALTER TABLE users DROP COLUMN IF EXISTS language;
@@ -0,0 +1,15 @@
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS language VARCHAR(5) NOT NULL DEFAULT 'id';
+
+ALTER TABLE users
+    ADD CONSTRAINT users_language_check CHECK (language IN ('id', 'en'));
+
+INSERT INTO permissions (name, description)
+VALUES ('notification:manage', 'Preview notification templates and manage notification delivery')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT roles.id, permissions.id
+FROM roles CROSS JOIN permissions
+WHERE roles.role_name = 'ADMIN' AND permissions.name = 'notification:manage'
+ON CONFLICT DO NOTHING;
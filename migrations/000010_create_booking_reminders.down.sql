@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_bookings_status_date;
+DROP TABLE IF EXISTS booking_reminders;
@@ -0,0 +1,13 @@
+-- Booking Reminders Table
+-- One row per reminder sent. The unique key is what stops two scheduler
+-- replicas, or a restart, from sending the same reminder twice.
+CREATE TABLE IF NOT EXISTS booking_reminders (
+    id SERIAL PRIMARY KEY,
+    booking_id INT NOT NULL,
+    offset_minutes INT NOT NULL,
+    sent_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (booking_id) REFERENCES bookings(id) ON DELETE CASCADE,
+    UNIQUE (booking_id, offset_minutes)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bookings_status_date ON bookings (status, booking_date);
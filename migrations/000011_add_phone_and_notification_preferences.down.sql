@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS notification_preferences;
+DROP TABLE IF EXISTS phone_verifications;
+
+DROP INDEX IF EXISTS idx_users_phone_number;
+ALTER TABLE users DROP COLUMN IF EXISTS phone_verified_at;
+ALTER TABLE users DROP COLUMN IF EXISTS phone_number;
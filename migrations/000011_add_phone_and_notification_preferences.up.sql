@@ -0,0 +1,30 @@
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS phone_number VARCHAR(16),
+    ADD COLUMN IF NOT EXISTS phone_verified_at TIMESTAMP;
+
+-- A number can be verified by one account only.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_phone_number ON users (phone_number)
+    WHERE phone_number IS NOT NULL AND deleted_at IS NULL;
+
+-- Phone Verifications Table
+-- At most one pending code per user; requesting a new one replaces it.
+CREATE TABLE IF NOT EXISTS phone_verifications (
+    user_id INT PRIMARY KEY,
+    phone_number VARCHAR(16) NOT NULL,
+    code_hash VARCHAR(64) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Notification Preferences Table
+-- Events without a row go by email.
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id INT NOT NULL,
+    event VARCHAR(50) NOT NULL,
+    channel VARCHAR(10) NOT NULL CHECK (channel IN ('EMAIL', 'WHATSAPP', 'SMS')),
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, event),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
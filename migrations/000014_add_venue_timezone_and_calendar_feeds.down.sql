@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS calendar_feeds;
+
+ALTER TABLE venues DROP COLUMN IF EXISTS timezone;
@@ -0,0 +1,12 @@
+-- IANA timezone of the venue; schedule times are wall-clock times in it.
+ALTER TABLE venues
+    ADD COLUMN IF NOT EXISTS timezone VARCHAR(64) NOT NULL DEFAULT 'Asia/Jakarta';
+
+-- Calendar Feeds Table
+-- One private subscription URL per user. Only a hash of its token is stored.
+CREATE TABLE IF NOT EXISTS calendar_feeds (
+    user_id INT PRIMARY KEY,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
@@ -0,0 +1,4 @@
+-- Postgres cannot drop an enum value, so CHECKED_IN stays in booking_status.
+UPDATE bookings SET status = 'CONFIRMED' WHERE status = 'CHECKED_IN';
+
+ALTER TABLE bookings DROP COLUMN IF EXISTS checked_in_at;
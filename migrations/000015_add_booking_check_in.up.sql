@@ -0,0 +1,5 @@
+ALTER TYPE booking_status ADD VALUE IF NOT EXISTS 'CHECKED_IN';
+
+-- Set when front-desk staff scan the customer's check-in code.
+ALTER TABLE bookings
+    ADD COLUMN IF NOT EXISTS checked_in_at TIMESTAMP;
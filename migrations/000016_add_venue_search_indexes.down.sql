@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_bookings_schedule_date;
+DROP INDEX IF EXISTS idx_fields_venue_id;
+DROP INDEX IF EXISTS idx_venues_created_at;
+DROP INDEX IF EXISTS idx_venues_city;
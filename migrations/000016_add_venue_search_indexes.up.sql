@@ -0,0 +1,6 @@
+-- Venue search filters on city and walks each venue's fields, schedules and
+-- the bookings of a schedule on one date.
+CREATE INDEX IF NOT EXISTS idx_venues_city ON venues (LOWER(city)) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_venues_created_at ON venues (created_at DESC, id DESC) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_fields_venue_id ON fields (venue_id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_bookings_schedule_date ON bookings (schedule_id, booking_date);
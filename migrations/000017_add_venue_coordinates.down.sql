@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_venues_coordinates;
+
+ALTER TABLE venues DROP CONSTRAINT IF EXISTS chk_venues_coordinates;
+
+ALTER TABLE venues
+    DROP COLUMN IF EXISTS longitude,
+    DROP COLUMN IF EXISTS latitude;
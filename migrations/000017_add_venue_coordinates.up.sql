@@ -0,0 +1,15 @@
+-- WGS 84 position of the venue, in degrees. Both are set or neither is.
+ALTER TABLE venues
+    ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION;
+
+ALTER TABLE venues
+    ADD CONSTRAINT chk_venues_coordinates CHECK (
+        (latitude IS NULL AND longitude IS NULL)
+        OR (latitude BETWEEN -90 AND 90 AND longitude BETWEEN -180 AND 180)
+    );
+
+-- Nearby search narrows to a bounding box on these columns before computing
+-- exact distances.
+CREATE INDEX IF NOT EXISTS idx_venues_coordinates ON venues (latitude, longitude)
+    WHERE deleted_at IS NULL AND latitude IS NOT NULL;
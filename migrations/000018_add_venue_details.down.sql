@@ -0,0 +1,9 @@
+DELETE FROM permissions WHERE name = 'amenity:manage';
+
+DROP TABLE IF EXISTS venue_opening_hours;
+DROP TABLE IF EXISTS venue_amenities;
+DROP TABLE IF EXISTS amenities;
+
+ALTER TABLE venues
+    DROP COLUMN IF EXISTS whatsapp_number,
+    DROP COLUMN IF EXISTS phone_number;
@@ -0,0 +1,55 @@
+-- Contact numbers in E.164 form; empty when the venue has none.
+ALTER TABLE venues
+    ADD COLUMN IF NOT EXISTS phone_number VARCHAR(20) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS whatsapp_number VARCHAR(20) NOT NULL DEFAULT '';
+
+-- Amenities Table
+CREATE TABLE IF NOT EXISTS amenities (
+    code VARCHAR(32) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO amenities (code, name) VALUES
+    ('PARKING', 'Parking'),
+    ('SHOWER', 'Shower'),
+    ('CANTEEN', 'Canteen'),
+    ('PRAYER_ROOM', 'Prayer room'),
+    ('WIFI', 'Wi-Fi'),
+    ('LOCKER_ROOM', 'Locker room'),
+    ('TOILET', 'Toilet')
+ON CONFLICT (code) DO NOTHING;
+
+-- Venue Amenities Table
+CREATE TABLE IF NOT EXISTS venue_amenities (
+    venue_id INT NOT NULL,
+    amenity_code VARCHAR(32) NOT NULL,
+    PRIMARY KEY (venue_id, amenity_code),
+    FOREIGN KEY (venue_id) REFERENCES venues(id) ON DELETE CASCADE,
+    FOREIGN KEY (amenity_code) REFERENCES amenities(code) ON DELETE CASCADE
+);
+
+-- Amenity filters look venues up by amenity.
+CREATE INDEX IF NOT EXISTS idx_venue_amenities_amenity ON venue_amenities (amenity_code);
+
+-- Venue Opening Hours Table
+-- Days without a row are closed. closes_at before opens_at means the venue
+-- closes after midnight.
+CREATE TABLE IF NOT EXISTS venue_opening_hours (
+    venue_id INT NOT NULL,
+    day_of_week INT NOT NULL CHECK (day_of_week BETWEEN 1 AND 7),
+    opens_at TIME NOT NULL,
+    closes_at TIME NOT NULL,
+    PRIMARY KEY (venue_id, day_of_week),
+    FOREIGN KEY (venue_id) REFERENCES venues(id) ON DELETE CASCADE
+);
+
+INSERT INTO permissions (name, description)
+VALUES ('amenity:manage', 'Add and remove the amenities venues can pick from')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT roles.id, permissions.id
+FROM roles CROSS JOIN permissions
+WHERE roles.role_name = 'ADMIN' AND permissions.name = 'amenity:manage'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,28 @@
+-- Photos Table
+-- Photos of a venue have no field_id; photos of a field have both ids. Files
+-- live in blob storage under storage_key and thumbnail_key.
+CREATE TABLE IF NOT EXISTS photos (
+    id SERIAL PRIMARY KEY,
+    venue_id INT NOT NULL,
+    field_id INT,
+    storage_key VARCHAR(255) NOT NULL UNIQUE,
+    thumbnail_key VARCHAR(255) NOT NULL UNIQUE,
+    content_type VARCHAR(50) NOT NULL,
+    width INT NOT NULL,
+    height INT NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    position INT NOT NULL,
+    is_cover BOOLEAN NOT NULL DEFAULT FALSE,
+    uploaded_by INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (venue_id) REFERENCES venues(id) ON DELETE CASCADE,
+    FOREIGN KEY (field_id) REFERENCES fields(id) ON DELETE CASCADE,
+    FOREIGN KEY (uploaded_by) REFERENCES users(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_photos_gallery ON photos (venue_id, field_id, position);
+
+-- At most one cover per gallery. COALESCE puts venue photos, whose field_id
+-- is NULL, in one gallery.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_photos_cover ON photos (venue_id, COALESCE(field_id, 0))
+    WHERE is_cover;
@@ -0,0 +1,16 @@
+ALTER TABLE fields
+    DROP COLUMN IF EXISTS status,
+    DROP COLUMN IF EXISTS max_players,
+    DROP COLUMN IF EXISTS has_lighting,
+    DROP COLUMN IF EXISTS is_indoor,
+    DROP COLUMN IF EXISTS width_m,
+    DROP COLUMN IF EXISTS length_m;
+
+-- Fails if a field uses a type added after the enum was dropped.
+CREATE TYPE field_type AS ENUM ('SINTETIS', 'VINYL', 'BETON');
+
+ALTER TABLE fields DROP CONSTRAINT IF EXISTS fk_fields_type;
+
+ALTER TABLE fields ALTER COLUMN type TYPE field_type USING type::field_type;
+
+DROP TABLE IF EXISTS field_types;
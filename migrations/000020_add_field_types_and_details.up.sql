@@ -0,0 +1,31 @@
+-- Field Types Table
+-- Replaces the field_type enum so surfaces can be added without a schema change.
+CREATE TABLE IF NOT EXISTS field_types (
+    code VARCHAR(20) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    sort_order INT NOT NULL DEFAULT 0
+);
+
+INSERT INTO field_types (code, name, description, sort_order) VALUES
+    ('SINTETIS', 'Synthetic grass', 'Artificial turf', 1),
+    ('VINYL', 'Vinyl', 'Vinyl sports flooring', 2),
+    ('BETON', 'Concrete', 'Concrete or cement court', 3)
+ON CONFLICT (code) DO NOTHING;
+
+ALTER TABLE fields ALTER COLUMN type TYPE VARCHAR(20) USING type::text;
+
+ALTER TABLE fields
+    ADD CONSTRAINT fk_fields_type FOREIGN KEY (type) REFERENCES field_types(code);
+
+DROP TYPE IF EXISTS field_type;
+
+-- Field Details
+-- Dimensions are in metres and optional. Inactive fields cannot be booked.
+ALTER TABLE fields
+    ADD COLUMN IF NOT EXISTS length_m NUMERIC(5, 2) CHECK (length_m > 0 AND length_m <= 100),
+    ADD COLUMN IF NOT EXISTS width_m NUMERIC(5, 2) CHECK (width_m > 0 AND width_m <= 100),
+    ADD COLUMN IF NOT EXISTS is_indoor BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS has_lighting BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS max_players INT NOT NULL DEFAULT 10 CHECK (max_players BETWEEN 2 AND 50),
+    ADD COLUMN IF NOT EXISTS status VARCHAR(10) NOT NULL DEFAULT 'ACTIVE' CHECK (status IN ('ACTIVE', 'INACTIVE'));
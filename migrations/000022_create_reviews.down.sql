@@ -0,0 +1,11 @@
+DELETE FROM permissions WHERE name = 'review:moderate';
+
+ALTER TABLE venues
+    DROP COLUMN IF EXISTS rating_count,
+    DROP COLUMN IF EXISTS rating_avg;
+
+DROP TABLE IF EXISTS reviews;
+DROP TYPE IF EXISTS review_status;
+
+-- Postgres cannot drop an enum value, so COMPLETED stays in booking_status.
+UPDATE bookings SET status = 'CONFIRMED' WHERE status = 'COMPLETED';
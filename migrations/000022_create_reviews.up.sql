@@ -0,0 +1,46 @@
+-- Set by the booking completion job once a booking's slot has ended. A new
+-- enum value cannot be used in the transaction that adds it, so nothing below
+-- refers to it.
+ALTER TYPE booking_status ADD VALUE IF NOT EXISTS 'COMPLETED';
+
+CREATE TYPE review_status AS ENUM ('PUBLISHED', 'HIDDEN');
+
+-- Reviews Table
+-- One review per completed booking. Hidden reviews are kept for moderators
+-- but left out of listings and the venue's rating.
+CREATE TABLE IF NOT EXISTS reviews (
+    id SERIAL PRIMARY KEY,
+    venue_id INT NOT NULL,
+    booking_id INT NOT NULL UNIQUE,
+    user_id INT NOT NULL,
+    rating INT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    comment TEXT NOT NULL DEFAULT '',
+    reply TEXT NOT NULL DEFAULT '',
+    replied_by INT,
+    replied_at TIMESTAMP,
+    status review_status NOT NULL DEFAULT 'PUBLISHED',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (venue_id) REFERENCES venues(id) ON DELETE CASCADE,
+    FOREIGN KEY (booking_id) REFERENCES bookings(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id),
+    FOREIGN KEY (replied_by) REFERENCES users(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reviews_venue ON reviews (venue_id, status, created_at DESC);
+
+-- Aggregates of the published reviews, kept up to date by the review
+-- repository so venue listings can sort on them.
+ALTER TABLE venues
+    ADD COLUMN IF NOT EXISTS rating_avg NUMERIC(3, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS rating_count INT NOT NULL DEFAULT 0;
+
+INSERT INTO permissions (name, description)
+VALUES ('review:moderate', 'Hide and delete venue reviews')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT roles.id, permissions.id
+FROM roles CROSS JOIN permissions
+WHERE roles.role_name = 'ADMIN' AND permissions.name = 'review:moderate'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS saved_search_alerts;
+DROP TABLE IF EXISTS saved_searches;
+DROP TABLE IF EXISTS favourite_fields;
+DROP TABLE IF EXISTS favourite_venues;
@@ -0,0 +1,50 @@
+-- Favourite Venues and Fields Tables
+CREATE TABLE IF NOT EXISTS favourite_venues (
+    user_id INT NOT NULL,
+    venue_id INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, venue_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (venue_id) REFERENCES venues(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS favourite_fields (
+    user_id INT NOT NULL,
+    field_id INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, field_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (field_id) REFERENCES fields(id) ON DELETE CASCADE
+);
+
+-- Saved Searches Table
+-- Empty criteria match anything; day_of_week 0 is any day.
+CREATE TABLE IF NOT EXISTS saved_searches (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    city VARCHAR(100) NOT NULL DEFAULT '',
+    field_type VARCHAR(20) NOT NULL DEFAULT '',
+    day_of_week INT NOT NULL DEFAULT 0 CHECK (day_of_week BETWEEN 0 AND 7),
+    start_time TIME,
+    end_time TIME,
+    notify BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_saved_searches_user ON saved_searches (user_id);
+CREATE INDEX IF NOT EXISTS idx_saved_searches_notify ON saved_searches (id) WHERE notify;
+
+-- A row claims one slot of one saved search, so each free slot is alerted
+-- once even with several instances running the alert job.
+CREATE TABLE IF NOT EXISTS saved_search_alerts (
+    saved_search_id INT NOT NULL,
+    schedule_id INT NOT NULL,
+    slot_date DATE NOT NULL,
+    notified_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (saved_search_id, schedule_id, slot_date),
+    FOREIGN KEY (saved_search_id) REFERENCES saved_searches(id) ON DELETE CASCADE,
+    FOREIGN KEY (schedule_id) REFERENCES schedules(id) ON DELETE CASCADE
+);
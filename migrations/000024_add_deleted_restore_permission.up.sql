@@ -0,0 +1,11 @@
+-- Venues, fields and schedules are soft-deleted and can be restored for a
+-- while by users with this permission.
+INSERT INTO permissions (name, description)
+VALUES ('deleted:restore', 'Restore deleted venues, fields and schedules')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT roles.id, permissions.id
+FROM roles CROSS JOIN permissions
+WHERE roles.role_name = 'ADMIN' AND permissions.name = 'deleted:restore'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,11 @@
+-- Venues, fields and schedules can be imported and exported in bulk as CSV
+-- or XLSX files by users with this permission.
+INSERT INTO permissions (name, description)
+VALUES ('catalog:manage', 'Import and export venues, fields and schedules')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT roles.id, permissions.id
+FROM roles CROSS JOIN permissions
+WHERE roles.role_name = 'ADMIN' AND permissions.name = 'catalog:manage'
+ON CONFLICT DO NOTHING;
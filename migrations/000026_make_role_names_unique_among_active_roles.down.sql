@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_roles_role_name_active;
+
+ALTER TABLE roles ADD CONSTRAINT roles_role_name_key UNIQUE (role_name);
@@ -0,0 +1,5 @@
+-- Roles are soft-deleted, so the name of a deleted role must be free to use
+-- again. Only roles that are not deleted need distinct names.
+ALTER TABLE roles DROP CONSTRAINT IF EXISTS roles_role_name_key;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_roles_role_name_active ON roles (role_name) WHERE deleted_at IS NULL;
@@ -0,0 +1,9 @@
+INSERT INTO permissions (name, description)
+VALUES ('payment:refund', 'Refund payments')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT roles.id, permissions.id
+FROM roles CROSS JOIN permissions
+WHERE roles.role_name = 'ADMIN' AND permissions.name = 'payment:refund'
+ON CONFLICT DO NOTHING;
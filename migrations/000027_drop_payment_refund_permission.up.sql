@@ -0,0 +1,3 @@
+-- payment:refund was seeded with the first permissions but no endpoint ever
+-- checked it.
+DELETE FROM permissions WHERE name = 'payment:refund';
@@ -0,0 +1,3 @@
+-- Subscriber response bodies are no longer kept: they could echo back
+-- anything the target returned.
+ALTER TABLE webhook_deliveries DROP COLUMN IF EXISTS response_body;
@@ -0,0 +1,14 @@
+UPDATE permissions SET description = 'Create, update and delete venues' WHERE name = 'venue:write';
+
+INSERT INTO permissions (name, description)
+VALUES
+    ('field:write', 'Create, update and delete fields'),
+    ('schedule:write', 'Create, update and delete schedules')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT roles.id, permissions.id
+FROM roles CROSS JOIN permissions
+WHERE roles.role_name IN ('ADMIN', 'VENUE_OPERATOR')
+  AND permissions.name IN ('field:write', 'schedule:write')
+ON CONFLICT DO NOTHING;
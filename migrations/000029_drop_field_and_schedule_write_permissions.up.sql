@@ -0,0 +1,6 @@
+-- Fields and schedules are changed by the members of their venue, which the
+-- services check, so these permissions no longer gate anything. venue:write
+-- stays: it is what lets a user create a venue.
+UPDATE permissions SET description = 'Create venues' WHERE name = 'venue:write';
+
+DELETE FROM permissions WHERE name IN ('field:write', 'schedule:write');
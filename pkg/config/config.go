@@ -2,7 +2,12 @@ package config
 
 import (
 	"errors"
+	"fmt"
+	"net"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -13,6 +18,167 @@ type Config struct {
 	Database DatabaseConfig
 	JWT      JWTConfig
 	Mailjet  MailjetConfig
+	OIDC     OIDCConfig
+
+	Notification       NotificationConfig
+	Outbox             OutboxConfig
+	Reminder           ReminderConfig
+	BookingCompletion  BookingCompletionConfig
+	SavedSearchAlert   SavedSearchAlertConfig
+	TextMessage        TextMessageConfig
+	PhoneVerification  PhoneVerificationConfig
+	Webhook            WebhookConfig
+	AvailabilityStream AvailabilityStreamConfig
+	Inbox              InboxConfig
+	Calendar           CalendarConfig
+	CheckIn            CheckInConfig
+	Storage            StorageConfig
+
+	LoginThrottle LoginThrottleConfig
+}
+
+// OIDCConfig describes a single OpenID Connect provider. Single sign-on is
+// disabled when IssuerURL is empty.
+type OIDCConfig struct {
+	ProviderName string
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+type LoginThrottleConfig struct {
+	AccountFreeAttempts  int
+	AccountLockThreshold int
+	IPFreeAttempts       int
+	IPLockThreshold      int
+	BaseDelay            time.Duration
+	MaxDelay             time.Duration
+	LockDuration         time.Duration
+	Window               time.Duration
+}
+
+// NotificationConfig selects the email backend: mailjet, smtp or file. The
+// file driver writes .eml files to FileDir, or to stdout when it is empty.
+type NotificationConfig struct {
+	Driver      string
+	SenderEmail string
+	SenderName  string
+	FileDir     string
+	SMTP        SMTPConfig
+}
+
+type OutboxConfig struct {
+	MaxAttempts  int
+	BaseDelay    time.Duration
+	MaxDelay     time.Duration
+	PollInterval time.Duration
+	BatchSize    int
+	Lease        time.Duration
+}
+
+// TextMessageConfig selects the SMS/WhatsApp backend: "fake" prints messages,
+// "http" posts them to a gateway at BaseURL.
+type TextMessageConfig struct {
+	Driver  string
+	BaseURL string
+	APIKey  string
+	Sender  string
+	Timeout time.Duration
+}
+
+type PhoneVerificationConfig struct {
+	Channel        string
+	CodeTTL        time.Duration
+	ResendCooldown time.Duration
+	MaxAttempts    int
+}
+
+// WebhookConfig controls outbound webhooks. Deliveries are retried with the
+// outbox settings.
+type WebhookConfig struct {
+	EncryptionKey string
+	Timeout       time.Duration
+}
+
+// AvailabilityStreamConfig controls the Server-Sent Events slot stream.
+// BufferSize is how many updates a slow client may fall behind before it is
+// disconnected.
+type AvailabilityStreamConfig struct {
+	HeartbeatInterval time.Duration
+	BufferSize        int
+}
+
+// InboxConfig controls how long read in-app notifications are kept. A zero
+// RetainRead keeps them forever.
+type InboxConfig struct {
+	RetainRead       time.Duration
+	CleanupInterval  time.Duration
+	CleanupBatchSize int
+}
+
+// CalendarConfig controls the iCalendar subscription feed.
+type CalendarConfig struct {
+	FeedLookback time.Duration
+}
+
+// CheckInConfig holds the key that signs booking check-in codes. Changing it
+// invalidates every code already shown to customers.
+type CheckInConfig struct {
+	SigningKey string
+}
+
+// StorageConfig selects where uploaded photos are kept: "local" writes them to
+// LocalDir, which the server then serves under /uploads; "s3" puts them in an
+// S3-compatible bucket. PublicBaseURL is the prefix of download URLs.
+type StorageConfig struct {
+	Driver         string
+	PublicBaseURL  string
+	LocalDir       string
+	S3             S3Config
+	MaxUploadBytes int64
+}
+
+type S3Config struct {
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	Timeout         time.Duration
+}
+
+// ReminderConfig controls booking reminders. One reminder is sent per offset
+// before a booking starts.
+type ReminderConfig struct {
+	Offsets      []time.Duration
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+// BookingCompletionConfig controls how often bookings whose slot has ended
+// are marked COMPLETED.
+type BookingCompletionConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+// SavedSearchAlertConfig controls alerts for saved searches. Slots are looked
+// for Lookahead ahead.
+type SavedSearchAlertConfig struct {
+	PollInterval time.Duration
+	Lookahead    time.Duration
+	BatchSize    int
+}
+
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	TLSMode  string
+	Timeout  time.Duration
 }
 
 type MailjetConfig struct {
@@ -29,10 +195,14 @@ type AppConfig struct {
 	Environment             string
 	AppDeploymentUrl        string
 	AppEmailVerificationKey string
+	AppTOTPEncryptionKey    string
 }
 
+// ServerConfig lists the reverse proxies in front of the server. Client IPs are
+// read from X-Forwarded-For only when the request comes from one of them.
 type ServerConfig struct {
-	Port string
+	Port           string
+	TrustedProxies []*net.IPNet
 }
 
 type DatabaseConfig struct {
@@ -60,6 +230,7 @@ func Load() (*Config, error) {
 			Environment:             getEnv("APP_ENV", "development"),
 			AppDeploymentUrl:        getEnv("APP_DEPLOYMENT_URL", ""),
 			AppEmailVerificationKey: getEnv("APP_EMAIL_VERIFICATION_KEY", ""),
+			AppTOTPEncryptionKey:    getEnv("APP_TOTP_ENCRYPTION_KEY", ""),
 		},
 		Server: ServerConfig{
 			Port: getEnv("SERVER_PORT", "8080"),
@@ -82,6 +253,125 @@ func Load() (*Config, error) {
 			MailjetSenderEmail:       getEnv("MAILJET_SENDER_EMAIL", ""),
 			MailjetSenderName:        getEnv("MAILJET_SENDER_NAME", ""),
 		},
+		Notification: NotificationConfig{
+			Driver:      getEnv("NOTIFICATION_DRIVER", "mailjet"),
+			SenderEmail: getEnv("NOTIFICATION_SENDER_EMAIL", getEnv("MAILJET_SENDER_EMAIL", "")),
+			SenderName:  getEnv("NOTIFICATION_SENDER_NAME", getEnv("MAILJET_SENDER_NAME", "")),
+			FileDir:     getEnv("NOTIFICATION_FILE_DIR", ""),
+			SMTP: SMTPConfig{
+				Host:     getEnv("SMTP_HOST", "localhost"),
+				Port:     getEnvInt("SMTP_PORT", 587),
+				Username: getEnv("SMTP_USERNAME", ""),
+				Password: getEnv("SMTP_PASSWORD", ""),
+				TLSMode:  getEnv("SMTP_TLS_MODE", "starttls"),
+				Timeout:  getEnvDuration("SMTP_TIMEOUT", 10*time.Second),
+			},
+		},
+		Outbox: OutboxConfig{
+			MaxAttempts:  getEnvInt("OUTBOX_MAX_ATTEMPTS", 8),
+			BaseDelay:    getEnvDuration("OUTBOX_BACKOFF_BASE_DELAY", 30*time.Second),
+			MaxDelay:     getEnvDuration("OUTBOX_BACKOFF_MAX_DELAY", time.Hour),
+			PollInterval: getEnvDuration("OUTBOX_POLL_INTERVAL", 2*time.Second),
+			BatchSize:    getEnvInt("OUTBOX_BATCH_SIZE", 20),
+			Lease:        getEnvDuration("OUTBOX_LEASE", 2*time.Minute),
+		},
+		TextMessage: TextMessageConfig{
+			Driver:  getEnv("TEXT_MESSAGE_DRIVER", "fake"),
+			BaseURL: getEnv("TEXT_MESSAGE_BASE_URL", ""),
+			APIKey:  getEnv("TEXT_MESSAGE_API_KEY", ""),
+			Sender:  getEnv("TEXT_MESSAGE_SENDER", ""),
+			Timeout: getEnvDuration("TEXT_MESSAGE_TIMEOUT", 10*time.Second),
+		},
+		PhoneVerification: PhoneVerificationConfig{
+			Channel:        strings.ToUpper(getEnv("PHONE_VERIFICATION_CHANNEL", "WHATSAPP")),
+			CodeTTL:        getEnvDuration("PHONE_VERIFICATION_CODE_TTL", 5*time.Minute),
+			ResendCooldown: getEnvDuration("PHONE_VERIFICATION_RESEND_COOLDOWN", time.Minute),
+			MaxAttempts:    getEnvInt("PHONE_VERIFICATION_MAX_ATTEMPTS", 5),
+		},
+		Webhook: WebhookConfig{
+			EncryptionKey: getEnv("WEBHOOK_ENCRYPTION_KEY", ""),
+			Timeout:       getEnvDuration("WEBHOOK_TIMEOUT", 10*time.Second),
+		},
+		AvailabilityStream: AvailabilityStreamConfig{
+			HeartbeatInterval: getEnvDuration("AVAILABILITY_STREAM_HEARTBEAT", 15*time.Second),
+			BufferSize:        getEnvInt("AVAILABILITY_STREAM_BUFFER", 16),
+		},
+		Inbox: InboxConfig{
+			RetainRead:       getEnvDuration("INBOX_RETAIN_READ", 30*24*time.Hour),
+			CleanupInterval:  getEnvDuration("INBOX_CLEANUP_INTERVAL", time.Hour),
+			CleanupBatchSize: getEnvInt("INBOX_CLEANUP_BATCH_SIZE", 500),
+		},
+		Calendar: CalendarConfig{
+			FeedLookback: getEnvDuration("CALENDAR_FEED_LOOKBACK", 90*24*time.Hour),
+		},
+		CheckIn: CheckInConfig{
+			SigningKey: getEnv("CHECKIN_SIGNING_KEY", ""),
+		},
+		Storage: StorageConfig{
+			Driver:         strings.ToLower(getEnv("STORAGE_DRIVER", "local")),
+			PublicBaseURL:  getEnv("STORAGE_PUBLIC_BASE_URL", ""),
+			LocalDir:       getEnv("STORAGE_LOCAL_DIR", "uploads"),
+			MaxUploadBytes: int64(getEnvInt("PHOTO_MAX_UPLOAD_BYTES", 10<<20)),
+			S3: S3Config{
+				Endpoint:        getEnv("S3_ENDPOINT", ""),
+				Region:          getEnv("S3_REGION", "us-east-1"),
+				Bucket:          getEnv("S3_BUCKET", ""),
+				AccessKeyID:     getEnv("S3_ACCESS_KEY_ID", ""),
+				SecretAccessKey: getEnv("S3_SECRET_ACCESS_KEY", ""),
+				Timeout:         getEnvDuration("S3_TIMEOUT", 30*time.Second),
+			},
+		},
+		Reminder: ReminderConfig{
+			PollInterval: getEnvDuration("REMINDER_POLL_INTERVAL", time.Minute),
+			BatchSize:    getEnvInt("REMINDER_BATCH_SIZE", 50),
+		},
+		BookingCompletion: BookingCompletionConfig{
+			PollInterval: getEnvDuration("BOOKING_COMPLETION_INTERVAL", 5*time.Minute),
+			BatchSize:    getEnvInt("BOOKING_COMPLETION_BATCH_SIZE", 500),
+		},
+		SavedSearchAlert: SavedSearchAlertConfig{
+			PollInterval: getEnvDuration("SAVED_SEARCH_ALERT_INTERVAL", 15*time.Minute),
+			Lookahead:    getEnvDuration("SAVED_SEARCH_ALERT_LOOKAHEAD", 14*24*time.Hour),
+			BatchSize:    getEnvInt("SAVED_SEARCH_ALERT_BATCH_SIZE", 100),
+		},
+		OIDC: OIDCConfig{
+			ProviderName: getEnv("OIDC_PROVIDER_NAME", "google"),
+			IssuerURL:    getEnv("OIDC_ISSUER_URL", ""),
+			ClientID:     getEnv("OIDC_CLIENT_ID", ""),
+			ClientSecret: getEnv("OIDC_CLIENT_SECRET", ""),
+			RedirectURL:  getEnv("OIDC_REDIRECT_URL", ""),
+			Scopes:       strings.Fields(getEnv("OIDC_SCOPES", "openid email profile")),
+		},
+		LoginThrottle: LoginThrottleConfig{
+			AccountFreeAttempts:  getEnvInt("LOGIN_ACCOUNT_FREE_ATTEMPTS", 3),
+			AccountLockThreshold: getEnvInt("LOGIN_ACCOUNT_LOCK_THRESHOLD", 10),
+			IPFreeAttempts:       getEnvInt("LOGIN_IP_FREE_ATTEMPTS", 10),
+			IPLockThreshold:      getEnvInt("LOGIN_IP_LOCK_THRESHOLD", 50),
+			BaseDelay:            getEnvDuration("LOGIN_BACKOFF_BASE_DELAY", time.Second),
+			MaxDelay:             getEnvDuration("LOGIN_BACKOFF_MAX_DELAY", time.Minute),
+			LockDuration:         getEnvDuration("LOGIN_LOCK_DURATION", 15*time.Minute),
+			Window:               getEnvDuration("LOGIN_ATTEMPT_WINDOW", time.Hour),
+		},
+	}
+
+	reminderOffsets, err := parseDurations(getEnv("REMINDER_OFFSETS", "24h,2h"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid REMINDER_OFFSETS: %w", err)
+	}
+	cfg.Reminder.Offsets = reminderOffsets
+
+	trustedProxies, err := parseCIDRs(getEnv("TRUSTED_PROXIES", ""))
+	if err != nil {
+		return nil, fmt.Errorf("invalid TRUSTED_PROXIES: %w", err)
+	}
+	cfg.Server.TrustedProxies = trustedProxies
+
+	if cfg.SavedSearchAlert.BatchSize <= 0 {
+		return nil, errors.New("SAVED_SEARCH_ALERT_BATCH_SIZE must be positive")
+	}
+
+	if cfg.PhoneVerification.Channel != "WHATSAPP" && cfg.PhoneVerification.Channel != "SMS" {
+		return nil, errors.New("PHONE_VERIFICATION_CHANNEL must be WHATSAPP or SMS")
 	}
 
 	if cfg.JWT.SecretKey == "" {
@@ -96,6 +386,42 @@ func Load() (*Config, error) {
 		return nil, errors.New("missing app deployment url")
 	}
 
+	if cfg.OIDC.IssuerURL != "" && (cfg.OIDC.ClientID == "" || cfg.OIDC.RedirectURL == "") {
+		return nil, errors.New("missing oidc client id or redirect url")
+	}
+
+	// TOTP secrets get their own key so a leaked email verification key cannot
+	// decrypt them. Deployments that relied on the old fallback set this to the
+	// value of APP_EMAIL_VERIFICATION_KEY to keep reading their stored secrets.
+	if cfg.App.AppTOTPEncryptionKey == "" {
+		return nil, errors.New("missing app totp encryption key")
+	}
+
+	// Webhook secrets are encrypted with AES, so the key must be 16, 24 or 32
+	// bytes long.
+	if cfg.Webhook.EncryptionKey == "" {
+		return nil, errors.New("missing webhook encryption key")
+	}
+
+	if !isAESKeyLength(cfg.Webhook.EncryptionKey) {
+		return nil, errors.New("WEBHOOK_ENCRYPTION_KEY must be 16, 24 or 32 bytes long")
+	}
+
+	// Check-in codes are signed with their own key so that one cannot be
+	// passed off as an access token or the other way round.
+	if cfg.CheckIn.SigningKey == "" {
+		return nil, errors.New("missing check-in signing key")
+	}
+
+	if cfg.CheckIn.SigningKey == cfg.JWT.SecretKey {
+		return nil, errors.New("check-in signing key must differ from the jwt secret")
+	}
+
+	// Local uploads are served by this server under /uploads.
+	if cfg.Storage.PublicBaseURL == "" && cfg.Storage.Driver == "local" {
+		cfg.Storage.PublicBaseURL = strings.TrimRight(cfg.App.AppDeploymentUrl, "/") + "/uploads"
+	}
+
 	if cfg.Database.Password == "" {
 		return nil, errors.New("missing database password")
 	}
@@ -103,6 +429,14 @@ func Load() (*Config, error) {
 	return cfg, nil
 }
 
+func isAESKeyLength(key string) bool {
+	switch len(key) {
+	case 16, 24, 32:
+		return true
+	}
+	return false
+}
+
 func getEnv(key, defaultVal string) string {
 	if val := os.Getenv(key); val != "" {
 		return val
@@ -110,3 +444,76 @@ func getEnv(key, defaultVal string) string {
 
 	return defaultVal
 }
+
+func getEnvInt(key string, defaultVal int) int {
+	if val, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return val
+	}
+
+	return defaultVal
+}
+
+func getEnvDuration(key string, defaultVal time.Duration) time.Duration {
+	if val, err := time.ParseDuration(os.Getenv(key)); err == nil {
+		return val
+	}
+
+	return defaultVal
+}
+
+// parseDurations parses a comma-separated list such as "24h,2h". Offsets are
+// stored in whole minutes, so anything shorter than a minute is rejected.
+func parseDurations(val string) ([]time.Duration, error) {
+	var durations []time.Duration
+	for _, part := range strings.Split(val, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		d, err := time.ParseDuration(part)
+		if err != nil {
+			return nil, err
+		}
+		if d < time.Minute {
+			return nil, fmt.Errorf("duration %q is shorter than a minute", part)
+		}
+
+		durations = append(durations, d)
+	}
+
+	return durations, nil
+}
+
+// parseCIDRs reads a comma separated list of CIDR ranges. A bare IP is a range
+// of one address.
+func parseCIDRs(val string) ([]*net.IPNet, error) {
+	var ranges []*net.IPNet
+	for _, part := range strings.Split(val, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		if !strings.Contains(part, "/") {
+			ip := net.ParseIP(part)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid IP address %q", part)
+			}
+			if ip.To4() != nil {
+				part += "/32"
+			} else {
+				part += "/128"
+			}
+		}
+
+		_, ipNet, err := net.ParseCIDR(part)
+		if err != nil {
+			return nil, err
+		}
+
+		ranges = append(ranges, ipNet)
+	}
+
+	return ranges, nil
+}
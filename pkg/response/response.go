@@ -6,6 +6,24 @@ type SuccessResponse struct {
 	Data    any    `json:"data,omitempty"`
 }
 
+// Pagination describes one page of a list. Clients page either by Offset, or
+// by passing NextCursor back as the cursor query parameter, which stays stable
+// while rows are added or removed.
+type Pagination struct {
+	Total      int64  `json:"total"`
+	Limit      int    `json:"limit"`
+	Offset     int    `json:"offset"`
+	HasMore    bool   `json:"has_more"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+type PaginatedResponse struct {
+	Success    bool       `json:"success"`
+	Message    string     `json:"message"`
+	Data       any        `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
 type ErrorResponse struct {
 	Success   bool   `json:"success"`
 	Error     string `json:"error"`
@@ -22,6 +40,15 @@ func Success(message string, data any) SuccessResponse {
 	}
 }
 
+func Paginated(message string, data any, pagination Pagination) PaginatedResponse {
+	return PaginatedResponse{
+		Success:    true,
+		Message:    message,
+		Data:       data,
+		Pagination: pagination,
+	}
+}
+
 func Error(err string, message string, details any) ErrorResponse {
 	return ErrorResponse{
 		Success: false,
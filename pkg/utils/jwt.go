@@ -10,14 +10,21 @@ import (
 
 type JWTClaims struct {
 	UserID string `json:"user_id"`
+	// RoleID is what permissions are checked against, so renaming a role does
+	// not affect tokens already issued. Role is the name, for display only.
+	RoleID uint   `json:"role_id"`
 	Role   string `json:"role"`
+	// Purpose is empty for access tokens. Challenge tokens carry a purpose and
+	// must never be accepted as access tokens.
+	Purpose string `json:"purpose,omitempty"`
 	jwt.RegisteredClaims
 }
 
-func GenerateJWT(userID, role string) (string, error) {
+func GenerateJWT(userID string, roleID uint, role string) (string, error) {
 	secretKey := os.Getenv("JWT_SECRET")
 	claims := JWTClaims{
 		UserID: userID,
+		RoleID: roleID,
 		Role:   role,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
@@ -51,3 +58,34 @@ func ParseJWT(tokenStr string) (*JWTClaims, error) {
 
 	return claims, nil
 }
+
+// GenerateChallengeJWT issues a short-lived token that only proves the first login
+// step succeeded for the given purpose.
+func GenerateChallengeJWT(userID, purpose string, ttl time.Duration) (string, error) {
+	secretKey := os.Getenv("JWT_SECRET")
+	claims := JWTClaims{
+		UserID:  userID,
+		Purpose: purpose,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+
+	return token.SignedString([]byte(secretKey))
+}
+
+func ParseChallengeJWT(tokenStr, purpose string) (*JWTClaims, error) {
+	claims, err := ParseJWT(tokenStr)
+	if err != nil {
+		return nil, err
+	}
+
+	if purpose == "" || claims.Purpose != purpose {
+		return nil, errors.New("invalid token purpose")
+	}
+
+	return claims, nil
+}